@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"net/http"
 	"os"
+	_ "time/tzdata"
 )
 
 func main() {
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/analytics/service"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/gorilla/mux"
+	"net/http"
+	"time"
+)
+
+// DefaultYears is the number of the years compared if the years query parameter is missing
+const DefaultYears = 2
+
+type AnalyticsHandlerObject struct {
+	analyticsService service.AnalyticsService
+}
+
+func NewAnalyticsHandler(analyticsService service.AnalyticsService) AnalyticsHandler {
+	return &AnalyticsHandlerObject{analyticsService}
+}
+
+func (a *AnalyticsHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewAnalyticsHandler(dependency.FindRequiredDependency[service.AnalyticsServiceObject, service.AnalyticsService](factory))
+}
+
+func (a *AnalyticsHandlerObject) Init(router *mux.Router) {
+	analyticsRouter := router.PathPrefix("/api/v1/houses").Subrouter()
+
+	analyticsRouter.Path("/{id}/providers/{providerId}/analytics").HandlerFunc(a.FindByProviderId()).Methods("GET")
+}
+
+type AnalyticsHandler interface {
+	FindByProviderId() http.HandlerFunc
+}
+
+// FindByProviderId returns the analytics of the provider payments of the house for the years query parameter ending with
+// the year of the date query parameter, the current date is used if the date is missing
+func (a *AnalyticsHandlerObject) FindByProviderId() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		id, userId, err := rest.GetIdRequestParameterAndUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		providerId, err := rest.GetUUIDRequestParameter(request, "providerId")
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		now := time.Now()
+		currency, _ := rest.GetQueryParamOrDefault(request, "currency", "")
+
+		if years, err := rest.GetQueryParamOrDefault(request, "years", DefaultYears); err != nil {
+			rest.HandleWithError(writer, err)
+		} else if date, err := rest.GetQueryParamOrDefaultReference[time.Time](request, "date", &now); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(a.analyticsService.FindByProviderId(id, providerId, userId, currency, years, *date)).
+				Perform()
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/VlasovArtem/hob/src/analytics/mocks"
+	"github.com/VlasovArtem/hob/src/analytics/model"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type AnalyticsHandlerTestSuite struct {
+	testhelper.MockTestSuite[AnalyticsHandler]
+	analytics *mocks.AnalyticsService
+}
+
+func TestAnalyticsHandlerTestSuite(t *testing.T) {
+	testingSuite := &AnalyticsHandlerTestSuite{}
+	testingSuite.TestObjectGenerator = func() AnalyticsHandler {
+		testingSuite.analytics = new(mocks.AnalyticsService)
+		return NewAnalyticsHandler(testingSuite.analytics)
+	}
+
+	suite.Run(t, testingSuite)
+}
+
+func (a *AnalyticsHandlerTestSuite) Test_FindByProviderId() {
+	id, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2022, time.March, 15, 0, 0, 0, 0, time.UTC)
+	month := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	total := model.MonthlyTotal{Month: month, Sum: money.FromMinorUnits(1000), Average: money.FromMinorUnits(1000)}
+	expected := model.ProviderAnalyticsDto{
+		HouseId:    id,
+		ProviderId: providerId,
+		Currency:   "EUR",
+		Years:      []model.YearDto{{Year: 2022, Sum: money.FromMinorUnits(1000)}},
+		Months:     []model.MonthDto{{Month: time.March, Sums: []money.Money{money.FromMinorUnits(1000)}}},
+		Trend:      []model.MonthlyTotal{total},
+		Min:        &total,
+		Max:        &total,
+	}
+
+	a.analytics.On("FindByProviderId", id, providerId, userId, "EUR", 1, date).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/providers/{providerId}/analytics?years={years}&currency={currency}&date={date}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(a.TestO.FindByProviderId()).
+		WithVar("id", id.String()).
+		WithVar("providerId", providerId.String()).
+		WithParameter("years", "1").
+		WithParameter("currency", "EUR").
+		WithParameter("date", date.Format(time.RFC3339))
+
+	content := testRequest.Verify(a.T(), http.StatusOK)
+
+	actual := model.ProviderAnalyticsDto{}
+
+	assert.Nil(a.T(), json.Unmarshal(content, &actual))
+	assert.Equal(a.T(), expected, actual)
+}
+
+func (a *AnalyticsHandlerTestSuite) Test_FindByProviderId_WithDefaultParameters() {
+	id, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+
+	a.analytics.On("FindByProviderId", id, providerId, userId, "", DefaultYears, mock.AnythingOfType("time.Time")).
+		Return(model.ProviderAnalyticsDto{}, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/providers/{providerId}/analytics").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(a.TestO.FindByProviderId()).
+		WithVar("id", id.String()).
+		WithVar("providerId", providerId.String())
+
+	testRequest.Verify(a.T(), http.StatusOK)
+}
+
+func (a *AnalyticsHandlerTestSuite) Test_FindByProviderId_WithInvalidProviderId() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/providers/{providerId}/analytics").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(a.TestO.FindByProviderId()).
+		WithVar("id", uuid.New().String()).
+		WithVar("providerId", "id")
+
+	testRequest.Verify(a.T(), http.StatusBadRequest)
+
+	a.analytics.AssertNotCalled(a.T(), "FindByProviderId", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (a *AnalyticsHandlerTestSuite) Test_FindByProviderId_WithInvalidYears() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/providers/{providerId}/analytics?years={years}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(a.TestO.FindByProviderId()).
+		WithVar("id", uuid.New().String()).
+		WithVar("providerId", uuid.New().String()).
+		WithParameter("years", "many")
+
+	testRequest.Verify(a.T(), http.StatusBadRequest)
+}
+
+func (a *AnalyticsHandlerTestSuite) Test_FindByProviderId_WithErrorFromService() {
+	id, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+
+	a.analytics.On("FindByProviderId", id, providerId, userId, "", 11, mock.AnythingOfType("time.Time")).
+		Return(model.ProviderAnalyticsDto{}, errors.New("years should be between 1 and 10"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/providers/{providerId}/analytics?years={years}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(a.TestO.FindByProviderId()).
+		WithVar("id", id.String()).
+		WithVar("providerId", providerId.String()).
+		WithParameter("years", "11")
+
+	content := testRequest.Verify(a.T(), http.StatusBadRequest)
+
+	assert.Equal(a.T(), "years should be between 1 and 10\n", string(content))
+}
+
+func (a *AnalyticsHandlerTestSuite) Test_FindByProviderId_WithNotFoundErrorFromService() {
+	id, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+
+	a.analytics.On("FindByProviderId", id, providerId, userId, "", DefaultYears, mock.AnythingOfType("time.Time")).
+		Return(model.ProviderAnalyticsDto{}, interrors.NewErrNotFound("house with id %s not found", id))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/providers/{providerId}/analytics").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(a.TestO.FindByProviderId()).
+		WithVar("id", id.String()).
+		WithVar("providerId", providerId.String())
+
+	testRequest.Verify(a.T(), http.StatusNotFound)
+}
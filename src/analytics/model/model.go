@@ -0,0 +1,54 @@
+package model
+
+import (
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/google/uuid"
+	"math"
+	"time"
+)
+
+// MonthlyTotal is the sum of the provider payments of the month, the average is the rolling average of the 12 months
+// ending with the month
+type MonthlyTotal struct {
+	Month   time.Time
+	Sum     money.Money
+	Average money.Money
+}
+
+// YearDto is the sum of the provider payments of the year, the change is the percentage change compared with the same
+// months of the previous year
+type YearDto struct {
+	Year   int
+	Sum    money.Money
+	Change *float64
+}
+
+// MonthDto is the sums of the calendar month side by side in the order of the years, the change is the percentage change
+// of the last year compared with the previous one
+type MonthDto struct {
+	Month  time.Month
+	Sums   []money.Money
+	Change *float64
+}
+
+// ProviderAnalyticsDto is the trend of the provider payments of the house in the currency, the months without payments
+// are not taken into account for the min and max months
+type ProviderAnalyticsDto struct {
+	HouseId    uuid.UUID
+	ProviderId uuid.UUID
+	Currency   string
+	Years      []YearDto
+	Months     []MonthDto
+	Trend      []MonthlyTotal
+	Min        *MonthlyTotal
+	Max        *MonthlyTotal
+}
+
+// Change returns the percentage change of the current sum rounded to hundredths, it is nil if the previous sum is zero
+func Change(current, previous money.Money) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	change := math.Round(float64(current-previous)/float64(previous)*10000) / 100
+	return &change
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/analytics/model"
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	houses "github.com/VlasovArtem/hob/src/house/service"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	payments "github.com/VlasovArtem/hob/src/payment/repository"
+	providers "github.com/VlasovArtem/hob/src/provider/service"
+	"github.com/google/uuid"
+	"time"
+)
+
+// MaxYears is the max number of the years compared side by side
+const MaxYears = 10
+
+type AnalyticsServiceObject struct {
+	houseService      houses.HouseService
+	providerService   providers.ProviderService
+	paymentRepository payments.PaymentRepository
+}
+
+func NewAnalyticsService(
+	houseService houses.HouseService,
+	providerService providers.ProviderService,
+	paymentRepository payments.PaymentRepository) AnalyticsService {
+	return &AnalyticsServiceObject{
+		houseService:      houseService,
+		providerService:   providerService,
+		paymentRepository: paymentRepository,
+	}
+}
+
+func (a *AnalyticsServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewAnalyticsService(
+		dependency.FindRequiredDependency[houses.HouseServiceObject, houses.HouseService](factory),
+		dependency.FindRequiredDependency[providers.ProviderServiceObject, providers.ProviderService](factory),
+		dependency.FindRequiredDependency[payments.PaymentRepositoryObject, payments.PaymentRepository](factory),
+	)
+}
+
+type AnalyticsService interface {
+	FindByProviderId(houseId uuid.UUID, providerId uuid.UUID, userId uuid.UUID, currency string, years int, date time.Time) (model.ProviderAnalyticsDto, error)
+}
+
+// FindByProviderId returns the monthly totals of the provider payments of the house for the years ending with the year of
+// the date, the months after the date are not included. The payments in the other currencies are not counted, the
+// currency of the house country is used if the currency is empty
+func (a *AnalyticsServiceObject) FindByProviderId(houseId uuid.UUID, providerId uuid.UUID, userId uuid.UUID, currency string, years int, date time.Time) (model.ProviderAnalyticsDto, error) {
+	if years < 1 || years > MaxYears {
+		return model.ProviderAnalyticsDto{}, errors.New(fmt.Sprintf("years should be between 1 and %d", MaxYears))
+	}
+	if !a.houseService.HasAccess(houseId, userId) {
+		return model.ProviderAnalyticsDto{}, interrors.NewErrNotFound("house with id %s not found", houseId)
+	}
+	if _, err := a.providerService.FindById(providerId, userId); err != nil {
+		return model.ProviderAnalyticsDto{}, err
+	}
+	currency, err := a.houseService.ResolveCurrency(&houseId, currency)
+	if err != nil {
+		return model.ProviderAnalyticsDto{}, err
+	}
+
+	firstYear := date.Year() - years + 1
+	from := time.Date(firstYear, time.January, 1, 0, 0, 0, 0, date.Location())
+	to := time.Date(date.Year(), date.Month()+1, 1, 0, 0, 0, 0, date.Location())
+
+	totals, err := a.paymentRepository.FindMonthlyTotals(houseId, providerId, currency, from, to)
+	if err != nil {
+		return model.ProviderAnalyticsDto{}, err
+	}
+
+	trend := common.MapSlice(totals, monthlyTotal)
+
+	return model.ProviderAnalyticsDto{
+		HouseId:    houseId,
+		ProviderId: providerId,
+		Currency:   currency,
+		Years:      yearTotals(trend, firstYear, years, date.Month()),
+		Months:     monthTotals(trend, firstYear, years, date.Month()),
+		Trend:      trend,
+		Min:        extreme(trend, func(sum, current money.Money) bool { return sum < current }),
+		Max:        extreme(trend, func(sum, current money.Money) bool { return sum > current }),
+	}, nil
+}
+
+// sums returns the sums of the trend by the year index and the month
+func sums(trend []model.MonthlyTotal, firstYear int, years int) [][12]money.Money {
+	result := make([][12]money.Money, years)
+	for _, total := range trend {
+		if index := total.Month.Year() - firstYear; index >= 0 && index < years {
+			result[index][total.Month.Month()-1] += total.Sum
+		}
+	}
+	return result
+}
+
+// yearTotals returns the sums of the years, the last year is compared with the same months of the previous year as it
+// ends with the month of the date
+func yearTotals(trend []model.MonthlyTotal, firstYear int, years int, lastMonth time.Month) []model.YearDto {
+	bySums := sums(trend, firstYear, years)
+	result := make([]model.YearDto, 0, years)
+
+	for index := range bySums {
+		months := time.December
+		if index == years-1 {
+			months = lastMonth
+		}
+		var sum, previous money.Money
+		for month := 0; month < int(months); month++ {
+			sum += bySums[index][month]
+			if index > 0 {
+				previous += bySums[index-1][month]
+			}
+		}
+
+		year := model.YearDto{Year: firstYear + index, Sum: sum}
+		if index > 0 {
+			year.Change = model.Change(sum, previous)
+		}
+		result = append(result, year)
+	}
+	return result
+}
+
+// monthTotals returns the sums of the calendar months side by side, the months of the last year after the month of the
+// date are not compared
+func monthTotals(trend []model.MonthlyTotal, firstYear int, years int, lastMonth time.Month) []model.MonthDto {
+	bySums := sums(trend, firstYear, years)
+	result := make([]model.MonthDto, 0, 12)
+
+	for month := time.January; month <= time.December; month++ {
+		monthDto := model.MonthDto{Month: month, Sums: make([]money.Money, 0, years)}
+		for index := range bySums {
+			monthDto.Sums = append(monthDto.Sums, bySums[index][month-1])
+		}
+		if years > 1 && month <= lastMonth {
+			monthDto.Change = model.Change(bySums[years-1][month-1], bySums[years-2][month-1])
+		}
+		result = append(result, monthDto)
+	}
+	return result
+}
+
+func monthlyTotal(total paymentModel.MonthlyTotal) model.MonthlyTotal {
+	return model.MonthlyTotal{
+		Month:   total.Month,
+		Sum:     total.Sum,
+		Average: total.Average,
+	}
+}
+
+// extreme returns the first month with payments that wins the comparison with all the other months with payments
+func extreme(trend []model.MonthlyTotal, wins func(sum, current money.Money) bool) *model.MonthlyTotal {
+	var result *model.MonthlyTotal
+	for index := range trend {
+		if trend[index].Sum == 0 {
+			continue
+		}
+		if result == nil || wins(trend[index].Sum, result.Sum) {
+			result = &trend[index]
+		}
+	}
+	return result
+}
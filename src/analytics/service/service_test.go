@@ -0,0 +1,186 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/analytics/model"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+var (
+	date = time.Date(2022, time.March, 15, 10, 0, 0, 0, time.UTC)
+	from = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to   = time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type AnalyticsServiceTestSuite struct {
+	testhelper.MockTestSuite[AnalyticsService]
+	houseService      *houseMocks.HouseService
+	providerService   *providerMocks.ProviderService
+	paymentRepository *paymentMocks.PaymentRepository
+}
+
+func TestAnalyticsServiceTestSuite(t *testing.T) {
+	ts := &AnalyticsServiceTestSuite{}
+	ts.TestObjectGenerator = func() AnalyticsService {
+		ts.houseService = new(houseMocks.HouseService)
+		ts.providerService = new(providerMocks.ProviderService)
+		ts.paymentRepository = new(paymentMocks.PaymentRepository)
+
+		return NewAnalyticsService(ts.houseService, ts.providerService, ts.paymentRepository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (a *AnalyticsServiceTestSuite) Test_FindByProviderId() {
+	houseId, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+	totals, trend := generateTrend(1000, 800, 0, 500, 500, 500, 500, 500, 500, 500, 500, 500, 1200, 800, 300)
+
+	a.houseService.On("HasAccess", houseId, userId).Return(true)
+	a.houseService.On("ResolveCurrency", &houseId, "").Return("UAH", nil)
+	a.providerService.On("FindById", providerId, userId).Return(providerModel.ProviderDto{Id: providerId}, nil)
+	a.paymentRepository.On("FindMonthlyTotals", houseId, providerId, "UAH", from, to).Return(totals, nil)
+
+	actual, err := a.TestO.FindByProviderId(houseId, providerId, userId, "", 2, date)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), houseId, actual.HouseId)
+	assert.Equal(a.T(), providerId, actual.ProviderId)
+	assert.Equal(a.T(), "UAH", actual.Currency)
+	assert.Equal(a.T(), trend, actual.Trend)
+	assert.Equal(a.T(), []model.YearDto{
+		{Year: 2021, Sum: money.FromMinorUnits(6300)},
+		{Year: 2022, Sum: money.FromMinorUnits(2300), Change: change(27.78)},
+	}, actual.Years)
+	assert.Len(a.T(), actual.Months, 12)
+	assert.Equal(a.T(), []model.MonthDto{
+		{Month: time.January, Sums: []money.Money{money.FromMinorUnits(1000), money.FromMinorUnits(1200)}, Change: change(20)},
+		{Month: time.February, Sums: []money.Money{money.FromMinorUnits(800), money.FromMinorUnits(800)}, Change: change(0)},
+		{Month: time.March, Sums: []money.Money{0, money.FromMinorUnits(300)}},
+		{Month: time.April, Sums: []money.Money{money.FromMinorUnits(500), 0}},
+	}, actual.Months[:4])
+	assert.Equal(a.T(), &trend[14], actual.Min)
+	assert.Equal(a.T(), &trend[12], actual.Max)
+}
+
+func (a *AnalyticsServiceTestSuite) Test_FindByProviderId_WithoutPayments() {
+	houseId, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+	totals, _ := generateTrend(make([]int64, 15)...)
+
+	a.houseService.On("HasAccess", houseId, userId).Return(true)
+	a.houseService.On("ResolveCurrency", &houseId, "EUR").Return("EUR", nil)
+	a.providerService.On("FindById", providerId, userId).Return(providerModel.ProviderDto{Id: providerId}, nil)
+	a.paymentRepository.On("FindMonthlyTotals", houseId, providerId, "EUR", from, to).Return(totals, nil)
+
+	actual, err := a.TestO.FindByProviderId(houseId, providerId, userId, "EUR", 2, date)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), []model.YearDto{{Year: 2021}, {Year: 2022}}, actual.Years)
+	assert.Nil(a.T(), actual.Months[0].Change)
+	assert.Nil(a.T(), actual.Min)
+	assert.Nil(a.T(), actual.Max)
+}
+
+func (a *AnalyticsServiceTestSuite) Test_FindByProviderId_WithErrorFromRepository() {
+	houseId, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+	expectedError := errors.New("error")
+
+	a.houseService.On("HasAccess", houseId, userId).Return(true)
+	a.houseService.On("ResolveCurrency", &houseId, "").Return("UAH", nil)
+	a.providerService.On("FindById", providerId, userId).Return(providerModel.ProviderDto{Id: providerId}, nil)
+	a.paymentRepository.On("FindMonthlyTotals", houseId, providerId, "UAH", from, to).Return(nil, expectedError)
+
+	actual, err := a.TestO.FindByProviderId(houseId, providerId, userId, "", 2, date)
+
+	assert.Equal(a.T(), expectedError, err)
+	assert.Equal(a.T(), model.ProviderAnalyticsDto{}, actual)
+}
+
+func (a *AnalyticsServiceTestSuite) Test_FindByProviderId_WithInvalidYears() {
+	tests := []struct {
+		name  string
+		years int
+	}{
+		{"zero", 0},
+		{"too many", MaxYears + 1},
+	}
+
+	for _, test := range tests {
+		a.Run(test.name, func() {
+			actual, err := a.TestO.FindByProviderId(uuid.New(), uuid.New(), uuid.New(), "", test.years, date)
+
+			assert.Equal(a.T(), errors.New("years should be between 1 and 10"), err)
+			assert.Equal(a.T(), model.ProviderAnalyticsDto{}, actual)
+		})
+	}
+
+	a.houseService.AssertNotCalled(a.T(), "HasAccess", mock.Anything, mock.Anything)
+}
+
+func (a *AnalyticsServiceTestSuite) Test_FindByProviderId_WithoutAccess() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	a.houseService.On("HasAccess", houseId, userId).Return(false)
+
+	actual, err := a.TestO.FindByProviderId(houseId, uuid.New(), userId, "", 2, date)
+
+	assert.Equal(a.T(), interrors.NewErrNotFound("house with id %s not found", houseId), err)
+	assert.Equal(a.T(), model.ProviderAnalyticsDto{}, actual)
+}
+
+func (a *AnalyticsServiceTestSuite) Test_FindByProviderId_WithMissingProvider() {
+	houseId, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+	expectedError := interrors.NewErrNotFound("provider with id %s not found", providerId)
+
+	a.houseService.On("HasAccess", houseId, userId).Return(true)
+	a.providerService.On("FindById", providerId, userId).Return(providerModel.ProviderDto{}, expectedError)
+
+	actual, err := a.TestO.FindByProviderId(houseId, providerId, userId, "", 2, date)
+
+	assert.Equal(a.T(), expectedError, err)
+	assert.Equal(a.T(), model.ProviderAnalyticsDto{}, actual)
+	a.paymentRepository.AssertNotCalled(a.T(), "FindMonthlyTotals", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (a *AnalyticsServiceTestSuite) Test_FindByProviderId_WithInvalidCurrency() {
+	houseId, providerId, userId := uuid.New(), uuid.New(), uuid.New()
+	expectedError := errors.New("currency not found")
+
+	a.houseService.On("HasAccess", houseId, userId).Return(true)
+	a.houseService.On("ResolveCurrency", &houseId, "XXX").Return("", expectedError)
+	a.providerService.On("FindById", providerId, userId).Return(providerModel.ProviderDto{Id: providerId}, nil)
+
+	actual, err := a.TestO.FindByProviderId(houseId, providerId, userId, "XXX", 2, date)
+
+	assert.Equal(a.T(), expectedError, err)
+	assert.Equal(a.T(), model.ProviderAnalyticsDto{}, actual)
+}
+
+// generateTrend returns the monthly totals of the repository and the expected trend of the analytics
+func generateTrend(sums ...int64) ([]paymentModel.MonthlyTotal, []model.MonthlyTotal) {
+	totals := make([]paymentModel.MonthlyTotal, 0, len(sums))
+	trend := make([]model.MonthlyTotal, 0, len(sums))
+	for index, sum := range sums {
+		month := from.AddDate(0, index, 0)
+		totals = append(totals, paymentModel.MonthlyTotal{Month: month, Sum: money.FromMinorUnits(sum), Average: money.FromMinorUnits(sum)})
+		trend = append(trend, model.MonthlyTotal{Month: month, Sum: money.FromMinorUnits(sum), Average: money.FromMinorUnits(sum)})
+	}
+	return totals, trend
+}
+
+func change(value float64) *float64 {
+	return &value
+}
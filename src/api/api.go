@@ -1,9 +1,14 @@
 package api
 
 import (
+	analyticsHandler "github.com/VlasovArtem/hob/src/analytics/handler"
 	"github.com/VlasovArtem/hob/src/app"
+	authHandler "github.com/VlasovArtem/hob/src/auth/handler"
+	budgetHandler "github.com/VlasovArtem/hob/src/budget/handler"
+	categoryHandler "github.com/VlasovArtem/hob/src/category/handler"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	countryHandler "github.com/VlasovArtem/hob/src/country/handler"
+	exchangeHandler "github.com/VlasovArtem/hob/src/exchange/handler"
 	"github.com/VlasovArtem/hob/src/group/handler"
 	healthHandler "github.com/VlasovArtem/hob/src/health/handler"
 	houseHandler "github.com/VlasovArtem/hob/src/house/handler"
@@ -13,6 +18,10 @@ import (
 	paymentHandler "github.com/VlasovArtem/hob/src/payment/handler"
 	paymentSchedulerHandler "github.com/VlasovArtem/hob/src/payment/scheduler/handler"
 	providerHandler "github.com/VlasovArtem/hob/src/provider/handler"
+	reportHandler "github.com/VlasovArtem/hob/src/report/handler"
+	schedulerHandler "github.com/VlasovArtem/hob/src/scheduler/handler"
+	summaryHandler "github.com/VlasovArtem/hob/src/summary/handler"
+	tagHandler "github.com/VlasovArtem/hob/src/tag/handler"
 	userHandler "github.com/VlasovArtem/hob/src/user/handler"
 	"github.com/gorilla/mux"
 )
@@ -22,18 +31,28 @@ type ApplicationHandler interface {
 	Init(*mux.Router)
 }
 
+// InitApi registers the handlers, all the routes except the public ones require the access token
 func InitApi(router *mux.Router, application *app.RootApplication) {
+	addHandler(router, application, new(authHandler.AuthHandlerObject))
 	addHandler(router, application, new(countryHandler.CountryHandlerObject))
 	addHandler(router, application, new(userHandler.UserHandlerObject))
 	addHandler(router, application, new(houseHandler.HouseHandlerObject))
 	addHandler(router, application, new(providerHandler.ProviderHandlerObject))
+	addHandler(router, application, new(categoryHandler.CategoryHandlerObject))
+	addHandler(router, application, new(tagHandler.TagHandlerObject))
 	addHandler(router, application, new(paymentHandler.PaymentHandlerObject))
 	addHandler(router, application, new(paymentSchedulerHandler.PaymentSchedulerHandlerObject))
 	addHandler(router, application, new(meterHandler.MeterHandlerObject))
 	addHandler(router, application, new(incomeHandler.IncomeHandlerObject))
 	addHandler(router, application, new(incomeSchedulerHandler.IncomeSchedulerHandlerObject))
+	addHandler(router, application, new(schedulerHandler.SchedulerHandlerObject))
 	addHandler(router, application, new(healthHandler.HealthHandlerObject))
 	addHandler(router, application, new(handler.GroupHandlerObject))
+	addHandler(router, application, new(exchangeHandler.ExchangeRateHandlerObject))
+	addHandler(router, application, new(reportHandler.ReportHandlerObject))
+	addHandler(router, application, new(budgetHandler.BudgetHandlerObject))
+	addHandler(router, application, new(summaryHandler.SummaryHandlerObject))
+	addHandler(router, application, new(analyticsHandler.AnalyticsHandlerObject))
 }
 
 func addHandler(router *mux.Router, application *app.RootApplication, handler ApplicationHandler) {
@@ -1,16 +1,32 @@
 package app
 
 import (
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	analyticsService "github.com/VlasovArtem/hob/src/analytics/service"
+	attemptModel "github.com/VlasovArtem/hob/src/auth/attempt/model"
+	attemptRepository "github.com/VlasovArtem/hob/src/auth/attempt/repository"
+	attemptService "github.com/VlasovArtem/hob/src/auth/attempt/service"
+	authModel "github.com/VlasovArtem/hob/src/auth/model"
+	authService "github.com/VlasovArtem/hob/src/auth/service"
+	budgetRepository "github.com/VlasovArtem/hob/src/budget/repository"
+	budgetService "github.com/VlasovArtem/hob/src/budget/service"
+	categoryRepository "github.com/VlasovArtem/hob/src/category/repository"
+	categoryService "github.com/VlasovArtem/hob/src/category/service"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/common/environment"
 	"github.com/VlasovArtem/hob/src/config"
 	"github.com/VlasovArtem/hob/src/country/model"
 	countries "github.com/VlasovArtem/hob/src/country/service"
 	"github.com/VlasovArtem/hob/src/db"
+	exchangeRepository "github.com/VlasovArtem/hob/src/exchange/repository"
+	exchangeService "github.com/VlasovArtem/hob/src/exchange/service"
+	groupMemberRepository "github.com/VlasovArtem/hob/src/group/member/repository"
 	"github.com/VlasovArtem/hob/src/group/repository"
 	groupService "github.com/VlasovArtem/hob/src/group/service"
+	holidayModel "github.com/VlasovArtem/hob/src/holiday/model"
+	holidays "github.com/VlasovArtem/hob/src/holiday/service"
 	houseRepository "github.com/VlasovArtem/hob/src/house/repository"
 	houseService "github.com/VlasovArtem/hob/src/house/service"
 	incomeRepository "github.com/VlasovArtem/hob/src/income/repository"
@@ -19,31 +35,59 @@ import (
 	incomeService "github.com/VlasovArtem/hob/src/income/service"
 	meterRepository "github.com/VlasovArtem/hob/src/meter/repository"
 	meterService "github.com/VlasovArtem/hob/src/meter/service"
+	"github.com/VlasovArtem/hob/src/notification"
 	paymentRepository "github.com/VlasovArtem/hob/src/payment/repository"
 	paymentSchedulerRepository "github.com/VlasovArtem/hob/src/payment/scheduler/repository"
 	paymentSchedulerService "github.com/VlasovArtem/hob/src/payment/scheduler/service"
 	paymentService "github.com/VlasovArtem/hob/src/payment/service"
 	providerRepository "github.com/VlasovArtem/hob/src/provider/repository"
 	providerService "github.com/VlasovArtem/hob/src/provider/service"
+	reportService "github.com/VlasovArtem/hob/src/report/service"
 	"github.com/VlasovArtem/hob/src/scheduler"
+	schedulerLockRepository "github.com/VlasovArtem/hob/src/scheduler/lock/repository"
+	schedulerLockService "github.com/VlasovArtem/hob/src/scheduler/lock/service"
+	schedulerRunRepository "github.com/VlasovArtem/hob/src/scheduler/run/repository"
+	schedulerRunService "github.com/VlasovArtem/hob/src/scheduler/run/service"
+	summaryService "github.com/VlasovArtem/hob/src/summary/service"
+	tagRepository "github.com/VlasovArtem/hob/src/tag/repository"
+	tagService "github.com/VlasovArtem/hob/src/tag/service"
+	accountRepository "github.com/VlasovArtem/hob/src/user/account/repository"
+	accountService "github.com/VlasovArtem/hob/src/user/account/service"
+	apiKeyRepository "github.com/VlasovArtem/hob/src/user/apikey/repository"
+	apiKeyService "github.com/VlasovArtem/hob/src/user/apikey/service"
 	userRepository "github.com/VlasovArtem/hob/src/user/repository"
+	resetRepository "github.com/VlasovArtem/hob/src/user/reset/repository"
+	resetService "github.com/VlasovArtem/hob/src/user/reset/service"
 	userService "github.com/VlasovArtem/hob/src/user/service"
 	userRequestValidator "github.com/VlasovArtem/hob/src/user/validator"
 	"github.com/rs/zerolog/log"
 	"io/ioutil"
 	"reflect"
+	"time"
 )
 
 const (
-	hostEnvironmentName     = "DB_HOST"
-	portEnvironmentName     = "DB_PORT"
-	userEnvironmentName     = "DB_USER"
-	passwordEnvironmentName = "DB_PASSWORD"
-	dbnameEnvironmentName   = "DB_NAME"
-	countriesDirVariable    = "COUNTRIES_DIR"
+	hostEnvironmentName      = "DB_HOST"
+	portEnvironmentName      = "DB_PORT"
+	userEnvironmentName      = "DB_USER"
+	passwordEnvironmentName  = "DB_PASSWORD"
+	dbnameEnvironmentName    = "DB_NAME"
+	countriesDirVariable     = "COUNTRIES_DIR"
+	catchUpPolicyVariable    = "SCHEDULER_CATCH_UP_POLICY"
+	authSecretVariable       = "AUTH_SECRET"
+	accessTokenTTLVariable   = "AUTH_ACCESS_TOKEN_TTL"
+	refreshTokenTTLVariable  = "AUTH_REFRESH_TOKEN_TTL"
+	loginMaxFailuresVariable = "LOGIN_MAX_FAILURES"
+	loginLockoutVariable     = "LOGIN_LOCKOUT"
+	loginMaxLockoutVariable  = "LOGIN_MAX_LOCKOUT"
+	notifierTypeVariable     = "NOTIFIER_TYPE"
+	notifierFileVariable     = "NOTIFIER_FILE"
 )
 
-var migratorType = reflect.TypeOf((*dependency.ObjectDatabaseMigrator)(nil)).Elem()
+var (
+	migratorType = reflect.TypeOf((*dependency.ObjectDatabaseMigrator)(nil)).Elem()
+	starterType  = reflect.TypeOf((*dependency.ObjectStarter)(nil)).Elem()
+)
 
 type RootApplication struct {
 	DependenciesFactory dependency.DependenciesProvider
@@ -67,6 +111,16 @@ func NewRootApplication(config *config.Config) *RootApplication {
 
 	applicationService.createCountriesService()
 
+	applicationService.createHolidaysService()
+
+	applicationService.createSchedulerConfiguration()
+
+	applicationService.createAuthConfiguration()
+
+	applicationService.createAttemptConfiguration()
+
+	applicationService.createNotifierConfiguration()
+
 	applicationService.addAutoInitializingDependencies()
 
 	return applicationService
@@ -84,36 +138,136 @@ func (a *RootApplication) createDatabaseConfiguration() {
 	a.DependenciesFactory.Add(configuration)
 }
 
+func (a *RootApplication) createSchedulerConfiguration() {
+	policy, err := scheduler.ParseCatchUpPolicy(environment.GetEnvironmentVariable(catchUpPolicyVariable, string(scheduler.CatchUpAll)))
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("Scheduler configuration is not valid")
+	}
+
+	a.DependenciesFactory.Add(scheduler.SchedulerConfiguration{CatchUpPolicy: policy})
+}
+
+// createAuthConfiguration generates the random secret if it is not provided, the tokens are not valid after the restart
+// and on the other instances of the application in this case
+func (a *RootApplication) createAuthConfiguration() {
+	secret := []byte(environment.GetEnvironmentVariable(authSecretVariable, ""))
+
+	if len(secret) == 0 {
+		log.Warn().Msgf("%s is not set, the random secret is used", authSecretVariable)
+
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatal().Err(err).Msg("Auth secret is not generated")
+		}
+	}
+
+	configuration := authModel.NewDefaultAuthConfiguration(secret)
+	configuration.AccessTokenTTL = a.durationVariable(accessTokenTTLVariable, configuration.AccessTokenTTL)
+	configuration.RefreshTokenTTL = a.durationVariable(refreshTokenTTLVariable, configuration.RefreshTokenTTL)
+
+	a.DependenciesFactory.Add(configuration)
+}
+
+func (a *RootApplication) createAttemptConfiguration() {
+	configuration := attemptModel.NewDefaultAttemptConfiguration()
+	configuration.MaxFailures = environment.GetEnvironmentIntVariable(loginMaxFailuresVariable, configuration.MaxFailures)
+	configuration.Lockout = a.durationVariable(loginLockoutVariable, configuration.Lockout)
+	configuration.MaxLockout = a.durationVariable(loginMaxLockoutVariable, configuration.MaxLockout)
+
+	a.DependenciesFactory.Add(configuration)
+}
+
+func (a *RootApplication) createNotifierConfiguration() {
+	configuration := notification.NewDefaultNotifierConfiguration()
+
+	notifierType, err := notification.ParseNotifierType(environment.GetEnvironmentVariable(notifierTypeVariable, string(configuration.Type)))
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("Notifier configuration is not valid")
+	}
+
+	configuration.Type = notifierType
+	configuration.File = environment.GetEnvironmentVariable(notifierFileVariable, configuration.File)
+
+	a.DependenciesFactory.Add(configuration)
+}
+
+func (a *RootApplication) durationVariable(name string, defaultValue time.Duration) time.Duration {
+	duration, err := time.ParseDuration(environment.GetEnvironmentVariable(name, defaultValue.String()))
+
+	if err != nil {
+		log.Fatal().Err(err).Msgf("%s is not valid duration", name)
+	}
+
+	return duration
+}
+
 func (a *RootApplication) addAutoInitializingDependencies() {
 	initializers := []dependency.ObjectDependencyInitializer{
 		new(userRequestValidator.UserRequestValidatorObject),
 		new(userRepository.UserRepositoryObject),
 		new(userService.UserServiceObject),
+		new(notification.NotifierObject),
+		new(resetRepository.PasswordResetRepositoryObject),
+		new(resetService.PasswordResetServiceObject),
+		new(apiKeyRepository.ApiKeyRepositoryObject),
+		new(apiKeyService.ApiKeyServiceObject),
+		new(attemptRepository.LoginAttemptRepositoryObject),
+		new(attemptService.LoginAttemptServiceObject),
+		new(authService.AuthServiceObject),
 		new(repository.GroupRepositoryObject),
+		new(groupMemberRepository.GroupMemberRepositoryObject),
 		new(groupService.GroupServiceObject),
 		new(houseRepository.HouseRepositoryObject),
 		new(houseService.HouseServiceObject),
 		new(scheduler.SchedulerServiceObject),
+		new(schedulerRunRepository.SchedulerRunRepositoryObject),
+		new(schedulerRunService.SchedulerRunServiceObject),
+		new(schedulerLockRepository.SchedulerLockRepositoryObject),
+		new(schedulerLockService.SchedulerLockServiceObject),
 		new(providerRepository.ProviderRepositoryObject),
 		new(providerService.ProviderServiceObject),
+		new(categoryRepository.CategoryRepositoryObject),
+		new(categoryService.CategoryServiceObject),
+		new(tagRepository.TagRepositoryObject),
+		new(tagService.TagServiceObject),
 		new(paymentRepository.PaymentRepositoryObject),
 		new(paymentService.PaymentServiceObject),
-		new(paymentSchedulerRepository.PaymentSchedulerRepositoryObject),
-		new(paymentSchedulerService.PaymentSchedulerServiceObject),
 		new(meterRepository.MeterRepositoryObject),
 		new(meterService.MeterServiceObject),
+		new(paymentSchedulerRepository.PaymentSchedulerRepositoryObject),
+		new(paymentSchedulerService.PaymentSchedulerServiceObject),
 		new(incomeRepository.IncomeRepositoryObject),
 		new(incomeService.IncomeServiceObject),
 		new(incomeSchedulerRepository.IncomeSchedulerRepositoryObject),
 		new(incomeSchedulerService.IncomeSchedulerServiceObject),
+		new(exchangeRepository.ExchangeRateRepositoryObject),
+		new(exchangeService.ExchangeRateServiceObject),
+		new(reportService.ReportServiceObject),
+		new(budgetRepository.BudgetRepositoryObject),
+		new(budgetService.BudgetServiceObject),
+		new(summaryService.SummaryServiceObject),
+		new(analyticsService.AnalyticsServiceObject),
+		new(accountRepository.AccountRepositoryObject),
+		new(accountService.AccountServiceObject),
 	}
 
+	var starters []dependency.ObjectStarter
+
 	for _, initializer := range initializers {
 		autoDependency := a.DependenciesFactory.AddAutoDependency(initializer)
 
 		if reflect.TypeOf(autoDependency).Implements(migratorType) {
 			a.migrate(autoDependency.(dependency.ObjectDatabaseMigrator))
 		}
+		if reflect.TypeOf(autoDependency).Implements(starterType) {
+			starters = append(starters, autoDependency.(dependency.ObjectStarter))
+		}
+	}
+
+	for _, starter := range starters {
+		a.start(starter)
 	}
 }
 
@@ -121,11 +275,22 @@ func (a *RootApplication) migrate(object dependency.ObjectDatabaseMigrator) {
 	if a.databaseService == nil {
 		log.Fatal().Msg("DatabaseService is not initialized")
 	}
+	if upgrader, ok := object.(dependency.ObjectSchemaUpgrader); ok {
+		if err := upgrader.UpgradeSchema(); err != nil {
+			log.Fatal().Err(err).Msgf("%s schema upgrade failed", reflect.TypeOf(object))
+		}
+	}
 	if err := a.databaseService.D().AutoMigrate(object.GetEntity()); err != nil {
 		log.Fatal().Err(err)
 	}
 }
 
+func (a *RootApplication) start(object dependency.ObjectStarter) {
+	if err := object.Start(); err != nil {
+		log.Fatal().Err(err).Msgf("%s start failed", reflect.TypeOf(object))
+	}
+}
+
 func (a *RootApplication) createCountriesService() {
 	file, err := ioutil.ReadFile(fmt.Sprintf("%scontent/countries.json", environment.GetEnvironmentVariable(countriesDirVariable, "./")))
 
@@ -145,3 +310,19 @@ func (a *RootApplication) createCountriesService() {
 
 	a.DependenciesFactory.Add(countries.NewCountryService(countriesContent))
 }
+
+func (a *RootApplication) createHolidaysService() {
+	file, err := ioutil.ReadFile(fmt.Sprintf("%scontent/holidays.json", environment.GetEnvironmentVariable(countriesDirVariable, "./")))
+
+	if err != nil {
+		log.Fatal().Msg("Holidays is not found")
+	}
+
+	var holidaysContent []holidayModel.CountryHolidays
+
+	if err = json.Unmarshal(file, &holidaysContent); err != nil {
+		log.Fatal().Err(err)
+	}
+
+	a.DependenciesFactory.Add(holidays.NewHolidayService(holidaysContent))
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/config"
+	"github.com/VlasovArtem/hob/src/db"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	paymentSchedulerMocks "github.com/VlasovArtem/hob/src/payment/scheduler/mocks"
+	paymentSchedulerModel "github.com/VlasovArtem/hob/src/payment/scheduler/model"
+	paymentSchedulerService "github.com/VlasovArtem/hob/src/payment/scheduler/service"
+	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	lockModel "github.com/VlasovArtem/hob/src/scheduler/lock/model"
+	runModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// RootApplicationTestSuite runs two applications against the same database as two replicas of the API
+type RootApplicationTestSuite struct {
+	suite.Suite
+	first    *RootApplication
+	second   *RootApplication
+	database db.DatabaseService
+}
+
+func TestRootApplicationTestSuite(t *testing.T) {
+	suite.Run(t, new(RootApplicationTestSuite))
+}
+
+func (r *RootApplicationTestSuite) SetupSuite() {
+	_ = os.Setenv(dbnameEnvironmentName, "hob_test")
+	_ = os.Setenv(countriesDirVariable, "../../")
+
+	r.first = NewRootApplication(&config.Config{})
+	r.second = NewRootApplication(&config.Config{})
+	r.database = dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](r.first.DependenciesFactory)
+}
+
+func (r *RootApplicationTestSuite) TearDownSuite() {
+	for _, application := range []*RootApplication{r.first, r.second} {
+		dependency.FindRequiredDependency[scheduler.SchedulerServiceObject, scheduler.ServiceScheduler](application.DependenciesFactory).Stop()
+	}
+
+	database.TruncateTable(r.database, lockModel.SchedulerLock{})
+	database.TruncateTable(r.database, runModel.SchedulerRun{})
+	database.TruncateTable(r.database, paymentSchedulerModel.PaymentScheduler{})
+	database.TruncateTable(r.database, paymentModel.Payment{})
+	database.TruncateTable(r.database, providerModel.Provider{})
+	database.TruncateTable(r.database, houseModel.House{})
+	database.TruncateTable(r.database, userModel.User{})
+}
+
+func (r *RootApplicationTestSuite) Test_StartPaymentSchedulers_WithMissedExecutions() {
+	user := userMocks.GenerateUser()
+	r.create(&user)
+
+	house := houseMocks.GenerateHouse(user.Id)
+	r.create(&house)
+
+	provider := providerMocks.GenerateProvider(user.Id)
+	r.create(&provider)
+
+	lastExecutedAt := time.Now().AddDate(0, 0, -3)
+	paymentScheduler := paymentSchedulerMocks.GeneratePaymentScheduler(house.Id, user.Id, provider.Id)
+	paymentScheduler.LastExecutedAt = &lastExecutedAt
+	r.create(&paymentScheduler)
+
+	var wg sync.WaitGroup
+	for _, application := range []*RootApplication{r.first, r.second} {
+		service := dependency.FindRequiredDependency[paymentSchedulerService.PaymentSchedulerServiceObject, paymentSchedulerService.PaymentSchedulerService](application.DependenciesFactory)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			assert.Nil(r.T(), service.(dependency.ObjectStarter).Start())
+		}()
+	}
+	wg.Wait()
+
+	var payments int64
+	err := r.database.DM(paymentModel.Payment{}).Where("house_id = ?", house.Id).Count(&payments).Error
+
+	assert.Nil(r.T(), err)
+	assert.Equal(r.T(), int64(3), payments)
+}
+
+func (r *RootApplicationTestSuite) create(entity any) {
+	if err := r.database.Create(entity); err != nil {
+		r.T().Fatal(err)
+	}
+}
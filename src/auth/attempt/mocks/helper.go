@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/auth/attempt/model"
+	"time"
+)
+
+func GenerateAttemptConfiguration() model.AttemptConfiguration {
+	return model.NewDefaultAttemptConfiguration()
+}
+
+func GenerateLoginAttempt(key string, failures int, lastFailureAt time.Time) model.LoginAttempt {
+	return model.LoginAttempt{
+		Key:           key,
+		Failures:      failures,
+		LastFailureAt: lastFailureAt,
+	}
+}
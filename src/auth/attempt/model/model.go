@@ -0,0 +1,67 @@
+package model
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+type AttemptConfiguration struct {
+	// MaxFailures is the number of the failed sign in attempts that are allowed before the lockout
+	MaxFailures int
+	// Lockout is the duration of the first lockout, it doubles for every next failure
+	Lockout    time.Duration
+	MaxLockout time.Duration
+}
+
+func NewDefaultAttemptConfiguration() AttemptConfiguration {
+	return AttemptConfiguration{
+		MaxFailures: 5,
+		Lockout:     30 * time.Second,
+		MaxLockout:  time.Hour,
+	}
+}
+
+// LoginAttempt counts the failed sign in attempts of the email or the ip address
+type LoginAttempt struct {
+	Key           string `gorm:"primarykey"`
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+func EmailKey(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func IpKey(ip string) string {
+	return "ip:" + ip
+}
+
+func NewLoginAttempt(key string) LoginAttempt {
+	return LoginAttempt{Key: key}
+}
+
+func (l LoginAttempt) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
+
+// LockedUntil returns the end of the lockout after the number of the failures registered at the date, nil is returned if
+// the failures are still allowed
+func (c AttemptConfiguration) LockedUntil(failures int, failedAt time.Time) *time.Time {
+	if failures < c.MaxFailures {
+		return nil
+	}
+
+	lockedUntil := failedAt.Add(lockout(c, failures-c.MaxFailures))
+	return &lockedUntil
+}
+
+func lockout(configuration AttemptConfiguration, exponent int) time.Duration {
+	duration := float64(configuration.Lockout) * math.Pow(2, float64(exponent))
+
+	if duration > float64(configuration.MaxLockout) {
+		return configuration.MaxLockout
+	}
+	return time.Duration(duration)
+}
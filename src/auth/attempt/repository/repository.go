@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/auth/attempt/model"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var entity = model.LoginAttempt{}
+
+type LoginAttemptRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewLoginAttemptRepository(database db.DatabaseService) LoginAttemptRepository {
+	return &LoginAttemptRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (l *LoginAttemptRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewLoginAttemptRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (l *LoginAttemptRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type LoginAttemptRepository interface {
+	FindByKeys(keys []string) []model.LoginAttempt
+	Fail(key string, now time.Time, resetBefore time.Time) (model.LoginAttempt, error)
+	Lock(key string, until time.Time) error
+	DeleteByKey(key string) error
+}
+
+func (l *LoginAttemptRepositoryObject) FindByKeys(keys []string) []model.LoginAttempt {
+	var attempts []model.LoginAttempt
+
+	if err := l.database.FindBy(&attempts, "key IN ?", keys); err != nil {
+		return []model.LoginAttempt{}
+	}
+
+	return attempts
+}
+
+// Fail increments the failures of the key in one statement, so the concurrent failures are not lost. The counter starts
+// again when the key is not locked and the last failure is before the reset date, the updated attempt is returned
+func (l *LoginAttemptRepositoryObject) Fail(key string, now time.Time, resetBefore time.Time) (model.LoginAttempt, error) {
+	attempt := model.LoginAttempt{Key: key, Failures: 1, LastFailureAt: now}
+
+	err := l.database.D().
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "key"}},
+				DoUpdates: clause.Assignments(map[string]any{
+					"failures": gorm.Expr(
+						"CASE WHEN (login_attempts.locked_until IS NULL OR login_attempts.locked_until <= ?) AND login_attempts.last_failure_at < ? "+
+							"THEN 1 ELSE login_attempts.failures + 1 END",
+						now, resetBefore,
+					),
+					"last_failure_at": now,
+				}),
+			},
+			clause.Returning{},
+		).
+		Create(&attempt).
+		Error
+
+	return attempt, err
+}
+
+// Lock locks the key until the date, the later lockout set by the concurrent failure is kept
+func (l *LoginAttemptRepositoryObject) Lock(key string, until time.Time) error {
+	return l.database.Modeled().
+		Where("key = ?", key).
+		Update("locked_until", gorm.Expr("GREATEST(COALESCE(locked_until, ?), ?)", until, until)).
+		Error
+}
+
+func (l *LoginAttemptRepositoryObject) DeleteByKey(key string) error {
+	return l.database.D().
+		Where("key = ?", key).
+		Delete(&model.LoginAttempt{}).
+		Error
+}
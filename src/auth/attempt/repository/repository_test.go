@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/auth/attempt/mocks"
+	"github.com/VlasovArtem/hob/src/auth/attempt/model"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"testing"
+	"time"
+)
+
+type LoginAttemptRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository LoginAttemptRepository
+}
+
+func (l *LoginAttemptRepositoryTestSuite) SetupSuite() {
+	l.InitDBTestSuite()
+
+	l.CreateRepository(
+		func(service db.DatabaseService) {
+			l.repository = NewLoginAttemptRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, model.LoginAttempt{})
+		}).
+		ExecuteMigration(model.LoginAttempt{})
+}
+
+func TestLoginAttemptRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(LoginAttemptRepositoryTestSuite))
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_Fail() {
+	now := time.Now()
+
+	actual, err := l.repository.Fail(model.EmailKey("mail@mail.com"), now, now.Add(-time.Hour))
+
+	assert.Nil(l.T(), err)
+	assert.Equal(l.T(), 1, actual.Failures)
+	assert.Nil(l.T(), actual.LockedUntil)
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_Fail_WithExistingAttempt() {
+	now := time.Now()
+	attempt := mocks.GenerateLoginAttempt(model.EmailKey("mail@mail.com"), 4, now.Add(-time.Minute))
+	l.CreateEntity(&attempt)
+
+	actual, err := l.repository.Fail(attempt.Key, now, now.Add(-time.Hour))
+
+	assert.Nil(l.T(), err)
+	assert.Equal(l.T(), 5, actual.Failures)
+	assert.Equal(l.T(), 5, l.repository.FindByKeys([]string{attempt.Key})[0].Failures)
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_Fail_WithOutdatedAttempt() {
+	now := time.Now()
+	attempt := mocks.GenerateLoginAttempt(model.EmailKey("mail@mail.com"), 4, now.Add(-2*time.Hour))
+	l.CreateEntity(&attempt)
+
+	actual, err := l.repository.Fail(attempt.Key, now, now.Add(-time.Hour))
+
+	assert.Nil(l.T(), err)
+	assert.Equal(l.T(), 1, actual.Failures)
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_Fail_WithLockedOutdatedAttempt() {
+	now := time.Now()
+	lockedUntil := now.Add(time.Minute)
+	attempt := mocks.GenerateLoginAttempt(model.EmailKey("mail@mail.com"), 10, now.Add(-2*time.Hour))
+	attempt.LockedUntil = &lockedUntil
+	l.CreateEntity(&attempt)
+
+	actual, err := l.repository.Fail(attempt.Key, now, now.Add(-time.Hour))
+
+	assert.Nil(l.T(), err)
+	assert.Equal(l.T(), 11, actual.Failures)
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_Fail_WithConcurrentFailures() {
+	key := model.EmailKey("mail@mail.com")
+	now := time.Now()
+	var wait sync.WaitGroup
+
+	for index := 0; index < 10; index++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			_, err := l.repository.Fail(key, now, now.Add(-time.Hour))
+			assert.Nil(l.T(), err)
+		}()
+	}
+	wait.Wait()
+
+	assert.Equal(l.T(), 10, l.repository.FindByKeys([]string{key})[0].Failures)
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_Lock() {
+	attempt := mocks.GenerateLoginAttempt(model.EmailKey("mail@mail.com"), 5, time.Now())
+	l.CreateEntity(&attempt)
+	lockedUntil := time.Now().Add(time.Minute)
+
+	err := l.repository.Lock(attempt.Key, lockedUntil)
+
+	assert.Nil(l.T(), err)
+
+	actual := l.repository.FindByKeys([]string{attempt.Key})
+
+	assert.True(l.T(), lockedUntil.Round(time.Millisecond).Equal(actual[0].LockedUntil.Round(time.Millisecond)))
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_Lock_WithLaterLockout() {
+	lockedUntil := time.Now().Add(time.Hour)
+	attempt := mocks.GenerateLoginAttempt(model.EmailKey("mail@mail.com"), 7, time.Now())
+	attempt.LockedUntil = &lockedUntil
+	l.CreateEntity(&attempt)
+
+	err := l.repository.Lock(attempt.Key, time.Now().Add(time.Minute))
+
+	assert.Nil(l.T(), err)
+
+	actual := l.repository.FindByKeys([]string{attempt.Key})
+
+	assert.True(l.T(), lockedUntil.Round(time.Millisecond).Equal(actual[0].LockedUntil.Round(time.Millisecond)))
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_FindByKeys() {
+	emailAttempt := mocks.GenerateLoginAttempt(model.EmailKey("mail@mail.com"), 1, time.Now())
+	ipAttempt := mocks.GenerateLoginAttempt(model.IpKey("192.168.0.1"), 2, time.Now())
+	otherAttempt := mocks.GenerateLoginAttempt(model.IpKey("192.168.0.2"), 3, time.Now())
+	l.CreateEntity(&emailAttempt)
+	l.CreateEntity(&ipAttempt)
+	l.CreateEntity(&otherAttempt)
+
+	actual := l.repository.FindByKeys([]string{emailAttempt.Key, ipAttempt.Key})
+
+	assert.ElementsMatch(l.T(), []string{emailAttempt.Key, ipAttempt.Key}, []string{actual[0].Key, actual[1].Key})
+}
+
+func (l *LoginAttemptRepositoryTestSuite) Test_DeleteByKey() {
+	attempt := mocks.GenerateLoginAttempt(model.EmailKey("mail@mail.com"), 1, time.Now())
+	l.CreateEntity(&attempt)
+
+	err := l.repository.DeleteByKey(attempt.Key)
+
+	assert.Nil(l.T(), err)
+	assert.Empty(l.T(), l.repository.FindByKeys([]string{attempt.Key}))
+}
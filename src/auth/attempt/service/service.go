@@ -0,0 +1,134 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/auth/attempt/model"
+	"github.com/VlasovArtem/hob/src/auth/attempt/repository"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+const lockedTimeLayout = "2006-01-02 15:04:05 MST"
+
+type LoginAttemptServiceObject struct {
+	configuration model.AttemptConfiguration
+	userService   userService.UserService
+	repository    repository.LoginAttemptRepository
+}
+
+func NewLoginAttemptService(
+	configuration model.AttemptConfiguration,
+	userService userService.UserService,
+	repository repository.LoginAttemptRepository,
+) LoginAttemptService {
+	return &LoginAttemptServiceObject{
+		configuration: configuration,
+		userService:   userService,
+		repository:    repository,
+	}
+}
+
+func (l *LoginAttemptServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewLoginAttemptService(
+		factory.FindRequiredByObject(model.AttemptConfiguration{}).(model.AttemptConfiguration),
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[repository.LoginAttemptRepositoryObject, repository.LoginAttemptRepository](factory),
+	)
+}
+
+type LoginAttemptService interface {
+	VerifyUser(email string, password string, ip string) (userModel.UserDto, error)
+}
+
+// VerifyUser checks the user credentials unless the email or the ip address is locked after the failed attempts.
+// The ip address is not tracked when it is empty. The successful attempt resets only the email counter,
+// so the valid credentials of one account do not unlock the guessing of the other accounts from the same address.
+func (l *LoginAttemptServiceObject) VerifyUser(email string, password string, ip string) (response userModel.UserDto, err error) {
+	now := time.Now()
+	keys := []string{model.EmailKey(email)}
+	if ip != "" {
+		keys = append(keys, model.IpKey(ip))
+	}
+
+	attempts := l.findAttempts(keys)
+
+	if err = lockedError(attempts, now); err != nil {
+		return response, err
+	}
+
+	if response, err = l.userService.VerifyUser(email, password); err != nil {
+		if errors.Is(err, interrors.ErrUnauthorized{}) {
+			if lockedErr := lockedError(l.fail(keys, now), now); lockedErr != nil {
+				return response, lockedErr
+			}
+		}
+		return response, err
+	}
+
+	if err := l.repository.DeleteByKey(model.EmailKey(email)); err != nil {
+		log.Error().Err(err).Msgf("sign in attempts of %s are not reset", email)
+	}
+
+	return response, nil
+}
+
+func (l *LoginAttemptServiceObject) findAttempts(keys []string) []model.LoginAttempt {
+	found := make(map[string]model.LoginAttempt)
+	for _, attempt := range l.repository.FindByKeys(keys) {
+		found[attempt.Key] = attempt
+	}
+
+	attempts := make([]model.LoginAttempt, 0, len(keys))
+	for _, key := range keys {
+		if attempt, ok := found[key]; ok {
+			attempts = append(attempts, attempt)
+		} else {
+			attempts = append(attempts, model.NewLoginAttempt(key))
+		}
+	}
+
+	return attempts
+}
+
+// fail registers the failed attempt of the keys, the lockout is calculated from the number of the failures returned by
+// the repository, so the concurrent failures of the same key are counted
+func (l *LoginAttemptServiceObject) fail(keys []string, now time.Time) []model.LoginAttempt {
+	failed := make([]model.LoginAttempt, 0, len(keys))
+
+	for _, key := range keys {
+		attempt, err := l.repository.Fail(key, now, now.Add(-l.configuration.MaxLockout))
+		if err != nil {
+			log.Error().Err(err).Msgf("failed sign in attempt of %s is not saved", key)
+			continue
+		}
+
+		if attempt.LockedUntil = l.configuration.LockedUntil(attempt.Failures, now); attempt.LockedUntil != nil {
+			if err = l.repository.Lock(key, *attempt.LockedUntil); err != nil {
+				log.Error().Err(err).Msgf("sign in attempts of %s are not locked", key)
+			}
+		}
+		failed = append(failed, attempt)
+	}
+
+	return failed
+}
+
+// lockedError returns the error with the latest lockout of the attempts
+func lockedError(attempts []model.LoginAttempt, now time.Time) error {
+	var until *time.Time
+
+	for _, attempt := range attempts {
+		if attempt.IsLocked(now) && (until == nil || attempt.LockedUntil.After(*until)) {
+			until = attempt.LockedUntil
+		}
+	}
+
+	if until == nil {
+		return nil
+	}
+	return interrors.NewErrLocked(*until, "account locked until %s", until.Format(lockedTimeLayout))
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/auth/attempt/mocks"
+	"github.com/VlasovArtem/hob/src/auth/attempt/model"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+const (
+	email    = "mail@mail.com"
+	password = "password"
+	ip       = "192.168.0.1"
+)
+
+var keys = []string{model.EmailKey(email), model.IpKey(ip)}
+
+type LoginAttemptServiceTestSuite struct {
+	testhelper.MockTestSuite[LoginAttemptService]
+	userService            *userMocks.UserService
+	loginAttemptRepository *mocks.LoginAttemptRepository
+}
+
+func TestLoginAttemptServiceTestSuite(t *testing.T) {
+	ts := &LoginAttemptServiceTestSuite{}
+	ts.TestObjectGenerator = func() LoginAttemptService {
+		ts.userService = new(userMocks.UserService)
+		ts.loginAttemptRepository = new(mocks.LoginAttemptRepository)
+
+		return NewLoginAttemptService(mocks.GenerateAttemptConfiguration(), ts.userService, ts.loginAttemptRepository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser() {
+	user := userMocks.GenerateUserResponse()
+
+	l.loginAttemptRepository.On("FindByKeys", keys).Return([]model.LoginAttempt{})
+	l.userService.On("VerifyUser", email, password).Return(user, nil)
+	l.loginAttemptRepository.On("DeleteByKey", model.EmailKey(email)).Return(nil)
+
+	actual, err := l.TestO.VerifyUser(email, password, ip)
+
+	assert.Nil(l.T(), err)
+	assert.Equal(l.T(), user, actual)
+	l.loginAttemptRepository.AssertNotCalled(l.T(), "DeleteByKey", model.IpKey(ip))
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithLockedEmail() {
+	attempt := mocks.GenerateLoginAttempt(model.EmailKey(email), 5, time.Now())
+	lockedUntil := time.Now().Add(time.Minute)
+	attempt.LockedUntil = &lockedUntil
+
+	l.loginAttemptRepository.On("FindByKeys", keys).Return([]model.LoginAttempt{attempt})
+
+	actual, err := l.TestO.VerifyUser(email, password, ip)
+
+	assert.Equal(l.T(), int_errors.NewErrLocked(lockedUntil, "account locked until %s", lockedUntil.Format(lockedTimeLayout)), err)
+	assert.Equal(l.T(), userModel.UserDto{}, actual)
+	l.userService.AssertNotCalled(l.T(), "VerifyUser", mock.Anything, mock.Anything)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithLockedIp() {
+	attempt := mocks.GenerateLoginAttempt(model.IpKey(ip), 5, time.Now())
+	lockedUntil := time.Now().Add(time.Minute)
+	attempt.LockedUntil = &lockedUntil
+
+	l.loginAttemptRepository.On("FindByKeys", keys).Return([]model.LoginAttempt{attempt})
+
+	_, err := l.TestO.VerifyUser(email, password, ip)
+
+	assert.ErrorIs(l.T(), err, int_errors.ErrLocked{})
+	l.userService.AssertNotCalled(l.T(), "VerifyUser", mock.Anything, mock.Anything)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithExpiredLockout() {
+	user := userMocks.GenerateUserResponse()
+	attempt := mocks.GenerateLoginAttempt(model.EmailKey(email), 5, time.Now().Add(-time.Minute))
+	lockedUntil := time.Now().Add(-time.Second)
+	attempt.LockedUntil = &lockedUntil
+
+	l.loginAttemptRepository.On("FindByKeys", keys).Return([]model.LoginAttempt{attempt})
+	l.userService.On("VerifyUser", email, password).Return(user, nil)
+	l.loginAttemptRepository.On("DeleteByKey", model.EmailKey(email)).Return(nil)
+
+	actual, err := l.TestO.VerifyUser(email, password, ip)
+
+	assert.Nil(l.T(), err)
+	assert.Equal(l.T(), user, actual)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithInvalidCredentials() {
+	expected := int_errors.NewErrUnauthorized("credentials are not valid")
+
+	l.loginAttemptRepository.On("FindByKeys", keys).Return([]model.LoginAttempt{})
+	l.userService.On("VerifyUser", email, password).Return(userModel.UserDto{}, expected)
+	for _, key := range keys {
+		l.loginAttemptRepository.On("Fail", key, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(mocks.GenerateLoginAttempt(key, 1, time.Now()), nil)
+	}
+
+	_, err := l.TestO.VerifyUser(email, password, ip)
+
+	assert.Equal(l.T(), expected, err)
+	l.loginAttemptRepository.AssertNumberOfCalls(l.T(), "Fail", 2)
+	l.loginAttemptRepository.AssertNotCalled(l.T(), "Lock", mock.Anything, mock.Anything)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithResetOfOutdatedFailures() {
+	configuration := mocks.GenerateAttemptConfiguration()
+
+	l.failWith(1)
+
+	_, err := l.TestO.VerifyUser(email, password, "")
+
+	assert.ErrorIs(l.T(), err, int_errors.ErrUnauthorized{})
+
+	now := l.loginAttemptRepository.Calls[1].Arguments.Get(1).(time.Time)
+	resetBefore := l.loginAttemptRepository.Calls[1].Arguments.Get(2).(time.Time)
+
+	assert.Equal(l.T(), now.Add(-configuration.MaxLockout), resetBefore)
+	l.loginAttemptRepository.AssertNotCalled(l.T(), "Lock", mock.Anything, mock.Anything)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithErrorOnFail() {
+	l.loginAttemptRepository.On("FindByKeys", []string{model.EmailKey(email)}).Return([]model.LoginAttempt{})
+	l.userService.On("VerifyUser", email, password).Return(userModel.UserDto{}, int_errors.NewErrUnauthorized("credentials are not valid"))
+	l.loginAttemptRepository.On("Fail", model.EmailKey(email), mock.Anything, mock.Anything).Return(model.LoginAttempt{}, errors.New("error"))
+
+	_, err := l.TestO.VerifyUser(email, password, "")
+
+	assert.ErrorIs(l.T(), err, int_errors.ErrUnauthorized{})
+	l.loginAttemptRepository.AssertNotCalled(l.T(), "Lock", mock.Anything, mock.Anything)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithLastAllowedFailure() {
+	configuration := mocks.GenerateAttemptConfiguration()
+
+	lockedUntil := l.failWith(configuration.MaxFailures)
+
+	_, err := l.TestO.VerifyUser(email, password, "")
+
+	assert.ErrorIs(l.T(), err, int_errors.ErrLocked{})
+	l.assertLockout(configuration.Lockout, *lockedUntil)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithExponentialBackoff() {
+	configuration := mocks.GenerateAttemptConfiguration()
+
+	lockedUntil := l.failWith(configuration.MaxFailures + 2)
+
+	_, err := l.TestO.VerifyUser(email, password, "")
+
+	assert.ErrorIs(l.T(), err, int_errors.ErrLocked{})
+	l.assertLockout(4*configuration.Lockout, *lockedUntil)
+}
+
+func (l *LoginAttemptServiceTestSuite) Test_VerifyUser_WithMaxLockout() {
+	configuration := mocks.GenerateAttemptConfiguration()
+
+	lockedUntil := l.failWith(configuration.MaxFailures + 21)
+
+	l.TestO.VerifyUser(email, password, "")
+
+	l.assertLockout(configuration.MaxLockout, *lockedUntil)
+}
+
+// failWith prepares the failed verification of the user without the ip address, the repository returns the failures
+// and the lockout set by the service is returned
+func (l *LoginAttemptServiceTestSuite) failWith(failures int) *time.Time {
+	lockedUntil := new(time.Time)
+
+	l.loginAttemptRepository.On("FindByKeys", []string{model.EmailKey(email)}).Return([]model.LoginAttempt{})
+	l.userService.On("VerifyUser", email, password).Return(userModel.UserDto{}, int_errors.NewErrUnauthorized("credentials are not valid"))
+	l.loginAttemptRepository.On("Fail", model.EmailKey(email), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(
+		func(key string, now time.Time, resetBefore time.Time) model.LoginAttempt {
+			return mocks.GenerateLoginAttempt(key, failures, now)
+		},
+		nil,
+	)
+	l.loginAttemptRepository.On("Lock", model.EmailKey(email), mock.AnythingOfType("time.Time")).Return(
+		func(key string, until time.Time) error {
+			*lockedUntil = until
+			return nil
+		})
+
+	return lockedUntil
+}
+
+func (l *LoginAttemptServiceTestSuite) assertLockout(expected time.Duration, lockedUntil time.Time) {
+	now := l.loginAttemptRepository.Calls[1].Arguments.Get(1).(time.Time)
+
+	assert.Equal(l.T(), now.Add(expected), lockedUntil)
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/auth/model"
+	"github.com/VlasovArtem/hob/src/auth/service"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	apiKeyService "github.com/VlasovArtem/hob/src/user/apikey/service"
+	resetModel "github.com/VlasovArtem/hob/src/user/reset/model"
+	resetService "github.com/VlasovArtem/hob/src/user/reset/service"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strings"
+)
+
+const bearerPrefix = "Bearer "
+
+// ApiKeyHeader is the header with the personal api key, it is used instead of the access token by the scripts
+const ApiKeyHeader = "X-API-Key"
+
+// readOnlyMethods are the methods that are allowed for the read only api keys
+var readOnlyMethods = map[string]bool{
+	"GET":     true,
+	"HEAD":    true,
+	"OPTIONS": true,
+}
+
+// publicRoutes are the path templates with the methods that are available without the access token
+var publicRoutes = map[string][]string{
+	"/api/v1/health":                      {"GET"},
+	"/api/v1/auth/login":                  {"POST"},
+	"/api/v1/auth/refresh":                {"POST"},
+	"/api/v1/auth/password-reset":         {"POST"},
+	"/api/v1/auth/password-reset/confirm": {"POST"},
+	"/api/v1/users":                       {"POST"},
+}
+
+type AuthHandlerObject struct {
+	authService   service.AuthService
+	apiKeyService apiKeyService.ApiKeyService
+	resetService  resetService.PasswordResetService
+}
+
+func NewAuthHandler(
+	authService service.AuthService,
+	apiKeyService apiKeyService.ApiKeyService,
+	resetService resetService.PasswordResetService,
+) AuthHandler {
+	return &AuthHandlerObject{authService, apiKeyService, resetService}
+}
+
+func (a *AuthHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewAuthHandler(
+		dependency.FindRequiredDependency[service.AuthServiceObject, service.AuthService](factory),
+		dependency.FindRequiredDependency[apiKeyService.ApiKeyServiceObject, apiKeyService.ApiKeyService](factory),
+		dependency.FindRequiredDependency[resetService.PasswordResetServiceObject, resetService.PasswordResetService](factory),
+	)
+}
+
+// Init registers the authentication routes and protects all the routes of the router with the access token
+func (a *AuthHandlerObject) Init(router *mux.Router) {
+	subrouter := router.PathPrefix("/api/v1/auth").Subrouter()
+
+	subrouter.Path("/login").HandlerFunc(a.Login()).Methods("POST")
+	subrouter.Path("/refresh").HandlerFunc(a.Refresh()).Methods("POST")
+	subrouter.Path("/password-reset").HandlerFunc(a.RequestPasswordReset()).Methods("POST")
+	subrouter.Path("/password-reset/confirm").HandlerFunc(a.ResetPassword()).Methods("POST")
+
+	router.Use(a.Middleware)
+}
+
+type AuthHandler interface {
+	Login() http.HandlerFunc
+	Refresh() http.HandlerFunc
+	RequestPasswordReset() http.HandlerFunc
+	ResetPassword() http.HandlerFunc
+	Middleware(next http.Handler) http.Handler
+}
+
+func (a *AuthHandlerObject) Login() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if body, err := rest.ReadRequestBody[model.LoginRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(a.authService.Login(body, rest.GetClientIp(request))).
+				Perform()
+		}
+	}
+}
+
+func (a *AuthHandlerObject) Refresh() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if body, err := rest.ReadRequestBody[model.RefreshRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(a.authService.Refresh(body)).
+				Perform()
+		}
+	}
+}
+
+// RequestPasswordReset always responds with no content, so the response does not disclose whether the email is registered
+func (a *AuthHandlerObject) RequestPasswordReset() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if body, err := rest.ReadRequestBody[resetModel.PasswordResetRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				StatusCode(http.StatusNoContent).
+				Error(a.resetService.Request(body)).
+				Perform()
+		}
+	}
+}
+
+func (a *AuthHandlerObject) ResetPassword() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if body, err := rest.ReadRequestBody[resetModel.ResetPasswordRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				StatusCode(http.StatusNoContent).
+				Error(a.resetService.Reset(body)).
+				Perform()
+		}
+	}
+}
+
+// Middleware authenticates the request with the api key or the bearer access token and stores the user id in the request context
+func (a *AuthHandlerObject) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if isPublic(request) {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		if key := request.Header.Get(ApiKeyHeader); key != "" {
+			a.authenticateApiKey(writer, request, next, key)
+			return
+		}
+
+		header := request.Header.Get("Authorization")
+		if !strings.HasPrefix(header, bearerPrefix) {
+			rest.HandleWithError(writer, int_errors.NewErrUnauthorized("access token is missing"))
+			return
+		}
+
+		if userId, err := a.authService.Authenticate(strings.TrimPrefix(header, bearerPrefix)); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			next.ServeHTTP(writer, rest.WithUserId(request, userId))
+		}
+	})
+}
+
+func (a *AuthHandlerObject) authenticateApiKey(writer http.ResponseWriter, request *http.Request, next http.Handler, key string) {
+	apiKey, err := a.apiKeyService.Authenticate(key)
+	if err != nil {
+		rest.HandleWithError(writer, err)
+		return
+	}
+
+	if apiKey.ReadOnly && !readOnlyMethods[request.Method] {
+		rest.HandleWithError(writer, int_errors.NewErrForbidden("api key is read only"))
+		return
+	}
+
+	next.ServeHTTP(writer, rest.WithApiKey(rest.WithUserId(request, apiKey.UserId)))
+}
+
+func isPublic(request *http.Request) bool {
+	route := mux.CurrentRoute(request)
+	if route == nil {
+		return false
+	}
+
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return false
+	}
+
+	for _, method := range publicRoutes[template] {
+		if method == request.Method {
+			return true
+		}
+	}
+
+	return false
+}
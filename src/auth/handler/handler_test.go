@@ -0,0 +1,307 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/auth/mocks"
+	"github.com/VlasovArtem/hob/src/auth/model"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	apiKeyMocks "github.com/VlasovArtem/hob/src/user/apikey/mocks"
+	apiKeyModel "github.com/VlasovArtem/hob/src/user/apikey/model"
+	resetMocks "github.com/VlasovArtem/hob/src/user/reset/mocks"
+	resetModel "github.com/VlasovArtem/hob/src/user/reset/model"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type AuthHandlerTestSuite struct {
+	testhelper.MockTestSuite[AuthHandler]
+	authService   *mocks.AuthService
+	apiKeyService *apiKeyMocks.ApiKeyService
+	resetService  *resetMocks.PasswordResetService
+}
+
+func TestAuthHandlerTestSuite(t *testing.T) {
+	ts := &AuthHandlerTestSuite{}
+	ts.TestObjectGenerator = func() AuthHandler {
+		ts.authService = new(mocks.AuthService)
+		ts.apiKeyService = new(apiKeyMocks.ApiKeyService)
+		ts.resetService = new(resetMocks.PasswordResetService)
+		return NewAuthHandler(ts.authService, ts.apiKeyService, ts.resetService)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (a *AuthHandlerTestSuite) Test_Login() {
+	request := mocks.GenerateLoginRequest()
+	expected := mocks.GenerateTokenDto()
+
+	a.authService.On("Login", request, "192.0.2.1").Return(expected, nil)
+
+	content := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/auth/login").
+		WithMethod("POST").
+		WithHandler(a.TestO.Login()).
+		WithBody(request).
+		Verify(a.T(), http.StatusOK)
+
+	actual := model.TokenDto{}
+
+	json.Unmarshal(content, &actual)
+
+	assert.Equal(a.T(), expected, actual)
+}
+
+func (a *AuthHandlerTestSuite) Test_Login_WithInvalidCredentials() {
+	request := mocks.GenerateLoginRequest()
+
+	a.authService.On("Login", request, "192.0.2.1").Return(model.TokenDto{}, int_errors.NewErrUnauthorized("credentials are not valid"))
+
+	content := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/auth/login").
+		WithMethod("POST").
+		WithHandler(a.TestO.Login()).
+		WithBody(request).
+		Verify(a.T(), http.StatusUnauthorized)
+
+	assert.Equal(a.T(), "credentials are not valid\n", string(content))
+}
+
+func (a *AuthHandlerTestSuite) Test_Login_WithLockedAccount() {
+	request := mocks.GenerateLoginRequest()
+	until := time.Now().Add(time.Minute)
+
+	a.authService.On("Login", request, "192.0.2.1").Return(model.TokenDto{}, int_errors.NewErrLocked(until, "account locked until %s", until.Format(time.RFC3339)))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/auth/login").
+		WithMethod("POST").
+		WithHandler(a.TestO.Login()).
+		WithBody(request)
+
+	content := testRequest.Verify(a.T(), http.StatusTooManyRequests)
+
+	assert.Equal(a.T(), fmt.Sprintf("account locked until %s\n", until.Format(time.RFC3339)), string(content))
+	assert.Equal(a.T(), "60", testRequest.Recorder.Header().Get("Retry-After"))
+}
+
+func (a *AuthHandlerTestSuite) Test_Login_WithMissingBody() {
+	testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/auth/login").
+		WithMethod("POST").
+		WithHandler(a.TestO.Login()).
+		Verify(a.T(), http.StatusBadRequest)
+}
+
+func (a *AuthHandlerTestSuite) Test_Refresh() {
+	request := model.RefreshRequest{RefreshToken: "refresh-token"}
+	expected := mocks.GenerateTokenDto()
+
+	a.authService.On("Refresh", request).Return(expected, nil)
+
+	content := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/auth/refresh").
+		WithMethod("POST").
+		WithHandler(a.TestO.Refresh()).
+		WithBody(request).
+		Verify(a.T(), http.StatusOK)
+
+	actual := model.TokenDto{}
+
+	json.Unmarshal(content, &actual)
+
+	assert.Equal(a.T(), expected, actual)
+}
+
+func (a *AuthHandlerTestSuite) Test_RequestPasswordReset() {
+	request := resetModel.PasswordResetRequest{Email: "mail@mail.com"}
+
+	a.resetService.On("Request", request).Return(nil)
+
+	testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/auth/password-reset").
+		WithMethod("POST").
+		WithHandler(a.TestO.RequestPasswordReset()).
+		WithBody(request).
+		Verify(a.T(), http.StatusNoContent)
+}
+
+func (a *AuthHandlerTestSuite) Test_ResetPassword() {
+	request := resetMocks.GenerateResetPasswordRequest()
+
+	a.resetService.On("Reset", request).Return(nil)
+
+	testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/auth/password-reset/confirm").
+		WithMethod("POST").
+		WithHandler(a.TestO.ResetPassword()).
+		WithBody(request).
+		Verify(a.T(), http.StatusNoContent)
+}
+
+func (a *AuthHandlerTestSuite) Test_ResetPassword_WithInvalidToken() {
+	request := resetMocks.GenerateResetPasswordRequest()
+
+	a.resetService.On("Reset", request).Return(int_errors.NewErrUnauthorized("reset token is not valid"))
+
+	content := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/auth/password-reset/confirm").
+		WithMethod("POST").
+		WithHandler(a.TestO.ResetPassword()).
+		WithBody(request).
+		Verify(a.T(), http.StatusUnauthorized)
+
+	assert.Equal(a.T(), "reset token is not valid\n", string(content))
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware() {
+	userId := uuid.New()
+
+	a.authService.On("Authenticate", "access-token").Return(userId, nil)
+
+	recorder := a.serve("GET", "/api/v1/payments", "Bearer access-token")
+
+	assert.Equal(a.T(), http.StatusOK, recorder.Code)
+	assert.Equal(a.T(), userId.String(), recorder.Body.String())
+	assert.Empty(a.T(), recorder.Header().Get(ApiKeyHeader))
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware_WithMissingToken() {
+	recorder := a.serve("GET", "/api/v1/payments", "")
+
+	assert.Equal(a.T(), http.StatusUnauthorized, recorder.Code)
+	assert.Equal(a.T(), "access token is missing\n", recorder.Body.String())
+	a.authService.AssertNotCalled(a.T(), "Authenticate")
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware_WithInvalidToken() {
+	a.authService.On("Authenticate", "access-token").Return(uuid.UUID{}, int_errors.NewErrUnauthorized("token is expired"))
+
+	recorder := a.serve("GET", "/api/v1/payments", "Bearer access-token")
+
+	assert.Equal(a.T(), http.StatusUnauthorized, recorder.Code)
+	assert.Equal(a.T(), "token is expired\n", recorder.Body.String())
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware_WithPublicRoutes() {
+	for _, route := range [][]string{
+		{"GET", "/api/v1/health"},
+		{"POST", "/api/v1/auth/login"},
+		{"POST", "/api/v1/auth/refresh"},
+		{"POST", "/api/v1/auth/password-reset"},
+		{"POST", "/api/v1/auth/password-reset/confirm"},
+		{"POST", "/api/v1/users"},
+	} {
+		recorder := a.serve(route[0], route[1], "")
+
+		assert.Equal(a.T(), http.StatusOK, recorder.Code, route[1])
+	}
+
+	a.authService.AssertNotCalled(a.T(), "Authenticate")
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware_WithProtectedMethodOfPublicPath() {
+	recorder := a.serve("GET", "/api/v1/users", "")
+
+	assert.Equal(a.T(), http.StatusUnauthorized, recorder.Code)
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware_WithApiKey() {
+	apiKey := apiKeyMocks.GenerateApiKey(uuid.New()).ToDto()
+
+	a.apiKeyService.On("Authenticate", apiKeyMocks.Key).Return(apiKey, nil)
+
+	recorder := a.serveWithApiKey("POST", "/api/v1/payments", apiKeyMocks.Key)
+
+	assert.Equal(a.T(), http.StatusOK, recorder.Code)
+	assert.Equal(a.T(), apiKey.UserId.String(), recorder.Body.String())
+	assert.Equal(a.T(), "authenticated", recorder.Header().Get(ApiKeyHeader))
+	a.authService.AssertNotCalled(a.T(), "Authenticate")
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware_WithReadOnlyApiKey() {
+	apiKey := apiKeyMocks.GenerateApiKey(uuid.New()).ToDto()
+	apiKey.ReadOnly = true
+
+	a.apiKeyService.On("Authenticate", apiKeyMocks.Key).Return(apiKey, nil)
+
+	recorder := a.serveWithApiKey("GET", "/api/v1/payments", apiKeyMocks.Key)
+
+	assert.Equal(a.T(), http.StatusOK, recorder.Code)
+	assert.Equal(a.T(), apiKey.UserId.String(), recorder.Body.String())
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware_WithReadOnlyApiKeyAndModifyingMethod() {
+	apiKey := apiKeyMocks.GenerateApiKey(uuid.New()).ToDto()
+	apiKey.ReadOnly = true
+
+	a.apiKeyService.On("Authenticate", apiKeyMocks.Key).Return(apiKey, nil)
+
+	recorder := a.serveWithApiKey("POST", "/api/v1/payments", apiKeyMocks.Key)
+
+	assert.Equal(a.T(), http.StatusForbidden, recorder.Code)
+	assert.Equal(a.T(), "api key is read only\n", recorder.Body.String())
+}
+
+func (a *AuthHandlerTestSuite) Test_Middleware_WithInvalidApiKey() {
+	a.apiKeyService.On("Authenticate", "invalid").Return(apiKeyModel.ApiKeyDto{}, int_errors.NewErrUnauthorized("api key is not valid"))
+
+	recorder := a.serveWithApiKey("GET", "/api/v1/payments", "invalid")
+
+	assert.Equal(a.T(), http.StatusUnauthorized, recorder.Code)
+	assert.Equal(a.T(), "api key is not valid\n", recorder.Body.String())
+}
+
+func (a *AuthHandlerTestSuite) serveWithApiKey(method string, path string, key string) *httptest.ResponseRecorder {
+	return a.serveRequest(method, path, func(request *http.Request) {
+		request.Header.Set(ApiKeyHeader, key)
+	})
+}
+
+func (a *AuthHandlerTestSuite) serve(method string, path string, authorization string) *httptest.ResponseRecorder {
+	return a.serveRequest(method, path, func(request *http.Request) {
+		if authorization != "" {
+			request.Header.Set("Authorization", authorization)
+		}
+	})
+}
+
+func (a *AuthHandlerTestSuite) serveRequest(method string, path string, prepare func(request *http.Request)) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+
+	handler := func(writer http.ResponseWriter, request *http.Request) {
+		if rest.IsApiKey(request) {
+			writer.Header().Set(ApiKeyHeader, "authenticated")
+		}
+		if userId, err := rest.GetUserId(request); err == nil {
+			writer.Write([]byte(userId.String()))
+		}
+	}
+
+	router.Path("/api/v1/health").HandlerFunc(handler).Methods("GET")
+	router.Path("/api/v1/auth/login").HandlerFunc(handler).Methods("POST")
+	router.Path("/api/v1/auth/refresh").HandlerFunc(handler).Methods("POST")
+	router.Path("/api/v1/auth/password-reset").HandlerFunc(handler).Methods("POST")
+	router.Path("/api/v1/auth/password-reset/confirm").HandlerFunc(handler).Methods("POST")
+	router.Path("/api/v1/users").HandlerFunc(handler).Methods("GET", "POST")
+	router.Path("/api/v1/payments").HandlerFunc(handler).Methods("GET", "POST")
+	router.Use(a.TestO.Middleware)
+
+	request := httptest.NewRequest(method, path, nil)
+	prepare(request)
+
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	return recorder
+}
@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/auth/model"
+	"time"
+)
+
+var Secret = []byte("secret")
+
+const Ip = "192.168.0.1"
+
+func GenerateAuthConfiguration() model.AuthConfiguration {
+	return model.NewDefaultAuthConfiguration(Secret)
+}
+
+func GenerateLoginRequest() model.LoginRequest {
+	return model.LoginRequest{
+		Email:    "mail@mail.com",
+		Password: "password",
+	}
+}
+
+func GenerateTokenDto() model.TokenDto {
+	return model.TokenDto{
+		AccessToken:      "access-token",
+		RefreshToken:     "refresh-token",
+		ExpiresAt:        time.Date(2022, time.January, 1, 0, 15, 0, 0, time.UTC),
+		RefreshExpiresAt: time.Date(2022, time.January, 8, 0, 0, 0, 0, time.UTC),
+	}
+}
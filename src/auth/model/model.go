@@ -0,0 +1,54 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type TokenType string
+
+const (
+	AccessToken  TokenType = "access"
+	RefreshToken TokenType = "refresh"
+)
+
+type AuthConfiguration struct {
+	// Secret signs the tokens, all the instances of the application should share the same secret
+	Secret          []byte
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+func NewDefaultAuthConfiguration(secret []byte) AuthConfiguration {
+	return AuthConfiguration{
+		Secret:          secret,
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+	}
+}
+
+// Claims is the payload of the signed token
+type Claims struct {
+	UserId    uuid.UUID `json:"sub"`
+	Type      TokenType `json:"typ"`
+	IssuedAt  int64     `json:"iat"`
+	ExpiresAt int64     `json:"exp"`
+	// Version is the token version of the user the token was issued for, the token is revoked once the version changes
+	Version int `json:"ver"`
+}
+
+type LoginRequest struct {
+	Email    string
+	Password string
+}
+
+type RefreshRequest struct {
+	RefreshToken string
+}
+
+type TokenDto struct {
+	AccessToken      string
+	RefreshToken     string
+	ExpiresAt        time.Time
+	RefreshExpiresAt time.Time
+}
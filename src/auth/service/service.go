@@ -0,0 +1,131 @@
+package service
+
+import (
+	"errors"
+	attemptService "github.com/VlasovArtem/hob/src/auth/attempt/service"
+	"github.com/VlasovArtem/hob/src/auth/model"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/google/uuid"
+	"time"
+)
+
+type AuthServiceObject struct {
+	configuration  model.AuthConfiguration
+	userService    userService.UserService
+	attemptService attemptService.LoginAttemptService
+}
+
+func NewAuthService(
+	configuration model.AuthConfiguration,
+	userService userService.UserService,
+	attemptService attemptService.LoginAttemptService,
+) AuthService {
+	return &AuthServiceObject{
+		configuration:  configuration,
+		userService:    userService,
+		attemptService: attemptService,
+	}
+}
+
+func (a *AuthServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewAuthService(
+		factory.FindRequiredByObject(model.AuthConfiguration{}).(model.AuthConfiguration),
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[attemptService.LoginAttemptServiceObject, attemptService.LoginAttemptService](factory),
+	)
+}
+
+type AuthService interface {
+	Login(request model.LoginRequest, ip string) (model.TokenDto, error)
+	Refresh(request model.RefreshRequest) (model.TokenDto, error)
+	Authenticate(accessToken string) (uuid.UUID, error)
+}
+
+// Login issues the tokens for the valid credentials, the failed attempts from the ip address lock the sign in
+func (a *AuthServiceObject) Login(request model.LoginRequest, ip string) (response model.TokenDto, err error) {
+	user, err := a.attemptService.VerifyUser(request.Email, request.Password, ip)
+	if err != nil {
+		return response, err
+	}
+
+	return a.issueTokens(user.Id)
+}
+
+// Refresh issues the new pair of tokens for the valid refresh token of the existing user
+func (a *AuthServiceObject) Refresh(request model.RefreshRequest) (response model.TokenDto, err error) {
+	claims, err := parseToken(request.RefreshToken, a.configuration.Secret, model.RefreshToken)
+	if err != nil {
+		return response, err
+	}
+
+	if err = a.verifyVersion(claims); err != nil {
+		return response, err
+	}
+
+	return a.issueTokens(claims.UserId)
+}
+
+// Authenticate returns the id of the user the access token was issued for, the tokens issued before the password
+// change are rejected
+func (a *AuthServiceObject) Authenticate(accessToken string) (uuid.UUID, error) {
+	claims, err := parseToken(accessToken, a.configuration.Secret, model.AccessToken)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if err = a.verifyVersion(claims); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return claims.UserId, nil
+}
+
+// verifyVersion checks that the user exists and the token is issued for the current token version of the user
+func (a *AuthServiceObject) verifyVersion(claims model.Claims) error {
+	version, err := a.userService.FindTokenVersion(claims.UserId)
+	if err != nil {
+		if errors.Is(err, int_errors.ErrNotFound{}) {
+			return int_errors.NewErrUnauthorized("token is not valid")
+		}
+		return err
+	}
+
+	if version != claims.Version {
+		return int_errors.NewErrUnauthorized("token is revoked")
+	}
+
+	return nil
+}
+
+func (a *AuthServiceObject) issueTokens(userId uuid.UUID) (response model.TokenDto, err error) {
+	version, err := a.userService.FindTokenVersion(userId)
+	if err != nil {
+		return response, err
+	}
+
+	now := time.Now()
+
+	response.ExpiresAt = now.Add(a.configuration.AccessTokenTTL)
+	if response.AccessToken, err = a.sign(userId, version, model.AccessToken, now, response.ExpiresAt); err != nil {
+		return model.TokenDto{}, err
+	}
+
+	response.RefreshExpiresAt = now.Add(a.configuration.RefreshTokenTTL)
+	if response.RefreshToken, err = a.sign(userId, version, model.RefreshToken, now, response.RefreshExpiresAt); err != nil {
+		return model.TokenDto{}, err
+	}
+
+	return response, nil
+}
+
+func (a *AuthServiceObject) sign(userId uuid.UUID, version int, tokenType model.TokenType, issuedAt time.Time, expiresAt time.Time) (string, error) {
+	return signToken(model.Claims{
+		UserId:    userId,
+		Type:      tokenType,
+		IssuedAt:  issuedAt.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+		Version:   version,
+	}, a.configuration.Secret)
+}
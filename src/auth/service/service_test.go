@@ -0,0 +1,225 @@
+package service
+
+import (
+	"errors"
+	attemptMocks "github.com/VlasovArtem/hob/src/auth/attempt/mocks"
+	"github.com/VlasovArtem/hob/src/auth/mocks"
+	"github.com/VlasovArtem/hob/src/auth/model"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"strings"
+	"testing"
+	"time"
+)
+
+type AuthServiceTestSuite struct {
+	testhelper.MockTestSuite[AuthService]
+	users    *userMocks.UserService
+	attempts *attemptMocks.LoginAttemptService
+}
+
+func TestAuthServiceTestSuite(t *testing.T) {
+	ts := &AuthServiceTestSuite{}
+	ts.TestObjectGenerator = func() AuthService {
+		ts.users = new(userMocks.UserService)
+		ts.attempts = new(attemptMocks.LoginAttemptService)
+		return NewAuthService(mocks.GenerateAuthConfiguration(), ts.users, ts.attempts)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (a *AuthServiceTestSuite) Test_Login() {
+	request := mocks.GenerateLoginRequest()
+	user := userMocks.GenerateUserResponse()
+
+	a.attempts.On("VerifyUser", request.Email, request.Password, mocks.Ip).Return(user, nil)
+	a.users.On("FindTokenVersion", user.Id).Return(1, nil)
+
+	actual, err := a.TestO.Login(request, mocks.Ip)
+
+	assert.Nil(a.T(), err)
+	assert.NotEmpty(a.T(), actual.AccessToken)
+	assert.NotEmpty(a.T(), actual.RefreshToken)
+	assert.True(a.T(), actual.ExpiresAt.Before(actual.RefreshExpiresAt))
+
+	userId, err := a.TestO.Authenticate(actual.AccessToken)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), user.Id, userId)
+}
+
+func (a *AuthServiceTestSuite) Test_Login_WithInvalidCredentials() {
+	request := mocks.GenerateLoginRequest()
+	expected := int_errors.NewErrUnauthorized("credentials are not valid")
+
+	a.attempts.On("VerifyUser", request.Email, request.Password, mocks.Ip).Return(userModel.UserDto{}, expected)
+
+	actual, err := a.TestO.Login(request, mocks.Ip)
+
+	assert.Equal(a.T(), expected, err)
+	assert.Equal(a.T(), model.TokenDto{}, actual)
+}
+
+func (a *AuthServiceTestSuite) Test_Login_WithLockedAccount() {
+	request := mocks.GenerateLoginRequest()
+	expected := int_errors.NewErrLocked(time.Now().Add(time.Minute), "account locked until")
+
+	a.attempts.On("VerifyUser", request.Email, request.Password, mocks.Ip).Return(userModel.UserDto{}, expected)
+
+	actual, err := a.TestO.Login(request, mocks.Ip)
+
+	assert.Equal(a.T(), expected, err)
+	assert.Equal(a.T(), model.TokenDto{}, actual)
+}
+
+func (a *AuthServiceTestSuite) Test_Refresh() {
+	userId := uuid.New()
+	token := a.sign(userId, model.RefreshToken, time.Hour)
+
+	a.users.On("FindTokenVersion", userId).Return(0, nil)
+
+	actual, err := a.TestO.Refresh(model.RefreshRequest{RefreshToken: token})
+
+	assert.Nil(a.T(), err)
+
+	actualUserId, err := a.TestO.Authenticate(actual.AccessToken)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), userId, actualUserId)
+}
+
+func (a *AuthServiceTestSuite) Test_Refresh_WithAccessToken() {
+	token := a.sign(uuid.New(), model.AccessToken, time.Hour)
+
+	_, err := a.TestO.Refresh(model.RefreshRequest{RefreshToken: token})
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is not refresh token"), err)
+	a.users.AssertNotCalled(a.T(), "FindTokenVersion", mock.Anything)
+}
+
+func (a *AuthServiceTestSuite) Test_Refresh_WithNotExistingUser() {
+	userId := uuid.New()
+	token := a.sign(userId, model.RefreshToken, time.Hour)
+
+	a.users.On("FindTokenVersion", userId).Return(0, int_errors.NewErrNotFound("user with id %s not found", userId))
+
+	_, err := a.TestO.Refresh(model.RefreshRequest{RefreshToken: token})
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is not valid"), err)
+}
+
+func (a *AuthServiceTestSuite) Test_Refresh_WithChangedPassword() {
+	userId := uuid.New()
+	token := a.sign(userId, model.RefreshToken, time.Hour)
+
+	a.users.On("FindTokenVersion", userId).Return(1, nil)
+
+	actual, err := a.TestO.Refresh(model.RefreshRequest{RefreshToken: token})
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is revoked"), err)
+	assert.Equal(a.T(), model.TokenDto{}, actual)
+}
+
+func (a *AuthServiceTestSuite) Test_Authenticate() {
+	userId := uuid.New()
+	token := a.sign(userId, model.AccessToken, time.Hour)
+
+	a.users.On("FindTokenVersion", userId).Return(0, nil)
+
+	actual, err := a.TestO.Authenticate(token)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), userId, actual)
+}
+
+func (a *AuthServiceTestSuite) Test_Authenticate_WithChangedPassword() {
+	userId := uuid.New()
+	token := a.sign(userId, model.AccessToken, time.Hour)
+
+	a.users.On("FindTokenVersion", userId).Return(1, nil)
+
+	actual, err := a.TestO.Authenticate(token)
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is revoked"), err)
+	assert.Equal(a.T(), uuid.UUID{}, actual)
+}
+
+func (a *AuthServiceTestSuite) Test_Authenticate_WithNotExistingUser() {
+	userId := uuid.New()
+	token := a.sign(userId, model.AccessToken, time.Hour)
+
+	a.users.On("FindTokenVersion", userId).Return(0, int_errors.NewErrNotFound("user with id %s not found", userId))
+
+	_, err := a.TestO.Authenticate(token)
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is not valid"), err)
+}
+
+func (a *AuthServiceTestSuite) Test_Authenticate_WithRefreshToken() {
+	token := a.sign(uuid.New(), model.RefreshToken, time.Hour)
+
+	_, err := a.TestO.Authenticate(token)
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is not access token"), err)
+}
+
+func (a *AuthServiceTestSuite) Test_Authenticate_WithExpiredToken() {
+	token := a.sign(uuid.New(), model.AccessToken, -time.Minute)
+
+	_, err := a.TestO.Authenticate(token)
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is expired"), err)
+}
+
+func (a *AuthServiceTestSuite) Test_Authenticate_WithAnotherSecret() {
+	token, _ := signToken(model.Claims{
+		UserId:    uuid.New(),
+		Type:      model.AccessToken,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}, []byte("another"))
+
+	_, err := a.TestO.Authenticate(token)
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is not valid"), err)
+}
+
+func (a *AuthServiceTestSuite) Test_Authenticate_WithModifiedPayload() {
+	token := a.sign(uuid.New(), model.AccessToken, time.Hour)
+	another := a.sign(uuid.New(), model.AccessToken, time.Hour)
+
+	_, err := a.TestO.Authenticate(replacePayload(token, another))
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("token is not valid"), err)
+}
+
+func (a *AuthServiceTestSuite) Test_Authenticate_WithMalformedToken() {
+	_, err := a.TestO.Authenticate("token")
+
+	assert.True(a.T(), errors.Is(err, int_errors.ErrUnauthorized{}))
+}
+
+func (a *AuthServiceTestSuite) sign(userId uuid.UUID, tokenType model.TokenType, ttl time.Duration) string {
+	token, err := signToken(model.Claims{
+		UserId:    userId,
+		Type:      tokenType,
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+	}, mocks.Secret)
+	assert.Nil(a.T(), err)
+
+	return token
+}
+
+func replacePayload(token string, another string) string {
+	tokenParts := strings.Split(token, ".")
+	anotherParts := strings.Split(another, ".")
+
+	return strings.Join([]string{tokenParts[0], anotherParts[1], tokenParts[2]}, ".")
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/VlasovArtem/hob/src/auth/model"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	"strings"
+	"time"
+)
+
+// tokenHeader is the encoded JWT header, the tokens are always signed with HMAC SHA-256
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+func signToken(claims model.Claims, secret []byte) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	return unsigned + "." + signature(unsigned, secret), nil
+}
+
+func parseToken(token string, secret []byte, tokenType model.TokenType) (claims model.Claims, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return claims, int_errors.NewErrUnauthorized("token is not valid")
+	}
+
+	if !hmac.Equal([]byte(parts[2]), []byte(signature(parts[0]+"."+parts[1], secret))) {
+		return claims, int_errors.NewErrUnauthorized("token is not valid")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return claims, int_errors.NewErrUnauthorized("token is not valid")
+	}
+
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return claims, int_errors.NewErrUnauthorized("token is not valid")
+	}
+
+	if claims.Type != tokenType {
+		return claims, int_errors.NewErrUnauthorized("token is not %s token", tokenType)
+	}
+
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return claims, int_errors.NewErrUnauthorized("token is expired")
+	}
+
+	return claims, nil
+}
+
+func signature(unsigned string, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/budget/model"
+	"github.com/VlasovArtem/hob/src/budget/service"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/gorilla/mux"
+	"net/http"
+	"time"
+)
+
+type BudgetHandlerObject struct {
+	budgetService service.BudgetService
+}
+
+func NewBudgetHandler(budgetService service.BudgetService) BudgetHandler {
+	return &BudgetHandlerObject{budgetService}
+}
+
+func (b *BudgetHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewBudgetHandler(dependency.FindRequiredDependency[service.BudgetServiceObject, service.BudgetService](factory))
+}
+
+func (b *BudgetHandlerObject) Init(router *mux.Router) {
+	budgetRouter := router.PathPrefix("/api/v1/budgets").Subrouter()
+
+	budgetRouter.Path("").HandlerFunc(b.Add()).Methods("POST")
+	budgetRouter.Path("/{id}").HandlerFunc(b.FindById()).Methods("GET")
+	budgetRouter.Path("/{id}").HandlerFunc(b.Update()).Methods("PUT")
+	budgetRouter.Path("/{id}").HandlerFunc(b.Delete()).Methods("DELETE")
+	budgetRouter.Path("/{id}/status").HandlerFunc(b.FindStatusById()).Methods("GET")
+	budgetRouter.Path("/house/{id}").HandlerFunc(b.FindByHouseId()).Methods("GET")
+	budgetRouter.Path("/house/{id}/status").HandlerFunc(b.FindStatusByHouseId()).Methods("GET")
+}
+
+type BudgetHandler interface {
+	Add() http.HandlerFunc
+	FindById() http.HandlerFunc
+	FindByHouseId() http.HandlerFunc
+	FindStatusById() http.HandlerFunc
+	FindStatusByHouseId() http.HandlerFunc
+	Update() http.HandlerFunc
+	Delete() http.HandlerFunc
+}
+
+func (b *BudgetHandlerObject) Add() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		if body, err := rest.ReadRequestBody[model.CreateBudgetRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			body.UserId = userId
+
+			rest.NewAPIResponse(writer).
+				Created(b.budgetService.Add(body)).
+				Perform()
+		}
+	}
+}
+
+func (b *BudgetHandlerObject) FindById() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(b.budgetService.FindById(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (b *BudgetHandlerObject) FindByHouseId() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Body(b.budgetService.FindByHouseId(id, userId)).
+				Perform()
+		}
+	}
+}
+
+// FindStatusById returns the status of the budget for the period containing the date query parameter or the current date
+func (b *BudgetHandlerObject) FindStatusById() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else if date, err := statusDate(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(b.budgetService.FindStatusById(id, userId, date)).
+				Perform()
+		}
+	}
+}
+
+// FindStatusByHouseId returns the statuses of the house budgets for the periods containing the date query parameter or
+// the current date
+func (b *BudgetHandlerObject) FindStatusByHouseId() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else if date, err := statusDate(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(b.budgetService.FindStatusByHouseId(id, userId, date)).
+				Perform()
+		}
+	}
+}
+
+func (b *BudgetHandlerObject) Update() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			if body, err := rest.ReadRequestBody[model.UpdateBudgetRequest](request); err != nil {
+				rest.HandleWithError(writer, err)
+			} else {
+				rest.NewAPIResponse(writer).
+					Error(b.budgetService.Update(id, userId, body)).
+					Perform()
+			}
+		}
+	}
+}
+
+func (b *BudgetHandlerObject) Delete() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(b.budgetService.DeleteById(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func statusDate(request *http.Request) (time.Time, error) {
+	now := time.Now()
+
+	if date, err := rest.GetQueryParamOrDefaultReference[time.Time](request, "date", &now); err != nil {
+		return time.Time{}, err
+	} else {
+		return *date, nil
+	}
+}
@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/VlasovArtem/hob/src/budget/mocks"
+	"github.com/VlasovArtem/hob/src/budget/model"
+	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type BudgetHandlerTestSuite struct {
+	testhelper.MockTestSuite[BudgetHandler]
+	budgetService *mocks.BudgetService
+}
+
+func TestBudgetHandlerTestSuite(t *testing.T) {
+	testingSuite := &BudgetHandlerTestSuite{}
+	testingSuite.TestObjectGenerator = func() BudgetHandler {
+		testingSuite.budgetService = new(mocks.BudgetService)
+		return NewBudgetHandler(testingSuite.budgetService)
+	}
+
+	suite.Run(t, testingSuite)
+}
+
+func (b *BudgetHandlerTestSuite) Test_Add() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateBudgetRequest(uuid.New(), userId)
+	expected := request.ToEntity().ToDto()
+
+	b.budgetService.On("Add", request).Return(expected, nil)
+
+	body := request
+	body.UserId = uuid.Nil
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(b.TestO.Add()).
+		WithBody(body)
+
+	content := testRequest.Verify(b.T(), http.StatusCreated)
+
+	actual := model.BudgetDto{}
+
+	assert.Nil(b.T(), json.Unmarshal(content, &actual))
+	assert.Equal(b.T(), expected, actual)
+}
+
+func (b *BudgetHandlerTestSuite) Test_Add_WithInvalidRequest() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(b.TestO.Add())
+
+	testRequest.Verify(b.T(), http.StatusBadRequest)
+}
+
+func (b *BudgetHandlerTestSuite) Test_Add_WithErrorFromService() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateBudgetRequest(uuid.New(), userId)
+
+	b.budgetService.On("Add", request).Return(model.BudgetDto{}, errors.New("error"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(b.TestO.Add()).
+		WithBody(request)
+
+	content := testRequest.Verify(b.T(), http.StatusBadRequest)
+
+	assert.Equal(b.T(), "error\n", string(content))
+}
+
+func (b *BudgetHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
+	expected := mocks.GenerateBudget(uuid.New(), userId).ToDto()
+
+	b.budgetService.On("FindById", expected.Id, userId).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/{id}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(b.TestO.FindById()).
+		WithVar("id", expected.Id.String())
+
+	content := testRequest.Verify(b.T(), http.StatusOK)
+
+	actual := model.BudgetDto{}
+
+	assert.Nil(b.T(), json.Unmarshal(content, &actual))
+	assert.Equal(b.T(), expected, actual)
+}
+
+func (b *BudgetHandlerTestSuite) Test_FindById_WithNotFoundErrorFromService() {
+	userId, id := uuid.New(), uuid.New()
+
+	b.budgetService.On("FindById", id, userId).Return(model.BudgetDto{}, int_errors.NewErrNotFound("test"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/{id}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(b.TestO.FindById()).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(b.T(), http.StatusNotFound)
+
+	assert.Equal(b.T(), "test\n", string(content))
+}
+
+func (b *BudgetHandlerTestSuite) Test_FindByHouseId() {
+	houseId, userId := uuid.New(), uuid.New()
+	expected := []model.BudgetDto{mocks.GenerateBudget(houseId, userId).ToDto()}
+
+	b.budgetService.On("FindByHouseId", houseId, userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/house/{id}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(b.TestO.FindByHouseId()).
+		WithVar("id", houseId.String())
+
+	content := testRequest.Verify(b.T(), http.StatusOK)
+
+	var actual []model.BudgetDto
+
+	assert.Nil(b.T(), json.Unmarshal(content, &actual))
+	assert.Equal(b.T(), expected, actual)
+}
+
+func (b *BudgetHandlerTestSuite) Test_FindStatusById() {
+	userId := uuid.New()
+	date := time.Date(2022, time.March, 15, 0, 0, 0, 0, time.UTC)
+	budget := mocks.GenerateBudget(uuid.New(), userId).ToDto()
+	from, to := budget.Period.Range(date)
+	expected := model.NewStatus(budget, from, to, money.FromMinorUnits(250000))
+
+	b.budgetService.On("FindStatusById", budget.Id, userId, date).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/{id}/status?date={date}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(b.TestO.FindStatusById()).
+		WithVar("id", budget.Id.String()).
+		WithParameter("date", date.Format(time.RFC3339))
+
+	content := testRequest.Verify(b.T(), http.StatusOK)
+
+	actual := model.BudgetStatusDto{}
+
+	assert.Nil(b.T(), json.Unmarshal(content, &actual))
+	assert.Equal(b.T(), expected, actual)
+}
+
+func (b *BudgetHandlerTestSuite) Test_FindStatusById_WithInvalidDate() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/{id}/status?date={date}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(b.TestO.FindStatusById()).
+		WithVar("id", uuid.New().String()).
+		WithParameter("date", "2022-03")
+
+	content := testRequest.Verify(b.T(), http.StatusBadRequest)
+
+	assert.Equal(b.T(), "the time is not valid RFC3339\n", string(content))
+	b.budgetService.AssertNotCalled(b.T(), "FindStatusById", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (b *BudgetHandlerTestSuite) Test_FindStatusByHouseId() {
+	houseId, userId := uuid.New(), uuid.New()
+	budget := mocks.GenerateBudget(houseId, userId).ToDto()
+	from, to := budget.Period.Range(time.Now())
+	expected := []model.BudgetStatusDto{model.NewStatus(budget, from, to, money.FromMinorUnits(1500000))}
+
+	b.budgetService.On("FindStatusByHouseId", houseId, userId, mock.AnythingOfType("time.Time")).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/house/{id}/status").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(b.TestO.FindStatusByHouseId()).
+		WithVar("id", houseId.String())
+
+	content := testRequest.Verify(b.T(), http.StatusOK)
+
+	var actual []model.BudgetStatusDto
+
+	assert.Nil(b.T(), json.Unmarshal(content, &actual))
+	assert.Len(b.T(), actual, 1)
+	assert.True(b.T(), actual[0].OverBudget)
+	assert.Equal(b.T(), money.FromMinorUnits(-500000), actual[0].Remaining)
+}
+
+func (b *BudgetHandlerTestSuite) Test_FindStatusByHouseId_WithNotFoundErrorFromService() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	b.budgetService.On("FindStatusByHouseId", houseId, userId, mock.Anything).Return(nil, int_errors.NewErrNotFound("test"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/house/{id}/status").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(b.TestO.FindStatusByHouseId()).
+		WithVar("id", houseId.String())
+
+	content := testRequest.Verify(b.T(), http.StatusNotFound)
+
+	assert.Equal(b.T(), "test\n", string(content))
+}
+
+func (b *BudgetHandlerTestSuite) Test_Update() {
+	userId, id := uuid.New(), uuid.New()
+	request := model.UpdateBudgetRequest{Name: "Annual", Period: model.YEARLY, Sum: money.FromMinorUnits(12000000)}
+
+	b.budgetService.On("Update", id, userId, request).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/{id}").
+		WithMethod("PUT").
+		WithUser(userId).
+		WithHandler(b.TestO.Update()).
+		WithVar("id", id.String()).
+		WithBody(request)
+
+	testRequest.Verify(b.T(), http.StatusOK)
+}
+
+func (b *BudgetHandlerTestSuite) Test_Delete() {
+	userId, id := uuid.New(), uuid.New()
+
+	b.budgetService.On("DeleteById", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/budgets/{id}").
+		WithMethod("DELETE").
+		WithUser(userId).
+		WithHandler(b.TestO.Delete()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(b.T(), http.StatusNoContent)
+}
@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/budget/model"
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/google/uuid"
+)
+
+func GenerateCreateBudgetRequest(houseId uuid.UUID, userId uuid.UUID) model.CreateBudgetRequest {
+	return model.CreateBudgetRequest{
+		Name:     "Household",
+		HouseId:  houseId,
+		UserId:   userId,
+		Period:   model.MONTHLY,
+		Sum:      money.FromMinorUnits(1000000),
+		Currency: "UAH",
+	}
+}
+
+func GenerateBudget(houseId uuid.UUID, userId uuid.UUID) model.Budget {
+	return model.Budget{
+		Id:       uuid.New(),
+		Name:     "Household",
+		HouseId:  houseId,
+		UserId:   userId,
+		Period:   model.MONTHLY,
+		Sum:      money.FromMinorUnits(1000000),
+		Currency: "UAH",
+	}
+}
@@ -0,0 +1,155 @@
+package model
+
+import (
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/common/money"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Period is the time span the budget is planned for, the budget starts again at the beginning of every period
+type Period string
+
+const (
+	MONTHLY Period = "MONTHLY"
+	YEARLY  Period = "YEARLY"
+)
+
+// Budget is the sum planned for the payments of the house during the period, the budget covers only the payments of the
+// provider and the category with its subcategories if they are set
+type Budget struct {
+	Id         uuid.UUID `gorm:"primarykey"`
+	Name       string
+	HouseId    uuid.UUID        `gorm:"index"`
+	House      houseModel.House `gorm:"foreignKey:HouseId;constraint:OnDelete:CASCADE"`
+	UserId     uuid.UUID
+	User       userModel.User `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+	ProviderId *uuid.UUID
+	Provider   providerModel.Provider `gorm:"foreignKey:ProviderId;constraint:OnDelete:CASCADE"`
+	CategoryId *uuid.UUID
+	Category   categoryModel.Category `gorm:"foreignKey:CategoryId;constraint:OnDelete:CASCADE"`
+	Period     Period
+	Sum        money.Money
+	// Currency is the ISO 4217 code of the sum, the payments in other currencies are converted to it
+	Currency string
+}
+
+type CreateBudgetRequest struct {
+	Name       string
+	HouseId    uuid.UUID
+	UserId     uuid.UUID
+	ProviderId *uuid.UUID
+	CategoryId *uuid.UUID
+	Period     Period
+	Sum        money.Money
+	Currency   string
+}
+
+type UpdateBudgetRequest struct {
+	Name       string
+	ProviderId *uuid.UUID
+	CategoryId *uuid.UUID
+	Period     Period
+	Sum        money.Money
+	Currency   string
+}
+
+type BudgetDto struct {
+	Id         uuid.UUID
+	Name       string
+	HouseId    uuid.UUID
+	UserId     uuid.UUID
+	ProviderId *uuid.UUID
+	CategoryId *uuid.UUID
+	Period     Period
+	Sum        money.Money
+	Currency   string
+}
+
+// BudgetStatusDto compares the planned sum of the budget with the payments of the period, the period starts at From and
+// ends before To
+type BudgetStatusDto struct {
+	Budget     BudgetDto
+	From       time.Time
+	To         time.Time
+	Planned    money.Money
+	Spent      money.Money
+	Remaining  money.Money
+	OverBudget bool
+}
+
+// IsValid checks that the period is one of the supported periods
+func (p Period) IsValid() bool {
+	return p == MONTHLY || p == YEARLY
+}
+
+// Range returns the start of the period containing the date and the start of the next period in the location of the date
+func (p Period) Range(date time.Time) (from time.Time, to time.Time) {
+	if p == YEARLY {
+		from = time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, date.Location())
+		return from, from.AddDate(1, 0, 0)
+	}
+
+	from = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	return from, from.AddDate(0, 1, 0)
+}
+
+func (b Budget) ToDto() BudgetDto {
+	return BudgetDto{
+		Id:         b.Id,
+		Name:       b.Name,
+		HouseId:    b.HouseId,
+		UserId:     b.UserId,
+		ProviderId: b.ProviderId,
+		CategoryId: b.CategoryId,
+		Period:     b.Period,
+		Sum:        b.Sum,
+		Currency:   b.Currency,
+	}
+}
+
+func (c CreateBudgetRequest) ToEntity() Budget {
+	return Budget{
+		Id:         uuid.New(),
+		Name:       c.Name,
+		HouseId:    c.HouseId,
+		UserId:     c.UserId,
+		ProviderId: c.ProviderId,
+		CategoryId: c.CategoryId,
+		Period:     c.Period,
+		Sum:        c.Sum,
+		Currency:   c.Currency,
+	}
+}
+
+func (u UpdateBudgetRequest) ToEntity(id uuid.UUID) Budget {
+	return Budget{
+		Id:         id,
+		Name:       u.Name,
+		ProviderId: u.ProviderId,
+		CategoryId: u.CategoryId,
+		Period:     u.Period,
+		Sum:        u.Sum,
+		Currency:   u.Currency,
+	}
+}
+
+func BudgetToBudgetDto(budget Budget) BudgetDto {
+	return budget.ToDto()
+}
+
+// NewStatus returns the status of the budget with the sum spent during the period
+func NewStatus(budget BudgetDto, from, to time.Time, spent money.Money) BudgetStatusDto {
+	return BudgetStatusDto{
+		Budget:     budget,
+		From:       from,
+		To:         to,
+		Planned:    budget.Sum,
+		Spent:      spent,
+		Remaining:  budget.Sum - spent,
+		OverBudget: spent > budget.Sum,
+	}
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/budget/model"
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+var entity = model.Budget{}
+
+type BudgetRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewBudgetRepository(database db.DatabaseService) BudgetRepository {
+	return &BudgetRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (b *BudgetRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewBudgetRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (b *BudgetRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type BudgetRepository interface {
+	Create(entity model.Budget) (model.Budget, error)
+	FindById(id uuid.UUID) (model.Budget, error)
+	FindByHouseId(houseId uuid.UUID) []model.BudgetDto
+	Update(entity model.Budget) error
+	DeleteById(id uuid.UUID) error
+}
+
+func (b *BudgetRepositoryObject) Create(entity model.Budget) (model.Budget, error) {
+	return entity, b.database.Create(&entity)
+}
+
+func (b *BudgetRepositoryObject) FindById(id uuid.UUID) (response model.Budget, err error) {
+	return response, b.database.Find(&response, id)
+}
+
+// FindByHouseId returns the budgets of the house sorted by the period and the name
+func (b *BudgetRepositoryObject) FindByHouseId(houseId uuid.UUID) []model.BudgetDto {
+	var entities []model.Budget
+
+	if err := b.database.Modeled().Where("house_id = ?", houseId).Order("period, name").Find(&entities).Error; err != nil {
+		log.Err(err).Msg("Error during find budgets by house id")
+		return []model.BudgetDto{}
+	}
+
+	return common.MapSlice(entities, model.BudgetToBudgetDto)
+}
+
+// Update changes the budget, the provider and the category are cleared if they are not set
+func (b *BudgetRepositoryObject) Update(entity model.Budget) error {
+	return b.database.Modeled().
+		Where("id = ?", entity.Id).
+		Select("Name", "ProviderId", "CategoryId", "Period", "Sum", "Currency").
+		Updates(entity).
+		Error
+}
+
+func (b *BudgetRepositoryObject) DeleteById(id uuid.UUID) error {
+	return b.database.Delete(id)
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/budget/mocks"
+	"github.com/VlasovArtem/hob/src/budget/model"
+	categoryMocks "github.com/VlasovArtem/hob/src/category/mocks"
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/VlasovArtem/hob/src/db"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type BudgetRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository      BudgetRepository
+	createdUser     userModel.User
+	createdHouse    houseModel.House
+	createdCategory categoryModel.Category
+}
+
+func (b *BudgetRepositoryTestSuite) SetupSuite() {
+	b.InitDBTestSuite()
+
+	b.CreateRepository(
+		func(service db.DatabaseService) {
+			b.repository = NewBudgetRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, model.Budget{})
+		}).
+		AddAfterSuite(func(service db.DatabaseService) {
+			database.TruncateTable(service, categoryModel.Category{})
+			database.TruncateTable(service, houseModel.House{})
+			database.TruncateTable(service, userModel.User{})
+		}).
+		ExecuteMigration(userModel.User{}, houseModel.House{}, providerModel.Provider{}, categoryModel.Category{}, model.Budget{})
+
+	b.createdUser = userMocks.GenerateUser()
+	b.CreateEntity(&b.createdUser)
+
+	b.createdHouse = houseMocks.GenerateHouse(b.createdUser.Id)
+	b.CreateEntity(&b.createdHouse)
+
+	b.createdCategory = categoryMocks.GenerateCategory(b.createdUser.Id, nil, "Groceries")
+	b.CreateEntity(&b.createdCategory)
+}
+
+func TestBudgetRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(BudgetRepositoryTestSuite))
+}
+
+func (b *BudgetRepositoryTestSuite) Test_Create() {
+	entity := mocks.GenerateBudget(b.createdHouse.Id, b.createdUser.Id)
+
+	actual, err := b.repository.Create(entity)
+
+	assert.Nil(b.T(), err)
+	assert.Equal(b.T(), entity, actual)
+}
+
+func (b *BudgetRepositoryTestSuite) Test_Create_WithMissingHouse() {
+	entity := mocks.GenerateBudget(uuid.New(), b.createdUser.Id)
+
+	_, err := b.repository.Create(entity)
+
+	assert.NotNil(b.T(), err)
+}
+
+func (b *BudgetRepositoryTestSuite) Test_FindById() {
+	entity := b.createBudget("Household", model.MONTHLY)
+
+	actual, err := b.repository.FindById(entity.Id)
+
+	assert.Nil(b.T(), err)
+	assert.Equal(b.T(), entity.ToDto(), actual.ToDto())
+}
+
+func (b *BudgetRepositoryTestSuite) Test_FindById_WithNotExists() {
+	actual, err := b.repository.FindById(uuid.New())
+
+	assert.ErrorIs(b.T(), err, gorm.ErrRecordNotFound)
+	assert.Equal(b.T(), model.Budget{}, actual)
+}
+
+func (b *BudgetRepositoryTestSuite) Test_FindByHouseId() {
+	yearly := b.createBudget("Household", model.YEARLY)
+	utilities := b.createBudget("Utilities", model.MONTHLY)
+	groceries := b.createBudget("Groceries", model.MONTHLY)
+
+	actual := b.repository.FindByHouseId(b.createdHouse.Id)
+
+	assert.Equal(b.T(), []model.BudgetDto{groceries.ToDto(), utilities.ToDto(), yearly.ToDto()}, actual)
+}
+
+func (b *BudgetRepositoryTestSuite) Test_FindByHouseId_WithMissingHouse() {
+	b.createBudget("Household", model.MONTHLY)
+
+	assert.Equal(b.T(), []model.BudgetDto{}, b.repository.FindByHouseId(uuid.New()))
+}
+
+func (b *BudgetRepositoryTestSuite) Test_Update() {
+	entity := b.createBudget("Groceries", model.MONTHLY)
+	entity.CategoryId = &b.createdCategory.Id
+	b.Database.D().Save(&entity)
+
+	updated := model.UpdateBudgetRequest{
+		Name:     "Household",
+		Period:   model.YEARLY,
+		Sum:      money.FromMinorUnits(12000000),
+		Currency: "EUR",
+	}.ToEntity(entity.Id)
+
+	assert.Nil(b.T(), b.repository.Update(updated))
+
+	actual, err := b.repository.FindById(entity.Id)
+
+	assert.Nil(b.T(), err)
+	assert.Equal(b.T(), model.BudgetDto{
+		Id:       entity.Id,
+		Name:     "Household",
+		HouseId:  entity.HouseId,
+		UserId:   entity.UserId,
+		Period:   model.YEARLY,
+		Sum:      money.FromMinorUnits(12000000),
+		Currency: "EUR",
+	}, actual.ToDto())
+}
+
+func (b *BudgetRepositoryTestSuite) Test_DeleteById() {
+	entity := b.createBudget("Household", model.MONTHLY)
+
+	assert.Nil(b.T(), b.repository.DeleteById(entity.Id))
+
+	_, err := b.repository.FindById(entity.Id)
+
+	assert.ErrorIs(b.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (b *BudgetRepositoryTestSuite) createBudget(name string, period model.Period) model.Budget {
+	entity := mocks.GenerateBudget(b.createdHouse.Id, b.createdUser.Id)
+	entity.Name = name
+	entity.Period = period
+
+	b.CreateEntity(&entity)
+
+	return entity
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/budget/model"
+	"github.com/VlasovArtem/hob/src/budget/repository"
+	categories "github.com/VlasovArtem/hob/src/category/service"
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/database"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	exchangeModel "github.com/VlasovArtem/hob/src/exchange/model"
+	exchanges "github.com/VlasovArtem/hob/src/exchange/service"
+	houses "github.com/VlasovArtem/hob/src/house/service"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	payments "github.com/VlasovArtem/hob/src/payment/repository"
+	providers "github.com/VlasovArtem/hob/src/provider/service"
+	users "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+type BudgetServiceObject struct {
+	userService         users.UserService
+	houseService        houses.HouseService
+	providerService     providers.ProviderService
+	categoryService     categories.CategoryService
+	exchangeRateService exchanges.ExchangeRateService
+	paymentRepository   payments.PaymentRepository
+	repository          repository.BudgetRepository
+}
+
+func NewBudgetService(
+	userService users.UserService,
+	houseService houses.HouseService,
+	providerService providers.ProviderService,
+	categoryService categories.CategoryService,
+	exchangeRateService exchanges.ExchangeRateService,
+	paymentRepository payments.PaymentRepository,
+	repository repository.BudgetRepository) BudgetService {
+	return &BudgetServiceObject{
+		userService:         userService,
+		houseService:        houseService,
+		providerService:     providerService,
+		categoryService:     categoryService,
+		exchangeRateService: exchangeRateService,
+		paymentRepository:   paymentRepository,
+		repository:          repository,
+	}
+}
+
+func (b *BudgetServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewBudgetService(
+		dependency.FindRequiredDependency[users.UserServiceObject, users.UserService](factory),
+		dependency.FindRequiredDependency[houses.HouseServiceObject, houses.HouseService](factory),
+		dependency.FindRequiredDependency[providers.ProviderServiceObject, providers.ProviderService](factory),
+		dependency.FindRequiredDependency[categories.CategoryServiceObject, categories.CategoryService](factory),
+		dependency.FindRequiredDependency[exchanges.ExchangeRateServiceObject, exchanges.ExchangeRateService](factory),
+		dependency.FindRequiredDependency[payments.PaymentRepositoryObject, payments.PaymentRepository](factory),
+		dependency.FindRequiredDependency[repository.BudgetRepositoryObject, repository.BudgetRepository](factory),
+	)
+}
+
+type BudgetService interface {
+	Add(request model.CreateBudgetRequest) (model.BudgetDto, error)
+	FindById(id uuid.UUID, userId uuid.UUID) (model.BudgetDto, error)
+	FindByHouseId(houseId uuid.UUID, userId uuid.UUID) []model.BudgetDto
+	FindStatusById(id uuid.UUID, userId uuid.UUID, date time.Time) (model.BudgetStatusDto, error)
+	FindStatusByHouseId(houseId uuid.UUID, userId uuid.UUID, date time.Time) ([]model.BudgetStatusDto, error)
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateBudgetRequest) error
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+}
+
+// Add creates the budget of the house, the currency of the house country is used if the currency is not set
+func (b *BudgetServiceObject) Add(request model.CreateBudgetRequest) (response model.BudgetDto, err error) {
+	if !b.userService.ExistsById(request.UserId) {
+		return response, interrors.NewErrNotFound("user with id %s not found", request.UserId)
+	}
+	if !b.houseService.CanModify(request.HouseId, request.UserId) {
+		return response, interrors.NewErrNotFound("house with id %s not found", request.HouseId)
+	}
+
+	entity := request.ToEntity()
+	if err = b.validate(&entity); err != nil {
+		return response, err
+	}
+
+	if entity, err = b.repository.Create(entity); err != nil {
+		return response, err
+	}
+	return entity.ToDto(), nil
+}
+
+// FindById returns the budget of the house available to the user
+func (b *BudgetServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (model.BudgetDto, error) {
+	if budget, err := b.find(id, userId, b.houseService.HasAccess); err != nil {
+		return model.BudgetDto{}, err
+	} else {
+		return budget.ToDto(), nil
+	}
+}
+
+func (b *BudgetServiceObject) FindByHouseId(houseId uuid.UUID, userId uuid.UUID) []model.BudgetDto {
+	if !b.houseService.HasAccess(houseId, userId) {
+		return make([]model.BudgetDto, 0)
+	}
+	return b.repository.FindByHouseId(houseId)
+}
+
+// FindStatusById returns the status of the budget for the period containing the date
+func (b *BudgetServiceObject) FindStatusById(id uuid.UUID, userId uuid.UUID, date time.Time) (model.BudgetStatusDto, error) {
+	budget, err := b.find(id, userId, b.houseService.HasAccess)
+	if err != nil {
+		return model.BudgetStatusDto{}, err
+	}
+	return b.status(budget.ToDto(), date)
+}
+
+// FindStatusByHouseId returns the statuses of the house budgets for the periods containing the date
+func (b *BudgetServiceObject) FindStatusByHouseId(houseId uuid.UUID, userId uuid.UUID, date time.Time) ([]model.BudgetStatusDto, error) {
+	if !b.houseService.HasAccess(houseId, userId) {
+		return nil, interrors.NewErrNotFound("house with id %s not found", houseId)
+	}
+
+	budgets := b.repository.FindByHouseId(houseId)
+	statuses := make([]model.BudgetStatusDto, 0, len(budgets))
+
+	for _, budget := range budgets {
+		status, err := b.status(budget, date)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Update changes the budget, the provider and the category should belong to the owner of the budget
+func (b *BudgetServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateBudgetRequest) error {
+	budget, err := b.find(id, userId, b.houseService.CanModify)
+	if err != nil {
+		return err
+	}
+
+	entity := request.ToEntity(id)
+	entity.HouseId = budget.HouseId
+	entity.UserId = budget.UserId
+	if err = b.validate(&entity); err != nil {
+		return err
+	}
+
+	return b.repository.Update(entity)
+}
+
+func (b *BudgetServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if _, err := b.find(id, userId, b.houseService.CanModify); err != nil {
+		return err
+	}
+	return b.repository.DeleteById(id)
+}
+
+// find returns the budget if the user is permitted to access its house
+func (b *BudgetServiceObject) find(id uuid.UUID, userId uuid.UUID, permitted func(houseId uuid.UUID, userId uuid.UUID) bool) (model.Budget, error) {
+	if budget, err := b.repository.FindById(id); err != nil {
+		return model.Budget{}, database.HandlerFindError(err, fmt.Sprintf("budget with id %s not found", id))
+	} else if !permitted(budget.HouseId, userId) {
+		return model.Budget{}, interrors.NewErrNotFound("budget with id %s not found", id)
+	} else {
+		return budget, nil
+	}
+}
+
+// status sums the payments of the budget period converted to the budget currency with the exchange rates of the budget
+// owner, the payments of the category subcategories are included
+func (b *BudgetServiceObject) status(budget model.BudgetDto, date time.Time) (model.BudgetStatusDto, error) {
+	var categoryIds []uuid.UUID
+
+	if budget.CategoryId != nil {
+		ids, err := b.categoryService.FindSubcategoryIds(*budget.CategoryId, budget.UserId)
+		if err != nil {
+			return model.BudgetStatusDto{}, err
+		}
+		categoryIds = ids
+	}
+
+	from, to := budget.Period.Range(date)
+	amounts := common.MapSlice(b.paymentRepository.FindAmounts(budget.HouseId, from, to, budget.ProviderId, categoryIds), paymentAmount)
+
+	spent, err := b.exchangeRateService.Total(budget.UserId, amounts, budget.Currency)
+	if err != nil {
+		return model.BudgetStatusDto{}, err
+	}
+
+	return model.NewStatus(budget, from, to, spent), nil
+}
+
+func paymentAmount(amount paymentModel.Amount) exchangeModel.Amount {
+	return exchangeModel.Amount{
+		Sum:      amount.Sum,
+		Currency: amount.Currency,
+		Date:     amount.Date,
+	}
+}
+
+// validate normalizes the name and the currency of the budget and checks its provider, category, period and sum
+func (b *BudgetServiceObject) validate(entity *model.Budget) (err error) {
+	entity.Name = strings.TrimSpace(entity.Name)
+	if entity.Name == "" {
+		return errors.New("name should not be empty")
+	}
+	if !entity.Period.IsValid() {
+		return errors.New(fmt.Sprintf("period '%s' is not valid, the valid values are '%s' and '%s'", entity.Period, model.MONTHLY, model.YEARLY))
+	}
+	if entity.Sum <= 0 {
+		return errors.New("sum should be positive")
+	}
+	if entity.ProviderId != nil && !b.providerService.ExistsByIdAndUserId(*entity.ProviderId, entity.UserId) {
+		return interrors.NewErrNotFound("provider with id %s not found", entity.ProviderId)
+	}
+	if entity.CategoryId != nil && !b.categoryService.ExistsByIdAndUserId(*entity.CategoryId, entity.UserId) {
+		return interrors.NewErrNotFound("category with id %s not found", entity.CategoryId)
+	}
+
+	entity.Currency, err = b.houseService.ResolveCurrency(&entity.HouseId, entity.Currency)
+	return err
+}
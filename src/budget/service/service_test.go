@@ -0,0 +1,384 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/budget/mocks"
+	"github.com/VlasovArtem/hob/src/budget/model"
+	categoryMocks "github.com/VlasovArtem/hob/src/category/mocks"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	exchangeMocks "github.com/VlasovArtem/hob/src/exchange/mocks"
+	exchangeModel "github.com/VlasovArtem/hob/src/exchange/model"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+var date = time.Date(2022, time.March, 15, 10, 0, 0, 0, time.UTC)
+
+type BudgetServiceTestSuite struct {
+	testhelper.MockTestSuite[BudgetService]
+	userService         *userMocks.UserService
+	houseService        *houseMocks.HouseService
+	providerService     *providerMocks.ProviderService
+	categoryService     *categoryMocks.CategoryService
+	exchangeRateService *exchangeMocks.ExchangeRateService
+	paymentRepository   *paymentMocks.PaymentRepository
+	repository          *mocks.BudgetRepository
+}
+
+func TestBudgetServiceTestSuite(t *testing.T) {
+	ts := &BudgetServiceTestSuite{}
+	ts.TestObjectGenerator = func() BudgetService {
+		ts.userService = new(userMocks.UserService)
+		ts.houseService = new(houseMocks.HouseService)
+		ts.providerService = new(providerMocks.ProviderService)
+		ts.categoryService = new(categoryMocks.CategoryService)
+		ts.exchangeRateService = new(exchangeMocks.ExchangeRateService)
+		ts.paymentRepository = new(paymentMocks.PaymentRepository)
+		ts.repository = new(mocks.BudgetRepository)
+
+		return NewBudgetService(ts.userService, ts.houseService, ts.providerService, ts.categoryService, ts.exchangeRateService, ts.paymentRepository, ts.repository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (b *BudgetServiceTestSuite) Test_Add() {
+	categoryId := uuid.New()
+	request := mocks.GenerateCreateBudgetRequest(uuid.New(), uuid.New())
+	request.Name = " Groceries "
+	request.CategoryId = &categoryId
+	request.Currency = ""
+
+	b.userService.On("ExistsById", request.UserId).Return(true)
+	b.houseService.On("CanModify", request.HouseId, request.UserId).Return(true)
+	b.categoryService.On("ExistsByIdAndUserId", categoryId, request.UserId).Return(true)
+	b.houseService.On("ResolveCurrency", &request.HouseId, "").Return("UAH", nil)
+	b.repository.On("Create", mock.Anything).Return(func(entity model.Budget) model.Budget { return entity }, nil)
+
+	actual, err := b.TestO.Add(request)
+
+	assert.Nil(b.T(), err)
+	assert.Equal(b.T(), model.BudgetDto{
+		Id:         actual.Id,
+		Name:       "Groceries",
+		HouseId:    request.HouseId,
+		UserId:     request.UserId,
+		CategoryId: &categoryId,
+		Period:     model.MONTHLY,
+		Sum:        request.Sum,
+		Currency:   "UAH",
+	}, actual)
+}
+
+func (b *BudgetServiceTestSuite) Test_Add_WithUserNotExists() {
+	request := mocks.GenerateCreateBudgetRequest(uuid.New(), uuid.New())
+
+	b.userService.On("ExistsById", request.UserId).Return(false)
+
+	actual, err := b.TestO.Add(request)
+
+	assert.Equal(b.T(), interrors.NewErrNotFound("user with id %s not found", request.UserId), err)
+	assert.Equal(b.T(), model.BudgetDto{}, actual)
+	b.repository.AssertNotCalled(b.T(), "Create", mock.Anything)
+}
+
+func (b *BudgetServiceTestSuite) Test_Add_WithHouseNotModifiable() {
+	request := mocks.GenerateCreateBudgetRequest(uuid.New(), uuid.New())
+
+	b.userService.On("ExistsById", request.UserId).Return(true)
+	b.houseService.On("CanModify", request.HouseId, request.UserId).Return(false)
+
+	_, err := b.TestO.Add(request)
+
+	assert.Equal(b.T(), interrors.NewErrNotFound("house with id %s not found", request.HouseId), err)
+	b.repository.AssertNotCalled(b.T(), "Create", mock.Anything)
+}
+
+func (b *BudgetServiceTestSuite) Test_Add_WithInvalidRequest() {
+	providerId := uuid.New()
+	tests := []struct {
+		name     string
+		modifier func(request *model.CreateBudgetRequest)
+		message  string
+	}{
+		{"empty name", func(r *model.CreateBudgetRequest) { r.Name = " " }, "name should not be empty"},
+		{"invalid period", func(r *model.CreateBudgetRequest) { r.Period = "WEEKLY" }, "period 'WEEKLY' is not valid, the valid values are 'MONTHLY' and 'YEARLY'"},
+		{"zero sum", func(r *model.CreateBudgetRequest) { r.Sum = 0 }, "sum should be positive"},
+		{"missing provider", func(r *model.CreateBudgetRequest) { r.ProviderId = &providerId }, "provider with id " + providerId.String() + " not found"},
+	}
+
+	for _, test := range tests {
+		b.Run(test.name, func() {
+			request := mocks.GenerateCreateBudgetRequest(uuid.New(), uuid.New())
+			test.modifier(&request)
+
+			b.userService.On("ExistsById", request.UserId).Return(true)
+			b.houseService.On("CanModify", request.HouseId, request.UserId).Return(true)
+			b.providerService.On("ExistsByIdAndUserId", providerId, request.UserId).Return(false)
+
+			_, err := b.TestO.Add(request)
+
+			assert.EqualError(b.T(), err, test.message)
+			b.repository.AssertNotCalled(b.T(), "Create", mock.Anything)
+		})
+	}
+}
+
+func (b *BudgetServiceTestSuite) Test_FindById() {
+	userId := uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), uuid.New())
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("HasAccess", budget.HouseId, userId).Return(true)
+
+	actual, err := b.TestO.FindById(budget.Id, userId)
+
+	assert.Nil(b.T(), err)
+	assert.Equal(b.T(), budget.ToDto(), actual)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindById_WithNotExists() {
+	id := uuid.New()
+
+	b.repository.On("FindById", id).Return(model.Budget{}, gorm.ErrRecordNotFound)
+
+	_, err := b.TestO.FindById(id, uuid.New())
+
+	assert.Equal(b.T(), interrors.NewErrNotFound("budget with id %s not found", id), err)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindById_WithoutAccess() {
+	userId := uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), uuid.New())
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("HasAccess", budget.HouseId, userId).Return(false)
+
+	actual, err := b.TestO.FindById(budget.Id, userId)
+
+	assert.Equal(b.T(), interrors.NewErrNotFound("budget with id %s not found", budget.Id), err)
+	assert.Equal(b.T(), model.BudgetDto{}, actual)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindByHouseId() {
+	houseId, userId := uuid.New(), uuid.New()
+	expected := []model.BudgetDto{mocks.GenerateBudget(houseId, userId).ToDto()}
+
+	b.houseService.On("HasAccess", houseId, userId).Return(true)
+	b.repository.On("FindByHouseId", houseId).Return(expected)
+
+	assert.Equal(b.T(), expected, b.TestO.FindByHouseId(houseId, userId))
+}
+
+func (b *BudgetServiceTestSuite) Test_FindByHouseId_WithoutAccess() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	b.houseService.On("HasAccess", houseId, userId).Return(false)
+
+	assert.Equal(b.T(), []model.BudgetDto{}, b.TestO.FindByHouseId(houseId, userId))
+	b.repository.AssertNotCalled(b.T(), "FindByHouseId", mock.Anything)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindStatusById() {
+	userId, categoryId, subcategoryId := uuid.New(), uuid.New(), uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), uuid.New())
+	budget.CategoryId = &categoryId
+	from := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC)
+	payments := []paymentModel.Amount{
+		{Sum: money.FromMinorUnits(800000), Currency: "UAH", Date: from},
+		{Sum: money.FromMinorUnits(10000), Currency: "EUR", Date: date},
+	}
+	amounts := []exchangeModel.Amount{
+		{Sum: money.FromMinorUnits(800000), Currency: "UAH", Date: from},
+		{Sum: money.FromMinorUnits(10000), Currency: "EUR", Date: date},
+	}
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("HasAccess", budget.HouseId, userId).Return(true)
+	b.categoryService.On("FindSubcategoryIds", categoryId, budget.UserId).Return([]uuid.UUID{categoryId, subcategoryId}, nil)
+	b.paymentRepository.On("FindAmounts", budget.HouseId, from, to, (*uuid.UUID)(nil), []uuid.UUID{categoryId, subcategoryId}).Return(payments)
+	b.exchangeRateService.On("Total", budget.UserId, amounts, "UAH").Return(money.FromMinorUnits(1100000), nil)
+
+	actual, err := b.TestO.FindStatusById(budget.Id, userId, date)
+
+	assert.Nil(b.T(), err)
+	assert.Equal(b.T(), model.BudgetStatusDto{
+		Budget:     budget.ToDto(),
+		From:       from,
+		To:         to,
+		Planned:    money.FromMinorUnits(1000000),
+		Spent:      money.FromMinorUnits(1100000),
+		Remaining:  money.FromMinorUnits(-100000),
+		OverBudget: true,
+	}, actual)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindStatusById_WithYearlyPeriod() {
+	userId, providerId := uuid.New(), uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), userId)
+	budget.Period = model.YEARLY
+	budget.ProviderId = &providerId
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("HasAccess", budget.HouseId, userId).Return(true)
+	b.paymentRepository.On("FindAmounts", budget.HouseId, from, to, &providerId, []uuid.UUID(nil)).Return([]paymentModel.Amount{})
+	b.exchangeRateService.On("Total", userId, []exchangeModel.Amount{}, "UAH").Return(money.Money(0), nil)
+
+	actual, err := b.TestO.FindStatusById(budget.Id, userId, date)
+
+	assert.Nil(b.T(), err)
+	assert.Equal(b.T(), from, actual.From)
+	assert.Equal(b.T(), to, actual.To)
+	assert.Equal(b.T(), budget.Sum, actual.Remaining)
+	assert.False(b.T(), actual.OverBudget)
+	b.categoryService.AssertNotCalled(b.T(), "FindSubcategoryIds", mock.Anything, mock.Anything)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindStatusById_WithMissingRate() {
+	userId := uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), userId)
+	expectedError := interrors.NewErrNotFound("exchange rate of EUR to UAH on 2022-03-15 is not found")
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("HasAccess", budget.HouseId, userId).Return(true)
+	b.paymentRepository.On("FindAmounts", budget.HouseId, mock.Anything, mock.Anything, (*uuid.UUID)(nil), []uuid.UUID(nil)).Return([]paymentModel.Amount{})
+	b.exchangeRateService.On("Total", userId, mock.Anything, "UAH").Return(money.Money(0), expectedError)
+
+	actual, err := b.TestO.FindStatusById(budget.Id, userId, date)
+
+	assert.Equal(b.T(), expectedError, err)
+	assert.Equal(b.T(), model.BudgetStatusDto{}, actual)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindStatusByHouseId() {
+	houseId, userId := uuid.New(), uuid.New()
+	first, second := mocks.GenerateBudget(houseId, userId).ToDto(), mocks.GenerateBudget(houseId, userId).ToDto()
+
+	b.houseService.On("HasAccess", houseId, userId).Return(true)
+	b.repository.On("FindByHouseId", houseId).Return([]model.BudgetDto{first, second})
+	b.paymentRepository.On("FindAmounts", houseId, mock.Anything, mock.Anything, (*uuid.UUID)(nil), []uuid.UUID(nil)).Return([]paymentModel.Amount{})
+	b.exchangeRateService.On("Total", userId, []exchangeModel.Amount{}, "UAH").Return(money.FromMinorUnits(500000), nil)
+
+	actual, err := b.TestO.FindStatusByHouseId(houseId, userId, date)
+
+	assert.Nil(b.T(), err)
+	assert.Len(b.T(), actual, 2)
+	assert.Equal(b.T(), first, actual[0].Budget)
+	assert.Equal(b.T(), second, actual[1].Budget)
+	assert.Equal(b.T(), money.FromMinorUnits(500000), actual[1].Remaining)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindStatusByHouseId_WithoutAccess() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	b.houseService.On("HasAccess", houseId, userId).Return(false)
+
+	actual, err := b.TestO.FindStatusByHouseId(houseId, userId, date)
+
+	assert.Equal(b.T(), interrors.NewErrNotFound("house with id %s not found", houseId), err)
+	assert.Nil(b.T(), actual)
+}
+
+func (b *BudgetServiceTestSuite) Test_FindStatusByHouseId_WithDeletedCategory() {
+	houseId, userId, categoryId := uuid.New(), uuid.New(), uuid.New()
+	budget := mocks.GenerateBudget(houseId, userId)
+	budget.CategoryId = &categoryId
+	expectedError := interrors.NewErrNotFound("category with id %s not found", categoryId)
+
+	b.houseService.On("HasAccess", houseId, userId).Return(true)
+	b.repository.On("FindByHouseId", houseId).Return([]model.BudgetDto{budget.ToDto()})
+	b.categoryService.On("FindSubcategoryIds", categoryId, userId).Return(nil, expectedError)
+
+	actual, err := b.TestO.FindStatusByHouseId(houseId, userId, date)
+
+	assert.Equal(b.T(), expectedError, err)
+	assert.Nil(b.T(), actual)
+}
+
+func (b *BudgetServiceTestSuite) Test_Update() {
+	userId := uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), uuid.New())
+	request := model.UpdateBudgetRequest{Name: "Annual", Period: model.YEARLY, Sum: money.FromMinorUnits(12000000), Currency: "eur"}
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("CanModify", budget.HouseId, userId).Return(true)
+	b.houseService.On("ResolveCurrency", &budget.HouseId, "eur").Return("EUR", nil)
+	b.repository.On("Update", mock.Anything).Return(nil)
+
+	assert.Nil(b.T(), b.TestO.Update(budget.Id, userId, request))
+	b.repository.AssertCalled(b.T(), "Update", model.Budget{
+		Id:       budget.Id,
+		Name:     "Annual",
+		HouseId:  budget.HouseId,
+		UserId:   budget.UserId,
+		Period:   model.YEARLY,
+		Sum:      money.FromMinorUnits(12000000),
+		Currency: "EUR",
+	})
+}
+
+func (b *BudgetServiceTestSuite) Test_Update_WithoutModifyAccess() {
+	userId := uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), uuid.New())
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("CanModify", budget.HouseId, userId).Return(false)
+
+	err := b.TestO.Update(budget.Id, userId, model.UpdateBudgetRequest{})
+
+	assert.Equal(b.T(), interrors.NewErrNotFound("budget with id %s not found", budget.Id), err)
+	b.repository.AssertNotCalled(b.T(), "Update", mock.Anything)
+}
+
+func (b *BudgetServiceTestSuite) Test_Update_WithMissingCategory() {
+	userId, categoryId := uuid.New(), uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), userId)
+	request := model.UpdateBudgetRequest{Name: "Groceries", CategoryId: &categoryId, Period: model.MONTHLY, Sum: budget.Sum}
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("CanModify", budget.HouseId, userId).Return(true)
+	b.categoryService.On("ExistsByIdAndUserId", categoryId, userId).Return(false)
+
+	err := b.TestO.Update(budget.Id, userId, request)
+
+	assert.Equal(b.T(), interrors.NewErrNotFound("category with id %s not found", &categoryId), err)
+	b.repository.AssertNotCalled(b.T(), "Update", mock.Anything)
+}
+
+func (b *BudgetServiceTestSuite) Test_DeleteById() {
+	userId := uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), userId)
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("CanModify", budget.HouseId, userId).Return(true)
+	b.repository.On("DeleteById", budget.Id).Return(nil)
+
+	assert.Nil(b.T(), b.TestO.DeleteById(budget.Id, userId))
+}
+
+func (b *BudgetServiceTestSuite) Test_DeleteById_WithErrorFromRepository() {
+	userId := uuid.New()
+	budget := mocks.GenerateBudget(uuid.New(), userId)
+	expectedError := errors.New("error")
+
+	b.repository.On("FindById", budget.Id).Return(budget, nil)
+	b.houseService.On("CanModify", budget.HouseId, userId).Return(true)
+	b.repository.On("DeleteById", budget.Id).Return(expectedError)
+
+	assert.Equal(b.T(), expectedError, b.TestO.DeleteById(budget.Id, userId))
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/category/service"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type CategoryHandlerObject struct {
+	categoryService service.CategoryService
+}
+
+func NewCategoryHandler(categoryService service.CategoryService) CategoryHandler {
+	return &CategoryHandlerObject{categoryService}
+}
+
+func (c *CategoryHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewCategoryHandler(
+		dependency.FindRequiredDependency[service.CategoryServiceObject, service.CategoryService](factory),
+	)
+}
+
+func (c *CategoryHandlerObject) Init(router *mux.Router) {
+	categoryRouter := router.PathPrefix("/api/v1/categories").Subrouter()
+
+	categoryRouter.Path("").HandlerFunc(c.Add()).Methods("POST")
+	categoryRouter.Path("").HandlerFunc(c.FindByUserId()).Methods("GET")
+	categoryRouter.Path("/{id}").HandlerFunc(c.FindById()).Methods("GET")
+	categoryRouter.Path("/{id}").HandlerFunc(c.Update()).Methods("PUT")
+	categoryRouter.Path("/{id}").HandlerFunc(c.Delete()).Methods("DELETE")
+}
+
+type CategoryHandler interface {
+	Add() http.HandlerFunc
+	FindById() http.HandlerFunc
+	FindByUserId() http.HandlerFunc
+	Update() http.HandlerFunc
+	Delete() http.HandlerFunc
+}
+
+func (c *CategoryHandlerObject) Add() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		if body, err := rest.ReadRequestBody[model.CreateCategoryRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			body.UserId = userId
+
+			rest.NewAPIResponse(writer).
+				Created(c.categoryService.Add(body)).
+				Perform()
+		}
+	}
+}
+
+func (c *CategoryHandlerObject) FindById() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(c.categoryService.FindById(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (c *CategoryHandlerObject) FindByUserId() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if userId, err := rest.GetUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Body(c.categoryService.FindByUserId(userId)).
+				Perform()
+		}
+	}
+}
+
+func (c *CategoryHandlerObject) Update() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			if body, err := rest.ReadRequestBody[model.UpdateCategoryRequest](request); err != nil {
+				rest.HandleWithError(writer, err)
+			} else {
+				rest.NewAPIResponse(writer).
+					Error(c.categoryService.Update(id, userId, body)).
+					Perform()
+			}
+		}
+	}
+}
+
+func (c *CategoryHandlerObject) Delete() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(c.categoryService.DeleteById(id, userId)).
+				Perform()
+		}
+	}
+}
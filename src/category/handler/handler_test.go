@@ -0,0 +1,242 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/VlasovArtem/hob/src/category/mocks"
+	"github.com/VlasovArtem/hob/src/category/model"
+	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+)
+
+type CategoryHandlerTestSuite struct {
+	testhelper.MockTestSuite[CategoryHandler]
+	categoryService *mocks.CategoryService
+}
+
+func TestCategoryHandlerTestSuite(t *testing.T) {
+	testingSuite := &CategoryHandlerTestSuite{}
+	testingSuite.TestObjectGenerator = func() CategoryHandler {
+		testingSuite.categoryService = new(mocks.CategoryService)
+		return NewCategoryHandler(testingSuite.categoryService)
+	}
+
+	suite.Run(t, testingSuite)
+}
+
+func (c *CategoryHandlerTestSuite) Test_Add() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateCategoryRequest(userId)
+	expected := request.ToEntity().ToDto()
+
+	c.categoryService.On("Add", request).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(c.TestO.Add()).
+		WithBody(model.CreateCategoryRequest{Name: request.Name})
+
+	content := testRequest.Verify(c.T(), http.StatusCreated)
+
+	actual := model.CategoryDto{}
+
+	assert.Nil(c.T(), json.Unmarshal(content, &actual))
+	assert.Equal(c.T(), expected, actual)
+}
+
+func (c *CategoryHandlerTestSuite) Test_Add_WithInvalidRequest() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(c.TestO.Add())
+
+	testRequest.Verify(c.T(), http.StatusBadRequest)
+}
+
+func (c *CategoryHandlerTestSuite) Test_Add_WithoutUser() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories").
+		WithMethod("POST").
+		WithHandler(c.TestO.Add()).
+		WithBody(mocks.GenerateCreateCategoryRequest(uuid.New()))
+
+	content := testRequest.Verify(c.T(), http.StatusUnauthorized)
+
+	assert.Equal(c.T(), "user is not authenticated\n", string(content))
+}
+
+func (c *CategoryHandlerTestSuite) Test_Add_WithErrorFromService() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateCategoryRequest(userId)
+
+	c.categoryService.On("Add", request).Return(model.CategoryDto{}, errors.New("error"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(c.TestO.Add()).
+		WithBody(request)
+
+	content := testRequest.Verify(c.T(), http.StatusBadRequest)
+
+	assert.Equal(c.T(), "error\n", string(content))
+}
+
+func (c *CategoryHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
+	expected := mocks.GenerateCategory(userId, nil, "Utilities").ToDto()
+
+	c.categoryService.On("FindById", expected.Id, userId).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories/{id}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(c.TestO.FindById()).
+		WithVar("id", expected.Id.String())
+
+	content := testRequest.Verify(c.T(), http.StatusOK)
+
+	actual := model.CategoryDto{}
+
+	assert.Nil(c.T(), json.Unmarshal(content, &actual))
+	assert.Equal(c.T(), expected, actual)
+}
+
+func (c *CategoryHandlerTestSuite) Test_FindById_WithNotFoundErrorFromService() {
+	userId, id := uuid.New(), uuid.New()
+
+	c.categoryService.On("FindById", id, userId).Return(model.CategoryDto{}, int_errors.NewErrNotFound("test"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories/{id}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(c.TestO.FindById()).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(c.T(), http.StatusNotFound)
+
+	assert.Equal(c.T(), "test\n", string(content))
+}
+
+func (c *CategoryHandlerTestSuite) Test_FindById_WithInvalidId() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories/{id}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(c.TestO.FindById()).
+		WithVar("id", "id")
+
+	content := testRequest.Verify(c.T(), http.StatusBadRequest)
+
+	assert.Equal(c.T(), "the id is not valid id\n", string(content))
+}
+
+func (c *CategoryHandlerTestSuite) Test_FindByUserId() {
+	userId := uuid.New()
+	expected := []model.CategoryDto{mocks.GenerateCategory(userId, nil, "Utilities").ToDto()}
+
+	c.categoryService.On("FindByUserId", userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(c.TestO.FindByUserId())
+
+	content := testRequest.Verify(c.T(), http.StatusOK)
+
+	var actual []model.CategoryDto
+
+	assert.Nil(c.T(), json.Unmarshal(content, &actual))
+	assert.Equal(c.T(), expected, actual)
+}
+
+func (c *CategoryHandlerTestSuite) Test_Update() {
+	userId, id := uuid.New(), uuid.New()
+	request := model.UpdateCategoryRequest{Name: "Repairs"}
+
+	c.categoryService.On("Update", id, userId, request).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories/{id}").
+		WithMethod("PUT").
+		WithUser(userId).
+		WithHandler(c.TestO.Update()).
+		WithVar("id", id.String()).
+		WithBody(request)
+
+	testRequest.Verify(c.T(), http.StatusOK)
+}
+
+func (c *CategoryHandlerTestSuite) Test_Update_WithErrorFromService() {
+	userId, id := uuid.New(), uuid.New()
+	request := model.UpdateCategoryRequest{Name: "Repairs"}
+
+	c.categoryService.On("Update", id, userId, request).Return(errors.New("error"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories/{id}").
+		WithMethod("PUT").
+		WithUser(userId).
+		WithHandler(c.TestO.Update()).
+		WithVar("id", id.String()).
+		WithBody(request)
+
+	content := testRequest.Verify(c.T(), http.StatusBadRequest)
+
+	assert.Equal(c.T(), "error\n", string(content))
+}
+
+func (c *CategoryHandlerTestSuite) Test_Update_WithInvalidRequest() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories/{id}").
+		WithMethod("PUT").
+		WithUser(uuid.New()).
+		WithHandler(c.TestO.Update()).
+		WithVar("id", uuid.New().String())
+
+	testRequest.Verify(c.T(), http.StatusBadRequest)
+}
+
+func (c *CategoryHandlerTestSuite) Test_Delete() {
+	userId, id := uuid.New(), uuid.New()
+
+	c.categoryService.On("DeleteById", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories/{id}").
+		WithMethod("DELETE").
+		WithUser(userId).
+		WithHandler(c.TestO.Delete()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(c.T(), http.StatusNoContent)
+}
+
+func (c *CategoryHandlerTestSuite) Test_Delete_WithErrorFromService() {
+	userId, id := uuid.New(), uuid.New()
+
+	c.categoryService.On("DeleteById", id, userId).Return(errors.New("category with subcategories could not be deleted"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/categories/{id}").
+		WithMethod("DELETE").
+		WithUser(userId).
+		WithHandler(c.TestO.Delete()).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(c.T(), http.StatusBadRequest)
+
+	assert.Equal(c.T(), "category with subcategories could not be deleted\n", string(content))
+}
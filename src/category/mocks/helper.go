@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/category/model"
+	"github.com/google/uuid"
+)
+
+func GenerateCreateCategoryRequest(userId uuid.UUID) model.CreateCategoryRequest {
+	return model.CreateCategoryRequest{
+		Name:   "Utilities",
+		UserId: userId,
+	}
+}
+
+func GenerateCategory(userId uuid.UUID, parentId *uuid.UUID, name string) model.Category {
+	return model.Category{
+		Id:       uuid.New(),
+		Name:     name,
+		ParentId: parentId,
+		UserId:   userId,
+	}
+}
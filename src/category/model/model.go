@@ -0,0 +1,73 @@
+package model
+
+import (
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// PathSeparator separates the names of the parent categories in the category path (Utilities > Electricity)
+const PathSeparator = " > "
+
+// Category groups the payments, payment schedulers and incomes of the user, the category without the parent is the top
+// level one
+type Category struct {
+	Id       uuid.UUID `gorm:"primarykey"`
+	Name     string
+	ParentId *uuid.UUID     `gorm:"index"`
+	Parent   *Category      `gorm:"foreignKey:ParentId"`
+	UserId   uuid.UUID      `gorm:"index"`
+	User     userModel.User `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+type CreateCategoryRequest struct {
+	Name     string
+	ParentId *uuid.UUID
+	UserId   uuid.UUID
+}
+
+type UpdateCategoryRequest struct {
+	Name     string
+	ParentId *uuid.UUID
+}
+
+type CategoryDto struct {
+	Id       uuid.UUID
+	Name     string
+	ParentId *uuid.UUID
+	// Path is the names of the parent categories and the category joined by the PathSeparator
+	Path   string
+	UserId uuid.UUID
+}
+
+func (c Category) ToDto() CategoryDto {
+	return CategoryDto{
+		Id:       c.Id,
+		Name:     c.Name,
+		ParentId: c.ParentId,
+		Path:     c.Name,
+		UserId:   c.UserId,
+	}
+}
+
+func (c CreateCategoryRequest) ToEntity() Category {
+	return Category{
+		Id:       uuid.New(),
+		Name:     c.Name,
+		ParentId: c.ParentId,
+		UserId:   c.UserId,
+	}
+}
+
+func (u UpdateCategoryRequest) ToEntity(id uuid.UUID) Category {
+	return Category{
+		Id:       id,
+		Name:     u.Name,
+		ParentId: u.ParentId,
+	}
+}
+
+// Path joins the names of the categories from the top level one
+func Path(names []string) string {
+	return strings.Join(names, PathSeparator)
+}
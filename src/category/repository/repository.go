@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	paymentSchedulerModel "github.com/VlasovArtem/hob/src/payment/scheduler/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var entity = model.Category{}
+
+type CategoryRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewCategoryRepository(database db.DatabaseService) CategoryRepository {
+	return &CategoryRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (c *CategoryRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewCategoryRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (c *CategoryRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type CategoryRepository interface {
+	Create(entity model.Category) (model.Category, error)
+	FindById(id uuid.UUID) (model.Category, error)
+	FindByUserId(userId uuid.UUID) []model.Category
+	ExistsByParentId(parentId uuid.UUID) bool
+	Update(entity model.Category) error
+	DeleteById(id uuid.UUID) error
+}
+
+func (c *CategoryRepositoryObject) Create(entity model.Category) (model.Category, error) {
+	return entity, c.database.Create(&entity)
+}
+
+func (c *CategoryRepositoryObject) FindById(id uuid.UUID) (response model.Category, err error) {
+	return response, c.database.Find(&response, id)
+}
+
+func (c *CategoryRepositoryObject) FindByUserId(userId uuid.UUID) (response []model.Category) {
+	if err := c.database.Modeled().Where("user_id = ?", userId).Order("name").Find(&response).Error; err != nil {
+		return []model.Category{}
+	}
+
+	return response
+}
+
+func (c *CategoryRepositoryObject) ExistsByParentId(parentId uuid.UUID) bool {
+	return c.database.ExistsBy("parent_id = ?", parentId)
+}
+
+// Update changes the name and the parent of the category, the category without the parent is moved to the top level
+func (c *CategoryRepositoryObject) Update(entity model.Category) error {
+	return c.database.Modeled().
+		Where("id = ?", entity.Id).
+		Select("Name", "ParentId").
+		Updates(entity).
+		Error
+}
+
+// DeleteById removes the category, the payments, payment schedulers and incomes of the category lose the reference to it
+func (c *CategoryRepositoryObject) DeleteById(id uuid.UUID) error {
+	return c.database.D().Transaction(func(tx *gorm.DB) error {
+		for _, categorized := range []any{&paymentModel.Payment{}, &paymentSchedulerModel.PaymentScheduler{}, &incomeModel.Income{}} {
+			if err := tx.Model(categorized).Where("category_id = ?", id).Update("category_id", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Delete(&model.Category{}, "id = ?", id).Error
+	})
+}
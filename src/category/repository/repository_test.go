@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/category/mocks"
+	"github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/db"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	paymentSchedulerModel "github.com/VlasovArtem/hob/src/payment/scheduler/model"
+	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type CategoryRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository      CategoryRepository
+	createdUser     userModel.User
+	createdHouse    houseModel.House
+	createdProvider providerModel.Provider
+}
+
+func (c *CategoryRepositoryTestSuite) SetupSuite() {
+	c.InitDBTestSuite()
+
+	c.CreateRepository(
+		func(service db.DatabaseService) {
+			c.repository = NewCategoryRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, paymentModel.Payment{})
+			database.TruncateTable(service, model.Category{})
+		}).
+		AddAfterSuite(func(service db.DatabaseService) {
+			database.TruncateTable(service, providerModel.Provider{})
+			database.TruncateTable(service, houseModel.House{})
+			database.TruncateTable(service, userModel.User{})
+		}).
+		ExecuteMigration(
+			userModel.User{},
+			houseModel.House{},
+			providerModel.Provider{},
+			model.Category{},
+			paymentModel.Payment{},
+			paymentSchedulerModel.PaymentScheduler{},
+			incomeModel.Income{},
+		)
+
+	c.createdUser = userMocks.GenerateUser()
+	c.CreateEntity(&c.createdUser)
+
+	c.createdHouse = houseMocks.GenerateHouse(c.createdUser.Id)
+	c.CreateEntity(&c.createdHouse)
+
+	c.createdProvider = providerMocks.GenerateProvider(c.createdUser.Id)
+	c.CreateEntity(&c.createdProvider)
+}
+
+func TestCategoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CategoryRepositoryTestSuite))
+}
+
+func (c *CategoryRepositoryTestSuite) Test_Create() {
+	entity := mocks.GenerateCategory(c.createdUser.Id, nil, "Utilities")
+
+	actual, err := c.repository.Create(entity)
+
+	assert.Nil(c.T(), err)
+	assert.Equal(c.T(), entity, actual)
+}
+
+func (c *CategoryRepositoryTestSuite) Test_FindById() {
+	entity := c.createCategory(nil, "Utilities")
+
+	actual, err := c.repository.FindById(entity.Id)
+
+	assert.Nil(c.T(), err)
+	assert.Equal(c.T(), entity, actual)
+}
+
+func (c *CategoryRepositoryTestSuite) Test_FindById_WithNotExists() {
+	actual, err := c.repository.FindById(uuid.New())
+
+	assert.ErrorIs(c.T(), err, gorm.ErrRecordNotFound)
+	assert.Equal(c.T(), model.Category{}, actual)
+}
+
+func (c *CategoryRepositoryTestSuite) Test_FindByUserId() {
+	utilities := c.createCategory(nil, "Utilities")
+	electricity := c.createCategory(&utilities.Id, "Electricity")
+
+	actual := c.repository.FindByUserId(c.createdUser.Id)
+
+	assert.Equal(c.T(), []model.Category{electricity, utilities}, actual)
+}
+
+func (c *CategoryRepositoryTestSuite) Test_FindByUserId_WithAnotherUser() {
+	c.createCategory(nil, "Utilities")
+
+	assert.Empty(c.T(), c.repository.FindByUserId(uuid.New()))
+}
+
+func (c *CategoryRepositoryTestSuite) Test_ExistsByParentId() {
+	utilities := c.createCategory(nil, "Utilities")
+	electricity := c.createCategory(&utilities.Id, "Electricity")
+
+	assert.True(c.T(), c.repository.ExistsByParentId(utilities.Id))
+	assert.False(c.T(), c.repository.ExistsByParentId(electricity.Id))
+}
+
+func (c *CategoryRepositoryTestSuite) Test_Update() {
+	utilities := c.createCategory(nil, "Utilities")
+	electricity := c.createCategory(&utilities.Id, "Electricity")
+
+	err := c.repository.Update(model.Category{Id: electricity.Id, Name: "Power"})
+
+	assert.Nil(c.T(), err)
+
+	actual, err := c.repository.FindById(electricity.Id)
+
+	assert.Nil(c.T(), err)
+	assert.Equal(c.T(), "Power", actual.Name)
+	assert.Nil(c.T(), actual.ParentId)
+}
+
+func (c *CategoryRepositoryTestSuite) Test_DeleteById() {
+	category := c.createCategory(nil, "Utilities")
+	payment := paymentMocks.GeneratePayment(c.createdHouse.Id, c.createdUser.Id, c.createdProvider.Id)
+	payment.CategoryId = &category.Id
+	c.CreateEntity(&payment)
+
+	err := c.repository.DeleteById(category.Id)
+
+	assert.Nil(c.T(), err)
+
+	_, err = c.repository.FindById(category.Id)
+
+	assert.ErrorIs(c.T(), err, gorm.ErrRecordNotFound)
+
+	actual := paymentModel.Payment{}
+	assert.Nil(c.T(), c.Database.FindById(&actual, payment.Id))
+	assert.Nil(c.T(), actual.CategoryId)
+}
+
+func (c *CategoryRepositoryTestSuite) createCategory(parentId *uuid.UUID, name string) model.Category {
+	entity := mocks.GenerateCategory(c.createdUser.Id, parentId, name)
+
+	c.CreateEntity(&entity)
+
+	return entity
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/category/repository"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/google/uuid"
+	"sort"
+	"strings"
+)
+
+type CategoryServiceObject struct {
+	userService userService.UserService
+	repository  repository.CategoryRepository
+}
+
+func NewCategoryService(userService userService.UserService, repository repository.CategoryRepository) CategoryService {
+	return &CategoryServiceObject{
+		userService: userService,
+		repository:  repository,
+	}
+}
+
+func (c *CategoryServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewCategoryService(
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[repository.CategoryRepositoryObject, repository.CategoryRepository](factory),
+	)
+}
+
+type CategoryService interface {
+	Add(request model.CreateCategoryRequest) (model.CategoryDto, error)
+	FindById(id uuid.UUID, userId uuid.UUID) (model.CategoryDto, error)
+	FindByUserId(userId uuid.UUID) []model.CategoryDto
+	FindSubcategoryIds(id uuid.UUID, userId uuid.UUID) ([]uuid.UUID, error)
+	ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateCategoryRequest) error
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+}
+
+// Add creates the category of the user, the name should be unique among the categories with the same parent
+func (c *CategoryServiceObject) Add(request model.CreateCategoryRequest) (response model.CategoryDto, err error) {
+	if !c.userService.ExistsById(request.UserId) {
+		return response, interrors.NewErrNotFound("user with id %s not found", request.UserId)
+	}
+
+	categories := newTree(c.repository.FindByUserId(request.UserId))
+
+	request.Name = strings.TrimSpace(request.Name)
+	if err = categories.validate(uuid.Nil, request.Name, request.ParentId); err != nil {
+		return response, err
+	}
+
+	if entity, err := c.repository.Create(request.ToEntity()); err != nil {
+		return response, err
+	} else {
+		categories[entity.Id] = entity
+		return categories.toDto(entity), nil
+	}
+}
+
+func (c *CategoryServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (response model.CategoryDto, err error) {
+	categories := newTree(c.repository.FindByUserId(userId))
+
+	if category, ok := categories[id]; !ok {
+		return response, notFoundError(id)
+	} else {
+		return categories.toDto(category), nil
+	}
+}
+
+// FindByUserId returns the categories of the user sorted by the path, the subcategories follow their parent
+func (c *CategoryServiceObject) FindByUserId(userId uuid.UUID) []model.CategoryDto {
+	categories := newTree(c.repository.FindByUserId(userId))
+
+	response := make([]model.CategoryDto, 0, len(categories))
+	for _, category := range categories {
+		response = append(response, categories.toDto(category))
+	}
+
+	sort.Slice(response, func(i, j int) bool { return response[i].Path < response[j].Path })
+
+	return response
+}
+
+// FindSubcategoryIds returns the id of the category with the ids of all the nested subcategories
+func (c *CategoryServiceObject) FindSubcategoryIds(id uuid.UUID, userId uuid.UUID) ([]uuid.UUID, error) {
+	categories := newTree(c.repository.FindByUserId(userId))
+
+	if _, ok := categories[id]; !ok {
+		return nil, notFoundError(id)
+	}
+
+	return categories.subcategoryIds(id), nil
+}
+
+func (c *CategoryServiceObject) ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool {
+	category, err := c.repository.FindById(id)
+
+	return err == nil && category.UserId == userId
+}
+
+// Update renames the category or moves it to another parent, the category could not be moved to its own subcategory
+func (c *CategoryServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateCategoryRequest) error {
+	categories := newTree(c.repository.FindByUserId(userId))
+
+	if _, ok := categories[id]; !ok {
+		return notFoundError(id)
+	}
+
+	request.Name = strings.TrimSpace(request.Name)
+	if err := categories.validate(id, request.Name, request.ParentId); err != nil {
+		return err
+	}
+
+	return c.repository.Update(request.ToEntity(id))
+}
+
+// DeleteById removes the category without subcategories, the payments, payment schedulers and incomes of the category
+// become uncategorized
+func (c *CategoryServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if !c.ExistsByIdAndUserId(id, userId) {
+		return notFoundError(id)
+	}
+	if c.repository.ExistsByParentId(id) {
+		return errors.New("category with subcategories could not be deleted")
+	}
+
+	return c.repository.DeleteById(id)
+}
+
+// tree is the categories of the user by the id
+type tree map[uuid.UUID]model.Category
+
+func newTree(categories []model.Category) tree {
+	response := make(tree, len(categories))
+	for _, category := range categories {
+		response[category.Id] = category
+	}
+	return response
+}
+
+// validate checks the name and the parent of the category, the id is uuid.Nil for the new category
+func (t tree) validate(id uuid.UUID, name string, parentId *uuid.UUID) error {
+	if name == "" {
+		return errors.New("name should not be empty")
+	}
+	if strings.Contains(name, strings.TrimSpace(model.PathSeparator)) {
+		return errors.New(fmt.Sprintf("name should not contain '%s'", strings.TrimSpace(model.PathSeparator)))
+	}
+
+	if parentId != nil {
+		if _, ok := t[*parentId]; !ok {
+			return notFoundError(*parentId)
+		}
+		if id != uuid.Nil && t.isSubcategory(*parentId, id) {
+			return errors.New("category could not be moved to itself or its subcategory")
+		}
+	}
+
+	for _, category := range t {
+		if category.Id != id && sameParent(category.ParentId, parentId) && strings.EqualFold(category.Name, name) {
+			return errors.New(fmt.Sprintf("category with name '%s' already exists", name))
+		}
+	}
+
+	return nil
+}
+
+// isSubcategory checks that the category is the ancestor itself or one of its nested subcategories
+func (t tree) isSubcategory(id uuid.UUID, ancestorId uuid.UUID) bool {
+	for depth := 0; depth <= len(t); depth++ {
+		if id == ancestorId {
+			return true
+		}
+		category, ok := t[id]
+		if !ok || category.ParentId == nil {
+			return false
+		}
+		id = *category.ParentId
+	}
+	return false
+}
+
+func (t tree) subcategoryIds(id uuid.UUID) []uuid.UUID {
+	response := []uuid.UUID{id}
+
+	for index := 0; index < len(response); index++ {
+		for _, category := range t {
+			if category.ParentId != nil && *category.ParentId == response[index] {
+				response = append(response, category.Id)
+			}
+		}
+	}
+
+	return response
+}
+
+func (t tree) toDto(category model.Category) model.CategoryDto {
+	names := []string{category.Name}
+
+	for parentId := category.ParentId; parentId != nil && len(names) <= len(t); {
+		parent, ok := t[*parentId]
+		if !ok {
+			break
+		}
+		names = append([]string{parent.Name}, names...)
+		parentId = parent.ParentId
+	}
+
+	dto := category.ToDto()
+	dto.Path = model.Path(names)
+
+	return dto
+}
+
+func sameParent(first *uuid.UUID, second *uuid.UUID) bool {
+	if first == nil || second == nil {
+		return first == second
+	}
+	return *first == *second
+}
+
+func notFoundError(id uuid.UUID) error {
+	return interrors.NewErrNotFound("category with id %s not found", id)
+}
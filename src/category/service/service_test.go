@@ -0,0 +1,341 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/category/mocks"
+	"github.com/VlasovArtem/hob/src/category/model"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type CategoryServiceTestSuite struct {
+	testhelper.MockTestSuite[CategoryService]
+	userService *userMocks.UserService
+	repository  *mocks.CategoryRepository
+}
+
+func TestCategoryServiceTestSuite(t *testing.T) {
+	ts := &CategoryServiceTestSuite{}
+	ts.TestObjectGenerator = func() CategoryService {
+		ts.userService = new(userMocks.UserService)
+		ts.repository = new(mocks.CategoryRepository)
+
+		return NewCategoryService(ts.userService, ts.repository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (c *CategoryServiceTestSuite) Test_Add() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+	request := model.CreateCategoryRequest{Name: " Electricity ", ParentId: &utilities.Id, UserId: userId}
+
+	c.userService.On("ExistsById", userId).Return(true)
+	c.repository.On("FindByUserId", userId).Return([]model.Category{utilities})
+	c.repository.On("Create", mock.Anything).Return(func(entity model.Category) model.Category { return entity }, nil)
+
+	actual, err := c.TestO.Add(request)
+
+	assert.Nil(c.T(), err)
+	assert.Equal(c.T(), model.CategoryDto{
+		Id:       actual.Id,
+		Name:     "Electricity",
+		ParentId: &utilities.Id,
+		Path:     "Utilities > Electricity",
+		UserId:   userId,
+	}, actual)
+}
+
+func (c *CategoryServiceTestSuite) Test_Add_WithUserNotExists() {
+	request := mocks.GenerateCreateCategoryRequest(uuid.New())
+
+	c.userService.On("ExistsById", request.UserId).Return(false)
+
+	actual, err := c.TestO.Add(request)
+
+	assert.Equal(c.T(), interrors.NewErrNotFound("user with id %s not found", request.UserId), err)
+	assert.Equal(c.T(), model.CategoryDto{}, actual)
+	c.repository.AssertNotCalled(c.T(), "Create", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_Add_WithEmptyName() {
+	request := mocks.GenerateCreateCategoryRequest(uuid.New())
+	request.Name = " "
+
+	c.userService.On("ExistsById", request.UserId).Return(true)
+	c.repository.On("FindByUserId", request.UserId).Return([]model.Category{})
+
+	_, err := c.TestO.Add(request)
+
+	assert.Equal(c.T(), errors.New("name should not be empty"), err)
+	c.repository.AssertNotCalled(c.T(), "Create", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_Add_WithSeparatorInName() {
+	request := mocks.GenerateCreateCategoryRequest(uuid.New())
+	request.Name = "Utilities > Gas"
+
+	c.userService.On("ExistsById", request.UserId).Return(true)
+	c.repository.On("FindByUserId", request.UserId).Return([]model.Category{})
+
+	_, err := c.TestO.Add(request)
+
+	assert.Equal(c.T(), errors.New("name should not contain '>'"), err)
+	c.repository.AssertNotCalled(c.T(), "Create", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_Add_WithParentNotExists() {
+	parentId := uuid.New()
+	request := mocks.GenerateCreateCategoryRequest(uuid.New())
+	request.ParentId = &parentId
+
+	c.userService.On("ExistsById", request.UserId).Return(true)
+	c.repository.On("FindByUserId", request.UserId).Return([]model.Category{})
+
+	_, err := c.TestO.Add(request)
+
+	assert.Equal(c.T(), interrors.NewErrNotFound("category with id %s not found", parentId), err)
+	c.repository.AssertNotCalled(c.T(), "Create", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_Add_WithExistingName() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateCategoryRequest(userId)
+	request.Name = "utilities"
+
+	c.userService.On("ExistsById", userId).Return(true)
+	c.repository.On("FindByUserId", userId).Return([]model.Category{mocks.GenerateCategory(userId, nil, "Utilities")})
+
+	_, err := c.TestO.Add(request)
+
+	assert.Equal(c.T(), errors.New("category with name 'utilities' already exists"), err)
+	c.repository.AssertNotCalled(c.T(), "Create", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_Add_WithExistingNameOfAnotherParent() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+	repairs := mocks.GenerateCategory(userId, nil, "Repairs")
+	electricity := mocks.GenerateCategory(userId, &utilities.Id, "Electricity")
+	request := model.CreateCategoryRequest{Name: "Electricity", ParentId: &repairs.Id, UserId: userId}
+
+	c.userService.On("ExistsById", userId).Return(true)
+	c.repository.On("FindByUserId", userId).Return([]model.Category{utilities, repairs, electricity})
+	c.repository.On("Create", mock.Anything).Return(func(entity model.Category) model.Category { return entity }, nil)
+
+	actual, err := c.TestO.Add(request)
+
+	assert.Nil(c.T(), err)
+	assert.Equal(c.T(), "Repairs > Electricity", actual.Path)
+}
+
+func (c *CategoryServiceTestSuite) Test_Add_WithErrorFromRepository() {
+	request := mocks.GenerateCreateCategoryRequest(uuid.New())
+	expectedError := errors.New("error")
+
+	c.userService.On("ExistsById", request.UserId).Return(true)
+	c.repository.On("FindByUserId", request.UserId).Return([]model.Category{})
+	c.repository.On("Create", mock.Anything).Return(model.Category{}, expectedError)
+
+	actual, err := c.TestO.Add(request)
+
+	assert.Equal(c.T(), expectedError, err)
+	assert.Equal(c.T(), model.CategoryDto{}, actual)
+}
+
+func (c *CategoryServiceTestSuite) Test_FindById() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+	electricity := mocks.GenerateCategory(userId, &utilities.Id, "Electricity")
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{utilities, electricity})
+
+	actual, err := c.TestO.FindById(electricity.Id, userId)
+
+	assert.Nil(c.T(), err)
+	assert.Equal(c.T(), "Utilities > Electricity", actual.Path)
+	assert.Equal(c.T(), electricity.Id, actual.Id)
+}
+
+func (c *CategoryServiceTestSuite) Test_FindById_WithNotExists() {
+	id, userId := uuid.New(), uuid.New()
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{})
+
+	actual, err := c.TestO.FindById(id, userId)
+
+	assert.Equal(c.T(), interrors.NewErrNotFound("category with id %s not found", id), err)
+	assert.Equal(c.T(), model.CategoryDto{}, actual)
+}
+
+func (c *CategoryServiceTestSuite) Test_FindByUserId() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+	electricity := mocks.GenerateCategory(userId, &utilities.Id, "Electricity")
+	repairs := mocks.GenerateCategory(userId, nil, "Repairs")
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{electricity, repairs, utilities})
+
+	actual := c.TestO.FindByUserId(userId)
+
+	assert.Equal(c.T(), []string{"Repairs", "Utilities", "Utilities > Electricity"}, []string{actual[0].Path, actual[1].Path, actual[2].Path})
+}
+
+func (c *CategoryServiceTestSuite) Test_FindByUserId_WithoutCategories() {
+	userId := uuid.New()
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{})
+
+	assert.Equal(c.T(), []model.CategoryDto{}, c.TestO.FindByUserId(userId))
+}
+
+func (c *CategoryServiceTestSuite) Test_FindSubcategoryIds() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+	electricity := mocks.GenerateCategory(userId, &utilities.Id, "Electricity")
+	daytime := mocks.GenerateCategory(userId, &electricity.Id, "Daytime")
+	repairs := mocks.GenerateCategory(userId, nil, "Repairs")
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{utilities, electricity, daytime, repairs})
+
+	actual, err := c.TestO.FindSubcategoryIds(utilities.Id, userId)
+
+	assert.Nil(c.T(), err)
+	assert.ElementsMatch(c.T(), []uuid.UUID{utilities.Id, electricity.Id, daytime.Id}, actual)
+}
+
+func (c *CategoryServiceTestSuite) Test_FindSubcategoryIds_WithNotExists() {
+	id, userId := uuid.New(), uuid.New()
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{})
+
+	actual, err := c.TestO.FindSubcategoryIds(id, userId)
+
+	assert.Equal(c.T(), interrors.NewErrNotFound("category with id %s not found", id), err)
+	assert.Nil(c.T(), actual)
+}
+
+func (c *CategoryServiceTestSuite) Test_ExistsByIdAndUserId() {
+	userId := uuid.New()
+	category := mocks.GenerateCategory(userId, nil, "Utilities")
+
+	c.repository.On("FindById", category.Id).Return(category, nil)
+
+	assert.True(c.T(), c.TestO.ExistsByIdAndUserId(category.Id, userId))
+}
+
+func (c *CategoryServiceTestSuite) Test_ExistsByIdAndUserId_WithAnotherUser() {
+	category := mocks.GenerateCategory(uuid.New(), nil, "Utilities")
+
+	c.repository.On("FindById", category.Id).Return(category, nil)
+
+	assert.False(c.T(), c.TestO.ExistsByIdAndUserId(category.Id, uuid.New()))
+}
+
+func (c *CategoryServiceTestSuite) Test_ExistsByIdAndUserId_WithNotExists() {
+	id := uuid.New()
+
+	c.repository.On("FindById", id).Return(model.Category{}, gorm.ErrRecordNotFound)
+
+	assert.False(c.T(), c.TestO.ExistsByIdAndUserId(id, uuid.New()))
+}
+
+func (c *CategoryServiceTestSuite) Test_Update() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+	repairs := mocks.GenerateCategory(userId, nil, "Repairs")
+	request := model.UpdateCategoryRequest{Name: "Plumbing", ParentId: &repairs.Id}
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{utilities, repairs})
+	c.repository.On("Update", request.ToEntity(utilities.Id)).Return(nil)
+
+	assert.Nil(c.T(), c.TestO.Update(utilities.Id, userId, request))
+}
+
+func (c *CategoryServiceTestSuite) Test_Update_WithSameName() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+	request := model.UpdateCategoryRequest{Name: "Utilities"}
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{utilities})
+	c.repository.On("Update", request.ToEntity(utilities.Id)).Return(nil)
+
+	assert.Nil(c.T(), c.TestO.Update(utilities.Id, userId, request))
+}
+
+func (c *CategoryServiceTestSuite) Test_Update_WithNotExists() {
+	id, userId := uuid.New(), uuid.New()
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{})
+
+	err := c.TestO.Update(id, userId, model.UpdateCategoryRequest{Name: "Utilities"})
+
+	assert.Equal(c.T(), interrors.NewErrNotFound("category with id %s not found", id), err)
+	c.repository.AssertNotCalled(c.T(), "Update", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_Update_WithSubcategoryAsParent() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+	electricity := mocks.GenerateCategory(userId, &utilities.Id, "Electricity")
+	daytime := mocks.GenerateCategory(userId, &electricity.Id, "Daytime")
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{utilities, electricity, daytime})
+
+	err := c.TestO.Update(utilities.Id, userId, model.UpdateCategoryRequest{Name: "Utilities", ParentId: &daytime.Id})
+
+	assert.Equal(c.T(), errors.New("category could not be moved to itself or its subcategory"), err)
+	c.repository.AssertNotCalled(c.T(), "Update", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_Update_WithItselfAsParent() {
+	userId := uuid.New()
+	utilities := mocks.GenerateCategory(userId, nil, "Utilities")
+
+	c.repository.On("FindByUserId", userId).Return([]model.Category{utilities})
+
+	err := c.TestO.Update(utilities.Id, userId, model.UpdateCategoryRequest{Name: "Utilities", ParentId: &utilities.Id})
+
+	assert.Equal(c.T(), errors.New("category could not be moved to itself or its subcategory"), err)
+	c.repository.AssertNotCalled(c.T(), "Update", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_DeleteById() {
+	userId := uuid.New()
+	category := mocks.GenerateCategory(userId, nil, "Utilities")
+
+	c.repository.On("FindById", category.Id).Return(category, nil)
+	c.repository.On("ExistsByParentId", category.Id).Return(false)
+	c.repository.On("DeleteById", category.Id).Return(nil)
+
+	assert.Nil(c.T(), c.TestO.DeleteById(category.Id, userId))
+	c.repository.AssertCalled(c.T(), "DeleteById", category.Id)
+}
+
+func (c *CategoryServiceTestSuite) Test_DeleteById_WithNotExists() {
+	id := uuid.New()
+
+	c.repository.On("FindById", id).Return(model.Category{}, gorm.ErrRecordNotFound)
+
+	assert.Equal(c.T(), interrors.NewErrNotFound("category with id %s not found", id), c.TestO.DeleteById(id, uuid.New()))
+	c.repository.AssertNotCalled(c.T(), "DeleteById", mock.Anything)
+}
+
+func (c *CategoryServiceTestSuite) Test_DeleteById_WithSubcategories() {
+	userId := uuid.New()
+	category := mocks.GenerateCategory(userId, nil, "Utilities")
+
+	c.repository.On("FindById", category.Id).Return(category, nil)
+	c.repository.On("ExistsByParentId", category.Id).Return(true)
+
+	assert.Equal(c.T(), errors.New("category with subcategories could not be deleted"), c.TestO.DeleteById(category.Id, userId))
+	c.repository.AssertNotCalled(c.T(), "DeleteById", mock.Anything)
+}
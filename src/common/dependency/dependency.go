@@ -34,6 +34,19 @@ type ObjectDatabaseMigrator interface {
 	GetEntity() any
 }
 
+// ObjectSchemaUpgrader converts the existing schema before the automatic migration of the entity,
+// it is required when the automatic migration would lose the stored data
+type ObjectSchemaUpgrader interface {
+	ObjectDatabaseMigrator
+	UpgradeSchema() error
+}
+
+// ObjectStarter is started once all auto dependencies are initialized and migrated
+type ObjectStarter interface {
+	ObjectDependencyInitializer
+	Start() error
+}
+
 func (d *dependenciesProviderObject) Add(dependency any) any {
 	name, typeOf := findNameAndType(dependency)
 
@@ -5,12 +5,19 @@ import (
 	"fmt"
 	"github.com/rs/zerolog/log"
 	"reflect"
+	"time"
 )
 
 var errNotFoundType = reflect.TypeOf(ErrNotFound{})
 
 var errResponseType = reflect.TypeOf(ErrResponse{})
 
+var errUnauthorizedType = reflect.TypeOf(ErrUnauthorized{})
+
+var errForbiddenType = reflect.TypeOf(ErrForbidden{})
+
+var errLockedType = reflect.TypeOf(ErrLocked{})
+
 type ErrNotFound struct {
 	message string
 }
@@ -27,6 +34,56 @@ func (e ErrNotFound) Is(err error) bool {
 	return reflect.TypeOf(err) == errNotFoundType
 }
 
+type ErrUnauthorized struct {
+	message string
+}
+
+func NewErrUnauthorized(message string, args ...any) error {
+	return &ErrUnauthorized{fmt.Sprintf(message, args...)}
+}
+
+func (e ErrUnauthorized) Error() string {
+	return e.message
+}
+
+func (e ErrUnauthorized) Is(err error) bool {
+	return reflect.TypeOf(err) == errUnauthorizedType
+}
+
+type ErrForbidden struct {
+	message string
+}
+
+func NewErrForbidden(message string, args ...any) error {
+	return &ErrForbidden{fmt.Sprintf(message, args...)}
+}
+
+func (e ErrForbidden) Error() string {
+	return e.message
+}
+
+func (e ErrForbidden) Is(err error) bool {
+	return reflect.TypeOf(err) == errForbiddenType
+}
+
+// ErrLocked reports that the action is temporarily not allowed, it is allowed again after Until
+type ErrLocked struct {
+	message string
+	Until   time.Time
+}
+
+func NewErrLocked(until time.Time, message string, args ...any) error {
+	return &ErrLocked{fmt.Sprintf(message, args...), until}
+}
+
+func (e ErrLocked) Error() string {
+	return e.message
+}
+
+func (e ErrLocked) Is(err error) bool {
+	return reflect.TypeOf(err) == errLockedType
+}
+
 type ErrResponse struct {
 	Response ErrorResponse
 }
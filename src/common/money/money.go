@@ -0,0 +1,123 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of the minor units (cents) in the major unit
+const Scale = 100
+
+var scaleRat = big.NewRat(Scale, 1)
+
+// Money is the amount in the minor units, the sums of the amounts are exact unlike the sums of the floats.
+// It is represented as the decimal number with two decimal places in JSON and as the minor units in the database.
+type Money int64
+
+func FromMinorUnits(units int64) Money {
+	return Money(units)
+}
+
+// FromFloat rounds the calculated value to the minor units, it should be used only for the values that are not entered by the user
+func FromFloat(value float64) Money {
+	return Money(math.Round(value * Scale))
+}
+
+// Parse reads the decimal amount like 12.34 exactly, the amount with more than two decimal places is not valid
+func Parse(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.Contains(value, "/") {
+		return 0, errors.New(fmt.Sprintf("amount %s is not valid", value))
+	}
+
+	amount, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return 0, errors.New(fmt.Sprintf("amount %s is not valid", value))
+	}
+
+	amount.Mul(amount, scaleRat)
+	if !amount.IsInt() {
+		return 0, errors.New(fmt.Sprintf("amount %s has more than two decimal places", value))
+	}
+	if !amount.Num().IsInt64() {
+		return 0, errors.New(fmt.Sprintf("amount %s is too large", value))
+	}
+
+	return Money(amount.Num().Int64()), nil
+}
+
+func (m Money) MinorUnits() int64 {
+	return int64(m)
+}
+
+func (m Money) Float64() float64 {
+	return float64(m) / Scale
+}
+
+func (m Money) String() string {
+	sign := ""
+	units := int64(m)
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, units/Scale, units%Scale)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON accepts the amount as the number or as the string
+func (m *Money) UnmarshalJSON(data []byte) error {
+	value := string(data)
+	if value == "null" {
+		return nil
+	}
+
+	parsed, err := Parse(strings.Trim(value, `"`))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return int64(m), nil
+}
+
+func (m *Money) Scan(value any) error {
+	switch v := value.(type) {
+	case int64:
+		*m = Money(v)
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	case nil:
+		*m = 0
+	default:
+		return errors.New(fmt.Sprintf("money could not be scanned from %T", value))
+	}
+	return nil
+}
+
+func (m *Money) scanString(value string) error {
+	units, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	*m = Money(units)
+	return nil
+}
+
+func (m Money) GormDataType() string {
+	return "bigint"
+}
@@ -0,0 +1,102 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Parse(t *testing.T) {
+	for value, expected := range map[string]Money{
+		"12.34": 1234,
+		"12.3":  1230,
+		"12":    1200,
+		"0.1":   10,
+		"-0.05": -5,
+		" 7.00": 700,
+		"1e2":   10000,
+	} {
+		actual, err := Parse(value)
+
+		assert.Nil(t, err, value)
+		assert.Equal(t, expected, actual, value)
+	}
+}
+
+func Test_Parse_WithInvalidValue(t *testing.T) {
+	for value, expected := range map[string]error{
+		"":         errors.New("amount  is not valid"),
+		"abc":      errors.New("amount abc is not valid"),
+		"1/3":      errors.New("amount 1/3 is not valid"),
+		"12.345":   errors.New("amount 12.345 has more than two decimal places"),
+		"1e20":     errors.New("amount 1e20 is too large"),
+		"12,34.00": errors.New("amount 12,34.00 is not valid"),
+	} {
+		actual, err := Parse(value)
+
+		assert.Equal(t, expected, err, value)
+		assert.Equal(t, Money(0), actual, value)
+	}
+}
+
+func Test_Sum(t *testing.T) {
+	var sum Money
+	var floatSum float32
+
+	for i := 0; i < 1000; i++ {
+		sum += FromMinorUnits(10)
+		floatSum += 0.1
+	}
+
+	assert.Equal(t, "100.00", sum.String())
+	assert.NotEqual(t, float32(100), floatSum)
+}
+
+func Test_String(t *testing.T) {
+	assert.Equal(t, "12.34", Money(1234).String())
+	assert.Equal(t, "0.05", Money(5).String())
+	assert.Equal(t, "-0.05", Money(-5).String())
+	assert.Equal(t, "-12.30", Money(-1230).String())
+	assert.Equal(t, "0.00", Money(0).String())
+}
+
+func Test_FromFloat(t *testing.T) {
+	assert.Equal(t, Money(1234), FromFloat(12.34))
+	assert.Equal(t, Money(1235), FromFloat(12.345000001))
+	assert.Equal(t, Money(-1234), FromFloat(-12.34))
+}
+
+func Test_JSON(t *testing.T) {
+	type amount struct {
+		Sum Money
+	}
+
+	content, err := json.Marshal(amount{Sum: 1234})
+
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Sum":12.34}`, string(content))
+
+	var actual amount
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"Sum":10.1}`), &actual))
+	assert.Equal(t, Money(1010), actual.Sum)
+	assert.Nil(t, json.Unmarshal([]byte(`{"Sum":"0.3"}`), &actual))
+	assert.Equal(t, Money(30), actual.Sum)
+	assert.NotNil(t, json.Unmarshal([]byte(`{"Sum":0.001}`), &actual))
+}
+
+func Test_Scan(t *testing.T) {
+	var actual Money
+
+	assert.Nil(t, actual.Scan(int64(1234)))
+	assert.Equal(t, Money(1234), actual)
+	assert.Nil(t, actual.Scan([]byte("-5")))
+	assert.Equal(t, Money(-5), actual)
+	assert.NotNil(t, actual.Scan(1.5))
+
+	value, err := Money(1234).Value()
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1234), value)
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,13 +10,26 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog/log"
 	"io/ioutil"
+	"math"
+	"net"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
-var nilTime *time.Time
+var (
+	nilTime *time.Time
+	nilUUID *uuid.UUID
+)
+
+type contextKey string
+
+const (
+	userIdContextKey contextKey = "userId"
+	apiKeyContextKey contextKey = "apiKey"
+)
 
 var mappers = map[reflect.Type]func(value string) (any, error){
 	reflect.TypeOf(uuid.UUID{}): func(value string) (any, error) {
@@ -34,6 +48,24 @@ var mappers = map[reflect.Type]func(value string) (any, error){
 	reflect.TypeOf(""): func(value string) (any, error) {
 		return value, nil
 	},
+	reflect.TypeOf(nilUUID): func(value string) (any, error) {
+		if parse, err := uuid.Parse(value); err != nil {
+			return nil, errors.New("the id is not valid UUID")
+		} else {
+			return &parse, nil
+		}
+	},
+	reflect.TypeOf([]uuid.UUID{}): func(value string) (any, error) {
+		var ids []uuid.UUID
+		for _, id := range strings.Split(value, ",") {
+			if parse, err := uuid.Parse(strings.TrimSpace(id)); err != nil {
+				return nil, errors.New("the id is not valid UUID")
+			} else {
+				ids = append(ids, parse)
+			}
+		}
+		return ids, nil
+	},
 	reflect.TypeOf(nilTime): func(value string) (any, error) {
 		if parse, err := time.Parse(time.RFC3339, value); err != nil {
 			return nil, errors.New("the time is not valid RFC3339")
@@ -177,20 +209,79 @@ func GetQueryParam[T any](request *http.Request, name string) (T, error) {
 }
 
 func GetIdRequestParameter(request *http.Request) (id uuid.UUID, err error) {
-	if parameter, err := GetRequestParameter(request, "id"); err != nil {
+	return GetUUIDRequestParameter(request, "id")
+}
+
+func GetUUIDRequestParameter(request *http.Request, name string) (id uuid.UUID, err error) {
+	if parameter, err := GetRequestParameter(request, name); err != nil {
 		return id, err
 	} else {
 
 		id, err = uuid.Parse(parameter)
 
 		if err != nil {
-			return id, errors.New(fmt.Sprintf("the id is not valid %s", parameter))
+			return id, errors.New(fmt.Sprintf("the %s is not valid %s", name, parameter))
 		}
 
 		return id, err
 	}
 }
 
+// WithUserId returns a copy of the request that carries the id of the authenticated user
+func WithUserId(request *http.Request, userId uuid.UUID) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), userIdContextKey, userId))
+}
+
+// WithApiKey returns a copy of the request that is marked as authenticated with the api key
+func WithApiKey(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), apiKeyContextKey, true))
+}
+
+// IsApiKey checks that the request is authenticated with the api key instead of the access token
+func IsApiKey(request *http.Request) bool {
+	apiKey, _ := request.Context().Value(apiKeyContextKey).(bool)
+	return apiKey
+}
+
+// GetUserId returns the id of the user the request was authenticated for
+func GetUserId(request *http.Request) (uuid.UUID, error) {
+	if userId, ok := request.Context().Value(userIdContextKey).(uuid.UUID); ok {
+		return userId, nil
+	}
+	return uuid.UUID{}, int_errors.NewErrUnauthorized("user is not authenticated")
+}
+
+// GetIdRequestParameterAndUserId returns the id from the path together with the id of the authenticated user
+func GetIdRequestParameterAndUserId(request *http.Request) (id uuid.UUID, userId uuid.UUID, err error) {
+	if userId, err = GetUserId(request); err != nil {
+		return id, userId, err
+	}
+
+	id, err = GetIdRequestParameter(request)
+
+	return id, userId, err
+}
+
+// GetUserIdRequestParameter returns the user id from the path when it matches the authenticated user.
+// Another user is reported as not found to avoid disclosing that it exists.
+func GetUserIdRequestParameter(request *http.Request) (uuid.UUID, error) {
+	userId, err := GetUserId(request)
+	if err != nil {
+		return userId, err
+	}
+
+	id, err := GetIdRequestParameter(request)
+	if err != nil {
+		return id, err
+	}
+
+	if id != userId {
+		return id, int_errors.NewErrNotFound("user with id %s not found", id)
+	}
+
+	return id, nil
+}
+
 func PerformResponse(writer http.ResponseWriter, body any, err error) {
 	if err != nil {
 		HandleWithError(writer, err)
@@ -215,6 +306,14 @@ func PerformResponseWithCode(writer http.ResponseWriter, body any, statusCode in
 	}
 }
 
+// GetClientIp returns the address of the client without the port
+func GetClientIp(request *http.Request) string {
+	if host, _, err := net.SplitHostPort(request.RemoteAddr); err == nil {
+		return host
+	}
+	return request.RemoteAddr
+}
+
 func HandleErrorResponseWithError(writer http.ResponseWriter, statusCode int, err error) {
 	message := err.Error()
 
@@ -224,6 +323,12 @@ func HandleErrorResponseWithError(writer http.ResponseWriter, statusCode int, er
 func HandleWithError(writer http.ResponseWriter, err error) {
 	if errors.Is(err, int_errors.ErrNotFound{}) {
 		HandleErrorResponseWithError(writer, http.StatusNotFound, err)
+	} else if errors.Is(err, int_errors.ErrUnauthorized{}) {
+		HandleErrorResponseWithError(writer, http.StatusUnauthorized, err)
+	} else if errors.Is(err, int_errors.ErrForbidden{}) {
+		HandleErrorResponseWithError(writer, http.StatusForbidden, err)
+	} else if locked := new(int_errors.ErrLocked); errors.As(err, &locked) {
+		handleLocked(writer, locked)
 	} else if errors.Is(err, int_errors.ErrResponse{}) {
 		handleBadRequestWithErrorResponse(writer, err.(*int_errors.ErrResponse).Response)
 	} else {
@@ -231,6 +336,17 @@ func HandleWithError(writer http.ResponseWriter, err error) {
 	}
 }
 
+// handleLocked tells the client when to retry the request with the Retry-After header
+func handleLocked(writer http.ResponseWriter, err *int_errors.ErrLocked) {
+	retryAfter := int(math.Ceil(time.Until(err.Until).Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+
+	writer.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	HandleErrorResponseWithError(writer, http.StatusTooManyRequests, err)
+}
+
 func handleBadRequestWithErrorResponse(writer http.ResponseWriter, response int_errors.ErrorResponse) {
 	if response != nil {
 		writer.WriteHeader(http.StatusBadRequest)
@@ -273,3 +389,20 @@ func GetRequestFiltering(request *http.Request) (from, to *time.Time) {
 
 	return from, to
 }
+
+// GetRequestTagging returns the tag ids of the comma separated tagIds query parameter, the rows should have all the tags
+// if the tagMatch query parameter is 'all' or any of them if it is 'any' or missing
+func GetRequestTagging(request *http.Request) (ids []uuid.UUID, all bool, err error) {
+	if ids, err = GetQueryParamOrDefault[[]uuid.UUID](request, "tagIds", nil); err != nil {
+		return nil, false, err
+	}
+
+	switch match, _ := GetQueryParamOrDefault(request, "tagMatch", "any"); match {
+	case "any":
+		return ids, false, nil
+	case "all":
+		return ids, true, nil
+	default:
+		return nil, false, errors.New(fmt.Sprintf("tag match '%s' is not valid, the valid values are 'any' and 'all'", match))
+	}
+}
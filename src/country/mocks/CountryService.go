@@ -30,21 +30,42 @@ func (_m *CountryService) FindAllCountries() []model.Country {
 }
 
 // FindCountryByCode provides a mock function with given fields: code
-func (_m *CountryService) FindCountryByCode(code string) (error, model.Country) {
+func (_m *CountryService) FindCountryByCode(code string) (model.Country, error) {
 	ret := _m.Called(code)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
+	var r0 model.Country
+	if rf, ok := ret.Get(0).(func(string) model.Country); ok {
 		r0 = rf(code)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.Country)
 	}
 
-	var r1 model.Country
-	if rf, ok := ret.Get(1).(func(string) model.Country); ok {
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
 		r1 = rf(code)
 	} else {
-		r1 = ret.Get(1).(model.Country)
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindCurrencyByCode provides a mock function with given fields: code
+func (_m *CountryService) FindCurrencyByCode(code string) (model.Currency, error) {
+	ret := _m.Called(code)
+
+	var r0 model.Currency
+	if rf, ok := ret.Get(0).(func(string) model.Currency); ok {
+		r0 = rf(code)
+	} else {
+		r0 = ret.Get(0).(model.Currency)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
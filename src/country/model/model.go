@@ -7,6 +7,7 @@ type Country struct {
 	Region   string   `json:"region"`
 	Currency Currency `json:"currency"`
 	Language Language `json:"language"`
+	TimeZone string   `json:"timezone"`
 	Flag     string   `json:"flag"`
 }
 
@@ -7,23 +7,27 @@ import (
 )
 
 type CountryServiceObject struct {
-	countriesMap map[string]model.Country
-	countries    []model.Country
+	countriesMap  map[string]model.Country
+	currenciesMap map[string]model.Currency
+	countries     []model.Country
 }
 
 type CountryService interface {
 	FindCountryByCode(code string) (model.Country, error)
 	FindAllCountries() []model.Country
+	FindCurrencyByCode(code string) (model.Currency, error)
 }
 
 func NewCountryService(countries []model.Country) CountryService {
 	object := &CountryServiceObject{
-		countriesMap: make(map[string]model.Country),
-		countries:    countries,
+		countriesMap:  make(map[string]model.Country),
+		currenciesMap: make(map[string]model.Currency),
+		countries:     countries,
 	}
 
 	for _, country := range object.countries {
 		object.countriesMap[country.Code] = country
+		object.currenciesMap[country.Currency.Code] = country.Currency
 	}
 
 	log.Info().Msg("Countries init completed")
@@ -41,3 +45,11 @@ func (c *CountryServiceObject) FindCountryByCode(code string) (country model.Cou
 func (c *CountryServiceObject) FindAllCountries() []model.Country {
 	return c.countries
 }
+
+// FindCurrencyByCode returns the currency used by at least one of the countries
+func (c *CountryServiceObject) FindCurrencyByCode(code string) (currency model.Currency, err error) {
+	if currency, ok := c.currenciesMap[code]; ok {
+		return currency, err
+	}
+	return currency, int_errors.NewErrNotFound("currency with code %s is not found", code)
+}
@@ -55,7 +55,8 @@ func TestFindCountryByCode(t *testing.T) {
 					Code: "uk",
 					Name: "Ukrainian",
 				},
-				Flag: "https://restcountries.eu/data/ukr.svg",
+				TimeZone: "Europe/Kyiv",
+				Flag:     "https://restcountries.eu/data/ukr.svg",
 			},
 		}, {
 			name: "with not existing country",
@@ -82,3 +83,17 @@ func TestFindCountryByCode(t *testing.T) {
 func TestFindAllCountries(t *testing.T) {
 	assert.Equal(t, 249, len(countriesService.FindAllCountries()), "FindAll() should have 249 countries")
 }
+
+func TestFindCurrencyByCode(t *testing.T) {
+	currency, err := countriesService.FindCurrencyByCode("EUR")
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.Currency{Code: "EUR", Name: "Euro", Symbol: "€"}, currency)
+}
+
+func TestFindCurrencyByCode_WithNotExistingCurrency(t *testing.T) {
+	currency, err := countriesService.FindCurrencyByCode("INVALID")
+
+	assert.Equal(t, int_errors.NewErrNotFound("currency with code INVALID is not found"), err)
+	assert.Equal(t, model.Currency{}, currency)
+}
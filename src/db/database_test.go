@@ -2,6 +2,7 @@ package db
 
 import (
 	"fmt"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
@@ -278,3 +279,42 @@ func (i *DatabaseTestSuite) createTestEntity() testEntity {
 
 	return entity
 }
+
+type legacySumEntity struct {
+	Id  uuid.UUID `gorm:"primarykey"`
+	Sum float32
+}
+
+type moneySumEntity struct {
+	Id  uuid.UUID `gorm:"primarykey"`
+	Sum money.Money
+}
+
+func (moneySumEntity) TableName() string {
+	return "legacy_sum_entities"
+}
+
+func (i *DatabaseTestSuite) Test_UpgradeMoneyColumn() {
+	i.Require().Nil(i.database.D().AutoMigrate(legacySumEntity{}))
+	defer i.database.D().Migrator().DropTable(legacySumEntity{})
+
+	legacy := legacySumEntity{Id: uuid.New(), Sum: 12.34}
+	i.Require().Nil(i.database.Create(&legacy))
+
+	modeled := ModeledDatabase{DatabaseService: i.database, Model: moneySumEntity{}}
+
+	assert.Nil(i.T(), modeled.UpgradeMoneyColumn("sum"))
+	assert.Nil(i.T(), modeled.UpgradeMoneyColumn("sum"))
+	assert.Nil(i.T(), i.database.D().AutoMigrate(moneySumEntity{}))
+
+	var actual moneySumEntity
+
+	assert.Nil(i.T(), i.database.FindById(&actual, legacy.Id))
+	assert.Equal(i.T(), money.FromMinorUnits(1234), actual.Sum)
+}
+
+func (i *DatabaseTestSuite) Test_UpgradeMoneyColumn_WithMissingTable() {
+	modeled := ModeledDatabase{DatabaseService: i.database, Model: moneySumEntity{}}
+
+	assert.Nil(i.T(), modeled.UpgradeMoneyColumn("sum"))
+}
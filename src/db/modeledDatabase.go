@@ -1,8 +1,11 @@
 package db
 
 import (
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
 )
 
 type ModeledDatabase struct {
@@ -45,3 +48,76 @@ func (m *ModeledDatabase) Update(id uuid.UUID, entity any, omit ...string) error
 func (m *ModeledDatabase) Modeled() *gorm.DB {
 	return m.DM(m.Model)
 }
+
+// UpgradeMoneyColumn converts the decimal amounts of the column to the minor units of money.Money rounding them to cents,
+// the column is not changed when the table is not created yet or the column is already converted
+func (m *ModeledDatabase) UpgradeMoneyColumn(column string) error {
+	migrator := m.D().Migrator()
+	if !migrator.HasTable(m.Model) {
+		return nil
+	}
+
+	columnTypes, err := migrator.ColumnTypes(m.Model)
+	if err != nil {
+		return err
+	}
+
+	for _, columnType := range columnTypes {
+		if columnType.Name() != column || !isDecimal(columnType.DatabaseTypeName()) {
+			continue
+		}
+
+		statement := &gorm.Statement{DB: m.D()}
+		if err := statement.Parse(m.Model); err != nil {
+			return err
+		}
+
+		return m.D().Exec(
+			"ALTER TABLE ? ALTER COLUMN ? TYPE bigint USING round(? * ?)",
+			clause.Table{Name: statement.Table}, clause.Column{Name: column}, clause.Column{Name: column}, money.Scale,
+		).Error
+	}
+
+	return nil
+}
+
+// AssignHouseCurrencies sets the currency of the house country to the rows persisted before the currency was stored,
+// the currencies are the currency codes by the country codes
+func (m *ModeledDatabase) AssignHouseCurrencies(currencies map[string]string) error {
+	statement := &gorm.Statement{DB: m.D()}
+	if err := statement.Parse(m.Model); err != nil {
+		return err
+	}
+	table := clause.Table{Name: statement.Table}
+
+	var countryCodes []string
+
+	err := m.D().Raw(
+		"SELECT DISTINCT houses.country_code FROM ? JOIN houses ON houses.id = ?.house_id WHERE ?.currency IS NULL OR ?.currency = ''",
+		table, table, table, table,
+	).Scan(&countryCodes).Error
+	if err != nil {
+		return err
+	}
+
+	for _, countryCode := range countryCodes {
+		currency, ok := currencies[countryCode]
+		if !ok {
+			continue
+		}
+
+		err = m.D().Exec(
+			"UPDATE ? SET currency = ? FROM houses WHERE houses.id = ?.house_id AND houses.country_code = ? AND (?.currency IS NULL OR ?.currency = '')",
+			table, currency, table, countryCode, table, table,
+		).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isDecimal(databaseType string) bool {
+	return strings.EqualFold(databaseType, "numeric") || strings.EqualFold(databaseType, "decimal")
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/VlasovArtem/hob/src/exchange/service"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type ExchangeRateHandlerObject struct {
+	exchangeRateService service.ExchangeRateService
+}
+
+func NewExchangeRateHandler(exchangeRateService service.ExchangeRateService) ExchangeRateHandler {
+	return &ExchangeRateHandlerObject{exchangeRateService}
+}
+
+func (e *ExchangeRateHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewExchangeRateHandler(
+		dependency.FindRequiredDependency[service.ExchangeRateServiceObject, service.ExchangeRateService](factory),
+	)
+}
+
+func (e *ExchangeRateHandlerObject) Init(router *mux.Router) {
+	exchangeRateRouter := router.PathPrefix("/api/v1/exchange-rates").Subrouter()
+
+	exchangeRateRouter.Path("").HandlerFunc(e.Add()).Methods("POST")
+	exchangeRateRouter.Path("").HandlerFunc(e.FindByUserId()).Methods("GET")
+	exchangeRateRouter.Path("/import").HandlerFunc(e.Import()).Methods("POST")
+	exchangeRateRouter.Path("/{id}").HandlerFunc(e.Delete()).Methods("DELETE")
+}
+
+type ExchangeRateHandler interface {
+	Add() http.HandlerFunc
+	Import() http.HandlerFunc
+	FindByUserId() http.HandlerFunc
+	Delete() http.HandlerFunc
+}
+
+func (e *ExchangeRateHandlerObject) Add() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		if body, err := rest.ReadRequestBody[model.CreateExchangeRateRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Created(e.exchangeRateService.Add(userId, body)).
+				Perform()
+		}
+	}
+}
+
+// Import reads the rates from the CSV request body
+func (e *ExchangeRateHandlerObject) Import() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if userId, err := rest.GetUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Created(e.exchangeRateService.Import(userId, request.Body)).
+				Perform()
+		}
+	}
+}
+
+func (e *ExchangeRateHandlerObject) FindByUserId() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if userId, err := rest.GetUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Body(e.exchangeRateService.FindByUserId(userId)).
+				Perform()
+		}
+	}
+}
+
+func (e *ExchangeRateHandlerObject) Delete() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(e.exchangeRateService.DeleteById(id, userId)).
+				Perform()
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/VlasovArtem/hob/src/exchange/mocks"
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type ExchangeRateHandlerTestSuite struct {
+	testhelper.MockTestSuite[ExchangeRateHandler]
+	exchangeRates *mocks.ExchangeRateService
+}
+
+func TestExchangeRateHandlerTestSuite(t *testing.T) {
+	testingSuite := &ExchangeRateHandlerTestSuite{}
+	testingSuite.TestObjectGenerator = func() ExchangeRateHandler {
+		testingSuite.exchangeRates = new(mocks.ExchangeRateService)
+		return NewExchangeRateHandler(testingSuite.exchangeRates)
+	}
+
+	suite.Run(t, testingSuite)
+}
+
+func (e *ExchangeRateHandlerTestSuite) Test_Add() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateExchangeRateRequest()
+	expected := request.ToEntity(userId).ToDto()
+
+	e.exchangeRates.On("Add", userId, request).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/exchange-rates").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(e.TestO.Add()).
+		WithBody(request)
+
+	responseByteArray := testRequest.Verify(e.T(), http.StatusCreated)
+
+	actual := model.ExchangeRateDto{}
+
+	json.Unmarshal(responseByteArray, &actual)
+
+	assert.Equal(e.T(), expected, actual)
+}
+
+func (e *ExchangeRateHandlerTestSuite) Test_Add_WithInvalidRequest() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/exchange-rates").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(e.TestO.Add())
+
+	testRequest.Verify(e.T(), http.StatusBadRequest)
+}
+
+func (e *ExchangeRateHandlerTestSuite) Test_Add_WithErrorFromService() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateExchangeRateRequest()
+
+	e.exchangeRates.On("Add", userId, request).Return(model.ExchangeRateDto{}, errors.New("error"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/exchange-rates").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(e.TestO.Add()).
+		WithBody(request)
+
+	responseByteArray := testRequest.Verify(e.T(), http.StatusBadRequest)
+
+	assert.Equal(e.T(), "error\n", string(responseByteArray))
+}
+
+func (e *ExchangeRateHandlerTestSuite) Test_Import() {
+	userId := uuid.New()
+	content := "2022-01-01,EUR,UAH,31.5\n"
+	expected := []model.ExchangeRateDto{mocks.GenerateExchangeRate(userId).ToDto()}
+
+	e.exchangeRates.On("Import", userId, mock.Anything).Return(
+		func(userId uuid.UUID, reader io.Reader) []model.ExchangeRateDto {
+			body, _ := io.ReadAll(reader)
+			assert.Equal(e.T(), content, string(body))
+			return expected
+		}, nil)
+
+	request := rest.WithUserId(httptest.NewRequest("POST", "https://test.com/api/v1/exchange-rates/import", strings.NewReader(content)), userId)
+	recorder := httptest.NewRecorder()
+
+	e.TestO.Import()(recorder, request)
+
+	assert.Equal(e.T(), http.StatusCreated, recorder.Code)
+
+	var actual []model.ExchangeRateDto
+
+	json.Unmarshal(recorder.Body.Bytes(), &actual)
+
+	assert.Equal(e.T(), expected, actual)
+}
+
+func (e *ExchangeRateHandlerTestSuite) Test_FindByUserId() {
+	userId := uuid.New()
+	expected := []model.ExchangeRateDto{mocks.GenerateExchangeRate(userId).ToDto()}
+
+	e.exchangeRates.On("FindByUserId", userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/exchange-rates").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(e.TestO.FindByUserId())
+
+	responseByteArray := testRequest.Verify(e.T(), http.StatusOK)
+
+	var actual []model.ExchangeRateDto
+
+	json.Unmarshal(responseByteArray, &actual)
+
+	assert.Equal(e.T(), expected, actual)
+}
+
+func (e *ExchangeRateHandlerTestSuite) Test_Delete() {
+	userId := uuid.New()
+	id := uuid.New()
+
+	e.exchangeRates.On("DeleteById", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/exchange-rates/{id}").
+		WithMethod("DELETE").
+		WithUser(userId).
+		WithHandler(e.TestO.Delete()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(e.T(), http.StatusNoContent)
+}
+
+func (e *ExchangeRateHandlerTestSuite) Test_Delete_WithInvalidParameter() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/exchange-rates/{id}").
+		WithMethod("DELETE").
+		WithUser(uuid.New()).
+		WithHandler(e.TestO.Delete()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(e.T(), http.StatusBadRequest)
+
+	assert.Equal(e.T(), "the id is not valid id\n", string(responseByteArray))
+}
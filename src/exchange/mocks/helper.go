@@ -0,0 +1,27 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+func GenerateCreateExchangeRateRequest() model.CreateExchangeRateRequest {
+	return model.CreateExchangeRateRequest{
+		Currency:     "EUR",
+		BaseCurrency: "UAH",
+		Date:         time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Rate:         31.5,
+	}
+}
+
+func GenerateExchangeRate(userId uuid.UUID) model.ExchangeRate {
+	return model.ExchangeRate{
+		Id:           uuid.New(),
+		UserId:       userId,
+		Currency:     "EUR",
+		BaseCurrency: "UAH",
+		Date:         time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Rate:         31.5,
+	}
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"github.com/VlasovArtem/hob/src/common/money"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+// DateFormat is the format of the rate date in the imported CSV
+const DateFormat = "2006-01-02"
+
+// ExchangeRate is the rate of the currency to the base currency on the date, one unit of the currency costs Rate units of
+// the base currency. The rates are entered by the user, there is only one rate of the currency pair per day
+type ExchangeRate struct {
+	Id           uuid.UUID `gorm:"primarykey"`
+	UserId       uuid.UUID `gorm:"uniqueIndex:idx_exchange_rates_pair_date"`
+	Currency     string    `gorm:"uniqueIndex:idx_exchange_rates_pair_date"`
+	BaseCurrency string    `gorm:"uniqueIndex:idx_exchange_rates_pair_date"`
+	Date         time.Time `gorm:"uniqueIndex:idx_exchange_rates_pair_date"`
+	Rate         float64
+	User         userModel.User `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+type ExchangeRateDto struct {
+	Id           uuid.UUID
+	UserId       uuid.UUID
+	Currency     string
+	BaseCurrency string
+	Date         time.Time
+	Rate         float64
+}
+
+type CreateExchangeRateRequest struct {
+	Currency     string
+	BaseCurrency string
+	Date         time.Time
+	Rate         float64
+}
+
+// Amount is the sum in the currency on the date, it is converted with the rate of the date
+type Amount struct {
+	Sum      money.Money
+	Currency string
+	Date     time.Time
+}
+
+func (e ExchangeRate) ToDto() ExchangeRateDto {
+	return ExchangeRateDto{
+		Id:           e.Id,
+		UserId:       e.UserId,
+		Currency:     e.Currency,
+		BaseCurrency: e.BaseCurrency,
+		Date:         e.Date,
+		Rate:         e.Rate,
+	}
+}
+
+func (c CreateExchangeRateRequest) ToEntity(userId uuid.UUID) ExchangeRate {
+	return ExchangeRate{
+		Id:           uuid.New(),
+		UserId:       userId,
+		Currency:     c.Currency,
+		BaseCurrency: c.BaseCurrency,
+		Date:         c.Date,
+		Rate:         c.Rate,
+	}
+}
+
+func ExchangeRateToExchangeRateDto(exchangeRate ExchangeRate) ExchangeRateDto {
+	return exchangeRate.ToDto()
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var entity = model.ExchangeRate{}
+
+type ExchangeRateRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewExchangeRateRepository(database db.DatabaseService) ExchangeRateRepository {
+	return &ExchangeRateRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (e *ExchangeRateRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewExchangeRateRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (e *ExchangeRateRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type ExchangeRateRepository interface {
+	Save(entities []model.ExchangeRate) ([]model.ExchangeRate, error)
+	FindByUserId(userId uuid.UUID) []model.ExchangeRateDto
+	FindRate(userId uuid.UUID, currency string, baseCurrency string, date time.Time) (model.ExchangeRate, error)
+	FindRates(userId uuid.UUID, currencies []string, baseCurrency string, from time.Time, to time.Time) ([]model.ExchangeRate, error)
+	ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool
+	DeleteById(id uuid.UUID) error
+}
+
+// Save creates the rates, the rate of the existing currency pair and date is replaced
+func (e *ExchangeRateRepositoryObject) Save(entities []model.ExchangeRate) ([]model.ExchangeRate, error) {
+	return entities, e.database.D().
+		Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "currency"}, {Name: "base_currency"}, {Name: "date"}},
+				DoUpdates: clause.AssignmentColumns([]string{"rate"}),
+			},
+			clause.Returning{},
+		).
+		Create(&entities).
+		Error
+}
+
+func (e *ExchangeRateRepositoryObject) FindByUserId(userId uuid.UUID) []model.ExchangeRateDto {
+	var rates []model.ExchangeRate
+
+	if err := e.database.Modeled().
+		Where("user_id = ?", userId).
+		Order("currency, base_currency, date desc").
+		Find(&rates).Error; err != nil {
+		return []model.ExchangeRateDto{}
+	}
+
+	return common.MapSlice(rates, model.ExchangeRateToExchangeRateDto)
+}
+
+// FindRate returns the latest rate of the currency pair on or before the date
+func (e *ExchangeRateRepositoryObject) FindRate(userId uuid.UUID, currency string, baseCurrency string, date time.Time) (response model.ExchangeRate, err error) {
+	return response, e.database.Modeled().
+		Where("user_id = ? AND currency = ? AND base_currency = ? AND date <= ?", userId, currency, baseCurrency, date).
+		Order("date desc").
+		First(&response).
+		Error
+}
+
+// FindRates returns the rates of the currencies to the base currency and of the reverse pairs that are used in the period,
+// they are the rates within the period and the latest rate on or before its start. The rates are sorted by the date
+func (e *ExchangeRateRepositoryObject) FindRates(userId uuid.UUID, currencies []string, baseCurrency string, from time.Time, to time.Time) (response []model.ExchangeRate, err error) {
+	return response, e.database.Modeled().
+		Where("user_id = ? AND ((currency IN ? AND base_currency = ?) OR (currency = ? AND base_currency IN ?)) AND date <= ?", userId, currencies, baseCurrency, baseCurrency, currencies, to).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM exchange_rates later
+			WHERE later.user_id = exchange_rates.user_id AND later.currency = exchange_rates.currency
+			AND later.base_currency = exchange_rates.base_currency AND later.date > exchange_rates.date AND later.date <= ?
+		)`, from).
+		Order("date").
+		Find(&response).
+		Error
+}
+
+func (e *ExchangeRateRepositoryObject) ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool {
+	return e.database.ExistsBy("id = ? AND user_id = ?", id, userId)
+}
+
+func (e *ExchangeRateRepositoryObject) DeleteById(id uuid.UUID) error {
+	return e.database.Delete(id)
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/exchange/mocks"
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+type ExchangeRateRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository  ExchangeRateRepository
+	createdUser userModel.User
+}
+
+func (e *ExchangeRateRepositoryTestSuite) SetupSuite() {
+	e.InitDBTestSuite()
+
+	e.CreateRepository(
+		func(service db.DatabaseService) {
+			e.repository = NewExchangeRateRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, model.ExchangeRate{})
+		}).
+		AddAfterSuite(func(service db.DatabaseService) {
+			database.TruncateTable(service, userModel.User{})
+		}).
+		ExecuteMigration(userModel.User{}, model.ExchangeRate{})
+
+	e.createdUser = userMocks.GenerateUser()
+	e.CreateEntity(&e.createdUser)
+}
+
+func TestExchangeRateRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ExchangeRateRepositoryTestSuite))
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_Save() {
+	exchangeRate := mocks.GenerateExchangeRate(e.createdUser.Id)
+
+	actual, err := e.repository.Save([]model.ExchangeRate{exchangeRate})
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), exchangeRate.ToDto(), actual[0].ToDto())
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_Save_WithExistingDate() {
+	existing := e.createExchangeRate(mocks.GenerateExchangeRate(e.createdUser.Id))
+
+	replacement := mocks.GenerateExchangeRate(e.createdUser.Id)
+	replacement.Rate = 32.1
+
+	actual, err := e.repository.Save([]model.ExchangeRate{replacement})
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), existing.Id, actual[0].Id)
+	assert.Equal(e.T(), []model.ExchangeRateDto{actual[0].ToDto()}, e.repository.FindByUserId(e.createdUser.Id))
+	assert.Equal(e.T(), 32.1, actual[0].Rate)
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_FindByUserId() {
+	exchangeRate := e.createExchangeRate(mocks.GenerateExchangeRate(e.createdUser.Id))
+
+	assert.Equal(e.T(), []model.ExchangeRateDto{exchangeRate.ToDto()}, e.repository.FindByUserId(e.createdUser.Id))
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_FindByUserId_WithMissingUser() {
+	e.createExchangeRate(mocks.GenerateExchangeRate(e.createdUser.Id))
+
+	assert.Equal(e.T(), []model.ExchangeRateDto{}, e.repository.FindByUserId(uuid.New()))
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_FindRate() {
+	first := e.createExchangeRate(mocks.GenerateExchangeRate(e.createdUser.Id))
+	second := mocks.GenerateExchangeRate(e.createdUser.Id)
+	second.Date = time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+	second.Rate = 32.1
+	e.createExchangeRate(second)
+
+	actual, err := e.repository.FindRate(e.createdUser.Id, "EUR", "UAH", time.Date(2022, time.January, 31, 12, 0, 0, 0, time.UTC))
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), first.Id, actual.Id)
+
+	actual, err = e.repository.FindRate(e.createdUser.Id, "EUR", "UAH", time.Date(2022, time.February, 1, 12, 0, 0, 0, time.UTC))
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), second.Id, actual.Id)
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_FindRate_WithEarlierDate() {
+	e.createExchangeRate(mocks.GenerateExchangeRate(e.createdUser.Id))
+
+	_, err := e.repository.FindRate(e.createdUser.Id, "EUR", "UAH", time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC))
+
+	assert.ErrorIs(e.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_FindRates() {
+	e.createExchangeRate(mocks.GenerateExchangeRate(e.createdUser.Id))
+	second := mocks.GenerateExchangeRate(e.createdUser.Id)
+	second.Date = time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+	second = e.createExchangeRate(second)
+	third := mocks.GenerateExchangeRate(e.createdUser.Id)
+	third.Date = time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+	third = e.createExchangeRate(third)
+	fourth := mocks.GenerateExchangeRate(e.createdUser.Id)
+	fourth.Date = time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC)
+	e.createExchangeRate(fourth)
+	reverse := mocks.GenerateExchangeRate(e.createdUser.Id)
+	reverse.Currency, reverse.BaseCurrency = "UAH", "USD"
+	reverse = e.createExchangeRate(reverse)
+	other := mocks.GenerateExchangeRate(e.createdUser.Id)
+	other.Currency = "PLN"
+	e.createExchangeRate(other)
+
+	actual, err := e.repository.FindRates(
+		e.createdUser.Id,
+		[]string{"EUR", "USD"},
+		"UAH",
+		time.Date(2022, time.February, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, time.March, 15, 0, 0, 0, 0, time.UTC),
+	)
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), []uuid.UUID{reverse.Id, second.Id, third.Id}, common.MapSlice(actual, func(rate model.ExchangeRate) uuid.UUID { return rate.Id }))
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_ExistsByIdAndUserId() {
+	exchangeRate := e.createExchangeRate(mocks.GenerateExchangeRate(e.createdUser.Id))
+
+	assert.True(e.T(), e.repository.ExistsByIdAndUserId(exchangeRate.Id, e.createdUser.Id))
+	assert.False(e.T(), e.repository.ExistsByIdAndUserId(exchangeRate.Id, uuid.New()))
+}
+
+func (e *ExchangeRateRepositoryTestSuite) Test_DeleteById() {
+	exchangeRate := e.createExchangeRate(mocks.GenerateExchangeRate(e.createdUser.Id))
+
+	err := e.repository.DeleteById(exchangeRate.Id)
+
+	assert.Nil(e.T(), err)
+	assert.False(e.T(), e.repository.ExistsByIdAndUserId(exchangeRate.Id, e.createdUser.Id))
+}
+
+func (e *ExchangeRateRepositoryTestSuite) createExchangeRate(exchangeRate model.ExchangeRate) model.ExchangeRate {
+	e.CreateEntity(&exchangeRate)
+
+	return exchangeRate
+}
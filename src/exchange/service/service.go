@@ -0,0 +1,284 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	countries "github.com/VlasovArtem/hob/src/country/service"
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/VlasovArtem/hob/src/exchange/repository"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ExchangeRateServiceObject struct {
+	userService      userService.UserService
+	countriesService countries.CountryService
+	repository       repository.ExchangeRateRepository
+}
+
+func NewExchangeRateService(
+	userService userService.UserService,
+	countriesService countries.CountryService,
+	repository repository.ExchangeRateRepository,
+) ExchangeRateService {
+	return &ExchangeRateServiceObject{
+		userService:      userService,
+		countriesService: countriesService,
+		repository:       repository,
+	}
+}
+
+func (e *ExchangeRateServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewExchangeRateService(
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[countries.CountryServiceObject, countries.CountryService](factory),
+		dependency.FindRequiredDependency[repository.ExchangeRateRepositoryObject, repository.ExchangeRateRepository](factory),
+	)
+}
+
+type ExchangeRateService interface {
+	Add(userId uuid.UUID, request model.CreateExchangeRateRequest) (model.ExchangeRateDto, error)
+	Import(userId uuid.UUID, reader io.Reader) ([]model.ExchangeRateDto, error)
+	FindByUserId(userId uuid.UUID) []model.ExchangeRateDto
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+	Convert(userId uuid.UUID, amount model.Amount, baseCurrency string) (money.Money, error)
+	Total(userId uuid.UUID, amounts []model.Amount, baseCurrency string) (money.Money, error)
+}
+
+// Add creates the rate of the currency pair on the date, the existing rate of the date is replaced
+func (e *ExchangeRateServiceObject) Add(userId uuid.UUID, request model.CreateExchangeRateRequest) (response model.ExchangeRateDto, err error) {
+	if !e.userService.ExistsById(userId) {
+		return response, interrors.NewErrNotFound("user with id %s not found", userId)
+	}
+
+	if request, err = e.validate(request); err != nil {
+		return response, err
+	}
+
+	if entities, err := e.repository.Save([]model.ExchangeRate{request.ToEntity(userId)}); err != nil {
+		return response, err
+	} else {
+		return entities[0].ToDto(), nil
+	}
+}
+
+// Import reads the historical rates from the CSV with the date (2006-01-02), currency, base currency and rate columns. The
+// header line is optional, the import is rejected if any of the lines is not valid
+func (e *ExchangeRateServiceObject) Import(userId uuid.UUID, reader io.Reader) ([]model.ExchangeRateDto, error) {
+	if !e.userService.ExistsById(userId) {
+		return nil, interrors.NewErrNotFound("user with id %s not found", userId)
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 4
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) > 0 && strings.EqualFold(strings.TrimSpace(records[0][0]), "date") {
+		records = records[1:]
+	}
+
+	builder := interrors.NewBuilder()
+	// the rates are unique by the pair and date, the last line of the duplicates is used
+	entities := make(map[string]model.ExchangeRate)
+	var keys []string
+
+	for index, record := range records {
+		if request, err := e.parse(record); err != nil {
+			builder.WithDetail(fmt.Sprintf("line %d: %s", index+1, err.Error()))
+		} else {
+			key := fmt.Sprintf("%s/%s/%s", request.Currency, request.BaseCurrency, request.Date.Format(model.DateFormat))
+			if _, ok := entities[key]; !ok {
+				keys = append(keys, key)
+			}
+			entities[key] = request.ToEntity(userId)
+		}
+	}
+
+	if builder.HasErrors() {
+		return nil, interrors.NewErrResponse(builder.WithMessage("Import exchange rates failed"))
+	}
+	if len(keys) == 0 {
+		return make([]model.ExchangeRateDto, 0), nil
+	}
+
+	batch := common.MapSlice(keys, func(key string) model.ExchangeRate { return entities[key] })
+
+	if saved, err := e.repository.Save(batch); err != nil {
+		return nil, err
+	} else {
+		return common.MapSlice(saved, model.ExchangeRateToExchangeRateDto), nil
+	}
+}
+
+func (e *ExchangeRateServiceObject) FindByUserId(userId uuid.UUID) []model.ExchangeRateDto {
+	return e.repository.FindByUserId(userId)
+}
+
+func (e *ExchangeRateServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if !e.repository.ExistsByIdAndUserId(id, userId) {
+		return interrors.NewErrNotFound("exchange rate with id %s not found", id)
+	}
+	return e.repository.DeleteById(id)
+}
+
+// Convert returns the sum in the base currency with the latest rate on or before the date of the amount, the rate of the
+// reverse currency pair is used if the direct one is missing
+func (e *ExchangeRateServiceObject) Convert(userId uuid.UUID, amount model.Amount, baseCurrency string) (money.Money, error) {
+	return convert(amount, baseCurrency, func(currency string, baseCurrency string, date time.Time) (model.ExchangeRate, error) {
+		return e.repository.FindRate(userId, currency, baseCurrency, date)
+	})
+}
+
+// Total returns the sum of the amounts converted to the base currency, each amount is converted with the rate of its date.
+// The rates of the period of the amounts are loaded at once
+func (e *ExchangeRateServiceObject) Total(userId uuid.UUID, amounts []model.Amount, baseCurrency string) (total money.Money, err error) {
+	var currencies []string
+	var from, to time.Time
+	added := make(map[string]bool)
+
+	for _, amount := range amounts {
+		if amount.Currency == baseCurrency {
+			continue
+		}
+		if !added[amount.Currency] {
+			added[amount.Currency] = true
+			currencies = append(currencies, amount.Currency)
+		}
+		if from.IsZero() || amount.Date.Before(from) {
+			from = amount.Date
+		}
+		if amount.Date.After(to) {
+			to = amount.Date
+		}
+	}
+
+	book := make(rateBook)
+
+	if len(currencies) > 0 {
+		rates, err := e.repository.FindRates(userId, currencies, baseCurrency, from, to)
+		if err != nil {
+			return 0, err
+		}
+		book = newRateBook(rates)
+	}
+
+	for _, amount := range amounts {
+		converted, err := convert(amount, baseCurrency, book.find)
+		if err != nil {
+			return 0, err
+		}
+		total += converted
+	}
+	return total, nil
+}
+
+// convert returns the sum in the base currency with the rate returned by the findRate, the rate of the reverse currency
+// pair is used if the direct one is missing
+func convert(amount model.Amount, baseCurrency string, findRate func(currency string, baseCurrency string, date time.Time) (model.ExchangeRate, error)) (money.Money, error) {
+	if amount.Currency == baseCurrency {
+		return amount.Sum, nil
+	}
+
+	if rate, err := findRate(amount.Currency, baseCurrency, amount.Date); err == nil {
+		return money.FromFloat(amount.Sum.Float64() * rate.Rate), nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, err
+	}
+
+	if rate, err := findRate(baseCurrency, amount.Currency, amount.Date); err == nil {
+		return money.FromFloat(amount.Sum.Float64() / rate.Rate), nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, err
+	}
+
+	return 0, interrors.NewErrNotFound("exchange rate of %s to %s on %s is not found", amount.Currency, baseCurrency, amount.Date.Format(model.DateFormat))
+}
+
+// rateBook keeps the loaded rates by the currency pair, the rates of the pair are sorted by the date
+type rateBook map[string][]model.ExchangeRate
+
+func newRateBook(rates []model.ExchangeRate) rateBook {
+	book := make(rateBook)
+	for _, rate := range rates {
+		key := pairKey(rate.Currency, rate.BaseCurrency)
+		book[key] = append(book[key], rate)
+	}
+	return book
+}
+
+// find returns the latest rate of the currency pair on or before the date, gorm.ErrRecordNotFound is returned if it is missing
+func (b rateBook) find(currency string, baseCurrency string, date time.Time) (model.ExchangeRate, error) {
+	rates := b[pairKey(currency, baseCurrency)]
+
+	index := sort.Search(len(rates), func(i int) bool { return rates[i].Date.After(date) })
+	if index == 0 {
+		return model.ExchangeRate{}, gorm.ErrRecordNotFound
+	}
+
+	return rates[index-1], nil
+}
+
+func pairKey(currency string, baseCurrency string) string {
+	return fmt.Sprintf("%s/%s", currency, baseCurrency)
+}
+
+func (e *ExchangeRateServiceObject) parse(record []string) (request model.CreateExchangeRateRequest, err error) {
+	date, err := time.Parse(model.DateFormat, strings.TrimSpace(record[0]))
+	if err != nil {
+		return request, errors.New(fmt.Sprintf("date %s is not valid, the valid format is %s", record[0], model.DateFormat))
+	}
+
+	rate, err := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+	if err != nil {
+		return request, errors.New(fmt.Sprintf("rate %s is not valid", record[3]))
+	}
+
+	return e.validate(model.CreateExchangeRateRequest{
+		Currency:     record[1],
+		BaseCurrency: record[2],
+		Date:         date,
+		Rate:         rate,
+	})
+}
+
+// validate normalizes the currency codes and the date of the rate
+func (e *ExchangeRateServiceObject) validate(request model.CreateExchangeRateRequest) (model.CreateExchangeRateRequest, error) {
+	request.Currency = strings.ToUpper(strings.TrimSpace(request.Currency))
+	request.BaseCurrency = strings.ToUpper(strings.TrimSpace(request.BaseCurrency))
+
+	if request.Date.IsZero() {
+		return request, errors.New("date is missing")
+	}
+	if request.Rate <= 0 {
+		return request, errors.New("rate should be positive")
+	}
+	if request.Currency == request.BaseCurrency {
+		return request, errors.New("currency and base currency should be different")
+	}
+	if _, err := e.countriesService.FindCurrencyByCode(request.Currency); err != nil {
+		return request, err
+	}
+	if _, err := e.countriesService.FindCurrencyByCode(request.BaseCurrency); err != nil {
+		return request, err
+	}
+
+	request.Date = time.Date(request.Date.Year(), request.Date.Month(), request.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+	return request, nil
+}
@@ -0,0 +1,360 @@
+package service
+
+import (
+	"errors"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/VlasovArtem/hob/src/exchange/mocks"
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"strings"
+	"testing"
+	"time"
+)
+
+type ExchangeRateServiceTestSuite struct {
+	testhelper.MockTestSuite[ExchangeRateService]
+	userService            *userMocks.UserService
+	exchangeRateRepository *mocks.ExchangeRateRepository
+}
+
+func TestExchangeRateServiceTestSuite(t *testing.T) {
+	ts := &ExchangeRateServiceTestSuite{}
+	ts.TestObjectGenerator = func() ExchangeRateService {
+		ts.userService = new(userMocks.UserService)
+		ts.exchangeRateRepository = new(mocks.ExchangeRateRepository)
+
+		return NewExchangeRateService(ts.userService, testhelper.InitCountryService(), ts.exchangeRateRepository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Add() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateExchangeRateRequest()
+	request.Currency = " eur"
+	request.Date = time.Date(2022, time.January, 1, 15, 30, 0, 0, time.UTC)
+
+	e.userService.On("ExistsById", userId).Return(true)
+	e.exchangeRateRepository.On("Save", mock.Anything).Return(
+		func(entities []model.ExchangeRate) []model.ExchangeRate {
+			return entities
+		}, nil)
+
+	actual, err := e.TestO.Add(userId, request)
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), model.ExchangeRateDto{
+		Id:           actual.Id,
+		UserId:       userId,
+		Currency:     "EUR",
+		BaseCurrency: "UAH",
+		Date:         time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Rate:         31.5,
+	}, actual)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Add_WithMissingUser() {
+	userId := uuid.New()
+
+	e.userService.On("ExistsById", userId).Return(false)
+
+	actual, err := e.TestO.Add(userId, mocks.GenerateCreateExchangeRateRequest())
+
+	assert.Equal(e.T(), interrors.NewErrNotFound("user with id %s not found", userId), err)
+	assert.Equal(e.T(), model.ExchangeRateDto{}, actual)
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "Save", mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Add_WithInvalidRequest() {
+	tests := []struct {
+		name     string
+		modifier func(request *model.CreateExchangeRateRequest)
+		expected error
+	}{
+		{"missing date", func(request *model.CreateExchangeRateRequest) { request.Date = time.Time{} }, errors.New("date is missing")},
+		{"zero rate", func(request *model.CreateExchangeRateRequest) { request.Rate = 0 }, errors.New("rate should be positive")},
+		{"same currencies", func(request *model.CreateExchangeRateRequest) { request.Currency = "uah" }, errors.New("currency and base currency should be different")},
+		{"not supported currency", func(request *model.CreateExchangeRateRequest) { request.Currency = "XXX" }, interrors.NewErrNotFound("currency with code XXX is not found")},
+		{"not supported base currency", func(request *model.CreateExchangeRateRequest) { request.BaseCurrency = "XXX" }, interrors.NewErrNotFound("currency with code XXX is not found")},
+	}
+
+	for _, test := range tests {
+		e.Run(test.name, func() {
+			userId := uuid.New()
+			request := mocks.GenerateCreateExchangeRateRequest()
+			test.modifier(&request)
+
+			e.userService.On("ExistsById", userId).Return(true)
+
+			actual, err := e.TestO.Add(userId, request)
+
+			assert.Equal(e.T(), test.expected, err)
+			assert.Equal(e.T(), model.ExchangeRateDto{}, actual)
+		})
+	}
+
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "Save", mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Import() {
+	userId := uuid.New()
+	content := "date,currency,base_currency,rate\n" +
+		"2022-01-01,EUR,UAH,31.5\n" +
+		"2022-01-02, usd, uah, 28.1\n" +
+		"2022-01-01,EUR,UAH,31.7\n"
+
+	var saved []model.ExchangeRate
+
+	e.userService.On("ExistsById", userId).Return(true)
+	e.exchangeRateRepository.On("Save", mock.Anything).Return(
+		func(entities []model.ExchangeRate) []model.ExchangeRate {
+			saved = entities
+			return entities
+		}, nil)
+
+	actual, err := e.TestO.Import(userId, strings.NewReader(content))
+
+	assert.Nil(e.T(), err)
+	assert.Len(e.T(), saved, 2)
+	assert.Equal(e.T(), []model.ExchangeRateDto{
+		{
+			Id:           saved[0].Id,
+			UserId:       userId,
+			Currency:     "EUR",
+			BaseCurrency: "UAH",
+			Date:         time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Rate:         31.7,
+		},
+		{
+			Id:           saved[1].Id,
+			UserId:       userId,
+			Currency:     "USD",
+			BaseCurrency: "UAH",
+			Date:         time.Date(2022, time.January, 2, 0, 0, 0, 0, time.UTC),
+			Rate:         28.1,
+		},
+	}, actual)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Import_WithInvalidLines() {
+	userId := uuid.New()
+	content := "01.01.2022,EUR,UAH,31.5\n" +
+		"2022-01-02,USD,UAH,rate\n" +
+		"2022-01-03,USD,UAH,28.1\n"
+
+	e.userService.On("ExistsById", userId).Return(true)
+
+	actual, err := e.TestO.Import(userId, strings.NewReader(content))
+
+	builder := interrors.NewBuilder()
+	builder.WithMessage("Import exchange rates failed")
+	builder.WithDetail("line 1: date 01.01.2022 is not valid, the valid format is 2006-01-02")
+	builder.WithDetail("line 2: rate rate is not valid")
+
+	expectedError := interrors.NewErrResponse(builder).(*interrors.ErrResponse)
+	actualError := err.(*interrors.ErrResponse)
+
+	assert.Equal(e.T(), *expectedError, *actualError)
+	assert.Nil(e.T(), actual)
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "Save", mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Import_WithWrongNumberOfColumns() {
+	userId := uuid.New()
+
+	e.userService.On("ExistsById", userId).Return(true)
+
+	actual, err := e.TestO.Import(userId, strings.NewReader("2022-01-01,EUR,31.5\n"))
+
+	assert.NotNil(e.T(), err)
+	assert.Nil(e.T(), actual)
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "Save", mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Import_WithEmptyContent() {
+	userId := uuid.New()
+
+	e.userService.On("ExistsById", userId).Return(true)
+
+	actual, err := e.TestO.Import(userId, strings.NewReader("date,currency,base_currency,rate\n"))
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), []model.ExchangeRateDto{}, actual)
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "Save", mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_FindByUserId() {
+	userId := uuid.New()
+	expected := []model.ExchangeRateDto{mocks.GenerateExchangeRate(userId).ToDto()}
+
+	e.exchangeRateRepository.On("FindByUserId", userId).Return(expected)
+
+	assert.Equal(e.T(), expected, e.TestO.FindByUserId(userId))
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_DeleteById() {
+	id, userId := uuid.New(), uuid.New()
+
+	e.exchangeRateRepository.On("ExistsByIdAndUserId", id, userId).Return(true)
+	e.exchangeRateRepository.On("DeleteById", id).Return(nil)
+
+	assert.Nil(e.T(), e.TestO.DeleteById(id, userId))
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_DeleteById_WithMissingRate() {
+	id, userId := uuid.New(), uuid.New()
+
+	e.exchangeRateRepository.On("ExistsByIdAndUserId", id, userId).Return(false)
+
+	err := e.TestO.DeleteById(id, userId)
+
+	assert.Equal(e.T(), interrors.NewErrNotFound("exchange rate with id %s not found", id), err)
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "DeleteById", mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Convert() {
+	userId := uuid.New()
+	amount := model.Amount{Sum: money.FromMinorUnits(1000), Currency: "EUR", Date: time.Now()}
+
+	e.exchangeRateRepository.On("FindRate", userId, "EUR", "UAH", amount.Date).Return(mocks.GenerateExchangeRate(userId), nil)
+
+	actual, err := e.TestO.Convert(userId, amount, "UAH")
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), money.FromMinorUnits(31500), actual)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Convert_WithSameCurrency() {
+	amount := model.Amount{Sum: money.FromMinorUnits(1000), Currency: "UAH", Date: time.Now()}
+
+	actual, err := e.TestO.Convert(uuid.New(), amount, "UAH")
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), amount.Sum, actual)
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "FindRate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Convert_WithReverseRate() {
+	userId := uuid.New()
+	amount := model.Amount{Sum: money.FromMinorUnits(63000), Currency: "UAH", Date: time.Now()}
+
+	e.exchangeRateRepository.On("FindRate", userId, "UAH", "EUR", amount.Date).Return(model.ExchangeRate{}, gorm.ErrRecordNotFound)
+	e.exchangeRateRepository.On("FindRate", userId, "EUR", "UAH", amount.Date).Return(mocks.GenerateExchangeRate(userId), nil)
+
+	actual, err := e.TestO.Convert(userId, amount, "EUR")
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), money.FromMinorUnits(2000), actual)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Convert_WithMissingRate() {
+	userId := uuid.New()
+	amount := model.Amount{Sum: money.FromMinorUnits(1000), Currency: "EUR", Date: time.Date(2022, time.January, 1, 10, 0, 0, 0, time.UTC)}
+
+	e.exchangeRateRepository.On("FindRate", userId, mock.Anything, mock.Anything, amount.Date).Return(model.ExchangeRate{}, gorm.ErrRecordNotFound)
+
+	actual, err := e.TestO.Convert(userId, amount, "UAH")
+
+	assert.Equal(e.T(), interrors.NewErrNotFound("exchange rate of EUR to UAH on 2022-01-01 is not found"), err)
+	assert.Equal(e.T(), money.Money(0), actual)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Convert_WithErrorFromRepository() {
+	userId := uuid.New()
+	amount := model.Amount{Sum: money.FromMinorUnits(1000), Currency: "EUR", Date: time.Now()}
+	expectedError := errors.New("error")
+
+	e.exchangeRateRepository.On("FindRate", userId, "EUR", "UAH", amount.Date).Return(model.ExchangeRate{}, expectedError)
+
+	actual, err := e.TestO.Convert(userId, amount, "UAH")
+
+	assert.Equal(e.T(), expectedError, err)
+	assert.Equal(e.T(), money.Money(0), actual)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Total() {
+	userId := uuid.New()
+	date := time.Now()
+	amounts := []model.Amount{
+		{Sum: money.FromMinorUnits(1000), Currency: "EUR", Date: date},
+		{Sum: money.FromMinorUnits(550), Currency: "UAH", Date: date},
+	}
+
+	e.exchangeRateRepository.On("FindRates", userId, []string{"EUR"}, "UAH", date, date).Return([]model.ExchangeRate{mocks.GenerateExchangeRate(userId)}, nil)
+
+	actual, err := e.TestO.Total(userId, amounts, "UAH")
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), money.FromMinorUnits(32050), actual)
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "FindRate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Total_WithRatesOfDates() {
+	userId := uuid.New()
+	first := time.Date(2022, time.January, 15, 10, 0, 0, 0, time.UTC)
+	second := time.Date(2022, time.February, 15, 10, 0, 0, 0, time.UTC)
+	amounts := []model.Amount{
+		{Sum: money.FromMinorUnits(1000), Currency: "EUR", Date: second},
+		{Sum: money.FromMinorUnits(1000), Currency: "EUR", Date: first},
+		{Sum: money.FromMinorUnits(4000), Currency: "USD", Date: first},
+	}
+
+	february := mocks.GenerateExchangeRate(userId)
+	february.Date = time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+	february.Rate = 30
+	reverse := mocks.GenerateExchangeRate(userId)
+	reverse.Currency, reverse.BaseCurrency, reverse.Rate = "UAH", "USD", 0.04
+
+	e.exchangeRateRepository.On("FindRates", userId, []string{"EUR", "USD"}, "UAH", first, second).
+		Return([]model.ExchangeRate{mocks.GenerateExchangeRate(userId), reverse, february}, nil)
+
+	actual, err := e.TestO.Total(userId, amounts, "UAH")
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), money.FromMinorUnits(30000+31500+100000), actual)
+	e.exchangeRateRepository.AssertNumberOfCalls(e.T(), "FindRates", 1)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Total_WithBaseCurrencyOnly() {
+	userId := uuid.New()
+	amounts := []model.Amount{{Sum: money.FromMinorUnits(550), Currency: "UAH", Date: time.Now()}}
+
+	actual, err := e.TestO.Total(userId, amounts, "UAH")
+
+	assert.Nil(e.T(), err)
+	assert.Equal(e.T(), money.FromMinorUnits(550), actual)
+	e.exchangeRateRepository.AssertNotCalled(e.T(), "FindRates", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Total_WithError() {
+	userId := uuid.New()
+	expectedError := errors.New("error")
+	amounts := []model.Amount{{Sum: money.FromMinorUnits(1000), Currency: "EUR", Date: time.Now()}}
+
+	e.exchangeRateRepository.On("FindRates", userId, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
+
+	actual, err := e.TestO.Total(userId, amounts, "UAH")
+
+	assert.Equal(e.T(), expectedError, err)
+	assert.Equal(e.T(), money.Money(0), actual)
+}
+
+func (e *ExchangeRateServiceTestSuite) Test_Total_WithMissingRate() {
+	userId := uuid.New()
+	amounts := []model.Amount{{Sum: money.FromMinorUnits(1000), Currency: "EUR", Date: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)}}
+
+	e.exchangeRateRepository.On("FindRates", userId, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.ExchangeRate{}, nil)
+
+	actual, err := e.TestO.Total(userId, amounts, "UAH")
+
+	assert.Equal(e.T(), interrors.NewErrNotFound("exchange rate of EUR to UAH on 2022-01-01 is not found"), err)
+	assert.Equal(e.T(), money.Money(0), actual)
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/common/rest"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	"github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/group/service"
 	"github.com/gorilla/mux"
@@ -26,10 +27,17 @@ func (g *GroupHandlerObject) Init(router *mux.Router) {
 
 	incomeRouter.Path("").HandlerFunc(g.Add()).Methods("POST")
 	incomeRouter.Path("/batch").HandlerFunc(g.AddBatch()).Methods("POST")
+	incomeRouter.Path("/invitations").HandlerFunc(g.FindInvitations()).Methods("GET")
 	incomeRouter.Path("/{id}").HandlerFunc(g.FindById()).Methods("GET")
 	incomeRouter.Path("/user/{id}").HandlerFunc(g.FindByUserId()).Methods("GET")
 	incomeRouter.Path("/{id}").HandlerFunc(g.Delete()).Methods("DELETE")
 	incomeRouter.Path("/{id}").HandlerFunc(g.Update()).Methods("PUT")
+	incomeRouter.Path("/{id}/members").HandlerFunc(g.FindMembers()).Methods("GET")
+	incomeRouter.Path("/{id}/members").HandlerFunc(g.Invite()).Methods("POST")
+	incomeRouter.Path("/{id}/members/{memberId}").HandlerFunc(g.UpdateMember()).Methods("PUT")
+	incomeRouter.Path("/{id}/members/{memberId}").HandlerFunc(g.RemoveMember()).Methods("DELETE")
+	incomeRouter.Path("/{id}/accept").HandlerFunc(g.Accept()).Methods("POST")
+	incomeRouter.Path("/{id}/decline").HandlerFunc(g.Decline()).Methods("POST")
 }
 
 type GroupHandler interface {
@@ -39,13 +47,28 @@ type GroupHandler interface {
 	FindByUserId() http.HandlerFunc
 	Update() http.HandlerFunc
 	Delete() http.HandlerFunc
+	Invite() http.HandlerFunc
+	Accept() http.HandlerFunc
+	Decline() http.HandlerFunc
+	FindMembers() http.HandlerFunc
+	FindInvitations() http.HandlerFunc
+	UpdateMember() http.HandlerFunc
+	RemoveMember() http.HandlerFunc
 }
 
 func (g *GroupHandlerObject) Add() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreateGroupRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			body.OwnerId = userId
+
 			rest.NewAPIResponse(writer).
 				Created(g.groupService.Add(body)).
 				Perform()
@@ -55,9 +78,19 @@ func (g *GroupHandlerObject) Add() http.HandlerFunc {
 
 func (g *GroupHandlerObject) AddBatch() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreateGroupBatchRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			for i := range body.Groups {
+				body.Groups[i].OwnerId = userId
+			}
+
 			rest.NewAPIResponse(writer).
 				Created(g.groupService.AddBatch(body)).
 				Perform()
@@ -67,11 +100,11 @@ func (g *GroupHandlerObject) AddBatch() http.HandlerFunc {
 
 func (g *GroupHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(g.groupService.FindById(id)).
+				Ok(g.groupService.FindById(id, userId)).
 				Perform()
 		}
 	}
@@ -79,7 +112,7 @@ func (g *GroupHandlerObject) FindById() http.HandlerFunc {
 
 func (g *GroupHandlerObject) FindByUserId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, err := rest.GetUserIdRequestParameter(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
@@ -91,14 +124,14 @@ func (g *GroupHandlerObject) FindByUserId() http.HandlerFunc {
 
 func (g *GroupHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdateGroupRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
 				rest.NewAPIResponse(writer).
-					Error(g.groupService.Update(id, body)).
+					Error(g.groupService.Update(id, userId, body)).
 					Perform()
 			}
 		}
@@ -107,12 +140,120 @@ func (g *GroupHandlerObject) Update() http.HandlerFunc {
 
 func (g *GroupHandlerObject) Delete() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				StatusCode(http.StatusNoContent).
+				Error(g.groupService.DeleteById(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (g *GroupHandlerObject) Invite() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			if body, err := rest.ReadRequestBody[memberModel.InviteMemberRequest](request); err != nil {
+				rest.HandleWithError(writer, err)
+			} else {
+				rest.NewAPIResponse(writer).
+					Created(g.groupService.Invite(id, userId, body)).
+					Perform()
+			}
+		}
+	}
+}
+
+func (g *GroupHandlerObject) Accept() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Error(g.groupService.Accept(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (g *GroupHandlerObject) Decline() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				StatusCode(http.StatusNoContent).
+				Error(g.groupService.Decline(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (g *GroupHandlerObject) FindMembers() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(g.groupService.FindMembers(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (g *GroupHandlerObject) FindInvitations() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if userId, err := rest.GetUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Body(g.groupService.FindInvitations(userId)).
+				Perform()
+		}
+	}
+}
+
+func (g *GroupHandlerObject) UpdateMember() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		id, userId, err := rest.GetIdRequestParameterAndUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		memberId, err := rest.GetUUIDRequestParameter(request, "memberId")
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		if body, err := rest.ReadRequestBody[memberModel.UpdateMemberRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Error(g.groupService.UpdateMember(id, userId, memberId, body)).
+				Perform()
+		}
+	}
+}
+
+func (g *GroupHandlerObject) RemoveMember() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		id, userId, err := rest.GetIdRequestParameterAndUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		if memberId, err := rest.GetUUIDRequestParameter(request, "memberId"); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
 				StatusCode(http.StatusNoContent).
-				Error(g.groupService.DeleteById(id)).
+				Error(g.groupService.RemoveMember(id, userId, memberId)).
 				Perform()
 		}
 	}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"github.com/VlasovArtem/hob/src/common"
 	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	memberMocks "github.com/VlasovArtem/hob/src/group/member/mocks"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	"github.com/VlasovArtem/hob/src/group/mocks"
 	"github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
@@ -36,6 +38,7 @@ func (g *GroupHandlerTestSuite) Test_Add_WithNotValidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/group").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(g.TestO.Add())
 
 	testRequest.Verify(g.T(), http.StatusBadRequest)
@@ -50,6 +53,7 @@ func (g *GroupHandlerTestSuite) Test_Add() {
 		WithURL("https://test.com/api/v1/group").
 		WithMethod("POST").
 		WithBody(request).
+		WithUser(request.OwnerId).
 		WithHandler(g.TestO.Add())
 
 	body := testRequest.Verify(g.T(), http.StatusCreated)
@@ -75,6 +79,7 @@ func (g *GroupHandlerTestSuite) Test_Add_WithErrorFromService() {
 		WithURL("https://test.com/api/v1/group").
 		WithMethod("POST").
 		WithBody(request).
+		WithUser(request.OwnerId).
 		WithHandler(g.TestO.Add())
 
 	actual := testRequest.Verify(g.T(), http.StatusBadRequest)
@@ -84,6 +89,7 @@ func (g *GroupHandlerTestSuite) Test_Add_WithErrorFromService() {
 
 func (g *GroupHandlerTestSuite) Test_AddBatch() {
 	request := mocks.GenerateCreateGroupBatchRequest(2)
+	request.Groups[1].OwnerId = request.Groups[0].OwnerId
 
 	addBatchResponse := common.MapSlice(request.Groups, func(r model.CreateGroupRequest) model.GroupDto {
 		return r.ToEntity().ToDto()
@@ -95,6 +101,7 @@ func (g *GroupHandlerTestSuite) Test_AddBatch() {
 		WithURL("https://test.com/api/v1/group/batch").
 		WithMethod("POST").
 		WithBody(request).
+		WithUser(request.Groups[0].OwnerId).
 		WithHandler(g.TestO.AddBatch())
 
 	body := testRequest.Verify(g.T(), http.StatusCreated)
@@ -120,6 +127,7 @@ func (g *GroupHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
 		WithURL("https://test.com/api/v1/group/batch").
 		WithMethod("POST").
 		WithBody(request).
+		WithUser(uuid.New()).
 		WithHandler(g.TestO.AddBatch())
 
 	actual := testRequest.Verify(g.T(), http.StatusBadRequest)
@@ -131,13 +139,15 @@ func (g *GroupHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
 }
 
 func (g *GroupHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
 	groupDto := mocks.GenerateGroupDto()
 
-	g.groupService.On("FindById", groupDto.Id).Return(groupDto, nil)
+	g.groupService.On("FindById", groupDto.Id, userId).Return(groupDto, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/group/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(g.TestO.FindById()).
 		WithVar("id", groupDto.Id.String())
 
@@ -150,6 +160,8 @@ func (g *GroupHandlerTestSuite) Test_FindById() {
 }
 
 func (g *GroupHandlerTestSuite) Test_FindById_WithErrorFromService() {
+	userId := uuid.New()
+
 	tests := []struct {
 		err        error
 		statusCode int
@@ -169,11 +181,12 @@ func (g *GroupHandlerTestSuite) Test_FindById_WithErrorFromService() {
 
 		id := uuid.New()
 
-		g.groupService.On("FindById", id).Return(model.GroupDto{}, test.err)
+		g.groupService.On("FindById", id, userId).Return(model.GroupDto{}, test.err)
 
 		testRequest := testhelper.NewTestRequest().
 			WithURL("https://test.com/api/v1/group/{id}").
 			WithMethod("GET").
+			WithUser(userId).
 			WithHandler(g.TestO.FindById()).
 			WithVar("id", id.String())
 
@@ -187,6 +200,7 @@ func (g *GroupHandlerTestSuite) Test_FindById_WithInvalidId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/group/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(g.TestO.FindById()).
 		WithVar("id", "id")
 
@@ -199,6 +213,7 @@ func (g *GroupHandlerTestSuite) Test_FindById_WithMissingId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/group/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(g.TestO.FindById())
 
 	body := testRequest.Verify(g.T(), http.StatusBadRequest)
@@ -215,6 +230,7 @@ func (g *GroupHandlerTestSuite) Test_FindByUserId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/group/user/{id}").
 		WithMethod("GET").
+		WithUser(groupDto.Id).
 		WithHandler(g.TestO.FindByUserId()).
 		WithVar("id", groupDto.Id.String())
 
@@ -234,6 +250,7 @@ func (g *GroupHandlerTestSuite) Test_FindByUserId_WithEmptyResponse() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/group/user/{id}").
 		WithMethod("GET").
+		WithUser(id).
 		WithHandler(g.TestO.FindByUserId()).
 		WithVar("id", id.String())
 
@@ -249,6 +266,7 @@ func (g *GroupHandlerTestSuite) Test_FindByUserId_WithInvalidId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/group/user/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(g.TestO.FindByUserId()).
 		WithVar("id", "id")
 
@@ -261,9 +279,174 @@ func (g *GroupHandlerTestSuite) Test_FindByUserId_WithMissingId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/group/user/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(g.TestO.FindByUserId())
 
 	body := testRequest.Verify(g.T(), http.StatusBadRequest)
 
 	assert.Equal(g.T(), "parameter 'id' not found\n", string(body))
 }
+
+func (g *GroupHandlerTestSuite) Test_Invite() {
+	id, userId := uuid.New(), uuid.New()
+	request := memberMocks.GenerateInviteMemberRequest()
+	member := memberModel.MemberDto{GroupId: id, UserId: uuid.New(), Email: request.Email, Role: request.Role, InvitedBy: userId}
+
+	g.groupService.On("Invite", id, userId, request).Return(member, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/{id}/members").
+		WithMethod("POST").
+		WithBody(request).
+		WithUser(userId).
+		WithHandler(g.TestO.Invite()).
+		WithVar("id", id.String())
+
+	body := testRequest.Verify(g.T(), http.StatusCreated)
+
+	var actual memberModel.MemberDto
+	json.Unmarshal(body, &actual)
+
+	assert.Equal(g.T(), member, actual)
+}
+
+func (g *GroupHandlerTestSuite) Test_Invite_WithErrorFromService() {
+	id, userId := uuid.New(), uuid.New()
+	request := memberMocks.GenerateInviteMemberRequest()
+
+	g.groupService.On("Invite", id, userId, request).Return(memberModel.MemberDto{}, interrors.NewErrNotFound("group with id %s not found", id))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/{id}/members").
+		WithMethod("POST").
+		WithBody(request).
+		WithUser(userId).
+		WithHandler(g.TestO.Invite()).
+		WithVar("id", id.String())
+
+	body := testRequest.Verify(g.T(), http.StatusNotFound)
+
+	assert.Equal(g.T(), fmt.Sprintf("group with id %s not found\n", id), string(body))
+}
+
+func (g *GroupHandlerTestSuite) Test_Accept() {
+	id, userId := uuid.New(), uuid.New()
+
+	g.groupService.On("Accept", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/{id}/accept").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(g.TestO.Accept()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(g.T(), http.StatusOK)
+}
+
+func (g *GroupHandlerTestSuite) Test_Decline() {
+	id, userId := uuid.New(), uuid.New()
+
+	g.groupService.On("Decline", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/{id}/decline").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(g.TestO.Decline()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(g.T(), http.StatusNoContent)
+}
+
+func (g *GroupHandlerTestSuite) Test_FindMembers() {
+	id, userId := uuid.New(), uuid.New()
+	members := []memberModel.MemberDto{memberMocks.GenerateMember(id, uuid.New(), memberModel.Viewer).ToDto()}
+
+	g.groupService.On("FindMembers", id, userId).Return(members, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/{id}/members").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(g.TestO.FindMembers()).
+		WithVar("id", id.String())
+
+	body := testRequest.Verify(g.T(), http.StatusOK)
+
+	var actual []memberModel.MemberDto
+	json.Unmarshal(body, &actual)
+
+	assert.Equal(g.T(), members, actual)
+}
+
+func (g *GroupHandlerTestSuite) Test_FindInvitations() {
+	userId := uuid.New()
+	invitations := []memberModel.MemberDto{{GroupId: uuid.New(), UserId: userId, Role: memberModel.Editor}}
+
+	g.groupService.On("FindInvitations", userId).Return(invitations)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/invitations").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(g.TestO.FindInvitations())
+
+	body := testRequest.Verify(g.T(), http.StatusOK)
+
+	var actual []memberModel.MemberDto
+	json.Unmarshal(body, &actual)
+
+	assert.Equal(g.T(), invitations, actual)
+}
+
+func (g *GroupHandlerTestSuite) Test_UpdateMember() {
+	id, userId, memberId := uuid.New(), uuid.New(), uuid.New()
+	request := memberModel.UpdateMemberRequest{Role: memberModel.Viewer}
+
+	g.groupService.On("UpdateMember", id, userId, memberId, request).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/{id}/members/{memberId}").
+		WithMethod("PUT").
+		WithBody(request).
+		WithUser(userId).
+		WithHandler(g.TestO.UpdateMember()).
+		WithVar("id", id.String()).
+		WithVar("memberId", memberId.String())
+
+	testRequest.Verify(g.T(), http.StatusOK)
+}
+
+func (g *GroupHandlerTestSuite) Test_UpdateMember_WithInvalidMemberId() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/{id}/members/{memberId}").
+		WithMethod("PUT").
+		WithBody(memberModel.UpdateMemberRequest{Role: memberModel.Viewer}).
+		WithUser(uuid.New()).
+		WithHandler(g.TestO.UpdateMember()).
+		WithVar("id", uuid.New().String()).
+		WithVar("memberId", "id")
+
+	body := testRequest.Verify(g.T(), http.StatusBadRequest)
+
+	assert.Equal(g.T(), "the memberId is not valid id\n", string(body))
+
+	g.groupService.AssertNotCalled(g.T(), "UpdateMember", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (g *GroupHandlerTestSuite) Test_RemoveMember() {
+	id, userId, memberId := uuid.New(), uuid.New(), uuid.New()
+
+	g.groupService.On("RemoveMember", id, userId, memberId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/groups/{id}/members/{memberId}").
+		WithMethod("DELETE").
+		WithUser(userId).
+		WithHandler(g.TestO.RemoveMember()).
+		WithVar("id", id.String()).
+		WithVar("memberId", memberId.String())
+
+	testRequest.Verify(g.T(), http.StatusNoContent)
+}
@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/group/member/model"
+	"github.com/google/uuid"
+)
+
+func GenerateMember(groupId uuid.UUID, userId uuid.UUID, role model.Role) model.Member {
+	return model.Member{
+		GroupId:   groupId,
+		UserId:    userId,
+		Role:      role,
+		Accepted:  true,
+		InvitedBy: uuid.New(),
+	}
+}
+
+func GenerateInviteMemberRequest() model.InviteMemberRequest {
+	return model.InviteMemberRequest{
+		Email: "member@mail.com",
+		Role:  model.Editor,
+	}
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"fmt"
+	groupModel "github.com/VlasovArtem/hob/src/group/model"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+)
+
+type Role string
+
+const (
+	// Owner manages the group members and modifies the shared data
+	Owner Role = "OWNER"
+	// Editor modifies the shared data
+	Editor Role = "EDITOR"
+	// Viewer only reads the shared data
+	Viewer Role = "VIEWER"
+)
+
+// ReadRoles are the roles that give access to the data shared with the group
+var ReadRoles = []Role{Owner, Editor, Viewer}
+
+// ModifyRoles are the roles that allow to change the data shared with the group
+var ModifyRoles = []Role{Owner, Editor}
+
+// ManageRoles are the roles that allow to manage the group and its members
+var ManageRoles = []Role{Owner}
+
+func (r Role) Validate() error {
+	switch r {
+	case Owner, Editor, Viewer:
+		return nil
+	default:
+		return fmt.Errorf("role %s is not supported", r)
+	}
+}
+
+// Member is the user that is invited to the group, the group data is shared with the member only after the invitation is accepted
+type Member struct {
+	GroupId   uuid.UUID `gorm:"primarykey"`
+	UserId    uuid.UUID `gorm:"primarykey"`
+	Role      Role
+	Accepted  bool
+	InvitedBy uuid.UUID
+	Group     groupModel.Group `gorm:"foreignKey:GroupId;constraint:OnDelete:CASCADE"`
+	User      userModel.User   `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+func (Member) TableName() string {
+	return "group_members"
+}
+
+type MemberDto struct {
+	GroupId   uuid.UUID
+	UserId    uuid.UUID
+	Email     string
+	Role      Role
+	Accepted  bool
+	InvitedBy uuid.UUID
+}
+
+type InviteMemberRequest struct {
+	Email string
+	Role  Role
+}
+
+type UpdateMemberRequest struct {
+	Role Role
+}
+
+func (m Member) ToDto() MemberDto {
+	return MemberDto{
+		GroupId:   m.GroupId,
+		UserId:    m.UserId,
+		Email:     m.User.Email,
+		Role:      m.Role,
+		Accepted:  m.Accepted,
+		InvitedBy: m.InvitedBy,
+	}
+}
+
+func MemberToMemberDto(member Member) MemberDto {
+	return member.ToDto()
+}
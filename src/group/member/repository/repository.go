@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/group/member/model"
+	"github.com/google/uuid"
+)
+
+var entity = model.Member{}
+
+type GroupMemberRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewGroupMemberRepository(database db.DatabaseService) GroupMemberRepository {
+	return &GroupMemberRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (g *GroupMemberRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewGroupMemberRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (g *GroupMemberRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type GroupMemberRepository interface {
+	Create(entity model.Member) (model.Member, error)
+	Find(groupId uuid.UUID, userId uuid.UUID) (model.Member, error)
+	FindByGroupId(groupId uuid.UUID) []model.MemberDto
+	FindInvitationsByUserId(userId uuid.UUID) []model.MemberDto
+	Exists(groupId uuid.UUID, userId uuid.UUID) bool
+	Accept(groupId uuid.UUID, userId uuid.UUID) error
+	UpdateRole(groupId uuid.UUID, userId uuid.UUID, role model.Role) error
+	Delete(groupId uuid.UUID, userId uuid.UUID) error
+}
+
+func (g *GroupMemberRepositoryObject) Create(entity model.Member) (model.Member, error) {
+	return entity, g.database.Create(&entity)
+}
+
+func (g *GroupMemberRepositoryObject) Find(groupId uuid.UUID, userId uuid.UUID) (response model.Member, err error) {
+	return response, g.database.FirstBy(&response, "group_id = ? AND user_id = ?", groupId, userId)
+}
+
+func (g *GroupMemberRepositoryObject) FindByGroupId(groupId uuid.UUID) []model.MemberDto {
+	return g.findBy("group_id = ?", groupId)
+}
+
+// FindInvitationsByUserId returns the invitations that are not accepted by the user yet
+func (g *GroupMemberRepositoryObject) FindInvitationsByUserId(userId uuid.UUID) []model.MemberDto {
+	return g.findBy("user_id = ? AND accepted = ?", userId, false)
+}
+
+func (g *GroupMemberRepositoryObject) Exists(groupId uuid.UUID, userId uuid.UUID) bool {
+	return g.database.ExistsBy("group_id = ? AND user_id = ?", groupId, userId)
+}
+
+func (g *GroupMemberRepositoryObject) Accept(groupId uuid.UUID, userId uuid.UUID) error {
+	return g.database.Modeled().
+		Where("group_id = ? AND user_id = ?", groupId, userId).
+		Update("accepted", true).
+		Error
+}
+
+func (g *GroupMemberRepositoryObject) UpdateRole(groupId uuid.UUID, userId uuid.UUID, role model.Role) error {
+	return g.database.Modeled().
+		Where("group_id = ? AND user_id = ?", groupId, userId).
+		Update("role", role).
+		Error
+}
+
+func (g *GroupMemberRepositoryObject) Delete(groupId uuid.UUID, userId uuid.UUID) error {
+	return g.database.D().
+		Where("group_id = ? AND user_id = ?", groupId, userId).
+		Delete(&model.Member{}).
+		Error
+}
+
+func (g *GroupMemberRepositoryObject) findBy(query any, args ...any) []model.MemberDto {
+	var members []model.Member
+
+	if err := g.database.Modeled().Preload("User").Where(query, args...).Find(&members).Error; err != nil {
+		return []model.MemberDto{}
+	}
+
+	return common.MapSlice(members, model.MemberToMemberDto)
+}
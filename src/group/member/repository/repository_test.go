@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/group/member/mocks"
+	"github.com/VlasovArtem/hob/src/group/member/model"
+	groupMocks "github.com/VlasovArtem/hob/src/group/mocks"
+	groupModel "github.com/VlasovArtem/hob/src/group/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type GroupMemberRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository   GroupMemberRepository
+	createdUser  userModel.User
+	createdGroup groupModel.Group
+}
+
+func (g *GroupMemberRepositoryTestSuite) SetupSuite() {
+	g.InitDBTestSuite()
+
+	g.CreateRepository(
+		func(service db.DatabaseService) {
+			g.repository = NewGroupMemberRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, model.Member{})
+		}).
+		AddAfterSuite(func(service db.DatabaseService) {
+			database.TruncateTable(service, groupModel.Group{})
+			database.TruncateTable(service, userModel.User{})
+		}).
+		ExecuteMigration(userModel.User{}, groupModel.Group{}, model.Member{})
+
+	g.createdUser = userMocks.GenerateUser()
+	g.CreateEntity(&g.createdUser)
+
+	g.createdGroup = groupMocks.GenerateGroup(g.createdUser.Id)
+	g.CreateEntity(&g.createdGroup)
+}
+
+func TestGroupMemberRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupMemberRepositoryTestSuite))
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_Create() {
+	member := mocks.GenerateMember(g.createdGroup.Id, g.createdUser.Id, model.Editor)
+
+	actual, err := g.repository.Create(member)
+
+	assert.Nil(g.T(), err)
+	assert.Equal(g.T(), member, actual)
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_Find() {
+	member := g.createMember(true)
+
+	actual, err := g.repository.Find(member.GroupId, member.UserId)
+
+	assert.Nil(g.T(), err)
+	assert.Equal(g.T(), member.Role, actual.Role)
+	assert.True(g.T(), actual.Accepted)
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_Find_WithMissingMember() {
+	_, err := g.repository.Find(g.createdGroup.Id, uuid.New())
+
+	assert.ErrorIs(g.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_FindByGroupId() {
+	member := g.createMember(true)
+
+	actual := g.repository.FindByGroupId(member.GroupId)
+
+	expected := member.ToDto()
+	expected.Email = g.createdUser.Email
+
+	assert.Equal(g.T(), []model.MemberDto{expected}, actual)
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_FindInvitationsByUserId() {
+	member := g.createMember(false)
+
+	actual := g.repository.FindInvitationsByUserId(member.UserId)
+
+	expected := member.ToDto()
+	expected.Email = g.createdUser.Email
+
+	assert.Equal(g.T(), []model.MemberDto{expected}, actual)
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_FindInvitationsByUserId_WithAcceptedInvitation() {
+	member := g.createMember(true)
+
+	assert.Equal(g.T(), []model.MemberDto{}, g.repository.FindInvitationsByUserId(member.UserId))
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_Exists() {
+	member := g.createMember(false)
+
+	assert.True(g.T(), g.repository.Exists(member.GroupId, member.UserId))
+	assert.False(g.T(), g.repository.Exists(member.GroupId, uuid.New()))
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_Accept() {
+	member := g.createMember(false)
+
+	assert.Nil(g.T(), g.repository.Accept(member.GroupId, member.UserId))
+
+	actual, err := g.repository.Find(member.GroupId, member.UserId)
+
+	assert.Nil(g.T(), err)
+	assert.True(g.T(), actual.Accepted)
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_UpdateRole() {
+	member := g.createMember(true)
+
+	assert.Nil(g.T(), g.repository.UpdateRole(member.GroupId, member.UserId, model.Owner))
+
+	actual, err := g.repository.Find(member.GroupId, member.UserId)
+
+	assert.Nil(g.T(), err)
+	assert.Equal(g.T(), model.Owner, actual.Role)
+}
+
+func (g *GroupMemberRepositoryTestSuite) Test_Delete() {
+	member := g.createMember(true)
+
+	assert.Nil(g.T(), g.repository.Delete(member.GroupId, member.UserId))
+	assert.False(g.T(), g.repository.Exists(member.GroupId, member.UserId))
+}
+
+func (g *GroupMemberRepositoryTestSuite) createMember(accepted bool) model.Member {
+	member := mocks.GenerateMember(g.createdGroup.Id, g.createdUser.Id, model.Viewer)
+	member.Accepted = accepted
+
+	g.CreateEntity(&member)
+
+	return member
+}
@@ -13,6 +13,20 @@ type GroupHandler struct {
 	mock.Mock
 }
 
+// Accept provides a mock function with given fields:
+func (_m *GroupHandler) Accept() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
 // Add provides a mock function with given fields:
 func (_m *GroupHandler) Add() http.HandlerFunc {
 	ret := _m.Called()
@@ -29,6 +43,20 @@ func (_m *GroupHandler) Add() http.HandlerFunc {
 	return r0
 }
 
+// Decline provides a mock function with given fields:
+func (_m *GroupHandler) Decline() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields:
 func (_m *GroupHandler) Delete() http.HandlerFunc {
 	ret := _m.Called()
@@ -77,6 +105,62 @@ func (_m *GroupHandler) FindByUserId() http.HandlerFunc {
 	return r0
 }
 
+// FindInvitations provides a mock function with given fields:
+func (_m *GroupHandler) FindInvitations() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// FindMembers provides a mock function with given fields:
+func (_m *GroupHandler) FindMembers() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// Invite provides a mock function with given fields:
+func (_m *GroupHandler) Invite() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// RemoveMember provides a mock function with given fields:
+func (_m *GroupHandler) RemoveMember() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields:
 func (_m *GroupHandler) Update() http.HandlerFunc {
 	ret := _m.Called()
@@ -92,3 +176,17 @@ func (_m *GroupHandler) Update() http.HandlerFunc {
 
 	return r0
 }
+
+// UpdateMember provides a mock function with given fields:
+func (_m *GroupHandler) UpdateMember() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	model "github.com/VlasovArtem/hob/src/group/model"
 	mock "github.com/stretchr/testify/mock"
 
@@ -100,6 +101,34 @@ func (_m *GroupRepository) ExistsByIds(ids []uuid.UUID) bool {
 	return r0
 }
 
+// ExistsByIdsAndMemberId provides a mock function with given fields: ids, userId, roles
+func (_m *GroupRepository) ExistsByIdsAndMemberId(ids []uuid.UUID, userId uuid.UUID, roles []memberModel.Role) bool {
+	ret := _m.Called(ids, userId, roles)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, uuid.UUID, []memberModel.Role) bool); ok {
+		r0 = rf(ids, userId, roles)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ExistsByIdsAndOwnerId provides a mock function with given fields: ids, ownerId
+func (_m *GroupRepository) ExistsByIdsAndOwnerId(ids []uuid.UUID, ownerId uuid.UUID) bool {
+	ret := _m.Called(ids, ownerId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ids, ownerId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // FindById provides a mock function with given fields: id
 func (_m *GroupRepository) FindById(id uuid.UUID) (model.GroupDto, error) {
 	ret := _m.Called(id)
@@ -137,6 +166,22 @@ func (_m *GroupRepository) FindByOwnerId(ownerId uuid.UUID) []model.GroupDto {
 	return r0
 }
 
+// FindByUserId provides a mock function with given fields: userId
+func (_m *GroupRepository) FindByUserId(userId uuid.UUID) []model.GroupDto {
+	ret := _m.Called(userId)
+
+	var r0 []model.GroupDto
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []model.GroupDto); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.GroupDto)
+		}
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: id, request
 func (_m *GroupRepository) Update(id uuid.UUID, request model.UpdateGroupRequest) error {
 	ret := _m.Called(id, request)
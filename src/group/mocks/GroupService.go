@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	model "github.com/VlasovArtem/hob/src/group/model"
 	mock "github.com/stretchr/testify/mock"
 
@@ -14,6 +15,20 @@ type GroupService struct {
 	mock.Mock
 }
 
+// Accept provides a mock function with given fields: id, userId
+func (_m *GroupService) Accept(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Add provides a mock function with given fields: request
 func (_m *GroupService) Add(request model.CreateGroupRequest) (model.GroupDto, error) {
 	ret := _m.Called(request)
@@ -58,13 +73,41 @@ func (_m *GroupService) AddBatch(request model.CreateGroupBatchRequest) ([]model
 	return r0, r1
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *GroupService) DeleteById(id uuid.UUID) error {
-	ret := _m.Called(id)
+// CanModify provides a mock function with given fields: ids, userId
+func (_m *GroupService) CanModify(ids []uuid.UUID, userId uuid.UUID) bool {
+	ret := _m.Called(ids, userId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ids, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Decline provides a mock function with given fields: id, userId
+func (_m *GroupService) Decline(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteById provides a mock function with given fields: id, userId
+func (_m *GroupService) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -100,20 +143,34 @@ func (_m *GroupService) ExistsByIds(ids []uuid.UUID) bool {
 	return r0
 }
 
-// FindById provides a mock function with given fields: id
-func (_m *GroupService) FindById(id uuid.UUID) (model.GroupDto, error) {
-	ret := _m.Called(id)
+// ExistsByIdsAndUserId provides a mock function with given fields: ids, userId
+func (_m *GroupService) ExistsByIdsAndUserId(ids []uuid.UUID, userId uuid.UUID) bool {
+	ret := _m.Called(ids, userId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ids, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FindById provides a mock function with given fields: id, userId
+func (_m *GroupService) FindById(id uuid.UUID, userId uuid.UUID) (model.GroupDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.GroupDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.GroupDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.GroupDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.GroupDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -137,13 +194,101 @@ func (_m *GroupService) FindByUserId(userId uuid.UUID) []model.GroupDto {
 	return r0
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *GroupService) Update(id uuid.UUID, request model.UpdateGroupRequest) error {
-	ret := _m.Called(id, request)
+// FindInvitations provides a mock function with given fields: userId
+func (_m *GroupService) FindInvitations(userId uuid.UUID) []memberModel.MemberDto {
+	ret := _m.Called(userId)
+
+	var r0 []memberModel.MemberDto
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []memberModel.MemberDto); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]memberModel.MemberDto)
+		}
+	}
+
+	return r0
+}
+
+// FindMembers provides a mock function with given fields: id, userId
+func (_m *GroupService) FindMembers(id uuid.UUID, userId uuid.UUID) ([]memberModel.MemberDto, error) {
+	ret := _m.Called(id, userId)
+
+	var r0 []memberModel.MemberDto
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) []memberModel.MemberDto); ok {
+		r0 = rf(id, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]memberModel.MemberDto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Invite provides a mock function with given fields: id, userId, request
+func (_m *GroupService) Invite(id uuid.UUID, userId uuid.UUID, request memberModel.InviteMemberRequest) (memberModel.MemberDto, error) {
+	ret := _m.Called(id, userId, request)
+
+	var r0 memberModel.MemberDto
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, memberModel.InviteMemberRequest) memberModel.MemberDto); ok {
+		r0 = rf(id, userId, request)
+	} else {
+		r0 = ret.Get(0).(memberModel.MemberDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, memberModel.InviteMemberRequest) error); ok {
+		r1 = rf(id, userId, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveMember provides a mock function with given fields: id, userId, memberId
+func (_m *GroupService) RemoveMember(id uuid.UUID, userId uuid.UUID, memberId uuid.UUID) error {
+	ret := _m.Called(id, userId, memberId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId, memberId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: id, userId, request
+func (_m *GroupService) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateGroupRequest) error {
+	ret := _m.Called(id, userId, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, model.UpdateGroupRequest) error); ok {
+		r0 = rf(id, userId, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateMember provides a mock function with given fields: id, userId, memberId, request
+func (_m *GroupService) UpdateMember(id uuid.UUID, userId uuid.UUID, memberId uuid.UUID, request memberModel.UpdateMemberRequest) error {
+	ret := _m.Called(id, userId, memberId, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdateGroupRequest) error); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, uuid.UUID, memberModel.UpdateMemberRequest) error); ok {
+		r0 = rf(id, userId, memberId, request)
 	} else {
 		r0 = ret.Error(0)
 	}
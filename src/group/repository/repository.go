@@ -3,6 +3,7 @@ package repository
 import (
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/db"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	"github.com/VlasovArtem/hob/src/group/model"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -36,8 +37,11 @@ type GroupRepository interface {
 	CreateBatch(entities []model.Group) ([]model.Group, error)
 	FindById(id uuid.UUID) (model.GroupDto, error)
 	FindByOwnerId(ownerId uuid.UUID) (response []model.GroupDto)
+	FindByUserId(userId uuid.UUID) (response []model.GroupDto)
 	ExistsById(id uuid.UUID) bool
 	ExistsByIds(ids []uuid.UUID) bool
+	ExistsByIdsAndOwnerId(ids []uuid.UUID, ownerId uuid.UUID) bool
+	ExistsByIdsAndMemberId(ids []uuid.UUID, userId uuid.UUID, roles []memberModel.Role) bool
 	DeleteById(id uuid.UUID) error
 	Update(id uuid.UUID, request model.UpdateGroupRequest) error
 }
@@ -64,6 +68,21 @@ func (g *GroupRepositoryObject) FindByOwnerId(ownerId uuid.UUID) (response []mod
 	return response
 }
 
+// FindByUserId returns the groups owned by the user and the groups where the user accepted the invitation
+func (g *GroupRepositoryObject) FindByUserId(userId uuid.UUID) (response []model.GroupDto) {
+	err := g.database.FindBy(
+		&response,
+		"owner_id = ? OR id IN (SELECT group_id FROM group_members WHERE user_id = ? AND accepted)",
+		userId, userId,
+	)
+
+	if err != nil {
+		return []model.GroupDto{}
+	}
+
+	return response
+}
+
 func (g *GroupRepositoryObject) ExistsById(id uuid.UUID) bool {
 	return g.database.Exists(id)
 }
@@ -79,6 +98,34 @@ func (g *GroupRepositoryObject) ExistsByIds(ids []uuid.UUID) bool {
 	return int64(len(ids)) == count
 }
 
+func (g *GroupRepositoryObject) ExistsByIdsAndOwnerId(ids []uuid.UUID, ownerId uuid.UUID) bool {
+	var count int64
+	err := g.database.Modeled().Where("id IN ? AND owner_id = ?", ids, ownerId).Count(&count).Error
+
+	if err != nil {
+		log.Error().Err(err)
+	}
+
+	return int64(len(ids)) == count
+}
+
+// ExistsByIdsAndMemberId checks that the user owns all the groups or accepted the invitation to them with one of the roles
+func (g *GroupRepositoryObject) ExistsByIdsAndMemberId(ids []uuid.UUID, userId uuid.UUID, roles []memberModel.Role) bool {
+	var count int64
+	err := g.database.Modeled().
+		Where(
+			"id IN ? AND (owner_id = ? OR EXISTS (SELECT 1 FROM group_members gm WHERE gm.group_id = groups.id AND gm.user_id = ? AND gm.accepted AND gm.role IN ?))",
+			ids, userId, userId, roles,
+		).
+		Count(&count).Error
+
+	if err != nil {
+		log.Error().Err(err)
+	}
+
+	return int64(len(ids)) == count
+}
+
 func (g *GroupRepositoryObject) DeleteById(id uuid.UUID) error {
 	return g.database.Delete(id)
 }
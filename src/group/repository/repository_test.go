@@ -3,6 +3,8 @@ package repository
 import (
 	"fmt"
 	"github.com/VlasovArtem/hob/src/db"
+	memberMocks "github.com/VlasovArtem/hob/src/group/member/mocks"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	"github.com/VlasovArtem/hob/src/group/mocks"
 	"github.com/VlasovArtem/hob/src/group/model"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
@@ -31,12 +33,13 @@ func (g *GroupRepositoryTestSuite) SetupSuite() {
 		},
 	).
 		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, memberModel.Member{})
 			database.TruncateTable(service, model.Group{})
 		}).
 		AddAfterSuite(func(service db.DatabaseService) {
 			database.TruncateTable(service, userModel.User{})
 		}).
-		ExecuteMigration(userModel.User{}, model.Group{})
+		ExecuteMigration(userModel.User{}, model.Group{}, memberModel.Member{})
 
 	g.createdUser = userMocks.GenerateUser()
 	g.CreateEntity(&g.createdUser)
@@ -91,6 +94,40 @@ func (g *GroupRepositoryTestSuite) Test_FindByOwnerId() {
 	assert.Equal(g.T(), []model.GroupDto{expected.ToDto()}, actual)
 }
 
+func (g *GroupRepositoryTestSuite) Test_FindByUserId() {
+	owned := g.createGroup()
+	shared := g.createSharedGroup(memberModel.Viewer, true)
+
+	actual := g.repository.FindByUserId(g.createdUser.Id)
+
+	assert.ElementsMatch(g.T(), []model.GroupDto{owned.ToDto(), shared.ToDto()}, actual)
+}
+
+func (g *GroupRepositoryTestSuite) Test_FindByUserId_WithNotAcceptedInvitation() {
+	g.createSharedGroup(memberModel.Viewer, false)
+
+	assert.Equal(g.T(), []model.GroupDto{}, g.repository.FindByUserId(g.createdUser.Id))
+}
+
+func (g *GroupRepositoryTestSuite) Test_ExistsByIdsAndMemberId() {
+	owned := g.createGroup()
+	shared := g.createSharedGroup(memberModel.Editor, true)
+
+	assert.True(g.T(), g.repository.ExistsByIdsAndMemberId([]uuid.UUID{owned.Id, shared.Id}, g.createdUser.Id, memberModel.ModifyRoles))
+}
+
+func (g *GroupRepositoryTestSuite) Test_ExistsByIdsAndMemberId_WithNotMatchingRole() {
+	shared := g.createSharedGroup(memberModel.Viewer, true)
+
+	assert.False(g.T(), g.repository.ExistsByIdsAndMemberId([]uuid.UUID{shared.Id}, g.createdUser.Id, memberModel.ModifyRoles))
+}
+
+func (g *GroupRepositoryTestSuite) Test_ExistsByIdsAndMemberId_WithNotAcceptedInvitation() {
+	shared := g.createSharedGroup(memberModel.Owner, false)
+
+	assert.False(g.T(), g.repository.ExistsByIdsAndMemberId([]uuid.UUID{shared.Id}, g.createdUser.Id, memberModel.ReadRoles))
+}
+
 func (g *GroupRepositoryTestSuite) Test_ExistsById() {
 	entity := g.createGroup()
 
@@ -152,3 +189,20 @@ func (g *GroupRepositoryTestSuite) createGroup() model.Group {
 
 	return entity
 }
+
+// createSharedGroup creates the group of another user where the created user is invited with the role
+func (g *GroupRepositoryTestSuite) createSharedGroup(role memberModel.Role, accepted bool) model.Group {
+	owner := userMocks.GenerateUser()
+	owner.Email = fmt.Sprintf("%s-%s", owner.Id, owner.Email)
+	g.CreateEntity(&owner)
+
+	entity := mocks.GenerateGroup(owner.Id)
+	entity.Name = fmt.Sprintf("Name %s", entity.Id)
+	g.CreateEntity(entity)
+
+	member := memberMocks.GenerateMember(entity.Id, g.createdUser.Id, role)
+	member.Accepted = accepted
+	g.CreateEntity(&member)
+
+	return entity
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/VlasovArtem/hob/src/common/database"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
+	memberRepository "github.com/VlasovArtem/hob/src/group/member/repository"
 	"github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/group/repository"
 	userService "github.com/VlasovArtem/hob/src/user/service"
@@ -13,17 +15,20 @@ import (
 )
 
 type GroupServiceObject struct {
-	userService userService.UserService
-	repository  repository.GroupRepository
+	userService      userService.UserService
+	repository       repository.GroupRepository
+	memberRepository memberRepository.GroupMemberRepository
 }
 
 func NewGroupService(
 	userService userService.UserService,
 	repository repository.GroupRepository,
+	memberRepository memberRepository.GroupMemberRepository,
 ) GroupService {
 	return &GroupServiceObject{
-		userService: userService,
-		repository:  repository,
+		userService:      userService,
+		repository:       repository,
+		memberRepository: memberRepository,
 	}
 }
 
@@ -31,18 +36,28 @@ func (g *GroupServiceObject) Initialize(factory dependency.DependenciesProvider)
 	return NewGroupService(
 		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
 		dependency.FindRequiredDependency[repository.GroupRepositoryObject, repository.GroupRepository](factory),
+		dependency.FindRequiredDependency[memberRepository.GroupMemberRepositoryObject, memberRepository.GroupMemberRepository](factory),
 	)
 }
 
 type GroupService interface {
 	Add(request model.CreateGroupRequest) (model.GroupDto, error)
 	AddBatch(request model.CreateGroupBatchRequest) ([]model.GroupDto, error)
-	FindById(id uuid.UUID) (model.GroupDto, error)
+	FindById(id uuid.UUID, userId uuid.UUID) (model.GroupDto, error)
 	FindByUserId(userId uuid.UUID) []model.GroupDto
 	ExistsById(id uuid.UUID) bool
 	ExistsByIds(ids []uuid.UUID) bool
-	DeleteById(id uuid.UUID) error
-	Update(id uuid.UUID, request model.UpdateGroupRequest) error
+	ExistsByIdsAndUserId(ids []uuid.UUID, userId uuid.UUID) bool
+	CanModify(ids []uuid.UUID, userId uuid.UUID) bool
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateGroupRequest) error
+	Invite(id uuid.UUID, userId uuid.UUID, request memberModel.InviteMemberRequest) (memberModel.MemberDto, error)
+	Accept(id uuid.UUID, userId uuid.UUID) error
+	Decline(id uuid.UUID, userId uuid.UUID) error
+	FindMembers(id uuid.UUID, userId uuid.UUID) ([]memberModel.MemberDto, error)
+	FindInvitations(userId uuid.UUID) []memberModel.MemberDto
+	UpdateMember(id uuid.UUID, userId uuid.UUID, memberId uuid.UUID, request memberModel.UpdateMemberRequest) error
+	RemoveMember(id uuid.UUID, userId uuid.UUID, memberId uuid.UUID) error
 }
 
 func (g *GroupServiceObject) Add(request model.CreateGroupRequest) (response model.GroupDto, err error) {
@@ -91,7 +106,10 @@ func (g *GroupServiceObject) AddBatch(request model.CreateGroupBatchRequest) (re
 	}
 }
 
-func (g *GroupServiceObject) FindById(id uuid.UUID) (response model.GroupDto, err error) {
+func (g *GroupServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (response model.GroupDto, err error) {
+	if !g.ExistsByIdsAndUserId([]uuid.UUID{id}, userId) {
+		return response, interrors.NewErrNotFound("group with id %s not found", id)
+	}
 	if response, err = g.repository.FindById(id); err != nil {
 		return response, database.HandlerFindError(err, "group with id %s not found", id)
 	} else {
@@ -99,8 +117,9 @@ func (g *GroupServiceObject) FindById(id uuid.UUID) (response model.GroupDto, er
 	}
 }
 
+// FindByUserId returns the groups owned by the user and the groups the user is a member of
 func (g *GroupServiceObject) FindByUserId(userId uuid.UUID) []model.GroupDto {
-	return g.repository.FindByOwnerId(userId)
+	return g.repository.FindByUserId(userId)
 }
 
 func (g *GroupServiceObject) ExistsById(id uuid.UUID) bool {
@@ -111,16 +130,120 @@ func (g *GroupServiceObject) ExistsByIds(ids []uuid.UUID) bool {
 	return g.repository.ExistsByIds(ids)
 }
 
-func (g *GroupServiceObject) DeleteById(id uuid.UUID) error {
-	if !g.ExistsById(id) {
+// ExistsByIdsAndUserId checks that all the groups are available to the user
+func (g *GroupServiceObject) ExistsByIdsAndUserId(ids []uuid.UUID, userId uuid.UUID) bool {
+	return g.repository.ExistsByIdsAndMemberId(ids, userId, memberModel.ReadRoles)
+}
+
+// CanModify checks that the user is allowed to change the data shared with all the groups
+func (g *GroupServiceObject) CanModify(ids []uuid.UUID, userId uuid.UUID) bool {
+	return g.repository.ExistsByIdsAndMemberId(ids, userId, memberModel.ModifyRoles)
+}
+
+// DeleteById removes the group, only the user that created the group is able to delete it
+func (g *GroupServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if !g.repository.ExistsByIdsAndOwnerId([]uuid.UUID{id}, userId) {
 		return interrors.NewErrNotFound("group with id %s not found", id)
 	}
 	return g.repository.DeleteById(id)
 }
 
-func (g *GroupServiceObject) Update(id uuid.UUID, request model.UpdateGroupRequest) error {
-	if !g.ExistsById(id) {
+func (g *GroupServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateGroupRequest) error {
+	if !g.canManage(id, userId) {
 		return interrors.NewErrNotFound("group with id %s not found", id)
 	}
 	return g.repository.Update(id, request)
 }
+
+// Invite adds the user with the email to the group, the group data is shared with the user after the invitation is accepted
+func (g *GroupServiceObject) Invite(id uuid.UUID, userId uuid.UUID, request memberModel.InviteMemberRequest) (response memberModel.MemberDto, err error) {
+	if !g.canManage(id, userId) {
+		return response, interrors.NewErrNotFound("group with id %s not found", id)
+	}
+	if err = request.Role.Validate(); err != nil {
+		return response, err
+	}
+
+	user, err := g.userService.FindByEmail(request.Email)
+	if err != nil {
+		return response, err
+	}
+
+	if g.repository.ExistsByIdsAndOwnerId([]uuid.UUID{id}, user.Id) || g.memberRepository.Exists(id, user.Id) {
+		return response, fmt.Errorf("user with email %s is already a member of the group", request.Email)
+	}
+
+	member, err := g.memberRepository.Create(memberModel.Member{
+		GroupId:   id,
+		UserId:    user.Id,
+		Role:      request.Role,
+		InvitedBy: userId,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	response = member.ToDto()
+	response.Email = user.Email
+
+	return response, nil
+}
+
+func (g *GroupServiceObject) Accept(id uuid.UUID, userId uuid.UUID) error {
+	if !g.hasInvitation(id, userId) {
+		return interrors.NewErrNotFound("invitation to the group with id %s not found", id)
+	}
+	return g.memberRepository.Accept(id, userId)
+}
+
+func (g *GroupServiceObject) Decline(id uuid.UUID, userId uuid.UUID) error {
+	if !g.hasInvitation(id, userId) {
+		return interrors.NewErrNotFound("invitation to the group with id %s not found", id)
+	}
+	return g.memberRepository.Delete(id, userId)
+}
+
+func (g *GroupServiceObject) FindMembers(id uuid.UUID, userId uuid.UUID) ([]memberModel.MemberDto, error) {
+	if !g.ExistsByIdsAndUserId([]uuid.UUID{id}, userId) {
+		return nil, interrors.NewErrNotFound("group with id %s not found", id)
+	}
+	return g.memberRepository.FindByGroupId(id), nil
+}
+
+// FindInvitations returns the invitations that are waiting for the user decision
+func (g *GroupServiceObject) FindInvitations(userId uuid.UUID) []memberModel.MemberDto {
+	return g.memberRepository.FindInvitationsByUserId(userId)
+}
+
+func (g *GroupServiceObject) UpdateMember(id uuid.UUID, userId uuid.UUID, memberId uuid.UUID, request memberModel.UpdateMemberRequest) error {
+	if !g.canManage(id, userId) {
+		return interrors.NewErrNotFound("group with id %s not found", id)
+	}
+	if err := request.Role.Validate(); err != nil {
+		return err
+	}
+	if !g.memberRepository.Exists(id, memberId) {
+		return interrors.NewErrNotFound("member with id %s not found", memberId)
+	}
+	return g.memberRepository.UpdateRole(id, memberId, request.Role)
+}
+
+// RemoveMember removes the member from the group, the member is able to leave the group without the manage rights
+func (g *GroupServiceObject) RemoveMember(id uuid.UUID, userId uuid.UUID, memberId uuid.UUID) error {
+	if userId != memberId && !g.canManage(id, userId) {
+		return interrors.NewErrNotFound("group with id %s not found", id)
+	}
+	if !g.memberRepository.Exists(id, memberId) {
+		return interrors.NewErrNotFound("member with id %s not found", memberId)
+	}
+	return g.memberRepository.Delete(id, memberId)
+}
+
+func (g *GroupServiceObject) canManage(id uuid.UUID, userId uuid.UUID) bool {
+	return g.repository.ExistsByIdsAndMemberId([]uuid.UUID{id}, userId, memberModel.ManageRoles)
+}
+
+func (g *GroupServiceObject) hasInvitation(id uuid.UUID, userId uuid.UUID) bool {
+	member, err := g.memberRepository.Find(id, userId)
+	return err == nil && !member.Accepted
+}
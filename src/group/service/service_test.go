@@ -5,15 +5,17 @@ import (
 	"fmt"
 	"github.com/VlasovArtem/hob/src/common"
 	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	memberMocks "github.com/VlasovArtem/hob/src/group/member/mocks"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	"github.com/VlasovArtem/hob/src/group/mocks"
 	"github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
-	"gorm.io/gorm"
 	"testing"
 )
 
@@ -21,6 +23,7 @@ type GroupServiceTestSuite struct {
 	testhelper.MockTestSuite[GroupService]
 	users           *userMocks.UserService
 	groupRepository *mocks.GroupRepository
+	members         *memberMocks.GroupMemberRepository
 }
 
 func TestGroupServiceTestSuite(t *testing.T) {
@@ -28,7 +31,8 @@ func TestGroupServiceTestSuite(t *testing.T) {
 	testingSuite.TestObjectGenerator = func() GroupService {
 		testingSuite.users = new(userMocks.UserService)
 		testingSuite.groupRepository = new(mocks.GroupRepository)
-		return NewGroupService(testingSuite.users, testingSuite.groupRepository)
+		testingSuite.members = new(memberMocks.GroupMemberRepository)
+		return NewGroupService(testingSuite.users, testingSuite.groupRepository, testingSuite.members)
 	}
 
 	suite.Run(t, testingSuite)
@@ -150,32 +154,35 @@ func (g *GroupServiceTestSuite) Test_AddBatch_WithErrorFromRepository() {
 func (g *GroupServiceTestSuite) Test_FindById() {
 	group := mocks.GenerateGroupDto()
 
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{group.Id}, group.OwnerId, memberModel.ReadRoles).Return(true)
 	g.groupRepository.On("FindById", group.Id).Return(group, nil)
 
-	actual, err := g.TestO.FindById(group.Id)
+	actual, err := g.TestO.FindById(group.Id, group.OwnerId)
 
 	assert.Nil(g.T(), err)
 	assert.Equal(g.T(), group, actual)
 }
 
 func (g *GroupServiceTestSuite) Test_FindById_WithNotExistingId() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
-	g.groupRepository.On("FindById", id).Return(model.GroupDto{}, gorm.ErrRecordNotFound)
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ReadRoles).Return(false)
 
-	actual, err := g.TestO.FindById(id)
+	actual, err := g.TestO.FindById(id, userId)
 
 	assert.Equal(g.T(), interrors.NewErrNotFound("group with id %s not found", id), err)
 	assert.Equal(g.T(), model.GroupDto{}, actual)
+	g.groupRepository.AssertNotCalled(g.T(), "FindById", id)
 }
 
 func (g *GroupServiceTestSuite) Test_FindById_WithError() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 	expectedError := errors.New("test")
 
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ReadRoles).Return(true)
 	g.groupRepository.On("FindById", id).Return(model.GroupDto{}, expectedError)
 
-	actual, err := g.TestO.FindById(id)
+	actual, err := g.TestO.FindById(id, userId)
 
 	assert.Equal(g.T(), expectedError, err)
 	assert.Equal(g.T(), model.GroupDto{}, actual)
@@ -184,7 +191,7 @@ func (g *GroupServiceTestSuite) Test_FindById_WithError() {
 func (g *GroupServiceTestSuite) Test_FindByUserId() {
 	groups := []model.GroupDto{mocks.GenerateGroupDto()}
 
-	g.groupRepository.On("FindByOwnerId", groups[0].OwnerId).Return(groups, nil)
+	g.groupRepository.On("FindByUserId", groups[0].OwnerId).Return(groups, nil)
 
 	actual := g.TestO.FindByUserId(groups[0].OwnerId)
 
@@ -196,7 +203,7 @@ func (g *GroupServiceTestSuite) Test_FindByUserId_WithNotExistingRecords() {
 
 	userId := uuid.New()
 
-	g.groupRepository.On("FindByOwnerId", userId).Return(groups, nil)
+	g.groupRepository.On("FindByUserId", userId).Return(groups, nil)
 
 	actual := g.TestO.FindByUserId(userId)
 
@@ -235,53 +242,288 @@ func (g *GroupServiceTestSuite) Test_ExistsByIds_WithNotExists() {
 	assert.False(g.T(), g.TestO.ExistsByIds(ids))
 }
 
+func (g *GroupServiceTestSuite) Test_ExistsByIdsAndUserId() {
+	ids, userId := []uuid.UUID{uuid.New()}, uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", ids, userId, memberModel.ReadRoles).Return(true)
+
+	assert.True(g.T(), g.TestO.ExistsByIdsAndUserId(ids, userId))
+}
+
+func (g *GroupServiceTestSuite) Test_CanModify() {
+	ids, userId := []uuid.UUID{uuid.New()}, uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", ids, userId, memberModel.ModifyRoles).Return(true)
+
+	assert.True(g.T(), g.TestO.CanModify(ids, userId))
+}
+
+func (g *GroupServiceTestSuite) Test_CanModify_WithViewer() {
+	ids, userId := []uuid.UUID{uuid.New()}, uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", ids, userId, memberModel.ModifyRoles).Return(false)
+
+	assert.False(g.T(), g.TestO.CanModify(ids, userId))
+}
+
 func (g *GroupServiceTestSuite) Test_DeleteById() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
-	g.groupRepository.On("ExistsById", id).Return(true)
+	g.groupRepository.On("ExistsByIdsAndOwnerId", []uuid.UUID{id}, userId).Return(true)
 	g.groupRepository.On("DeleteById", id).Return(nil)
 
-	assert.Nil(g.T(), g.TestO.DeleteById(id))
+	assert.Nil(g.T(), g.TestO.DeleteById(id, userId))
 }
 
 func (g *GroupServiceTestSuite) Test_DeleteById_WithNotExists() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
-	g.groupRepository.On("ExistsById", id).Return(false)
+	g.groupRepository.On("ExistsByIdsAndOwnerId", []uuid.UUID{id}, userId).Return(false)
 
-	assert.Equal(g.T(), interrors.NewErrNotFound("group with id %s not found", id), g.TestO.DeleteById(id))
+	assert.Equal(g.T(), interrors.NewErrNotFound("group with id %s not found", id), g.TestO.DeleteById(id, userId))
 
 	g.groupRepository.AssertNotCalled(g.T(), "DeleteById", id)
 }
 
 func (g *GroupServiceTestSuite) Test_Update() {
 	id, request := mocks.GenerateUpdateGroupRequest()
+	userId := uuid.New()
 
-	g.groupRepository.On("ExistsById", id).Return(true)
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
 	g.groupRepository.On("Update", id, request).Return(nil)
 
-	assert.Nil(g.T(), g.TestO.Update(id, request))
+	assert.Nil(g.T(), g.TestO.Update(id, userId, request))
 
 	g.groupRepository.AssertCalled(g.T(), "Update", id, request)
 }
 
 func (g *GroupServiceTestSuite) Test_Update_WithErrorFromDatabase() {
 	id, request := mocks.GenerateUpdateGroupRequest()
+	userId := uuid.New()
 
-	g.groupRepository.On("ExistsById", id).Return(true)
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
 	g.groupRepository.On("Update", id, request).Return(errors.New("test"))
 
-	err := g.TestO.Update(id, request)
+	err := g.TestO.Update(id, userId, request)
 	assert.Equal(g.T(), errors.New("test"), err)
 }
 
 func (g *GroupServiceTestSuite) Test_Update_WithNotExists() {
 	id, request := mocks.GenerateUpdateGroupRequest()
+	userId := uuid.New()
 
-	g.groupRepository.On("ExistsById", id).Return(false)
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(false)
 
-	err := g.TestO.Update(id, request)
+	err := g.TestO.Update(id, userId, request)
 	assert.Equal(g.T(), interrors.NewErrNotFound("group with id %s not found", id), err)
 
 	g.groupRepository.AssertNotCalled(g.T(), "Update", id, request)
 }
+
+func (g *GroupServiceTestSuite) Test_Invite() {
+	id, userId := uuid.New(), uuid.New()
+	request := memberMocks.GenerateInviteMemberRequest()
+	user := userModel.UserDto{Id: uuid.New(), Email: request.Email}
+	member := memberModel.Member{GroupId: id, UserId: user.Id, Role: request.Role, InvitedBy: userId}
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
+	g.users.On("FindByEmail", request.Email).Return(user, nil)
+	g.groupRepository.On("ExistsByIdsAndOwnerId", []uuid.UUID{id}, user.Id).Return(false)
+	g.members.On("Exists", id, user.Id).Return(false)
+	g.members.On("Create", member).Return(member, nil)
+
+	response, err := g.TestO.Invite(id, userId, request)
+
+	assert.Nil(g.T(), err)
+	assert.Equal(g.T(), memberModel.MemberDto{
+		GroupId:   id,
+		UserId:    user.Id,
+		Email:     request.Email,
+		Role:      request.Role,
+		InvitedBy: userId,
+	}, response)
+}
+
+func (g *GroupServiceTestSuite) Test_Invite_WithoutManageRights() {
+	id, userId := uuid.New(), uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(false)
+
+	_, err := g.TestO.Invite(id, userId, memberMocks.GenerateInviteMemberRequest())
+
+	assert.Equal(g.T(), interrors.NewErrNotFound("group with id %s not found", id), err)
+
+	g.members.AssertNotCalled(g.T(), "Create", mock.Anything)
+}
+
+func (g *GroupServiceTestSuite) Test_Invite_WithInvalidRole() {
+	id, userId := uuid.New(), uuid.New()
+	request := memberModel.InviteMemberRequest{Email: "member@mail.com", Role: "ADMIN"}
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
+
+	_, err := g.TestO.Invite(id, userId, request)
+
+	assert.Equal(g.T(), errors.New("role ADMIN is not supported"), err)
+
+	g.users.AssertNotCalled(g.T(), "FindByEmail", mock.Anything)
+}
+
+func (g *GroupServiceTestSuite) Test_Invite_WithExistingMember() {
+	id, userId := uuid.New(), uuid.New()
+	request := memberMocks.GenerateInviteMemberRequest()
+	user := userModel.UserDto{Id: uuid.New(), Email: request.Email}
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
+	g.users.On("FindByEmail", request.Email).Return(user, nil)
+	g.groupRepository.On("ExistsByIdsAndOwnerId", []uuid.UUID{id}, user.Id).Return(false)
+	g.members.On("Exists", id, user.Id).Return(true)
+
+	_, err := g.TestO.Invite(id, userId, request)
+
+	assert.Equal(g.T(), fmt.Errorf("user with email %s is already a member of the group", request.Email), err)
+
+	g.members.AssertNotCalled(g.T(), "Create", mock.Anything)
+}
+
+func (g *GroupServiceTestSuite) Test_Invite_WithNotExistingUser() {
+	id, userId := uuid.New(), uuid.New()
+	request := memberMocks.GenerateInviteMemberRequest()
+	expectedError := interrors.NewErrNotFound("user with email %s not found", request.Email)
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
+	g.users.On("FindByEmail", request.Email).Return(userModel.UserDto{}, expectedError)
+
+	_, err := g.TestO.Invite(id, userId, request)
+
+	assert.Equal(g.T(), expectedError, err)
+}
+
+func (g *GroupServiceTestSuite) Test_Accept() {
+	id, userId := uuid.New(), uuid.New()
+	member := memberMocks.GenerateMember(id, userId, memberModel.Viewer)
+	member.Accepted = false
+
+	g.members.On("Find", id, userId).Return(member, nil)
+	g.members.On("Accept", id, userId).Return(nil)
+
+	assert.Nil(g.T(), g.TestO.Accept(id, userId))
+}
+
+func (g *GroupServiceTestSuite) Test_Accept_WithAcceptedInvitation() {
+	id, userId := uuid.New(), uuid.New()
+
+	g.members.On("Find", id, userId).Return(memberMocks.GenerateMember(id, userId, memberModel.Viewer), nil)
+
+	assert.Equal(g.T(), interrors.NewErrNotFound("invitation to the group with id %s not found", id), g.TestO.Accept(id, userId))
+
+	g.members.AssertNotCalled(g.T(), "Accept", id, userId)
+}
+
+func (g *GroupServiceTestSuite) Test_Decline() {
+	id, userId := uuid.New(), uuid.New()
+	member := memberMocks.GenerateMember(id, userId, memberModel.Viewer)
+	member.Accepted = false
+
+	g.members.On("Find", id, userId).Return(member, nil)
+	g.members.On("Delete", id, userId).Return(nil)
+
+	assert.Nil(g.T(), g.TestO.Decline(id, userId))
+}
+
+func (g *GroupServiceTestSuite) Test_Decline_WithNotExistingInvitation() {
+	id, userId := uuid.New(), uuid.New()
+
+	g.members.On("Find", id, userId).Return(memberModel.Member{}, errors.New("record not found"))
+
+	assert.Equal(g.T(), interrors.NewErrNotFound("invitation to the group with id %s not found", id), g.TestO.Decline(id, userId))
+
+	g.members.AssertNotCalled(g.T(), "Delete", id, userId)
+}
+
+func (g *GroupServiceTestSuite) Test_FindMembers() {
+	id, userId := uuid.New(), uuid.New()
+	members := []memberModel.MemberDto{memberMocks.GenerateMember(id, userId, memberModel.Viewer).ToDto()}
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ReadRoles).Return(true)
+	g.members.On("FindByGroupId", id).Return(members)
+
+	response, err := g.TestO.FindMembers(id, userId)
+
+	assert.Nil(g.T(), err)
+	assert.Equal(g.T(), members, response)
+}
+
+func (g *GroupServiceTestSuite) Test_FindMembers_WithoutAccess() {
+	id, userId := uuid.New(), uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ReadRoles).Return(false)
+
+	response, err := g.TestO.FindMembers(id, userId)
+
+	assert.Equal(g.T(), interrors.NewErrNotFound("group with id %s not found", id), err)
+	assert.Nil(g.T(), response)
+}
+
+func (g *GroupServiceTestSuite) Test_FindInvitations() {
+	userId := uuid.New()
+	invitations := []memberModel.MemberDto{{GroupId: uuid.New(), UserId: userId, Role: memberModel.Editor}}
+
+	g.members.On("FindInvitationsByUserId", userId).Return(invitations)
+
+	assert.Equal(g.T(), invitations, g.TestO.FindInvitations(userId))
+}
+
+func (g *GroupServiceTestSuite) Test_UpdateMember() {
+	id, userId, memberId := uuid.New(), uuid.New(), uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
+	g.members.On("Exists", id, memberId).Return(true)
+	g.members.On("UpdateRole", id, memberId, memberModel.Owner).Return(nil)
+
+	assert.Nil(g.T(), g.TestO.UpdateMember(id, userId, memberId, memberModel.UpdateMemberRequest{Role: memberModel.Owner}))
+}
+
+func (g *GroupServiceTestSuite) Test_UpdateMember_WithNotExistingMember() {
+	id, userId, memberId := uuid.New(), uuid.New(), uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
+	g.members.On("Exists", id, memberId).Return(false)
+
+	err := g.TestO.UpdateMember(id, userId, memberId, memberModel.UpdateMemberRequest{Role: memberModel.Owner})
+
+	assert.Equal(g.T(), interrors.NewErrNotFound("member with id %s not found", memberId), err)
+
+	g.members.AssertNotCalled(g.T(), "UpdateRole", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (g *GroupServiceTestSuite) Test_RemoveMember() {
+	id, userId, memberId := uuid.New(), uuid.New(), uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(true)
+	g.members.On("Exists", id, memberId).Return(true)
+	g.members.On("Delete", id, memberId).Return(nil)
+
+	assert.Nil(g.T(), g.TestO.RemoveMember(id, userId, memberId))
+}
+
+func (g *GroupServiceTestSuite) Test_RemoveMember_WithLeavingMember() {
+	id, userId := uuid.New(), uuid.New()
+
+	g.members.On("Exists", id, userId).Return(true)
+	g.members.On("Delete", id, userId).Return(nil)
+
+	assert.Nil(g.T(), g.TestO.RemoveMember(id, userId, userId))
+
+	g.groupRepository.AssertNotCalled(g.T(), "ExistsByIdsAndMemberId", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (g *GroupServiceTestSuite) Test_RemoveMember_WithoutManageRights() {
+	id, userId, memberId := uuid.New(), uuid.New(), uuid.New()
+
+	g.groupRepository.On("ExistsByIdsAndMemberId", []uuid.UUID{id}, userId, memberModel.ManageRoles).Return(false)
+
+	assert.Equal(g.T(), interrors.NewErrNotFound("group with id %s not found", id), g.TestO.RemoveMember(id, userId, memberId))
+
+	g.members.AssertNotCalled(g.T(), "Delete", id, memberId)
+}
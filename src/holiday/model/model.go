@@ -0,0 +1,12 @@
+package model
+
+type CountryHolidays struct {
+	Code     string    `json:"code"`
+	Holidays []Holiday `json:"holidays"`
+}
+
+// Holiday is a public holiday, the Date is either a specific day "2006-01-02" or a day repeated every year "01-02"
+type Holiday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"github.com/VlasovArtem/hob/src/holiday/model"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+const (
+	dateLayout          = "2006-01-02"
+	recurringDateLayout = "01-02"
+)
+
+type HolidayServiceObject struct {
+	holidays map[string]map[string]bool
+}
+
+type HolidayService interface {
+	IsBusinessDay(countryCode string, date time.Time) bool
+	Adjust(countryCode string, date time.Time, adjustment scheduler.BusinessDayAdjustment) time.Time
+}
+
+func NewHolidayService(countriesHolidays []model.CountryHolidays) HolidayService {
+	object := &HolidayServiceObject{
+		holidays: make(map[string]map[string]bool),
+	}
+
+	for _, countryHolidays := range countriesHolidays {
+		dates := make(map[string]bool)
+
+		for _, holiday := range countryHolidays.Holidays {
+			dates[holiday.Date] = true
+		}
+
+		object.holidays[countryHolidays.Code] = dates
+	}
+
+	log.Info().Msg("Holidays init completed")
+
+	return object
+}
+
+// IsBusinessDay reports whether the date is neither a weekend nor a public holiday of the country
+func (h *HolidayServiceObject) IsBusinessDay(countryCode string, date time.Time) bool {
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		return false
+	}
+
+	dates := h.holidays[countryCode]
+
+	return !dates[date.Format(dateLayout)] && !dates[date.Format(recurringDateLayout)]
+}
+
+// Adjust moves the date falling on a non-business day of the country according to the adjustment, the time of the day is kept
+func (h *HolidayServiceObject) Adjust(countryCode string, date time.Time, adjustment scheduler.BusinessDayAdjustment) time.Time {
+	var step int
+
+	switch adjustment {
+	case scheduler.NextBusinessDay:
+		step = 1
+	case scheduler.PreviousBusinessDay:
+		step = -1
+	default:
+		return date
+	}
+
+	for !h.IsBusinessDay(countryCode, date) {
+		date = date.AddDate(0, 0, step)
+	}
+
+	return date
+}
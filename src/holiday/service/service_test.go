@@ -0,0 +1,68 @@
+package service
+
+import (
+	"github.com/VlasovArtem/hob/src/holiday/model"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var holidayService = NewHolidayService([]model.CountryHolidays{
+	{
+		Code: "UA",
+		Holidays: []model.Holiday{
+			{Date: "01-01", Name: "New Year's Day"},
+			{Date: "08-24", Name: "Independence Day"},
+			{Date: "2023-04-17", Name: "Easter Monday"},
+		},
+	},
+})
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		name        string
+		countryCode string
+		date        time.Time
+		want        bool
+	}{
+		{"working day", "UA", date(2023, time.April, 18), true},
+		{"saturday", "UA", date(2023, time.April, 15), false},
+		{"sunday", "UA", date(2023, time.April, 16), false},
+		{"recurring holiday", "UA", date(2023, time.August, 24), false},
+		{"holiday", "UA", date(2023, time.April, 17), false},
+		{"holiday of other year", "UA", date(2024, time.April, 17), true},
+		{"country without holidays", "PL", date(2023, time.August, 24), true},
+		{"weekend of country without holidays", "PL", date(2023, time.April, 16), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, holidayService.IsBusinessDay(tt.countryCode, tt.date))
+		})
+	}
+}
+
+func TestAdjust(t *testing.T) {
+	tests := []struct {
+		name       string
+		date       time.Time
+		adjustment scheduler.BusinessDayAdjustment
+		want       time.Time
+	}{
+		{"business day", date(2023, time.April, 18), scheduler.NextBusinessDay, date(2023, time.April, 18)},
+		{"without adjustment", date(2023, time.April, 15), scheduler.NoAdjustment, date(2023, time.April, 15)},
+		{"empty adjustment", date(2023, time.April, 15), "", date(2023, time.April, 15)},
+		{"next over weekend and holiday", date(2023, time.April, 15), scheduler.NextBusinessDay, date(2023, time.April, 18)},
+		{"previous over weekend and holiday", date(2023, time.April, 17), scheduler.PreviousBusinessDay, date(2023, time.April, 14)},
+		{"next over new year", date(2022, time.December, 31), scheduler.NextBusinessDay, date(2023, time.January, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, holidayService.Adjust("UA", tt.date, tt.adjustment))
+		})
+	}
+}
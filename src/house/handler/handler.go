@@ -43,10 +43,18 @@ type HouseHandler interface {
 
 func (h *HouseHandlerObject) Add() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		requestBody, err := rest.ReadRequestBody[model.CreateHouseRequest](request)
 		if err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			requestBody.UserId = userId
+
 			rest.NewAPIResponse(writer).
 				Created(h.houseService.Add(requestBody)).
 				Perform()
@@ -56,10 +64,20 @@ func (h *HouseHandlerObject) Add() http.HandlerFunc {
 
 func (h *HouseHandlerObject) AddBatch() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		requestBody, err := rest.ReadRequestBody[model.CreateHouseBatchRequest](request)
 		if err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			for i := range requestBody.Houses {
+				requestBody.Houses[i].UserId = userId
+			}
+
 			rest.NewAPIResponse(writer).
 				Created(h.houseService.AddBatch(requestBody)).
 				Perform()
@@ -69,11 +87,11 @@ func (h *HouseHandlerObject) AddBatch() http.HandlerFunc {
 
 func (h *HouseHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(h.houseService.FindById(id)).
+				Ok(h.houseService.FindById(id, userId)).
 				Perform()
 		}
 	}
@@ -81,14 +99,14 @@ func (h *HouseHandlerObject) FindById() http.HandlerFunc {
 
 func (h *HouseHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdateHouseRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
 				rest.NewAPIResponse(writer).
-					Error(h.houseService.Update(id, body)).
+					Error(h.houseService.Update(id, userId, body)).
 					Perform()
 			}
 		}
@@ -97,12 +115,12 @@ func (h *HouseHandlerObject) Update() http.HandlerFunc {
 
 func (h *HouseHandlerObject) Delete() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
 				StatusCode(http.StatusNoContent).
-				Error(h.houseService.DeleteById(id)).
+				Error(h.houseService.DeleteById(id, userId)).
 				Perform()
 		}
 	}
@@ -110,7 +128,7 @@ func (h *HouseHandlerObject) Delete() http.HandlerFunc {
 
 func (h *HouseHandlerObject) FindByUserId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, err := rest.GetUserIdRequestParameter(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
@@ -38,6 +38,7 @@ func (h *HouseHandlerTestSuite) Test_Add_WithNotValidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.Add())
 
 	testRequest.Verify(h.T(), http.StatusBadRequest)
@@ -52,6 +53,7 @@ func (h *HouseHandlerTestSuite) Test_Add() {
 		WithURL("https://test.com/api/v1/houses").
 		WithMethod("POST").
 		WithBody(request).
+		WithUser(request.UserId).
 		WithHandler(h.TestO.Add())
 
 	body := testRequest.Verify(h.T(), http.StatusCreated)
@@ -82,6 +84,7 @@ func (h *HouseHandlerTestSuite) Test_Add_WithErrorFromService() {
 		WithURL("https://test.com/api/v1/houses").
 		WithMethod("POST").
 		WithBody(request).
+		WithUser(request.UserId).
 		WithHandler(h.TestO.Add())
 
 	actual := testRequest.Verify(h.T(), http.StatusBadRequest)
@@ -89,8 +92,38 @@ func (h *HouseHandlerTestSuite) Test_Add_WithErrorFromService() {
 	assert.Equal(h.T(), "error\n", string(actual))
 }
 
+func (h *HouseHandlerTestSuite) Test_Add_WithUserFromToken() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateHouseRequest()
+
+	expected := request
+	expected.UserId = userId
+
+	h.houses.On("Add", expected).Return(expected.ToEntity(test.CountryObject).ToDto(), nil)
+
+	testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses").
+		WithMethod("POST").
+		WithBody(request).
+		WithUser(userId).
+		WithHandler(h.TestO.Add()).
+		Verify(h.T(), http.StatusCreated)
+}
+
+func (h *HouseHandlerTestSuite) Test_Add_WithoutUser() {
+	testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses").
+		WithMethod("POST").
+		WithBody(mocks.GenerateCreateHouseRequest()).
+		WithHandler(h.TestO.Add()).
+		Verify(h.T(), http.StatusUnauthorized)
+
+	h.houses.AssertNotCalled(h.T(), "Add", mock.Anything)
+}
+
 func (h *HouseHandlerTestSuite) Test_AddBatch() {
 	request := mocks.GenerateCreateHouseBatchRequest(2)
+	request.Houses[1].UserId = request.Houses[0].UserId
 
 	serviceResponse := common.MapSlice(request.Houses, func(house model.CreateHouseRequest) model.HouseDto {
 		return house.ToEntity(test.CountryObject).ToDto()
@@ -102,6 +135,7 @@ func (h *HouseHandlerTestSuite) Test_AddBatch() {
 		WithURL("https://test.com/api/v1/houses/batch").
 		WithMethod("POST").
 		WithBody(request).
+		WithUser(request.Houses[0].UserId).
 		WithHandler(h.TestO.AddBatch())
 
 	body := testRequest.Verify(h.T(), http.StatusCreated)
@@ -150,6 +184,7 @@ func (h *HouseHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
 		WithURL("https://test.com/api/v1/houses/batch").
 		WithMethod("POST").
 		WithBody(request).
+		WithUser(request.Houses[0].UserId).
 		WithHandler(h.TestO.AddBatch())
 
 	actual := testRequest.Verify(h.T(), http.StatusBadRequest)
@@ -161,13 +196,15 @@ func (h *HouseHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
 }
 
 func (h *HouseHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
 	houseResponse := mocks.GenerateHouseResponse()
 
-	h.houses.On("FindById", houseResponse.Id).Return(houseResponse, nil)
+	h.houses.On("FindById", houseResponse.Id, userId).Return(houseResponse, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(h.TestO.FindById()).
 		WithVar("id", houseResponse.Id.String())
 
@@ -180,6 +217,8 @@ func (h *HouseHandlerTestSuite) Test_FindById() {
 }
 
 func (h *HouseHandlerTestSuite) Test_FindById_WithErrorFromService() {
+	userId := uuid.New()
+
 	tests := []struct {
 		err        error
 		statusCode int
@@ -197,11 +236,12 @@ func (h *HouseHandlerTestSuite) Test_FindById_WithErrorFromService() {
 	for _, test := range tests {
 		id := uuid.New()
 
-		h.houses.On("FindById", id).Return(model.HouseDto{}, test.err)
+		h.houses.On("FindById", id, userId).Return(model.HouseDto{}, test.err)
 
 		testRequest := testhelper.NewTestRequest().
 			WithURL("https://test.com/api/v1/houses/{id}").
 			WithMethod("GET").
+			WithUser(userId).
 			WithHandler(h.TestO.FindById()).
 			WithVar("id", id.String())
 
@@ -215,6 +255,7 @@ func (h *HouseHandlerTestSuite) Test_FindById_WithInvalidId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.FindById()).
 		WithVar("id", "id")
 
@@ -227,6 +268,7 @@ func (h *HouseHandlerTestSuite) Test_FindById_WithMissingId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.FindById())
 
 	body := testRequest.Verify(h.T(), http.StatusBadRequest)
@@ -243,6 +285,7 @@ func (h *HouseHandlerTestSuite) Test_FindByUserId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/user/{id}").
 		WithMethod("GET").
+		WithUser(houseResponse.Id).
 		WithHandler(h.TestO.FindByUserId()).
 		WithVar("id", houseResponse.Id.String())
 
@@ -262,6 +305,7 @@ func (h *HouseHandlerTestSuite) Test_FindByUserId_WithEmptyResponse() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/user/{id}").
 		WithMethod("GET").
+		WithUser(id).
 		WithHandler(h.TestO.FindByUserId()).
 		WithVar("id", id.String())
 
@@ -273,10 +317,27 @@ func (h *HouseHandlerTestSuite) Test_FindByUserId_WithEmptyResponse() {
 	assert.Equal(h.T(), []model.HouseDto{}, responses)
 }
 
+func (h *HouseHandlerTestSuite) Test_FindByUserId_WithAnotherUser() {
+	id := uuid.New()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/user/{id}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(h.TestO.FindByUserId()).
+		WithVar("id", id.String())
+
+	body := testRequest.Verify(h.T(), http.StatusNotFound)
+
+	assert.Equal(h.T(), fmt.Sprintf("user with id %s not found\n", id), string(body))
+	h.houses.AssertNotCalled(h.T(), "FindByUserId", mock.Anything)
+}
+
 func (h *HouseHandlerTestSuite) Test_FindByUserId_WithInvalidId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/user/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.FindByUserId()).
 		WithVar("id", "id")
 
@@ -289,6 +350,7 @@ func (h *HouseHandlerTestSuite) Test_FindByUserId_WithMissingId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/user/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.FindByUserId())
 
 	body := testRequest.Verify(h.T(), http.StatusBadRequest)
@@ -297,13 +359,15 @@ func (h *HouseHandlerTestSuite) Test_FindByUserId_WithMissingId() {
 }
 
 func (h *HouseHandlerTestSuite) Test_Update() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateHouseRequest()
 
-	h.houses.On("Update", id, request).Return(nil)
+	h.houses.On("Update", id, userId, request).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(h.TestO.Update()).
 		WithBody(request).
 		WithVar("id", id.String())
@@ -317,6 +381,7 @@ func (h *HouseHandlerTestSuite) Test_Update_WithInvalidId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.Update()).
 		WithBody(request).
 		WithVar("id", "id")
@@ -325,13 +390,14 @@ func (h *HouseHandlerTestSuite) Test_Update_WithInvalidId() {
 
 	assert.Equal(h.T(), "the id is not valid id\n", string(responseByteArray))
 
-	h.houses.AssertNotCalled(h.T(), "Update", mock.Anything, mock.Anything)
+	h.houses.AssertNotCalled(h.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (h *HouseHandlerTestSuite) Test_Update_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.Update()).
 		WithVar("id", uuid.New().String())
 
@@ -339,15 +405,17 @@ func (h *HouseHandlerTestSuite) Test_Update_WithInvalidRequest() {
 }
 
 func (h *HouseHandlerTestSuite) Test_Update_WithErrorFromService() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateHouseRequest()
 
 	expected := errors.New("error")
 
-	h.houses.On("Update", id, request).Return(expected)
+	h.houses.On("Update", id, userId, request).Return(expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(h.TestO.Update()).
 		WithVar("id", id.String()).
 		WithBody(request)
@@ -358,13 +426,15 @@ func (h *HouseHandlerTestSuite) Test_Update_WithErrorFromService() {
 }
 
 func (h *HouseHandlerTestSuite) Test_Delete() {
+	userId := uuid.New()
 	id := uuid.New()
 
-	h.houses.On("DeleteById", id).Return(nil)
+	h.houses.On("DeleteById", id, userId).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("DELETE").
+		WithUser(userId).
 		WithHandler(h.TestO.Delete()).
 		WithVar("id", id.String())
 
@@ -375,6 +445,7 @@ func (h *HouseHandlerTestSuite) Test_Delete_WithMissingParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.Delete())
 
 	responseByteArray := testRequest.Verify(h.T(), http.StatusBadRequest)
@@ -386,6 +457,7 @@ func (h *HouseHandlerTestSuite) Test_Delete_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/houses/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(h.TestO.Delete()).
 		WithVar("id", "id")
 
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	model "github.com/VlasovArtem/hob/src/house/model"
 	mock "github.com/stretchr/testify/mock"
 
@@ -123,6 +124,34 @@ func (_m *HouseRepository) FindByUserId(id uuid.UUID) []model.House {
 	return r0
 }
 
+// HasAccess provides a mock function with given fields: id, userId, roles
+func (_m *HouseRepository) HasAccess(id uuid.UUID, userId uuid.UUID, roles []memberModel.Role) bool {
+	ret := _m.Called(id, userId, roles)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, []memberModel.Role) bool); ok {
+		r0 = rf(id, userId, roles)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsOwner provides a mock function with given fields: id, userId
+func (_m *HouseRepository) IsOwner(id uuid.UUID, userId uuid.UUID) bool {
+	ret := _m.Called(id, userId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: id, request
 func (_m *HouseRepository) Update(id uuid.UUID, request model.UpdateHouseRequest) error {
 	ret := _m.Called(id, request)
@@ -58,13 +58,43 @@ func (_m *HouseService) AddBatch(house model.CreateHouseBatchRequest) ([]model.H
 	return r0, r1
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *HouseService) DeleteById(id uuid.UUID) error {
-	ret := _m.Called(id)
+// CanModify provides a mock function with given fields: id, userId
+func (_m *HouseService) CanModify(id uuid.UUID, userId uuid.UUID) bool {
+	ret := _m.Called(id, userId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// CountryCurrencies provides a mock function with given fields:
+func (_m *HouseService) CountryCurrencies() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// DeleteById provides a mock function with given fields: id, userId
+func (_m *HouseService) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -86,20 +116,20 @@ func (_m *HouseService) ExistsById(id uuid.UUID) bool {
 	return r0
 }
 
-// FindById provides a mock function with given fields: id
-func (_m *HouseService) FindById(id uuid.UUID) (model.HouseDto, error) {
-	ret := _m.Called(id)
+// FindById provides a mock function with given fields: id, userId
+func (_m *HouseService) FindById(id uuid.UUID, userId uuid.UUID) (model.HouseDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.HouseDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.HouseDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.HouseDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.HouseDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -123,13 +153,48 @@ func (_m *HouseService) FindByUserId(userId uuid.UUID) []model.HouseDto {
 	return r0
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *HouseService) Update(id uuid.UUID, request model.UpdateHouseRequest) error {
-	ret := _m.Called(id, request)
+// HasAccess provides a mock function with given fields: id, userId
+func (_m *HouseService) HasAccess(id uuid.UUID, userId uuid.UUID) bool {
+	ret := _m.Called(id, userId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ResolveCurrency provides a mock function with given fields: id, currency
+func (_m *HouseService) ResolveCurrency(id *uuid.UUID, currency string) (string, error) {
+	ret := _m.Called(id, currency)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, string) string); ok {
+		r0 = rf(id, currency)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, string) error); ok {
+		r1 = rf(id, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: id, userId, request
+func (_m *HouseService) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateHouseRequest) error {
+	ret := _m.Called(id, userId, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdateHouseRequest) error); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, model.UpdateHouseRequest) error); ok {
+		r0 = rf(id, userId, request)
 	} else {
 		r0 = ret.Error(0)
 	}
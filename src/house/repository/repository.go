@@ -4,6 +4,7 @@ import (
 	"github.com/VlasovArtem/hob/src/common"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/db"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	groupModel "github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/house/model"
 	"github.com/google/uuid"
@@ -16,6 +17,11 @@ var (
 	entity              = model.House{}
 )
 
+// sharedWithUser matches the houses that belong to a group the user accepted the invitation to with one of the roles, the
+// owner of the group is matched only if the roles contain the owner role
+const sharedWithUser = "EXISTS (SELECT 1 FROM house_groups hg JOIN groups g ON g.id = hg.group_id WHERE hg.house_id = houses.id AND " +
+	"((g.owner_id = ? AND ? IN ?) OR EXISTS (SELECT 1 FROM group_members gm WHERE gm.group_id = g.id AND gm.user_id = ? AND gm.accepted AND gm.role IN ?)))"
+
 type HouseRepositoryObject struct {
 	db db.ModeledDatabase
 }
@@ -43,6 +49,8 @@ type HouseRepository interface {
 	FindById(id uuid.UUID) (model.House, error)
 	FindByUserId(id uuid.UUID) []model.House
 	ExistsById(id uuid.UUID) bool
+	HasAccess(id uuid.UUID, userId uuid.UUID, roles []memberModel.Role) bool
+	IsOwner(id uuid.UUID, userId uuid.UUID) bool
 	DeleteById(id uuid.UUID) error
 	Update(id uuid.UUID, request model.UpdateHouseRequest) error
 }
@@ -63,8 +71,15 @@ func (h *HouseRepositoryObject) FindById(id uuid.UUID) (response model.House, er
 	return response, err
 }
 
+// FindByUserId returns the houses owned by the user and the houses shared with the user via the groups
 func (h *HouseRepositoryObject) FindByUserId(id uuid.UUID) (response []model.House) {
-	if err := h.db.D().Preload("Groups").Where("user_id = ?", id).Find(&response).Error; err != nil {
+	err := h.db.D().
+		Preload("Groups").
+		Where("user_id = ? OR "+sharedWithUser, id, id, memberModel.Owner, memberModel.ReadRoles, id, memberModel.ReadRoles).
+		Find(&response).
+		Error
+
+	if err != nil {
 		log.Error().Err(err)
 	}
 
@@ -75,10 +90,25 @@ func (h *HouseRepositoryObject) ExistsById(id uuid.UUID) bool {
 	return h.db.Exists(id)
 }
 
+// HasAccess checks that the user owns the house or the house is shared with the user via the group with one of the roles
+func (h *HouseRepositoryObject) HasAccess(id uuid.UUID, userId uuid.UUID, roles []memberModel.Role) bool {
+	return h.db.ExistsBy(
+		"id = ? AND (user_id = ? OR "+sharedWithUser+")",
+		id, userId, userId, memberModel.Owner, roles, userId, roles,
+	)
+}
+
+// IsOwner checks that the user created the house, the members of the groups the house is shared with are not the owners
+func (h *HouseRepositoryObject) IsOwner(id uuid.UUID, userId uuid.UUID) bool {
+	return h.db.ExistsBy("id = ? AND user_id = ?", id, userId)
+}
+
 func (h *HouseRepositoryObject) DeleteById(id uuid.UUID) error {
 	return h.db.Delete(id)
 }
 
+// Update updates the house, the groups of the house are replaced only if the group ids are set, an empty group ids unshares
+// the house
 func (h *HouseRepositoryObject) Update(id uuid.UUID, request model.UpdateHouseRequest) error {
 	err := h.db.Update(id, struct {
 		Name        string
@@ -94,7 +124,7 @@ func (h *HouseRepositoryObject) Update(id uuid.UUID, request model.UpdateHouseRe
 		request.StreetLine2,
 	})
 
-	if err != nil {
+	if err != nil || request.GroupIds == nil {
 		return err
 	}
 
@@ -3,6 +3,9 @@ package repository
 import (
 	"fmt"
 	"github.com/VlasovArtem/hob/src/db"
+	memberMocks "github.com/VlasovArtem/hob/src/group/member/mocks"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
+	groupMocks "github.com/VlasovArtem/hob/src/group/mocks"
 	groupModel "github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/house/mocks"
 	"github.com/VlasovArtem/hob/src/house/model"
@@ -40,7 +43,7 @@ func (h *HouseRepositoryTestSuite) SetupSuite() {
 				truncateDynamic(service)
 			},
 		).
-		ExecuteMigration(userModel.User{}, model.House{}, groupModel.Group{})
+		ExecuteMigration(userModel.User{}, model.House{}, groupModel.Group{}, memberModel.Member{})
 
 	h.createdUser = userMocks.GenerateUser()
 	h.CreateEntity(&h.createdUser)
@@ -156,6 +159,62 @@ func (h *HouseRepositoryTestSuite) Test_FindByUserId_WithMissingId() {
 	assert.Equal(h.T(), []model.House{}, actual)
 }
 
+func (h *HouseRepositoryTestSuite) Test_FindByUserId_WithSharedHouse() {
+	member := userMocks.GenerateUser()
+	member.Email = fmt.Sprintf("%s-%s", member.Id, member.Email)
+	h.CreateEntity(&member)
+
+	group := groupMocks.GenerateGroup(h.createdUser.Id)
+	house := h.createHouseWithGroups([]groupModel.Group{group})
+	groupMember := memberMocks.GenerateMember(group.Id, member.Id, memberModel.Viewer)
+	h.CreateEntity(&groupMember)
+
+	actual := h.repository.FindByUserId(member.Id)
+
+	assert.Len(h.T(), actual, 1)
+	assert.Equal(h.T(), house.Id, actual[0].Id)
+}
+
+func (h *HouseRepositoryTestSuite) Test_HasAccess() {
+	house := h.createHouse()
+
+	assert.True(h.T(), h.repository.HasAccess(house.Id, h.createdUser.Id, memberModel.ManageRoles))
+	assert.False(h.T(), h.repository.HasAccess(house.Id, uuid.New(), memberModel.ReadRoles))
+}
+
+func (h *HouseRepositoryTestSuite) Test_HasAccess_WithGroupMember() {
+	member := userMocks.GenerateUser()
+	member.Email = fmt.Sprintf("%s-%s", member.Id, member.Email)
+	h.CreateEntity(&member)
+
+	group := groupMocks.GenerateGroup(h.createdUser.Id)
+	house := h.createHouseWithGroups([]groupModel.Group{group})
+	groupMember := memberMocks.GenerateMember(group.Id, member.Id, memberModel.Viewer)
+	h.CreateEntity(&groupMember)
+
+	assert.True(h.T(), h.repository.HasAccess(house.Id, member.Id, memberModel.ReadRoles))
+	assert.False(h.T(), h.repository.HasAccess(house.Id, member.Id, memberModel.ModifyRoles))
+}
+
+func (h *HouseRepositoryTestSuite) Test_HasAccess_WithGroupOwner() {
+	owner := userMocks.GenerateUser()
+	owner.Email = fmt.Sprintf("%s-%s", owner.Id, owner.Email)
+	h.CreateEntity(&owner)
+
+	group := groupMocks.GenerateGroup(owner.Id)
+	house := h.createHouseWithGroups([]groupModel.Group{group})
+
+	assert.True(h.T(), h.repository.HasAccess(house.Id, owner.Id, memberModel.ManageRoles))
+	assert.False(h.T(), h.repository.HasAccess(house.Id, owner.Id, []memberModel.Role{memberModel.Viewer}))
+}
+
+func (h *HouseRepositoryTestSuite) Test_IsOwner() {
+	house := h.createHouse()
+
+	assert.True(h.T(), h.repository.IsOwner(house.Id, h.createdUser.Id))
+	assert.False(h.T(), h.repository.IsOwner(house.Id, uuid.New()))
+}
+
 func (h *HouseRepositoryTestSuite) Test_ExistsById() {
 	house := h.createHouse()
 
@@ -262,6 +321,42 @@ func (h *HouseRepositoryTestSuite) Test_Update_WithUpdatingGroups() {
 	assert.Equal(h.T(), []groupModel.Group{newGroup}, response.Groups)
 }
 
+func (h *HouseRepositoryTestSuite) Test_Update_WithEmptyGroups() {
+	group := groupModel.Group{
+		Id:      uuid.New(),
+		Name:    "Test Group",
+		OwnerId: h.createdUser.Id,
+	}
+
+	house := h.createHouseWithGroups([]groupModel.Group{group})
+
+	err := h.repository.Update(house.Id, model.UpdateHouseRequest{Name: house.Name, GroupIds: []uuid.UUID{}})
+
+	assert.Nil(h.T(), err)
+
+	response, err := h.repository.FindById(house.Id)
+	assert.Nil(h.T(), err)
+	assert.Equal(h.T(), []groupModel.Group{}, response.Groups)
+}
+
+func (h *HouseRepositoryTestSuite) Test_Update_WithoutGroups() {
+	group := groupModel.Group{
+		Id:      uuid.New(),
+		Name:    "Test Group",
+		OwnerId: h.createdUser.Id,
+	}
+
+	house := h.createHouseWithGroups([]groupModel.Group{group})
+
+	err := h.repository.Update(house.Id, model.UpdateHouseRequest{Name: house.Name})
+
+	assert.Nil(h.T(), err)
+
+	response, err := h.repository.FindById(house.Id)
+	assert.Nil(h.T(), err)
+	assert.Equal(h.T(), []groupModel.Group{group}, response.Groups)
+}
+
 func (h *HouseRepositoryTestSuite) Test_Update_WithExtendingGroups() {
 	group := groupModel.Group{
 		Id:      uuid.New(),
@@ -341,6 +436,7 @@ func (h *HouseRepositoryTestSuite) createHouseWithGroups(groups []groupModel.Gro
 
 func truncateDynamic(service db.DatabaseService) {
 	service.D().Exec("DELETE FROM house_groups")
+	database.TruncateTable(service, memberModel.Member{})
 	database.TruncateTable(service, groupModel.Group{})
 	database.TruncateTable(service, model.House{})
 }
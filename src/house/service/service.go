@@ -1,6 +1,7 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"github.com/VlasovArtem/hob/src/common"
 	"github.com/VlasovArtem/hob/src/common/database"
@@ -8,12 +9,14 @@ import (
 	"github.com/VlasovArtem/hob/src/common/int-errors"
 	countryModel "github.com/VlasovArtem/hob/src/country/model"
 	countries "github.com/VlasovArtem/hob/src/country/service"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	groupService "github.com/VlasovArtem/hob/src/group/service"
 	"github.com/VlasovArtem/hob/src/house/model"
 	"github.com/VlasovArtem/hob/src/house/repository"
 	userService "github.com/VlasovArtem/hob/src/user/service"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"strings"
 )
 
 type HouseServiceObject struct {
@@ -53,11 +56,15 @@ func (h *HouseServiceObject) Initialize(factory dependency.DependenciesProvider)
 type HouseService interface {
 	Add(house model.CreateHouseRequest) (model.HouseDto, error)
 	AddBatch(house model.CreateHouseBatchRequest) ([]model.HouseDto, error)
-	FindById(id uuid.UUID) (model.HouseDto, error)
+	FindById(id uuid.UUID, userId uuid.UUID) (model.HouseDto, error)
 	FindByUserId(userId uuid.UUID) []model.HouseDto
 	ExistsById(id uuid.UUID) bool
-	DeleteById(id uuid.UUID) error
-	Update(id uuid.UUID, request model.UpdateHouseRequest) error
+	HasAccess(id uuid.UUID, userId uuid.UUID) bool
+	CanModify(id uuid.UUID, userId uuid.UUID) bool
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateHouseRequest) error
+	ResolveCurrency(id *uuid.UUID, currency string) (string, error)
+	CountryCurrencies() map[string]string
 }
 
 func (h *HouseServiceObject) Add(request model.CreateHouseRequest) (response model.HouseDto, err error) {
@@ -65,7 +72,7 @@ func (h *HouseServiceObject) Add(request model.CreateHouseRequest) (response mod
 		return response, err
 	} else if !h.userService.ExistsById(request.UserId) {
 		return response, int_errors.NewErrNotFound("user with id %s not found", request.UserId)
-	} else if len(request.GroupIds) != 0 && !h.groupService.ExistsByIds(request.GroupIds) {
+	} else if len(request.GroupIds) != 0 && !h.groupService.CanModify(request.GroupIds, request.UserId) {
 		return response, int_errors.NewErrNotFound("not all group with ids %s found", common.Join(request.GroupIds, ","))
 	} else {
 		entity := request.ToEntity(&country)
@@ -85,14 +92,17 @@ func (h *HouseServiceObject) AddBatch(request model.CreateHouseBatchRequest) ([]
 
 	countryShortName := make(map[string]bool)
 	userIds := make(map[uuid.UUID]bool)
-	groups := make(map[uuid.UUID]bool)
+	groups := make(map[uuid.UUID]map[uuid.UUID]bool)
 
 	for _, createHouseRequest := range request.Houses {
 		countryShortName[createHouseRequest.CountryCode] = true
 		userIds[createHouseRequest.UserId] = true
 		if len(createHouseRequest.GroupIds) != 0 {
+			if _, ok := groups[createHouseRequest.UserId]; !ok {
+				groups[createHouseRequest.UserId] = make(map[uuid.UUID]bool)
+			}
 			for _, groupId := range createHouseRequest.GroupIds {
-				groups[groupId] = true
+				groups[createHouseRequest.UserId][groupId] = true
 			}
 		}
 	}
@@ -115,14 +125,16 @@ func (h *HouseServiceObject) AddBatch(request model.CreateHouseBatchRequest) ([]
 		}
 	}
 
-	var groupIds []uuid.UUID
+	for userId, userGroups := range groups {
+		var groupIds []uuid.UUID
 
-	for groupId := range groups {
-		groupIds = append(groupIds, groupId)
-	}
+		for groupId := range userGroups {
+			groupIds = append(groupIds, groupId)
+		}
 
-	if len(groupIds) != 0 && !h.groupService.ExistsByIds(groupIds) {
-		builder.WithDetail(fmt.Sprintf("not all group with ids %s found", common.Join(groupIds, ",")))
+		if !h.groupService.CanModify(groupIds, userId) {
+			builder.WithDetail(fmt.Sprintf("not all group with ids %s found", common.Join(groupIds, ",")))
+		}
 	}
 
 	if builder.HasErrors() {
@@ -147,7 +159,10 @@ func (h *HouseServiceObject) AddBatch(request model.CreateHouseBatchRequest) ([]
 	}
 }
 
-func (h *HouseServiceObject) FindById(id uuid.UUID) (response model.HouseDto, err error) {
+func (h *HouseServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (response model.HouseDto, err error) {
+	if !h.HasAccess(id, userId) {
+		return response, notFoundError(id)
+	}
 	if entity, err := h.houseRepository.FindById(id); err != nil {
 		return response, database.HandlerFindError(err, "house with id %s not found", id)
 	} else {
@@ -167,19 +182,37 @@ func (h *HouseServiceObject) ExistsById(id uuid.UUID) bool {
 	return h.houseRepository.ExistsById(id)
 }
 
-func (h *HouseServiceObject) DeleteById(id uuid.UUID) error {
-	if !h.ExistsById(id) {
-		return int_errors.NewErrNotFound("house with id %s not found", id)
+// HasAccess checks that the user owns the house or the house is shared with the user via the group
+func (h *HouseServiceObject) HasAccess(id uuid.UUID, userId uuid.UUID) bool {
+	return h.houseRepository.HasAccess(id, userId, memberModel.ReadRoles)
+}
+
+// CanModify checks that the user owns the house or the house is shared with the user via the group with the editor or owner role
+func (h *HouseServiceObject) CanModify(id uuid.UUID, userId uuid.UUID) bool {
+	return h.houseRepository.HasAccess(id, userId, memberModel.ModifyRoles)
+}
+
+// DeleteById removes the house, only the owner of the house is allowed to delete it
+func (h *HouseServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if !h.houseRepository.IsOwner(id, userId) {
+		return notFoundError(id)
 	}
 	return h.houseRepository.DeleteById(id)
 }
 
-func (h *HouseServiceObject) Update(id uuid.UUID, request model.UpdateHouseRequest) error {
-	if !h.ExistsById(id) {
-		return int_errors.NewErrNotFound("house with id %s not found", id)
+// Update updates the house, the groups are kept if the group ids are not set. Only the owner of the house is allowed to
+// change the groups, an empty group ids unshares the house
+func (h *HouseServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateHouseRequest) error {
+	if !h.CanModify(id, userId) {
+		return notFoundError(id)
 	}
-	if len(request.GroupIds) != 0 && !h.groupService.ExistsByIds(request.GroupIds) {
-		return int_errors.NewErrNotFound("not all group with ids %s found", common.Join(request.GroupIds, ","))
+	if request.GroupIds != nil {
+		if !h.houseRepository.IsOwner(id, userId) {
+			return int_errors.NewErrForbidden("only the owner of the house with id %s is allowed to change the groups", id)
+		}
+		if len(request.GroupIds) != 0 && !h.groupService.CanModify(request.GroupIds, userId) {
+			return int_errors.NewErrNotFound("not all group with ids %s found", common.Join(request.GroupIds, ","))
+		}
 	}
 	if _, err := h.countriesService.FindCountryByCode(request.CountryCode); err != nil {
 		return err
@@ -187,3 +220,42 @@ func (h *HouseServiceObject) Update(id uuid.UUID, request model.UpdateHouseReque
 		return h.houseRepository.Update(id, request)
 	}
 }
+
+// ResolveCurrency validates the currency code, the currency of the house country is returned if the code is not set
+func (h *HouseServiceObject) ResolveCurrency(id *uuid.UUID, currency string) (string, error) {
+	if currency = strings.ToUpper(strings.TrimSpace(currency)); currency != "" {
+		if _, err := h.countriesService.FindCurrencyByCode(currency); err != nil {
+			return "", err
+		}
+		return currency, nil
+	}
+	if id == nil {
+		return "", errors.New("currency is missing")
+	}
+
+	house, err := h.houseRepository.FindById(*id)
+	if err != nil {
+		return "", database.HandlerFindError(err, "house with id %s not found", *id)
+	}
+	country, err := h.countriesService.FindCountryByCode(house.CountryCode)
+	if err != nil {
+		return "", err
+	}
+
+	return country.Currency.Code, nil
+}
+
+// CountryCurrencies returns the currency code by the country code
+func (h *HouseServiceObject) CountryCurrencies() map[string]string {
+	currencies := make(map[string]string)
+
+	for _, country := range h.countriesService.FindAllCountries() {
+		currencies[country.Code] = country.Currency.Code
+	}
+
+	return currencies
+}
+
+func notFoundError(id uuid.UUID) error {
+	return int_errors.NewErrNotFound("house with id %s not found", id)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/VlasovArtem/hob/src/common"
 	"github.com/VlasovArtem/hob/src/common/int-errors"
 	countries "github.com/VlasovArtem/hob/src/country/service"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
 	groupMocks "github.com/VlasovArtem/hob/src/group/mocks"
 	groupModel "github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/house/mocks"
@@ -71,7 +72,7 @@ func (h *HouseServiceTestSuite) Test_Add_WithGroupsNotFound() {
 	request.GroupIds = []uuid.UUID{uuid.New()}
 
 	h.users.On("ExistsById", request.UserId).Return(true)
-	h.groups.On("ExistsByIds", mock.Anything).Return(false)
+	h.groups.On("CanModify", mock.Anything, mock.Anything).Return(false)
 
 	income, err := h.TestO.Add(request)
 
@@ -85,7 +86,7 @@ func (h *HouseServiceTestSuite) Test_Add_WithUserNotFound() {
 	request := mocks.GenerateCreateHouseRequest()
 
 	h.users.On("ExistsById", request.UserId).Return(false)
-	h.groups.On("ExistsByIds", mock.Anything).Return(true)
+	h.groups.On("CanModify", mock.Anything, mock.Anything).Return(true)
 
 	income, err := h.TestO.Add(request)
 
@@ -138,7 +139,7 @@ func (h *HouseServiceTestSuite) Test_AddBatch_WithIssues() {
 
 	h.users.On("ExistsById", request.Houses[0].UserId).Return(false)
 	h.users.On("ExistsById", request.Houses[1].UserId).Return(true)
-	h.groups.On("ExistsByIds", mock.Anything).Return(false)
+	h.groups.On("CanModify", mock.Anything, mock.Anything).Return(false)
 
 	actual, err := h.TestO.AddBatch(request)
 
@@ -162,9 +163,10 @@ func (h *HouseServiceTestSuite) Test_AddBatch_WithIssues() {
 func (h *HouseServiceTestSuite) Test_FindById() {
 	house := mocks.GenerateHouse(uuid.New())
 
+	h.houseRepository.On("HasAccess", house.Id, house.UserId, memberModel.ReadRoles).Return(true)
 	h.houseRepository.On("FindById", house.Id).Return(house, nil)
 
-	actual, err := h.TestO.FindById(house.Id)
+	actual, err := h.TestO.FindById(house.Id, house.UserId)
 
 	assert.Nil(h.T(), err)
 	assert.Equal(h.T(), house.ToDto(), actual)
@@ -173,21 +175,36 @@ func (h *HouseServiceTestSuite) Test_FindById() {
 func (h *HouseServiceTestSuite) Test_FindById_WithRecordNotFound() {
 	id := uuid.New()
 
+	h.houseRepository.On("HasAccess", id, mock.Anything, memberModel.ReadRoles).Return(true)
 	h.houseRepository.On("FindById", id).Return(model.House{}, gorm.ErrRecordNotFound)
 
-	actual, err := h.TestO.FindById(id)
+	actual, err := h.TestO.FindById(id, uuid.New())
 
 	assert.Equal(h.T(), int_errors.NewErrNotFound("house with id %s not found", id), err)
 	assert.Equal(h.T(), model.HouseDto{}, actual)
 }
 
+func (h *HouseServiceTestSuite) Test_FindById_WithoutAccess() {
+	house := mocks.GenerateHouse(uuid.New())
+	userId := uuid.New()
+
+	h.houseRepository.On("HasAccess", house.Id, userId, memberModel.ReadRoles).Return(false)
+
+	actual, err := h.TestO.FindById(house.Id, userId)
+
+	assert.Equal(h.T(), int_errors.NewErrNotFound("house with id %s not found", house.Id), err)
+	assert.Equal(h.T(), model.HouseDto{}, actual)
+	h.houseRepository.AssertNotCalled(h.T(), "FindById", house.Id)
+}
+
 func (h *HouseServiceTestSuite) Test_FindById_WithRecordNotFoundExists() {
 	id := uuid.New()
 
 	expectedError := errors.New("error")
+	h.houseRepository.On("HasAccess", id, mock.Anything, memberModel.ReadRoles).Return(true)
 	h.houseRepository.On("FindById", id).Return(model.House{}, expectedError)
 
-	actual, err := h.TestO.FindById(id)
+	actual, err := h.TestO.FindById(id, uuid.New())
 
 	assert.Equal(h.T(), expectedError, err)
 	assert.Equal(h.T(), model.HouseDto{}, actual)
@@ -229,50 +246,77 @@ func (h *HouseServiceTestSuite) Test_ExistsById_WithNotExists() {
 	assert.False(h.T(), h.TestO.ExistsById(id))
 }
 
+func (h *HouseServiceTestSuite) Test_HasAccess() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	h.houseRepository.On("HasAccess", houseId, userId, memberModel.ReadRoles).Return(true)
+
+	assert.True(h.T(), h.TestO.HasAccess(houseId, userId))
+}
+
+func (h *HouseServiceTestSuite) Test_CanModify() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	h.houseRepository.On("HasAccess", houseId, userId, memberModel.ModifyRoles).Return(true)
+
+	assert.True(h.T(), h.TestO.CanModify(houseId, userId))
+}
+
+func (h *HouseServiceTestSuite) Test_CanModify_WithViewer() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	h.houseRepository.On("HasAccess", houseId, userId, memberModel.ModifyRoles).Return(false)
+
+	assert.False(h.T(), h.TestO.CanModify(houseId, userId))
+}
+
 func (h *HouseServiceTestSuite) Test_DeleteById() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
-	h.houseRepository.On("ExistsById", id).Return(true)
+	h.houseRepository.On("IsOwner", id, userId).Return(true)
 	h.houseRepository.On("DeleteById", id).Return(nil)
 
-	assert.Nil(h.T(), h.TestO.DeleteById(id))
+	assert.Nil(h.T(), h.TestO.DeleteById(id, userId))
 }
 
 func (h *HouseServiceTestSuite) Test_DeleteById_WithNotExists() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
-	h.houseRepository.On("ExistsById", id).Return(false)
+	h.houseRepository.On("IsOwner", id, userId).Return(false)
 
-	assert.Equal(h.T(), int_errors.NewErrNotFound("house with id %s not found", id), h.TestO.DeleteById(id))
+	assert.Equal(h.T(), int_errors.NewErrNotFound("house with id %s not found", id), h.TestO.DeleteById(id, userId))
 
 	h.houseRepository.AssertNotCalled(h.T(), "DeleteById", id)
 }
 
 func (h *HouseServiceTestSuite) Test_Update() {
 	id, request := mocks.GenerateUpdateHouseRequest()
+	userId := uuid.New()
 
-	h.houseRepository.On("ExistsById", id).Return(true)
+	h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(true)
 	h.houseRepository.On("Update", id, request).Return(nil)
 
-	assert.Nil(h.T(), h.TestO.Update(id, request))
+	assert.Nil(h.T(), h.TestO.Update(id, userId, request))
 }
 
 func (h *HouseServiceTestSuite) Test_Update_WithErrorFromDatabase() {
 	id, request := mocks.GenerateUpdateHouseRequest()
+	userId := uuid.New()
 
-	h.houseRepository.On("ExistsById", id).Return(true)
+	h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(true)
 	h.houseRepository.On("Update", id, request).Return(errors.New("test"))
 
-	err := h.TestO.Update(id, request)
+	err := h.TestO.Update(id, userId, request)
 	assert.Equal(h.T(), errors.New("test"), err)
 }
 
 func (h *HouseServiceTestSuite) Test_Update_WithNotExists() {
 	id, request := mocks.GenerateUpdateHouseRequest()
+	userId := uuid.New()
 
-	h.houseRepository.On("ExistsById", id).Return(false)
+	h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(false)
 
-	err := h.TestO.Update(id, request)
+	err := h.TestO.Update(id, userId, request)
 	assert.Equal(h.T(), int_errors.NewErrNotFound("house with id %s not found", id), err)
 
 	h.houseRepository.AssertNotCalled(h.T(), "Update", id, request)
@@ -280,11 +324,12 @@ func (h *HouseServiceTestSuite) Test_Update_WithNotExists() {
 
 func (h *HouseServiceTestSuite) Test_Update_WithNotMatchingCountry() {
 	id, request := mocks.GenerateUpdateHouseRequest()
+	userId := uuid.New()
 	request.CountryCode = "invalid"
 
-	h.houseRepository.On("ExistsById", id).Return(true)
+	h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(true)
 
-	err := h.TestO.Update(id, request)
+	err := h.TestO.Update(id, userId, request)
 	assert.Equal(h.T(), int_errors.NewErrNotFound("country with code %s is not found", request.CountryCode), err)
 
 	h.houseRepository.AssertNotCalled(h.T(), "Update", id, request)
@@ -292,14 +337,133 @@ func (h *HouseServiceTestSuite) Test_Update_WithNotMatchingCountry() {
 
 func (h *HouseServiceTestSuite) Test_Update_WithGroupsIdsNotFound() {
 	id, request := mocks.GenerateUpdateHouseRequest()
+	userId := uuid.New()
 	request.GroupIds = []uuid.UUID{uuid.New()}
 
-	h.houseRepository.On("ExistsById", id).Return(true)
-	h.groups.On("ExistsByIds", mock.Anything).Return(false)
+	h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(true)
+	h.houseRepository.On("IsOwner", id, userId).Return(true)
+	h.groups.On("CanModify", mock.Anything, mock.Anything).Return(false)
 
-	err := h.TestO.Update(id, request)
+	err := h.TestO.Update(id, userId, request)
 
 	assert.Equal(h.T(), int_errors.NewErrNotFound("not all group with ids %s found", common.Join(request.GroupIds, ",")), err)
 
 	h.houseRepository.AssertNotCalled(h.T(), "Update", id, request)
 }
+
+func (h *HouseServiceTestSuite) Test_Update_WithGroupIds() {
+	id, request := mocks.GenerateUpdateHouseRequest()
+	userId := uuid.New()
+	request.GroupIds = []uuid.UUID{uuid.New()}
+
+	h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(true)
+	h.houseRepository.On("IsOwner", id, userId).Return(true)
+	h.groups.On("CanModify", request.GroupIds, userId).Return(true)
+	h.houseRepository.On("Update", id, request).Return(nil)
+
+	assert.Nil(h.T(), h.TestO.Update(id, userId, request))
+}
+
+func (h *HouseServiceTestSuite) Test_Update_WithEmptyGroupIds() {
+	id, request := mocks.GenerateUpdateHouseRequest()
+	userId := uuid.New()
+	request.GroupIds = []uuid.UUID{}
+
+	h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(true)
+	h.houseRepository.On("IsOwner", id, userId).Return(true)
+	h.houseRepository.On("Update", id, request).Return(nil)
+
+	assert.Nil(h.T(), h.TestO.Update(id, userId, request))
+
+	h.groups.AssertNotCalled(h.T(), "CanModify", mock.Anything, mock.Anything)
+}
+
+func (h *HouseServiceTestSuite) Test_Update_WithoutGroupIds() {
+	id, request := mocks.GenerateUpdateHouseRequest()
+	userId := uuid.New()
+
+	h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(true)
+	h.houseRepository.On("Update", id, request).Return(nil)
+
+	assert.Nil(h.T(), h.TestO.Update(id, userId, request))
+
+	h.houseRepository.AssertNotCalled(h.T(), "IsOwner", id, userId)
+}
+
+func (h *HouseServiceTestSuite) Test_Update_WithGroupIdsByEditor() {
+	tests := []struct {
+		name     string
+		groupIds []uuid.UUID
+	}{
+		{"empty", []uuid.UUID{}},
+		{"own groups", []uuid.UUID{uuid.New()}},
+	}
+
+	for _, test := range tests {
+		h.Run(test.name, func() {
+			id, request := mocks.GenerateUpdateHouseRequest()
+			userId := uuid.New()
+			request.GroupIds = test.groupIds
+
+			h.houseRepository.On("HasAccess", id, userId, memberModel.ModifyRoles).Return(true)
+			h.houseRepository.On("IsOwner", id, userId).Return(false)
+			h.groups.On("CanModify", request.GroupIds, userId).Return(true)
+
+			err := h.TestO.Update(id, userId, request)
+
+			assert.Equal(h.T(), int_errors.NewErrForbidden("only the owner of the house with id %s is allowed to change the groups", id), err)
+			h.houseRepository.AssertNotCalled(h.T(), "Update", id, request)
+		})
+	}
+}
+
+func (h *HouseServiceTestSuite) Test_ResolveCurrency() {
+	currency, err := h.TestO.ResolveCurrency(nil, " eur ")
+
+	assert.Nil(h.T(), err)
+	assert.Equal(h.T(), "EUR", currency)
+	h.houseRepository.AssertNotCalled(h.T(), "FindById", mock.Anything)
+}
+
+func (h *HouseServiceTestSuite) Test_ResolveCurrency_WithNotSupportedCurrency() {
+	currency, err := h.TestO.ResolveCurrency(nil, "invalid")
+
+	assert.Equal(h.T(), int_errors.NewErrNotFound("currency with code INVALID is not found"), err)
+	assert.Equal(h.T(), "", currency)
+}
+
+func (h *HouseServiceTestSuite) Test_ResolveCurrency_WithHouseDefault() {
+	house := mocks.GenerateHouse(uuid.New())
+
+	h.houseRepository.On("FindById", house.Id).Return(house, nil)
+
+	currency, err := h.TestO.ResolveCurrency(&house.Id, "")
+
+	assert.Nil(h.T(), err)
+	assert.Equal(h.T(), "UAH", currency)
+}
+
+func (h *HouseServiceTestSuite) Test_ResolveCurrency_WithMissingHouse() {
+	id := uuid.New()
+
+	h.houseRepository.On("FindById", id).Return(model.House{}, gorm.ErrRecordNotFound)
+
+	currency, err := h.TestO.ResolveCurrency(&id, "")
+
+	assert.Equal(h.T(), int_errors.NewErrNotFound("house with id %s not found", id), err)
+	assert.Equal(h.T(), "", currency)
+}
+
+func (h *HouseServiceTestSuite) Test_ResolveCurrency_WithoutHouseAndCurrency() {
+	currency, err := h.TestO.ResolveCurrency(nil, "")
+
+	assert.Equal(h.T(), errors.New("currency is missing"), err)
+	assert.Equal(h.T(), "", currency)
+}
+
+func (h *HouseServiceTestSuite) Test_CountryCurrencies() {
+	currencies := h.TestO.CountryCurrencies()
+
+	assert.Equal(h.T(), "UAH", currencies["UA"])
+	assert.Equal(h.T(), "EUR", currencies["DE"])
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/VlasovArtem/hob/src/common/rest"
 	"github.com/VlasovArtem/hob/src/income/model"
 	"github.com/VlasovArtem/hob/src/income/service"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/gorilla/mux"
 	"net/http"
 )
@@ -43,11 +44,17 @@ type IncomeHandler interface {
 
 func (i *IncomeHandlerObject) Add() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreateIncomeRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Created(i.incomeService.Add(body)).
+				Created(i.incomeService.Add(body, userId)).
 				Perform()
 		}
 	}
@@ -55,12 +62,12 @@ func (i *IncomeHandlerObject) Add() http.HandlerFunc {
 
 func (i *IncomeHandlerObject) Delete() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
 				StatusCode(http.StatusNoContent).
-				Error(i.incomeService.DeleteById(id)).
+				Error(i.incomeService.DeleteById(id, userId)).
 				Perform()
 		}
 	}
@@ -68,14 +75,14 @@ func (i *IncomeHandlerObject) Delete() http.HandlerFunc {
 
 func (i *IncomeHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdateIncomeRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
 				rest.NewAPIResponse(writer).
-					Error(i.incomeService.Update(id, body)).
+					Error(i.incomeService.Update(id, userId, body)).
 					Perform()
 			}
 		}
@@ -84,11 +91,17 @@ func (i *IncomeHandlerObject) Update() http.HandlerFunc {
 
 func (i *IncomeHandlerObject) AddBatch() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreateIncomeBatchRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Created(i.incomeService.AddBatch(body)).
+				Created(i.incomeService.AddBatch(body, userId)).
 				Perform()
 		}
 	}
@@ -96,11 +109,11 @@ func (i *IncomeHandlerObject) AddBatch() http.HandlerFunc {
 
 func (i *IncomeHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(i.incomeService.FindById(id)).
+				Ok(i.incomeService.FindById(id, userId)).
 				Perform()
 		}
 	}
@@ -108,14 +121,20 @@ func (i *IncomeHandlerObject) FindById() http.HandlerFunc {
 
 func (i *IncomeHandlerObject) FindByHouseId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			tagIds, allTags, err := rest.GetRequestTagging(request)
+			if err != nil {
+				rest.HandleWithError(writer, err)
+				return
+			}
+
 			limit, offset := rest.GetRequestPaging(request, 25, 0)
 			from, to := rest.GetRequestFiltering(request)
 
 			rest.NewAPIResponse(writer).
-				Body(i.incomeService.FindByHouseId(id, limit, offset, from, to)).
+				Body(i.incomeService.FindByHouseId(id, userId, limit, offset, from, to, tagModel.Filter{Ids: tagIds, All: allTags})).
 				Perform()
 		}
 	}
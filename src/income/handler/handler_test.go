@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"github.com/VlasovArtem/hob/src/common"
 	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
 	groupModel "github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/income/mocks"
 	"github.com/VlasovArtem/hob/src/income/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -37,13 +39,15 @@ func TestIncomeHandlerTestSuite(t *testing.T) {
 }
 
 func (i *IncomeHandlerTestSuite) Test_Add() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeRequest()
 
-	i.incomes.On("Add", request).Return(request.ToEntity().ToDto(), nil)
+	i.incomes.On("Add", request, userId).Return(request.ToEntity().ToDto(), nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes").
 		WithMethod("POST").
+		WithUser(userId).
 		WithHandler(i.TestO.Add()).
 		WithBody(request)
 
@@ -58,21 +62,23 @@ func (i *IncomeHandlerTestSuite) Test_Add() {
 		Name:        "Name",
 		Date:        mocks.Date,
 		Description: "Description",
-		Sum:         100.1,
+		Sum:         money.FromMinorUnits(10010),
 		HouseId:     actual.HouseId,
 		Groups:      []groupModel.GroupDto{},
 	}, actual)
 }
 
 func (i *IncomeHandlerTestSuite) Test_Add_WithNilHouseId() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeRequest()
 	request.HouseId = nil
 
-	i.incomes.On("Add", request).Return(request.ToEntity().ToDto(), nil)
+	i.incomes.On("Add", request, userId).Return(request.ToEntity().ToDto(), nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes").
 		WithMethod("POST").
+		WithUser(userId).
 		WithHandler(i.TestO.Add()).
 		WithBody(request)
 
@@ -87,7 +93,7 @@ func (i *IncomeHandlerTestSuite) Test_Add_WithNilHouseId() {
 		Name:        "Name",
 		Date:        mocks.Date,
 		Description: "Description",
-		Sum:         100.1,
+		Sum:         money.FromMinorUnits(10010),
 		HouseId:     nil,
 		Groups:      []groupModel.GroupDto{},
 	}, actual)
@@ -97,20 +103,23 @@ func (i *IncomeHandlerTestSuite) Test_Add_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(i.TestO.Add())
 
 	testRequest.Verify(i.T(), http.StatusBadRequest)
 }
 
 func (i *IncomeHandlerTestSuite) Test_Add_WithErrorFromService() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeRequest()
 
 	err := errors.New("error")
-	i.incomes.On("Add", request).Return(model.IncomeDto{}, err)
+	i.incomes.On("Add", request, userId).Return(model.IncomeDto{}, err)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes").
 		WithMethod("POST").
+		WithUser(userId).
 		WithHandler(i.TestO.Add()).
 		WithBody(request)
 
@@ -120,15 +129,17 @@ func (i *IncomeHandlerTestSuite) Test_Add_WithErrorFromService() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_AddBatch() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeBatchRequest(1)
 
-	i.incomes.On("AddBatch", request).Return(common.MapSlice(request.Incomes, func(request model.CreateIncomeRequest) model.IncomeDto {
+	i.incomes.On("AddBatch", request, userId).Return(common.MapSlice(request.Incomes, func(request model.CreateIncomeRequest) model.IncomeDto {
 		return request.ToEntity().ToDto()
 	}), nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/batch").
 		WithMethod("POST").
+		WithUser(userId).
 		WithHandler(i.TestO.AddBatch()).
 		WithBody(request)
 
@@ -145,7 +156,7 @@ func (i *IncomeHandlerTestSuite) Test_AddBatch() {
 			Name:        "Income Name #0",
 			Date:        mocks.Date,
 			Description: "Description",
-			Sum:         100.1,
+			Sum:         money.FromMinorUnits(10010),
 			HouseId:     request.Incomes[0].HouseId,
 			Groups:      []groupModel.GroupDto{},
 		},
@@ -156,12 +167,14 @@ func (i *IncomeHandlerTestSuite) Test_AddBatch_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/batch").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(i.TestO.AddBatch())
 
 	testRequest.Verify(i.T(), http.StatusBadRequest)
 }
 
 func (i *IncomeHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeBatchRequest(1)
 
 	errorResponse := int_errors.NewBuilder().
@@ -169,11 +182,12 @@ func (i *IncomeHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
 		WithMessage("error")
 	err := int_errors.NewErrResponse(errorResponse)
 
-	i.incomes.On("AddBatch", request).Return([]model.IncomeDto{}, err)
+	i.incomes.On("AddBatch", request, userId).Return([]model.IncomeDto{}, err)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/batch").
 		WithMethod("POST").
+		WithUser(userId).
 		WithHandler(i.TestO.AddBatch()).
 		WithBody(request)
 
@@ -186,14 +200,16 @@ func (i *IncomeHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
 	response := mocks.GenerateIncomeDto()
 
-	i.incomes.On("FindById", response.Id).
+	i.incomes.On("FindById", response.Id, userId).
 		Return(response, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(i.TestO.FindById()).
 		WithVar("id", response.Id.String())
 
@@ -207,16 +223,18 @@ func (i *IncomeHandlerTestSuite) Test_FindById() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_FindById_WithError() {
+	userId := uuid.New()
 	id := uuid.New()
 
 	expected := errors.New("error")
 
-	i.incomes.On("FindById", id).
+	i.incomes.On("FindById", id, userId).
 		Return(model.IncomeDto{}, expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(i.TestO.FindById()).
 		WithVar("id", id.String())
 
@@ -229,6 +247,7 @@ func (i *IncomeHandlerTestSuite) Test_FindById_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(i.TestO.FindById()).
 		WithVar("id", "id")
 
@@ -238,15 +257,17 @@ func (i *IncomeHandlerTestSuite) Test_FindById_WithInvalidParameter() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_FindByHouseId() {
+	userId := uuid.New()
 	response := []model.IncomeDto{mocks.GenerateIncomeDto()}
 	from, fromString, to, toString := createFromAndTo()
 
-	i.incomes.On("FindByHouseId", *response[0].HouseId, 10, 0, from, to).
+	i.incomes.On("FindByHouseId", *response[0].HouseId, userId, 10, 0, from, to, tagModel.Filter{}).
 		Return(response, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/house/{id}?limit={limit}&offset={offset}&from={from}&to={to}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(i.TestO.FindByHouseId()).
 		WithVar("id", response[0].HouseId.String()).
 		WithParameter("limit", "10").
@@ -264,15 +285,17 @@ func (i *IncomeHandlerTestSuite) Test_FindByHouseId() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithFrom() {
+	userId := uuid.New()
 	response := []model.IncomeDto{mocks.GenerateIncomeDto()}
 	from, fromString, _, _ := createFromAndTo()
 
-	i.incomes.On("FindByHouseId", *response[0].HouseId, 10, 0, from, nilTime).
+	i.incomes.On("FindByHouseId", *response[0].HouseId, userId, 10, 0, from, nilTime, tagModel.Filter{}).
 		Return(response, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/house/{id}?limit={limit}&offset={offset}&from={from}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(i.TestO.FindByHouseId()).
 		WithVar("id", response[0].HouseId.String()).
 		WithParameter("limit", "10").
@@ -289,14 +312,16 @@ func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithFrom() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithDefaultLimitAndOffset() {
+	userId := uuid.New()
 	response := []model.IncomeDto{mocks.GenerateIncomeDto()}
 
-	i.incomes.On("FindByHouseId", *response[0].HouseId, 25, 0, nilTime, nilTime).
+	i.incomes.On("FindByHouseId", *response[0].HouseId, userId, 25, 0, nilTime, nilTime, tagModel.Filter{}).
 		Return(response, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/house/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(i.TestO.FindByHouseId()).
 		WithVar("id", response[0].HouseId.String())
 
@@ -310,14 +335,16 @@ func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithDefaultLimitAndOffset()
 }
 
 func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithEmptyResult() {
+	userId := uuid.New()
 	id := uuid.New()
 
-	i.incomes.On("FindByHouseId", id, 25, 0, nilTime, nilTime).
+	i.incomes.On("FindByHouseId", id, userId, 25, 0, nilTime, nilTime, tagModel.Filter{}).
 		Return([]model.IncomeDto{})
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/house/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(i.TestO.FindByHouseId()).
 		WithVar("id", id.String())
 
@@ -330,10 +357,45 @@ func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithEmptyResult() {
 	assert.Equal(i.T(), []model.IncomeDto{}, actual)
 }
 
+func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithTags() {
+	userId, id, tagId := uuid.New(), uuid.New(), uuid.New()
+	tags := tagModel.Filter{Ids: []uuid.UUID{tagId}}
+
+	i.incomes.On("FindByHouseId", id, userId, 25, 0, nilTime, nilTime, tags).
+		Return([]model.IncomeDto{})
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/incomes/house/{id}?tagIds={tagIds}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(i.TestO.FindByHouseId()).
+		WithVar("id", id.String()).
+		WithParameter("tagIds", tagId.String())
+
+	testRequest.Verify(i.T(), http.StatusOK)
+
+	i.incomes.AssertCalled(i.T(), "FindByHouseId", id, userId, 25, 0, nilTime, nilTime, tags)
+}
+
+func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithInvalidTagIds() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/incomes/house/{id}?tagIds={tagIds}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(i.TestO.FindByHouseId()).
+		WithVar("id", uuid.New().String()).
+		WithParameter("tagIds", "tag")
+
+	responseByteArray := testRequest.Verify(i.T(), http.StatusBadRequest)
+
+	assert.Equal(i.T(), "the id is not valid UUID\n", string(responseByteArray))
+}
+
 func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/house/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(i.TestO.FindByHouseId()).
 		WithVar("id", "id")
 
@@ -343,13 +405,15 @@ func (i *IncomeHandlerTestSuite) Test_FindByHouseId_WithInvalidParameter() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_Update() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateIncomeRequest()
 
-	i.incomes.On("Update", id, request).Return(nil)
+	i.incomes.On("Update", id, userId, request).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(i.TestO.Update()).
 		WithBody(request).
 		WithVar("id", id.String())
@@ -363,6 +427,7 @@ func (i *IncomeHandlerTestSuite) Test_Update_WithInvalidId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(i.TestO.Update()).
 		WithBody(request).
 		WithVar("id", "id")
@@ -371,13 +436,14 @@ func (i *IncomeHandlerTestSuite) Test_Update_WithInvalidId() {
 
 	assert.Equal(i.T(), "the id is not valid id\n", string(responseByteArray))
 
-	i.incomes.AssertNotCalled(i.T(), "Update", mock.Anything, mock.Anything)
+	i.incomes.AssertNotCalled(i.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (i *IncomeHandlerTestSuite) Test_Update_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(i.TestO.Update()).
 		WithVar("id", uuid.New().String())
 
@@ -385,15 +451,17 @@ func (i *IncomeHandlerTestSuite) Test_Update_WithInvalidRequest() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_Update_WithErrorFromService() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateIncomeRequest()
 
 	expected := errors.New("error")
 
-	i.incomes.On("Update", id, request).Return(expected)
+	i.incomes.On("Update", id, userId, request).Return(expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(i.TestO.Update()).
 		WithVar("id", id.String()).
 		WithBody(request)
@@ -404,13 +472,15 @@ func (i *IncomeHandlerTestSuite) Test_Update_WithErrorFromService() {
 }
 
 func (i *IncomeHandlerTestSuite) Test_Delete() {
+	userId := uuid.New()
 	id := uuid.New()
 
-	i.incomes.On("DeleteById", id).Return(nil)
+	i.incomes.On("DeleteById", id, userId).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("DELETE").
+		WithUser(userId).
 		WithHandler(i.TestO.Delete()).
 		WithVar("id", id.String())
 
@@ -421,6 +491,7 @@ func (i *IncomeHandlerTestSuite) Test_Delete_WithMissingParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(i.TestO.Delete())
 
 	responseByteArray := testRequest.Verify(i.T(), http.StatusBadRequest)
@@ -432,6 +503,7 @@ func (i *IncomeHandlerTestSuite) Test_Delete_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/incomes/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(i.TestO.Delete()).
 		WithVar("id", "id")
 
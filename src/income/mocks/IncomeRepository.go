@@ -6,6 +6,8 @@ import (
 	model "github.com/VlasovArtem/hob/src/income/model"
 	mock "github.com/stretchr/testify/mock"
 
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -16,6 +18,20 @@ type IncomeRepository struct {
 	mock.Mock
 }
 
+// AssignHouseCurrencies provides a mock function with given fields: currencies
+func (_m *IncomeRepository) AssignHouseCurrencies(currencies map[string]string) error {
+	ret := _m.Called(currencies)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string) error); ok {
+		r0 = rf(currencies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: entity
 func (_m *IncomeRepository) Create(entity model.Income) (model.Income, error) {
 	ret := _m.Called(entity)
@@ -111,13 +127,13 @@ func (_m *IncomeRepository) FindByGroupIds(groupIds []uuid.UUID, limit int, offs
 	return r0, r1
 }
 
-// FindByHouseId provides a mock function with given fields: id, limit, offset, from, to
-func (_m *IncomeRepository) FindByHouseId(id uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) ([]model.IncomeDto, error) {
-	ret := _m.Called(id, limit, offset, from, to)
+// FindByHouseId provides a mock function with given fields: id, limit, offset, from, to, tags
+func (_m *IncomeRepository) FindByHouseId(id uuid.UUID, limit int, offset int, from *time.Time, to *time.Time, tags tagModel.Filter) ([]model.IncomeDto, error) {
+	ret := _m.Called(id, limit, offset, from, to, tags)
 
 	var r0 []model.IncomeDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time) []model.IncomeDto); ok {
-		r0 = rf(id, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time, tagModel.Filter) []model.IncomeDto); ok {
+		r0 = rf(id, limit, offset, from, to, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.IncomeDto)
@@ -125,8 +141,8 @@ func (_m *IncomeRepository) FindByHouseId(id uuid.UUID, limit int, offset int, f
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID, int, int, *time.Time, *time.Time) error); ok {
-		r1 = rf(id, limit, offset, from, to)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, int, int, *time.Time, *time.Time, tagModel.Filter) error); ok {
+		r1 = rf(id, limit, offset, from, to, tags)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -155,6 +171,29 @@ func (_m *IncomeRepository) FindById(id uuid.UUID) (model.Income, error) {
 	return r0, r1
 }
 
+// SumByHouseId provides a mock function with given fields: houseId, from, to, groupBy
+func (_m *IncomeRepository) SumByHouseId(houseId uuid.UUID, from *time.Time, to *time.Time, groupBy model.GroupBy) ([]model.Total, error) {
+	ret := _m.Called(houseId, from, to, groupBy)
+
+	var r0 []model.Total
+	if rf, ok := ret.Get(0).(func(uuid.UUID, *time.Time, *time.Time, model.GroupBy) []model.Total); ok {
+		r0 = rf(houseId, from, to, groupBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Total)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, *time.Time, *time.Time, model.GroupBy) error); ok {
+		r1 = rf(houseId, from, to, groupBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: id, request
 func (_m *IncomeRepository) Update(id uuid.UUID, request model.UpdateIncomeRequest) error {
 	ret := _m.Called(id, request)
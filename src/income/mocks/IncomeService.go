@@ -6,6 +6,8 @@ import (
 	model "github.com/VlasovArtem/hob/src/income/model"
 	mock "github.com/stretchr/testify/mock"
 
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -16,20 +18,20 @@ type IncomeService struct {
 	mock.Mock
 }
 
-// Add provides a mock function with given fields: request
-func (_m *IncomeService) Add(request model.CreateIncomeRequest) (model.IncomeDto, error) {
-	ret := _m.Called(request)
+// Add provides a mock function with given fields: request, userId
+func (_m *IncomeService) Add(request model.CreateIncomeRequest, userId uuid.UUID) (model.IncomeDto, error) {
+	ret := _m.Called(request, userId)
 
 	var r0 model.IncomeDto
-	if rf, ok := ret.Get(0).(func(model.CreateIncomeRequest) model.IncomeDto); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(model.CreateIncomeRequest, uuid.UUID) model.IncomeDto); ok {
+		r0 = rf(request, userId)
 	} else {
 		r0 = ret.Get(0).(model.IncomeDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(model.CreateIncomeRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(model.CreateIncomeRequest, uuid.UUID) error); ok {
+		r1 = rf(request, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -37,13 +39,13 @@ func (_m *IncomeService) Add(request model.CreateIncomeRequest) (model.IncomeDto
 	return r0, r1
 }
 
-// AddBatch provides a mock function with given fields: request
-func (_m *IncomeService) AddBatch(request model.CreateIncomeBatchRequest) ([]model.IncomeDto, error) {
-	ret := _m.Called(request)
+// AddBatch provides a mock function with given fields: request, userId
+func (_m *IncomeService) AddBatch(request model.CreateIncomeBatchRequest, userId uuid.UUID) ([]model.IncomeDto, error) {
+	ret := _m.Called(request, userId)
 
 	var r0 []model.IncomeDto
-	if rf, ok := ret.Get(0).(func(model.CreateIncomeBatchRequest) []model.IncomeDto); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(model.CreateIncomeBatchRequest, uuid.UUID) []model.IncomeDto); ok {
+		r0 = rf(request, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.IncomeDto)
@@ -51,8 +53,8 @@ func (_m *IncomeService) AddBatch(request model.CreateIncomeBatchRequest) ([]mod
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(model.CreateIncomeBatchRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(model.CreateIncomeBatchRequest, uuid.UUID) error); ok {
+		r1 = rf(request, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,13 +62,34 @@ func (_m *IncomeService) AddBatch(request model.CreateIncomeBatchRequest) ([]mod
 	return r0, r1
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *IncomeService) DeleteById(id uuid.UUID) error {
-	ret := _m.Called(id)
+// AddScheduled provides a mock function with given fields: request, userId
+func (_m *IncomeService) AddScheduled(request model.CreateIncomeRequest, userId uuid.UUID) (model.IncomeDto, error) {
+	ret := _m.Called(request, userId)
+
+	var r0 model.IncomeDto
+	if rf, ok := ret.Get(0).(func(model.CreateIncomeRequest, uuid.UUID) model.IncomeDto); ok {
+		r0 = rf(request, userId)
+	} else {
+		r0 = ret.Get(0).(model.IncomeDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(model.CreateIncomeRequest, uuid.UUID) error); ok {
+		r1 = rf(request, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteById provides a mock function with given fields: id, userId
+func (_m *IncomeService) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -88,13 +111,13 @@ func (_m *IncomeService) ExistsById(id uuid.UUID) bool {
 	return r0
 }
 
-// FindByGroupIds provides a mock function with given fields: ids, limit, offset, from, to
-func (_m *IncomeService) FindByGroupIds(ids []uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.IncomeDto {
-	ret := _m.Called(ids, limit, offset, from, to)
+// FindByGroupIds provides a mock function with given fields: ids, userId, limit, offset, from, to
+func (_m *IncomeService) FindByGroupIds(ids []uuid.UUID, userId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.IncomeDto {
+	ret := _m.Called(ids, userId, limit, offset, from, to)
 
 	var r0 []model.IncomeDto
-	if rf, ok := ret.Get(0).(func([]uuid.UUID, int, int, *time.Time, *time.Time) []model.IncomeDto); ok {
-		r0 = rf(ids, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, uuid.UUID, int, int, *time.Time, *time.Time) []model.IncomeDto); ok {
+		r0 = rf(ids, userId, limit, offset, from, to)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.IncomeDto)
@@ -104,13 +127,13 @@ func (_m *IncomeService) FindByGroupIds(ids []uuid.UUID, limit int, offset int,
 	return r0
 }
 
-// FindByHouseId provides a mock function with given fields: id, limit, offset, from, to
-func (_m *IncomeService) FindByHouseId(id uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.IncomeDto {
-	ret := _m.Called(id, limit, offset, from, to)
+// FindByHouseId provides a mock function with given fields: id, userId, limit, offset, from, to, tags
+func (_m *IncomeService) FindByHouseId(id uuid.UUID, userId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time, tags tagModel.Filter) []model.IncomeDto {
+	ret := _m.Called(id, userId, limit, offset, from, to, tags)
 
 	var r0 []model.IncomeDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time) []model.IncomeDto); ok {
-		r0 = rf(id, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int, int, *time.Time, *time.Time, tagModel.Filter) []model.IncomeDto); ok {
+		r0 = rf(id, userId, limit, offset, from, to, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.IncomeDto)
@@ -120,20 +143,20 @@ func (_m *IncomeService) FindByHouseId(id uuid.UUID, limit int, offset int, from
 	return r0
 }
 
-// FindById provides a mock function with given fields: id
-func (_m *IncomeService) FindById(id uuid.UUID) (model.IncomeDto, error) {
-	ret := _m.Called(id)
+// FindById provides a mock function with given fields: id, userId
+func (_m *IncomeService) FindById(id uuid.UUID, userId uuid.UUID) (model.IncomeDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.IncomeDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.IncomeDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.IncomeDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.IncomeDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -141,13 +164,13 @@ func (_m *IncomeService) FindById(id uuid.UUID) (model.IncomeDto, error) {
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *IncomeService) Update(id uuid.UUID, request model.UpdateIncomeRequest) error {
-	ret := _m.Called(id, request)
+// Update provides a mock function with given fields: id, userId, request
+func (_m *IncomeService) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateIncomeRequest) error {
+	ret := _m.Called(id, userId, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdateIncomeRequest) error); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, model.UpdateIncomeRequest) error); ok {
+		r0 = rf(id, userId, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/income/model"
 	"github.com/google/uuid"
 	"strconv"
@@ -15,7 +16,8 @@ func GenerateIncome(houseId *uuid.UUID) model.Income {
 		Name:        "Name",
 		Date:        time.Now().Truncate(time.Microsecond),
 		Description: "Description",
-		Sum:         100.1,
+		Sum:         money.FromMinorUnits(10010),
+		Currency:    "UAH",
 		HouseId:     houseId,
 	}
 }
@@ -26,7 +28,8 @@ func GenerateCreateIncomeRequest() model.CreateIncomeRequest {
 		Name:        "Name",
 		Date:        Date,
 		Description: "Description",
-		Sum:         100.1,
+		Sum:         money.FromMinorUnits(10010),
+		Currency:    "UAH",
 		HouseId:     &houseId,
 	}
 }
@@ -46,7 +49,8 @@ func GenerateUpdateIncomeRequest() (uuid.UUID, model.UpdateIncomeRequest) {
 		Name:        "Name",
 		Date:        Date,
 		Description: "Description",
-		Sum:         100.1,
+		Sum:         money.FromMinorUnits(10010),
+		Currency:    "UAH",
 	}
 }
 
@@ -57,7 +61,8 @@ func GenerateIncomeDto() model.IncomeDto {
 		Name:        "Name",
 		Date:        Date,
 		Description: "Description",
-		Sum:         100.1,
+		Sum:         money.FromMinorUnits(10010),
+		Currency:    "UAH",
 		HouseId:     &houseId,
 	}
 }
@@ -1,9 +1,12 @@
 package model
 
 import (
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
 	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/money"
 	groupModel "github.com/VlasovArtem/hob/src/group/model"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/google/uuid"
 	"time"
 )
@@ -13,19 +16,27 @@ type Income struct {
 	Name        string
 	Description string
 	Date        time.Time
-	Sum         float32
-	HouseId     *uuid.UUID
-	House       houseModel.House   `gorm:"foreignKey:HouseId"`
-	Groups      []groupModel.Group `gorm:"many2many:income_groups"`
+	Sum         money.Money
+	// Currency is the ISO 4217 code of the sum
+	Currency   string
+	HouseId    *uuid.UUID
+	House      houseModel.House `gorm:"foreignKey:HouseId"`
+	CategoryId *uuid.UUID
+	Category   categoryModel.Category `gorm:"foreignKey:CategoryId"`
+	Groups     []groupModel.Group     `gorm:"many2many:income_groups"`
+	Tags       []tagModel.Tag         `gorm:"many2many:income_tags"`
 }
 
 type CreateIncomeRequest struct {
 	Name        string
 	Description string
 	Date        time.Time
-	Sum         float32
+	Sum         money.Money
+	Currency    string
 	HouseId     *uuid.UUID
+	CategoryId  *uuid.UUID
 	GroupIds    []uuid.UUID
+	TagIds      []uuid.UUID
 }
 
 type CreateIncomeBatchRequest struct {
@@ -36,8 +47,11 @@ type UpdateIncomeRequest struct {
 	Name        string
 	Description string
 	Date        time.Time
-	Sum         float32
+	Sum         money.Money
+	Currency    string
+	CategoryId  *uuid.UUID
 	GroupIds    []uuid.UUID
+	TagIds      []uuid.UUID
 }
 
 type IncomeDto struct {
@@ -45,9 +59,37 @@ type IncomeDto struct {
 	Name        string
 	Description string
 	Date        time.Time
-	Sum         float32
+	Sum         money.Money
+	Currency    string
 	HouseId     *uuid.UUID
+	CategoryId  *uuid.UUID
 	Groups      []groupModel.GroupDto
+	Tags        []tagModel.TagDto
+}
+
+// GroupBy is the field the sums of the incomes are grouped by, the incomes have no provider
+type GroupBy string
+
+const (
+	GroupByMonth    GroupBy = "month"
+	GroupByProvider GroupBy = "provider"
+	GroupByCategory GroupBy = "category"
+	// GroupByDate keeps the dates of the sums for the conversion with the exchange rates of the dates
+	GroupByDate GroupBy = "date"
+	// GroupByTag sums the tagged incomes by the tag, the income with several tags is counted in each of them
+	GroupByTag GroupBy = "tag"
+)
+
+// Total is the sum of the incomes of the group in the currency aggregated in the database, only the field of the group
+// is set
+type Total struct {
+	Month      *time.Time
+	Date       *time.Time
+	TagId      *uuid.UUID
+	TagName    string
+	CategoryId *uuid.UUID
+	Currency   string
+	Sum        money.Money
 }
 
 func (i Income) ToDto() IncomeDto {
@@ -57,8 +99,11 @@ func (i Income) ToDto() IncomeDto {
 		Description: i.Description,
 		Date:        i.Date,
 		Sum:         i.Sum,
+		Currency:    i.Currency,
 		HouseId:     i.HouseId,
+		CategoryId:  i.CategoryId,
 		Groups:      common.MapSlice(i.Groups, groupModel.GroupToGroupDto),
+		Tags:        common.MapSlice(i.Tags, tagModel.TagToTagDto),
 	}
 }
 
@@ -69,11 +114,38 @@ func (c CreateIncomeRequest) ToEntity() Income {
 		Description: c.Description,
 		Date:        c.Date,
 		Sum:         c.Sum,
+		Currency:    c.Currency,
 		HouseId:     c.HouseId,
+		CategoryId:  c.CategoryId,
 		Groups: common.MapSlice(c.GroupIds, func(groupId uuid.UUID) groupModel.Group {
 			return groupModel.Group{Id: groupId}
 		}),
+		Tags: common.MapSlice(c.TagIds, tagModel.TagIdToTag),
+	}
+}
+
+// Column returns the selected expression and the name of the column the sums are grouped by
+func (g GroupBy) Column() (expression string, name string) {
+	switch g {
+	case GroupByProvider:
+		return "provider_id", "provider_id"
+	case GroupByCategory:
+		return "category_id", "category_id"
+	case GroupByDate:
+		return "date", "date"
+	case GroupByTag:
+		return "tags.id AS tag_id, tags.name AS tag_name", "tag_id, tag_name"
+	default:
+		return "date_trunc('month', date) AS month", "month"
+	}
+}
+
+// Join returns the join of the tables the group column is selected from, it is empty if the group is the column of the incomes
+func (g GroupBy) Join() string {
+	if g == GroupByTag {
+		return "JOIN income_tags ON income_tags.income_id = incomes.id JOIN tags ON tags.id = income_tags.tag_id"
 	}
+	return ""
 }
 
 func IncomeToDto(income Income) IncomeDto {
@@ -3,9 +3,11 @@ package repository
 import (
 	"github.com/VlasovArtem/hob/src/common"
 	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/db"
 	groupModel "github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/income/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/google/uuid"
 	"time"
 )
@@ -24,6 +26,11 @@ func (i *IncomeRepositoryObject) GetEntity() any {
 	return entity
 }
 
+// UpgradeSchema converts the sums stored as the decimals to the minor units
+func (i *IncomeRepositoryObject) UpgradeSchema() error {
+	return i.db.UpgradeMoneyColumn("sum")
+}
+
 func NewIncomeRepository(database db.DatabaseService) IncomeRepository {
 	return &IncomeRepositoryObject{
 		db.ModeledDatabase{
@@ -37,30 +44,33 @@ type IncomeRepository interface {
 	Create(entity model.Income) (model.Income, error)
 	CreateBatch(entity []model.Income) ([]model.Income, error)
 	FindById(id uuid.UUID) (model.Income, error)
-	FindByHouseId(id uuid.UUID, limit int, offset int, from, to *time.Time) ([]model.IncomeDto, error)
+	FindByHouseId(id uuid.UUID, limit int, offset int, from, to *time.Time, tags tagModel.Filter) ([]model.IncomeDto, error)
 	FindByGroupIds(groupIds []uuid.UUID, limit int, offset int, from, to *time.Time) ([]model.IncomeDto, error)
+	SumByHouseId(houseId uuid.UUID, from, to *time.Time, groupBy model.GroupBy) ([]model.Total, error)
 	ExistsById(id uuid.UUID) bool
 	DeleteById(id uuid.UUID) error
 	Update(id uuid.UUID, request model.UpdateIncomeRequest) error
+	AssignHouseCurrencies(currencies map[string]string) error
 }
 
 func (i *IncomeRepositoryObject) Create(entity model.Income) (model.Income, error) {
-	return entity, i.db.D().Omit("Groups.*").Create(&entity).Error
+	return entity, i.db.D().Omit("Groups.*", "Tags.*").Create(&entity).Error
 }
 
 func (i *IncomeRepositoryObject) CreateBatch(entities []model.Income) ([]model.Income, error) {
-	return entities, i.db.D().Omit("Groups.*").Create(&entities).Error
+	return entities, i.db.D().Omit("Groups.*", "Tags.*").Create(&entities).Error
 }
 
 func (i *IncomeRepositoryObject) FindById(id uuid.UUID) (response model.Income, err error) {
 	response.Id = id
-	if err = i.db.D().Preload("Groups").First(&response).Error; err != nil {
+	if err = i.db.D().Preload("Groups").Preload("Tags").First(&response).Error; err != nil {
 		return model.Income{}, err
 	}
 	return response, err
 }
 
-func (i *IncomeRepositoryObject) FindByHouseId(id uuid.UUID, limit int, offset int, from, to *time.Time) (response []model.IncomeDto, err error) {
+// FindByHouseId returns the incomes of the house and its groups, the incomes are filtered by the tags
+func (i *IncomeRepositoryObject) FindByHouseId(id uuid.UUID, limit int, offset int, from, to *time.Time, tags tagModel.Filter) (response []model.IncomeDto, err error) {
 	var responseEntities []model.Income
 
 	whereQuery := "(incomes.house_id = ? OR hg.house_id = ?)"
@@ -74,6 +84,12 @@ func (i *IncomeRepositoryObject) FindByHouseId(id uuid.UUID, limit int, offset i
 		whereArgs = append(whereArgs, from)
 	}
 
+	if !tags.IsEmpty() {
+		tagsQuery, tagsArgs := tags.Condition("incomes.id", "income_tags", "income_id")
+		whereQuery += " AND " + tagsQuery
+		whereArgs = append(whereArgs, tagsArgs...)
+	}
+
 	if err := i.db.D().
 		Joins("FULL JOIN income_groups ig ON ig.income_id = incomes.id FULL JOIN house_groups hg ON hg.group_id = ig.group_id").
 		Order("incomes.date desc").
@@ -81,6 +97,7 @@ func (i *IncomeRepositoryObject) FindByHouseId(id uuid.UUID, limit int, offset i
 		Limit(limit).
 		Offset(offset).
 		Preload("Groups").
+		Preload("Tags").
 		Find(&responseEntities).Error; err != nil {
 		return []model.IncomeDto{}, err
 	}
@@ -113,7 +130,7 @@ func (i *IncomeRepositoryObject) FindByGroupIds(groupIds []uuid.UUID, limit int,
 		Where(whereQuery, whereArgs...).
 		Limit(limit).
 		Offset(offset).
-		Joins("JOIN income_groups ON income_groups.income_id = incomes.id AND income_groups.group_id IN ?", groupIds).Preload("Groups").Find(&responseEntity).Error; err != nil {
+		Joins("JOIN income_groups ON income_groups.income_id = incomes.id AND income_groups.group_id IN ?", groupIds).Preload("Groups").Preload("Tags").Find(&responseEntity).Error; err != nil {
 		return []model.IncomeDto{}, err
 	}
 	return common.MapSlice(responseEntity, func(entity model.Income) model.IncomeDto {
@@ -121,12 +138,44 @@ func (i *IncomeRepositoryObject) FindByGroupIds(groupIds []uuid.UUID, limit int,
 	}), nil
 }
 
+// SumByHouseId returns the sums of the incomes of the house and its groups by the group and the currency, the incomes are
+// filtered by the period bounds that are set. The incomes have no provider, they are summed only by the currency if they
+// are grouped by the provider
+func (i *IncomeRepositoryObject) SumByHouseId(houseId uuid.UUID, from, to *time.Time, groupBy model.GroupBy) (totals []model.Total, err error) {
+	query := i.db.Modeled().Where(
+		"(incomes.house_id = ? OR incomes.id IN (SELECT ig.income_id FROM income_groups ig JOIN house_groups hg ON hg.group_id = ig.group_id WHERE hg.house_id = ?))",
+		houseId, houseId,
+	)
+
+	if join := groupBy.Join(); join != "" {
+		query = query.Joins(join)
+	}
+	if from != nil {
+		query = query.Where("date >= ?", from)
+	}
+	if to != nil {
+		query = query.Where("date <= ?", to)
+	}
+
+	selection, group := "currency, SUM(sum) AS sum", "currency"
+	if groupBy != model.GroupByProvider {
+		expression, column := groupBy.Column()
+		selection, group = expression+", "+selection, column+", "+group
+	}
+
+	if err = query.Select(selection).Group(group).Order(group).Scan(&totals).Error; err != nil {
+		return []model.Total{}, err
+	}
+	return totals, nil
+}
+
 func (i *IncomeRepositoryObject) ExistsById(id uuid.UUID) bool {
 	return i.db.Exists(id)
 }
 
+// DeleteById removes the income with the references to its tags
 func (i *IncomeRepositoryObject) DeleteById(id uuid.UUID) error {
-	return i.db.Delete(id)
+	return i.db.D().Select("Tags").Delete(&model.Income{Id: id}).Error
 }
 
 func (i *IncomeRepositoryObject) Update(id uuid.UUID, request model.UpdateIncomeRequest) error {
@@ -134,12 +183,16 @@ func (i *IncomeRepositoryObject) Update(id uuid.UUID, request model.UpdateIncome
 		Name        string
 		Description string
 		Date        time.Time
-		Sum         float32
+		Sum         money.Money
+		Currency    string
+		CategoryId  *uuid.UUID
 	}{
 		request.Name,
 		request.Description,
 		request.Date,
 		request.Sum,
+		request.Currency,
+		request.CategoryId,
 	})
 
 	if err != nil {
@@ -154,5 +207,16 @@ func (i *IncomeRepositoryObject) Update(id uuid.UUID, request model.UpdateIncome
 
 	var groups = common.MapSlice(request.GroupIds, groupModel.GroupIdToGroup)
 
-	return i.db.DM(&entity).Association("Groups").Replace(groups)
+	if err = i.db.DM(&entity).Association("Groups").Replace(groups); err != nil {
+		return err
+	}
+
+	var tags = common.MapSlice(request.TagIds, tagModel.TagIdToTag)
+
+	return i.db.DM(&entity).Omit("Tags.*").Association("Tags").Replace(tags)
+}
+
+// AssignHouseCurrencies sets the currency of the house country to the incomes persisted before the currency was stored
+func (i *IncomeRepositoryObject) AssignHouseCurrencies(currencies map[string]string) error {
+	return i.db.AssignHouseCurrencies(currencies)
 }
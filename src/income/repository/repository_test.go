@@ -2,12 +2,15 @@ package repository
 
 import (
 	"fmt"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/db"
 	groupModel "github.com/VlasovArtem/hob/src/group/model"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
 	"github.com/VlasovArtem/hob/src/income/mocks"
 	"github.com/VlasovArtem/hob/src/income/model"
+	tagMocks "github.com/VlasovArtem/hob/src/tag/mocks"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper/database"
 	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
 	userModel "github.com/VlasovArtem/hob/src/user/model"
@@ -37,12 +40,13 @@ func (i *IncomeRepositoryTestSuite) SetupSuite() {
 		AddAfterTest(truncateDynamic).
 		AddAfterSuite(func(service db.DatabaseService) {
 			service.D().Exec("DELETE FROM income_groups")
+			database.TruncateTable(service, tagModel.Tag{})
 			database.TruncateTable(service, groupModel.Group{})
 			database.TruncateTable(service, model.Income{})
 			database.TruncateTable(service, houseModel.House{})
 			database.TruncateTable(service, userModel.User{})
 		}).
-		ExecuteMigration(userModel.User{}, groupModel.Group{}, houseModel.House{}, model.Income{})
+		ExecuteMigration(userModel.User{}, groupModel.Group{}, houseModel.House{}, tagModel.Tag{}, model.Income{})
 
 	i.createdUser = userMocks.GenerateUser()
 	i.CreateEntity(&i.createdUser)
@@ -104,6 +108,7 @@ func (i *IncomeRepositoryTestSuite) Test_FindById() {
 	actual, err := i.repository.FindById(income.Id)
 
 	income.Groups = []groupModel.Group{}
+	income.Tags = []tagModel.Tag{}
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), income, actual)
 }
@@ -118,7 +123,7 @@ func (i *IncomeRepositoryTestSuite) Test_FindById_WithMissingId() {
 func (i *IncomeRepositoryTestSuite) Test_FindByHouseId() {
 	income := i.createIncomeWithHouse()
 
-	actual, err := i.repository.FindByHouseId(*income.HouseId, 10, 0, nil, nil)
+	actual, err := i.repository.FindByHouseId(*income.HouseId, 10, 0, nil, nil, tagModel.Filter{})
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), []model.IncomeDto{income.ToDto()}, actual)
@@ -136,7 +141,7 @@ func (i *IncomeRepositoryTestSuite) Test_FindByHouseId_WithFromAndTo() {
 	from := time.Now().Add(-time.Hour * 12)
 	to := time.Now()
 
-	actual, err := i.repository.FindByHouseId(i.createdHouse.Id, 10, 0, &from, &to)
+	actual, err := i.repository.FindByHouseId(i.createdHouse.Id, 10, 0, &from, &to, tagModel.Filter{})
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), []model.IncomeDto{second.ToDto()}, actual)
@@ -153,7 +158,7 @@ func (i *IncomeRepositoryTestSuite) Test_FindByHouseId_WithFrom() {
 
 	from := time.Now().Add(-time.Hour * 12)
 
-	actual, err := i.repository.FindByHouseId(i.createdHouse.Id, 10, 0, &from, nil)
+	actual, err := i.repository.FindByHouseId(i.createdHouse.Id, 10, 0, &from, nil, tagModel.Filter{})
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), []model.IncomeDto{second.ToDto()}, actual)
@@ -174,19 +179,85 @@ func (i *IncomeRepositoryTestSuite) Test_FindByHouseId_WithGroupsAndHouseId() {
 	incomeWithGroups.Date = time.Now().Truncate(time.Microsecond)
 	i.CreateEntity(&incomeWithGroups)
 
-	actual, err := i.repository.FindByHouseId(house.Id, 10, 0, nil, nil)
+	actual, err := i.repository.FindByHouseId(house.Id, 10, 0, nil, nil, tagModel.Filter{})
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), []model.IncomeDto{incomeWithGroups.ToDto(), incomeWithHouseId.ToDto()}, actual)
 }
 
+func (i *IncomeRepositoryTestSuite) Test_FindByHouseId_WithTags() {
+	salary := tagMocks.GenerateTag(i.createdUser.Id, "salary")
+	i.CreateEntity(&salary)
+
+	_ = i.createIncome()
+	tagged := mocks.GenerateIncome(&i.createdHouse.Id)
+	tagged.Date = time.Now().Truncate(time.Microsecond)
+	tagged.Tags = []tagModel.Tag{salary}
+	i.CreateEntity(&tagged)
+
+	actual, err := i.repository.FindByHouseId(i.createdHouse.Id, 10, 0, nil, nil, tagModel.Filter{Ids: []uuid.UUID{salary.Id}, All: true})
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), []model.IncomeDto{tagged.ToDto()}, actual)
+}
+
 func (i *IncomeRepositoryTestSuite) Test_FindByHouseId_WithMissingId() {
-	actual, err := i.repository.FindByHouseId(uuid.New(), 10, 0, nil, nil)
+	actual, err := i.repository.FindByHouseId(uuid.New(), 10, 0, nil, nil, tagModel.Filter{})
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), []model.IncomeDto{}, actual)
 }
 
+func (i *IncomeRepositoryTestSuite) Test_SumByHouseId() {
+	income := i.createIncome()
+	_ = i.createIncomeWithHouse()
+
+	actual, err := i.repository.SumByHouseId(i.createdHouse.Id, nil, nil, model.GroupByProvider)
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), []model.Total{{Currency: income.Currency, Sum: income.Sum}}, actual)
+}
+
+func (i *IncomeRepositoryTestSuite) Test_SumByHouseId_WithMonthAndFrom() {
+	income := i.createIncome()
+	from := income.Date.Add(-time.Hour)
+
+	actual, err := i.repository.SumByHouseId(i.createdHouse.Id, &from, nil, model.GroupByMonth)
+
+	assert.Nil(i.T(), err)
+	assert.Len(i.T(), actual, 1)
+	assert.NotNil(i.T(), actual[0].Month)
+	assert.Equal(i.T(), income.Sum, actual[0].Sum)
+}
+
+func (i *IncomeRepositoryTestSuite) Test_SumByHouseId_WithDate() {
+	income := i.createIncome()
+
+	actual, err := i.repository.SumByHouseId(i.createdHouse.Id, nil, nil, model.GroupByDate)
+
+	assert.Nil(i.T(), err)
+	assert.Len(i.T(), actual, 1)
+	assert.NotNil(i.T(), actual[0].Date)
+	assert.Equal(i.T(), income.Sum, actual[0].Sum)
+}
+
+func (i *IncomeRepositoryTestSuite) Test_SumByHouseId_WithTag() {
+	salary := tagMocks.GenerateTag(i.createdUser.Id, "salary")
+	i.CreateEntity(&salary)
+
+	_ = i.createIncome()
+	tagged := mocks.GenerateIncome(&i.createdHouse.Id)
+	tagged.Tags = []tagModel.Tag{salary}
+	i.CreateEntity(&tagged)
+
+	actual, err := i.repository.SumByHouseId(i.createdHouse.Id, nil, nil, model.GroupByTag)
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), []model.Total{
+		{TagId: &salary.Id, TagName: salary.Name, Currency: tagged.Currency, Sum: tagged.Sum},
+	}, actual)
+}
+
 func (i *IncomeRepositoryTestSuite) Test_FindByGroupIds() {
 	first := i.createGroup()
 	second := i.createGroup()
@@ -287,7 +358,8 @@ func (i *IncomeRepositoryTestSuite) Test_Update() {
 		Name:        fmt.Sprintf("%s-new", income.Name),
 		Description: fmt.Sprintf("%s-new", income.Description),
 		Date:        mocks.Date,
-		Sum:         income.Sum + 100.0,
+		Sum:         income.Sum + money.FromMinorUnits(10000),
+		Currency:    "EUR",
 	}
 
 	err := i.repository.Update(income.Id, updatedIncome)
@@ -301,10 +373,12 @@ func (i *IncomeRepositoryTestSuite) Test_Update() {
 		Name:        "Name-new",
 		Description: "Description-new",
 		Date:        updatedIncome.Date,
-		Sum:         200.1,
+		Sum:         money.FromMinorUnits(20010),
+		Currency:    "EUR",
 		HouseId:     income.HouseId,
 		House:       income.House,
 		Groups:      []groupModel.Group{},
+		Tags:        []tagModel.Tag{},
 	}, response)
 }
 
@@ -339,6 +413,7 @@ func (i *IncomeRepositoryTestSuite) createIncomeWithGroups(groups []groupModel.G
 
 func truncateDynamic(service db.DatabaseService) {
 	service.D().Exec("DELETE FROM income_groups")
+	service.D().Exec("DELETE FROM income_tags")
 	database.TruncateTable(service, groupModel.Group{})
 	database.TruncateTable(service, model.Income{})
 }
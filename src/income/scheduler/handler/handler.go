@@ -5,6 +5,7 @@ import (
 	"github.com/VlasovArtem/hob/src/common/rest"
 	"github.com/VlasovArtem/hob/src/income/scheduler/model"
 	"github.com/VlasovArtem/hob/src/income/scheduler/service"
+	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/gorilla/mux"
 	"net/http"
 )
@@ -28,6 +29,11 @@ func (i *IncomeSchedulerHandlerObject) Init(router *mux.Router) {
 	incomeSchedulerRouter.Path("/{id}").HandlerFunc(i.FindById()).Methods("GET")
 	incomeSchedulerRouter.Path("/{id}").HandlerFunc(i.Update()).Methods("PUT")
 	incomeSchedulerRouter.Path("/{id}").HandlerFunc(i.Remove()).Methods("DELETE")
+	incomeSchedulerRouter.Path("/{id}/pause").HandlerFunc(i.Pause()).Methods("POST")
+	incomeSchedulerRouter.Path("/{id}/resume").HandlerFunc(i.Resume()).Methods("POST")
+	incomeSchedulerRouter.Path("/{id}/trigger").HandlerFunc(i.Trigger()).Methods("POST")
+	incomeSchedulerRouter.Path("/{id}/runs").HandlerFunc(i.FindRunsById()).Methods("GET")
+	incomeSchedulerRouter.Path("/{id}/next").HandlerFunc(i.FindNextExecutionsById()).Methods("GET")
 	incomeSchedulerRouter.Path("/house/{id}").HandlerFunc(i.FindByHouseId()).Methods("GET")
 }
 
@@ -37,13 +43,26 @@ type IncomeSchedulerHandler interface {
 	Remove() http.HandlerFunc
 	FindById() http.HandlerFunc
 	FindByHouseId() http.HandlerFunc
+	Pause() http.HandlerFunc
+	Resume() http.HandlerFunc
+	Trigger() http.HandlerFunc
+	FindRunsById() http.HandlerFunc
+	FindNextExecutionsById() http.HandlerFunc
 }
 
 func (i *IncomeSchedulerHandlerObject) Add() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreateIncomeSchedulerRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			body.UserId = userId
+
 			rest.NewAPIResponse(writer).
 				Created(i.incomeSchedulerService.Add(body)).
 				Perform()
@@ -53,14 +72,14 @@ func (i *IncomeSchedulerHandlerObject) Add() http.HandlerFunc {
 
 func (i *IncomeSchedulerHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdateIncomeSchedulerRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
 				rest.NewAPIResponse(writer).
-					Error(i.incomeSchedulerService.Update(id, body)).
+					Error(i.incomeSchedulerService.Update(id, userId, body)).
 					Perform()
 			}
 		}
@@ -69,12 +88,12 @@ func (i *IncomeSchedulerHandlerObject) Update() http.HandlerFunc {
 
 func (i *IncomeSchedulerHandlerObject) Remove() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
 				StatusCode(http.StatusNoContent).
-				Error(i.incomeSchedulerService.DeleteById(id)).
+				Error(i.incomeSchedulerService.DeleteById(id, userId)).
 				Perform()
 		}
 	}
@@ -82,11 +101,11 @@ func (i *IncomeSchedulerHandlerObject) Remove() http.HandlerFunc {
 
 func (i *IncomeSchedulerHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(i.incomeSchedulerService.FindById(id)).
+				Ok(i.incomeSchedulerService.FindById(id, userId)).
 				Perform()
 		}
 	}
@@ -94,11 +113,73 @@ func (i *IncomeSchedulerHandlerObject) FindById() http.HandlerFunc {
 
 func (i *IncomeSchedulerHandlerObject) FindByHouseId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Body(i.incomeSchedulerService.FindByHouseId(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (i *IncomeSchedulerHandlerObject) Pause() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(i.incomeSchedulerService.Pause(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (i *IncomeSchedulerHandlerObject) Resume() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(i.incomeSchedulerService.Resume(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (i *IncomeSchedulerHandlerObject) Trigger() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(i.incomeSchedulerService.Trigger(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (i *IncomeSchedulerHandlerObject) FindRunsById() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(i.incomeSchedulerService.FindRunsById(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (i *IncomeSchedulerHandlerObject) FindNextExecutionsById() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else if count, err := rest.GetQueryParamOrDefault(request, "count", scheduler.DefaultNextExecutions); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Body(i.incomeSchedulerService.FindByHouseId(id)).
+				Ok(i.incomeSchedulerService.FindNextExecutionsById(id, userId, count)).
 				Perform()
 		}
 	}
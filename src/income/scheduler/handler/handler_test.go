@@ -4,14 +4,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/income/scheduler/mocks"
 	incomeSchedulerModel "github.com/VlasovArtem/hob/src/income/scheduler/model"
 	scheduler2 "github.com/VlasovArtem/hob/src/scheduler"
+	runMocks "github.com/VlasovArtem/hob/src/scheduler/run/mocks"
+	runModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
+	"time"
 )
 
 var (
@@ -35,6 +41,7 @@ func Test_Add(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(handler.Add()).
 		WithBody(request)
 
@@ -53,6 +60,7 @@ func Test_Add_WithInvalidRequest(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(handler.Add())
 
 	testRequest.Verify(t, http.StatusBadRequest)
@@ -70,6 +78,7 @@ func Test_Add_WithErrorFromService(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(handler.Add()).
 		WithBody(request)
 
@@ -81,13 +90,15 @@ func Test_Add_WithErrorFromService(t *testing.T) {
 func Test_Remove(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
-	incomesScheduler.On("DeleteById", id).Return(nil)
+	incomesScheduler.On("DeleteById", id, userId).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/{id}").
 		WithMethod("DELETE").
+		WithUser(userId).
 		WithHandler(handler.Remove()).
 		WithVar("id", id.String())
 
@@ -100,6 +111,7 @@ func Test_Remove_WithMissingParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(handler.Remove())
 
 	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
@@ -113,6 +125,7 @@ func Test_Remove_WithInvalidParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(handler.Remove()).
 		WithVar("id", "id")
 
@@ -124,16 +137,18 @@ func Test_Remove_WithInvalidParameter(t *testing.T) {
 func Test_FindById(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	incomeSchedulerResponse := generateIncomeSchedulerResponse(id)
 
-	incomesScheduler.On("FindById", id).
+	incomesScheduler.On("FindById", id, userId).
 		Return(incomeSchedulerResponse, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindById()).
 		WithVar("id", id.String())
 
@@ -149,16 +164,18 @@ func Test_FindById(t *testing.T) {
 func Test_FindById_WithError(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	expected := errors.New("error")
 
-	incomesScheduler.On("FindById", id).
+	incomesScheduler.On("FindById", id, userId).
 		Return(incomeSchedulerModel.IncomeSchedulerDto{}, expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindById()).
 		WithVar("id", id.String())
 
@@ -173,6 +190,7 @@ func Test_FindById_WithInvalidParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(handler.FindById()).
 		WithVar("id", "id")
 
@@ -184,16 +202,18 @@ func Test_FindById_WithInvalidParameter(t *testing.T) {
 func Test_FindByHouseId(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	incomeSchedulerResponse := generateIncomeSchedulerResponse(id)
 
-	incomesScheduler.On("FindByHouseId", id).
+	incomesScheduler.On("FindByHouseId", id, userId).
 		Return([]incomeSchedulerModel.IncomeSchedulerDto{incomeSchedulerResponse})
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/house/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindByHouseId()).
 		WithVar("id", id.String())
 
@@ -209,14 +229,16 @@ func Test_FindByHouseId(t *testing.T) {
 func Test_FindByHouseId_WithEmptyResponse(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
-	incomesScheduler.On("FindByHouseId", id).
+	incomesScheduler.On("FindByHouseId", id, userId).
 		Return([]incomeSchedulerModel.IncomeSchedulerDto{})
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/house/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindByHouseId()).
 		WithVar("id", id.String())
 
@@ -235,6 +257,7 @@ func Test_FindByHouseId_WithInvalidParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/house/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(handler.FindByHouseId()).
 		WithVar("id", "id")
 
@@ -249,6 +272,7 @@ func Test_FindByHouseId_WithMissingParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/income/scheduler/house/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(handler.FindByHouseId())
 
 	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
@@ -261,7 +285,8 @@ func generateCreateIncomeSchedulerRequest() incomeSchedulerModel.CreateIncomeSch
 		Name:        "Test Income",
 		Description: "Test Income Description",
 		HouseId:     houseId,
-		Sum:         1000,
+		UserId:      mocks.UserId,
+		Sum:         money.FromMinorUnits(100000),
 		Spec:        scheduler2.DAILY,
 	}
 }
@@ -272,7 +297,333 @@ func generateIncomeSchedulerResponse(id uuid.UUID) incomeSchedulerModel.IncomeSc
 		Name:        "Test Income",
 		Description: "Test Income Description",
 		HouseId:     houseId,
-		Sum:         1000,
+		UserId:      mocks.UserId,
+		Sum:         money.FromMinorUnits(100000),
 		Spec:        scheduler2.DAILY,
 	}
 }
+
+func Test_Pause(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	incomesScheduler.On("Pause", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/pause").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Pause()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(t, http.StatusNoContent)
+
+	incomesScheduler.AssertCalled(t, "Pause", id, userId)
+}
+
+func Test_Pause_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+
+	incomesScheduler.On("Pause", id, userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/pause").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Pause()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_Pause_WithInvalidParameter(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/pause").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(handler.Pause()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
+}
+
+func Test_Resume(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	incomesScheduler.On("Resume", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/resume").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Resume()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(t, http.StatusNoContent)
+
+	incomesScheduler.AssertCalled(t, "Resume", id, userId)
+}
+
+func Test_Resume_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+
+	incomesScheduler.On("Resume", id, userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/resume").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Resume()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_Resume_WithInvalidParameter(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/resume").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(handler.Resume()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
+}
+
+func Test_Trigger(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	incomesScheduler.On("Trigger", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/trigger").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Trigger()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(t, http.StatusNoContent)
+
+	incomesScheduler.AssertCalled(t, "Trigger", id, userId)
+}
+
+func Test_Trigger_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+
+	incomesScheduler.On("Trigger", id, userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/trigger").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Trigger()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_Trigger_WithInvalidParameter(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/trigger").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(handler.Trigger()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
+}
+
+func Test_FindRunsById(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	runs := []runModel.SchedulerRunDto{runMocks.GenerateSchedulerRun(id).ToDto()}
+
+	incomesScheduler.On("FindRunsById", id, userId).
+		Return(runs, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/runs").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindRunsById()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusOK)
+
+	var actual []runModel.SchedulerRunDto
+
+	json.Unmarshal(responseByteArray, &actual)
+
+	assert.Equal(t, runs, actual)
+}
+
+func Test_FindRunsById_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+
+	incomesScheduler.On("FindRunsById", id, userId).
+		Return(nil, expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/runs").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindRunsById()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_FindRunsById_WithInvalidParameter(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/income/scheduler/{id}/runs").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(handler.FindRunsById()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
+}
+
+func Test_FindNextExecutionsById(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	next := []time.Time{
+		time.Date(2022, time.February, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, time.March, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	incomesScheduler.On("FindNextExecutionsById", id, userId, 2).
+		Return(next, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/incomes/schedulers/{id}/next?count=2").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindNextExecutionsById()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusOK)
+
+	var actual []time.Time
+
+	json.Unmarshal(responseByteArray, &actual)
+
+	assert.Equal(t, next, actual)
+}
+
+func Test_FindNextExecutionsById_WithDefaultCount(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	incomesScheduler.On("FindNextExecutionsById", id, userId, scheduler2.DefaultNextExecutions).
+		Return([]time.Time{}, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/incomes/schedulers/{id}/next").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindNextExecutionsById()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(t, http.StatusOK)
+
+	incomesScheduler.AssertCalled(t, "FindNextExecutionsById", id, userId, scheduler2.DefaultNextExecutions)
+}
+
+func Test_FindNextExecutionsById_WithInvalidCount(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/incomes/schedulers/{id}/next?count=invalid").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(handler.FindNextExecutionsById()).
+		WithVar("id", uuid.New().String())
+
+	testRequest.Verify(t, http.StatusBadRequest)
+
+	incomesScheduler.AssertNotCalled(t, "FindNextExecutionsById", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_FindNextExecutionsById_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+
+	incomesScheduler.On("FindNextExecutionsById", id, userId, scheduler2.DefaultNextExecutions).
+		Return(nil, expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/incomes/schedulers/{id}/next").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindNextExecutionsById()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
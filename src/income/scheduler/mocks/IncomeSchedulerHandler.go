@@ -61,6 +61,48 @@ func (_m *IncomeSchedulerHandler) FindById() http.HandlerFunc {
 	return r0
 }
 
+// FindNextExecutionsById provides a mock function with given fields:
+func (_m *IncomeSchedulerHandler) FindNextExecutionsById() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// FindRunsById provides a mock function with given fields:
+func (_m *IncomeSchedulerHandler) FindRunsById() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// Pause provides a mock function with given fields:
+func (_m *IncomeSchedulerHandler) Pause() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
 // Remove provides a mock function with given fields:
 func (_m *IncomeSchedulerHandler) Remove() http.HandlerFunc {
 	ret := _m.Called()
@@ -76,3 +118,31 @@ func (_m *IncomeSchedulerHandler) Remove() http.HandlerFunc {
 
 	return r0
 }
+
+// Resume provides a mock function with given fields:
+func (_m *IncomeSchedulerHandler) Resume() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// Trigger provides a mock function with given fields:
+func (_m *IncomeSchedulerHandler) Trigger() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
@@ -6,6 +6,8 @@ import (
 	model "github.com/VlasovArtem/hob/src/income/scheduler/model"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -14,6 +16,34 @@ type IncomeSchedulerRepository struct {
 	mock.Mock
 }
 
+// AssignHouseCurrencies provides a mock function with given fields: currencies
+func (_m *IncomeSchedulerRepository) AssignHouseCurrencies(currencies map[string]string) error {
+	ret := _m.Called(currencies)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string) error); ok {
+		r0 = rf(currencies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssignHouseOwners provides a mock function with given fields:
+func (_m *IncomeSchedulerRepository) AssignHouseOwners() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: scheduler
 func (_m *IncomeSchedulerRepository) Create(scheduler model.IncomeScheduler) (model.IncomeScheduler, error) {
 	ret := _m.Called(scheduler)
@@ -63,6 +93,29 @@ func (_m *IncomeSchedulerRepository) ExistsById(id uuid.UUID) bool {
 	return r0
 }
 
+// FindAll provides a mock function with given fields:
+func (_m *IncomeSchedulerRepository) FindAll() ([]model.IncomeScheduler, error) {
+	ret := _m.Called()
+
+	var r0 []model.IncomeScheduler
+	if rf, ok := ret.Get(0).(func() []model.IncomeScheduler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.IncomeScheduler)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByHouseId provides a mock function with given fields: houseId
 func (_m *IncomeSchedulerRepository) FindByHouseId(houseId uuid.UUID) ([]model.IncomeSchedulerDto, error) {
 	ret := _m.Called(houseId)
@@ -107,6 +160,20 @@ func (_m *IncomeSchedulerRepository) FindById(id uuid.UUID) (model.IncomeSchedul
 	return r0, r1
 }
 
+// IncrementOccurrences provides a mock function with given fields: id
+func (_m *IncomeSchedulerRepository) IncrementOccurrences(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: id, scheduler
 func (_m *IncomeSchedulerRepository) Update(id uuid.UUID, scheduler model.UpdateIncomeSchedulerRequest) (model.IncomeScheduler, error) {
 	ret := _m.Called(id, scheduler)
@@ -127,3 +194,31 @@ func (_m *IncomeSchedulerRepository) Update(id uuid.UUID, scheduler model.Update
 
 	return r0, r1
 }
+
+// UpdateLastExecutedAt provides a mock function with given fields: id, executedAt
+func (_m *IncomeSchedulerRepository) UpdateLastExecutedAt(id uuid.UUID, executedAt time.Time) error {
+	ret := _m.Called(id, executedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(id, executedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePaused provides a mock function with given fields: id, paused
+func (_m *IncomeSchedulerRepository) UpdatePaused(id uuid.UUID, paused bool) error {
+	ret := _m.Called(id, paused)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, bool) error); ok {
+		r0 = rf(id, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -6,6 +6,10 @@ import (
 	model "github.com/VlasovArtem/hob/src/income/scheduler/model"
 	mock "github.com/stretchr/testify/mock"
 
+	runmodel "github.com/VlasovArtem/hob/src/scheduler/run/model"
+
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -35,13 +39,13 @@ func (_m *IncomeSchedulerService) Add(request model.CreateIncomeSchedulerRequest
 	return r0, r1
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *IncomeSchedulerService) DeleteById(id uuid.UUID) error {
-	ret := _m.Called(id)
+// DeleteById provides a mock function with given fields: id, userId
+func (_m *IncomeSchedulerService) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,13 +53,13 @@ func (_m *IncomeSchedulerService) DeleteById(id uuid.UUID) error {
 	return r0
 }
 
-// FindByHouseId provides a mock function with given fields: id
-func (_m *IncomeSchedulerService) FindByHouseId(id uuid.UUID) []model.IncomeSchedulerDto {
-	ret := _m.Called(id)
+// FindByHouseId provides a mock function with given fields: id, userId
+func (_m *IncomeSchedulerService) FindByHouseId(id uuid.UUID, userId uuid.UUID) []model.IncomeSchedulerDto {
+	ret := _m.Called(id, userId)
 
 	var r0 []model.IncomeSchedulerDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) []model.IncomeSchedulerDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) []model.IncomeSchedulerDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.IncomeSchedulerDto)
@@ -65,20 +69,20 @@ func (_m *IncomeSchedulerService) FindByHouseId(id uuid.UUID) []model.IncomeSche
 	return r0
 }
 
-// FindById provides a mock function with given fields: id
-func (_m *IncomeSchedulerService) FindById(id uuid.UUID) (model.IncomeSchedulerDto, error) {
-	ret := _m.Called(id)
+// FindById provides a mock function with given fields: id, userId
+func (_m *IncomeSchedulerService) FindById(id uuid.UUID, userId uuid.UUID) (model.IncomeSchedulerDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.IncomeSchedulerDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.IncomeSchedulerDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.IncomeSchedulerDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.IncomeSchedulerDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -86,13 +90,101 @@ func (_m *IncomeSchedulerService) FindById(id uuid.UUID) (model.IncomeSchedulerD
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *IncomeSchedulerService) Update(id uuid.UUID, request model.UpdateIncomeSchedulerRequest) error {
-	ret := _m.Called(id, request)
+// FindNextExecutionsById provides a mock function with given fields: id, userId, count
+func (_m *IncomeSchedulerService) FindNextExecutionsById(id uuid.UUID, userId uuid.UUID, count int) ([]time.Time, error) {
+	ret := _m.Called(id, userId, count)
+
+	var r0 []time.Time
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int) []time.Time); ok {
+		r0 = rf(id, userId, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = rf(id, userId, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindRunsById provides a mock function with given fields: id, userId
+func (_m *IncomeSchedulerService) FindRunsById(id uuid.UUID, userId uuid.UUID) ([]runmodel.SchedulerRunDto, error) {
+	ret := _m.Called(id, userId)
+
+	var r0 []runmodel.SchedulerRunDto
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) []runmodel.SchedulerRunDto); ok {
+		r0 = rf(id, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]runmodel.SchedulerRunDto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Pause provides a mock function with given fields: id, userId
+func (_m *IncomeSchedulerService) Pause(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Resume provides a mock function with given fields: id, userId
+func (_m *IncomeSchedulerService) Resume(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Trigger provides a mock function with given fields: id, userId
+func (_m *IncomeSchedulerService) Trigger(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: id, userId, request
+func (_m *IncomeSchedulerService) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateIncomeSchedulerRequest) error {
+	ret := _m.Called(id, userId, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdateIncomeSchedulerRequest) error); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, model.UpdateIncomeSchedulerRequest) error); ok {
+		r0 = rf(id, userId, request)
 	} else {
 		r0 = ret.Error(0)
 	}
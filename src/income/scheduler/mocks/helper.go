@@ -1,14 +1,19 @@
 package mocks
 
 import (
+	"github.com/VlasovArtem/hob/src/common/money"
 	im "github.com/VlasovArtem/hob/src/income/model"
 	"github.com/VlasovArtem/hob/src/income/scheduler/model"
 	"github.com/VlasovArtem/hob/src/scheduler"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"time"
 )
 
-var Date = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.Local)
+var (
+	Date   = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.Local)
+	UserId = testhelper.ParseUUID("ad2c5035-6745-48d0-9eee-fd22f5dae8e0")
+)
 
 func GenerateIncomeScheduler(houseId uuid.UUID) model.IncomeScheduler {
 	return model.IncomeScheduler{
@@ -17,10 +22,12 @@ func GenerateIncomeScheduler(houseId uuid.UUID) model.IncomeScheduler {
 			Name:        "Name",
 			Description: "Description",
 			Date:        Date,
-			Sum:         1000,
+			Sum:         money.FromMinorUnits(100000),
+			Currency:    "UAH",
 			HouseId:     &houseId,
 		},
-		Spec: scheduler.DAILY,
+		UserId: UserId,
+		Spec:   scheduler.DAILY,
 	}
 }
 
@@ -29,7 +36,9 @@ func GenerateCreateIncomeSchedulerRequest() model.CreateIncomeSchedulerRequest {
 		Name:        "Test Income",
 		Description: "Test Income Description",
 		HouseId:     uuid.New(),
-		Sum:         1000,
+		UserId:      UserId,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 		Spec:        scheduler.DAILY,
 	}
 }
@@ -38,7 +47,8 @@ func GenerateUpdateIncomeSchedulerRequest() (uuid.UUID, model.UpdateIncomeSchedu
 	return uuid.New(), model.UpdateIncomeSchedulerRequest{
 		Name:        "Test Income",
 		Description: "Test Income Description",
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 		Spec:        scheduler.DAILY,
 	}
 }
@@ -1,38 +1,67 @@
 package model
 
 import (
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/income/model"
 	"github.com/VlasovArtem/hob/src/scheduler"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
 	"github.com/google/uuid"
+	"time"
 )
 
 type IncomeScheduler struct {
 	model.Income
-	Spec scheduler.SchedulingSpecification
+	// UserId is the creator of the scheduler, the incomes are created on behalf of the user
+	UserId     uuid.UUID
+	User       userModel.User `gorm:"foreignKey:UserId"`
+	Spec       scheduler.SchedulingSpecification
+	TimeZone   scheduler.TimeZone
+	Adjustment scheduler.BusinessDayAdjustment
+	// LastExecutedAt is the fire time of the latest successful execution, used to catch up missed executions
+	LastExecutedAt *time.Time
+	Paused         bool
+	Occurrences    int
+	scheduler.Limits
 }
 
 type CreateIncomeSchedulerRequest struct {
 	Name        string
 	Description string
-	Sum         float32
+	Sum         money.Money
+	Currency    string
 	HouseId     uuid.UUID
+	UserId      uuid.UUID
 	Spec        scheduler.SchedulingSpecification
+	TimeZone    scheduler.TimeZone
+	Adjustment  scheduler.BusinessDayAdjustment
+	scheduler.Limits
 }
 
 type UpdateIncomeSchedulerRequest struct {
 	Name        string
 	Description string
-	Sum         float32
+	Sum         money.Money
+	Currency    string
 	Spec        scheduler.SchedulingSpecification
+	TimeZone    scheduler.TimeZone
+	Adjustment  scheduler.BusinessDayAdjustment
+	scheduler.Limits
 }
 
 type IncomeSchedulerDto struct {
 	Id          uuid.UUID
 	Name        string
 	Description string
-	Sum         float32
+	Sum         money.Money
+	Currency    string
 	HouseId     uuid.UUID
+	UserId      uuid.UUID
 	Spec        scheduler.SchedulingSpecification
+	TimeZone    scheduler.TimeZone
+	Adjustment  scheduler.BusinessDayAdjustment
+	Paused      bool
+	Occurrences int
+	scheduler.Limits
 }
 
 func (i IncomeScheduler) ToDto() IncomeSchedulerDto {
@@ -41,8 +70,15 @@ func (i IncomeScheduler) ToDto() IncomeSchedulerDto {
 		Name:        i.Name,
 		Description: i.Description,
 		Sum:         i.Sum,
+		Currency:    i.Currency,
 		HouseId:     *i.HouseId,
+		UserId:      i.UserId,
 		Spec:        i.Spec,
+		TimeZone:    i.TimeZone,
+		Adjustment:  i.Adjustment,
+		Paused:      i.Paused,
+		Occurrences: i.Occurrences,
+		Limits:      i.Limits,
 	}
 }
 
@@ -53,9 +89,14 @@ func (c CreateIncomeSchedulerRequest) ToEntity() IncomeScheduler {
 			Name:        c.Name,
 			Description: c.Description,
 			Sum:         c.Sum,
+			Currency:    c.Currency,
 			HouseId:     &c.HouseId,
 		},
-		Spec: c.Spec,
+		UserId:     c.UserId,
+		Spec:       c.Spec,
+		TimeZone:   c.TimeZone,
+		Adjustment: c.Adjustment,
+		Limits:     c.Limits,
 	}
 }
 
@@ -66,7 +107,11 @@ func (u UpdateIncomeSchedulerRequest) ToEntity(id uuid.UUID) IncomeScheduler {
 			Name:        u.Name,
 			Description: u.Description,
 			Sum:         u.Sum,
+			Currency:    u.Currency,
 		},
-		Spec: u.Spec,
+		Spec:       u.Spec,
+		TimeZone:   u.TimeZone,
+		Adjustment: u.Adjustment,
+		Limits:     u.Limits,
 	}
 }
@@ -4,7 +4,10 @@ import (
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/db"
 	"github.com/VlasovArtem/hob/src/income/scheduler/model"
+	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
 )
 
 var entity = model.IncomeScheduler{}
@@ -30,13 +33,24 @@ func (i *IncomeSchedulerRepositoryObject) GetEntity() any {
 	return entity
 }
 
+// UpgradeSchema converts the sums stored as the decimals to the minor units
+func (i *IncomeSchedulerRepositoryObject) UpgradeSchema() error {
+	return i.database.UpgradeMoneyColumn("sum")
+}
+
 type IncomeSchedulerRepository interface {
 	Create(scheduler model.IncomeScheduler) (model.IncomeScheduler, error)
 	ExistsById(id uuid.UUID) bool
 	DeleteById(id uuid.UUID) error
 	FindById(id uuid.UUID) (model.IncomeScheduler, error)
+	FindAll() ([]model.IncomeScheduler, error)
 	FindByHouseId(houseId uuid.UUID) ([]model.IncomeSchedulerDto, error)
 	Update(id uuid.UUID, scheduler model.UpdateIncomeSchedulerRequest) (model.IncomeScheduler, error)
+	UpdateLastExecutedAt(id uuid.UUID, executedAt time.Time) error
+	UpdatePaused(id uuid.UUID, paused bool) error
+	IncrementOccurrences(id uuid.UUID) error
+	AssignHouseOwners() error
+	AssignHouseCurrencies(currencies map[string]string) error
 }
 
 func (i *IncomeSchedulerRepositoryObject) Create(scheduler model.IncomeScheduler) (model.IncomeScheduler, error) {
@@ -55,14 +69,50 @@ func (i *IncomeSchedulerRepositoryObject) FindById(id uuid.UUID) (response model
 	return response, i.database.Find(&response, id)
 }
 
+func (i *IncomeSchedulerRepositoryObject) FindAll() (response []model.IncomeScheduler, err error) {
+	return response, i.database.Modeled().Find(&response).Error
+}
+
 func (i *IncomeSchedulerRepositoryObject) FindByHouseId(houseId uuid.UUID) (response []model.IncomeSchedulerDto, err error) {
 	return response, i.database.FindBy(&response, "house_id = ?", houseId)
 }
 
-func (i *IncomeSchedulerRepositoryObject) Update(id uuid.UUID, scheduler model.UpdateIncomeSchedulerRequest) (response model.IncomeScheduler, err error) {
-	if err = i.database.Update(id, scheduler); err != nil {
+// Update changes the scheduler, the limits are always written so the start date, the end date and the max occurrences
+// are cleared if they are not set
+func (i *IncomeSchedulerRepositoryObject) Update(id uuid.UUID, request model.UpdateIncomeSchedulerRequest) (response model.IncomeScheduler, err error) {
+	err = i.database.D().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(entity).Where("id = ?", id).Omit("Id").Updates(request).Error; err != nil {
+			return err
+		}
+		return tx.Model(entity).Where("id = ?", id).Select(scheduler.LimitColumns).Updates(request.Limits).Error
+	})
+	if err != nil {
 		return response, err
 	}
 
 	return i.FindById(id)
 }
+
+func (i *IncomeSchedulerRepositoryObject) UpdateLastExecutedAt(id uuid.UUID, executedAt time.Time) error {
+	return i.database.Modeled().Where("id = ?", id).Update("last_executed_at", executedAt).Error
+}
+
+func (i *IncomeSchedulerRepositoryObject) UpdatePaused(id uuid.UUID, paused bool) error {
+	return i.database.Modeled().Where("id = ?", id).Update("paused", paused).Error
+}
+
+func (i *IncomeSchedulerRepositoryObject) IncrementOccurrences(id uuid.UUID) error {
+	return i.database.Modeled().Where("id = ?", id).Update("occurrences", gorm.Expr("occurrences + 1")).Error
+}
+
+// AssignHouseOwners sets the owner of the house as the creator of the schedulers persisted before the creator was stored
+func (i *IncomeSchedulerRepositoryObject) AssignHouseOwners() error {
+	return i.database.D().
+		Exec("UPDATE income_schedulers SET user_id = houses.user_id FROM houses WHERE houses.id = income_schedulers.house_id AND income_schedulers.user_id IS NULL").
+		Error
+}
+
+// AssignHouseCurrencies sets the currency of the house country to the income schedulers persisted before the currency was stored
+func (i *IncomeSchedulerRepositoryObject) AssignHouseCurrencies(currencies map[string]string) error {
+	return i.database.AssignHouseCurrencies(currencies)
+}
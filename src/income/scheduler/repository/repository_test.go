@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/db"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 type IncomeSchedulerRepositoryTestSuite struct {
@@ -89,6 +91,42 @@ func (i *IncomeSchedulerRepositoryTestSuite) Test_FindById_WithMissingId() {
 	assert.Equal(i.T(), model.IncomeScheduler{}, actual)
 }
 
+func (i *IncomeSchedulerRepositoryTestSuite) Test_FindAll() {
+	payment := i.createIncomeSchedulerWithNewHouse()
+
+	actual, err := i.repository.FindAll()
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), []model.IncomeScheduler{payment}, actual)
+}
+
+func (i *IncomeSchedulerRepositoryTestSuite) Test_UpdatePaused() {
+	income := i.createIncomeSchedulerWithNewHouse()
+
+	err := i.repository.UpdatePaused(income.Id, true)
+
+	assert.Nil(i.T(), err)
+
+	actual, err := i.repository.FindById(income.Id)
+
+	assert.Nil(i.T(), err)
+	assert.True(i.T(), actual.Paused)
+}
+
+func (i *IncomeSchedulerRepositoryTestSuite) Test_UpdateLastExecutedAt() {
+	income := i.createIncomeSchedulerWithNewHouse()
+	executedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	err := i.repository.UpdateLastExecutedAt(income.Id, executedAt)
+
+	assert.Nil(i.T(), err)
+
+	actual, err := i.repository.FindById(income.Id)
+
+	assert.Nil(i.T(), err)
+	assert.True(i.T(), executedAt.Equal(*actual.LastExecutedAt))
+}
+
 func (i *IncomeSchedulerRepositoryTestSuite) Test_FindByHouseId() {
 	payment := i.createIncomeSchedulerWithNewHouse()
 
@@ -139,7 +177,7 @@ func (i *IncomeSchedulerRepositoryTestSuite) Test_Update() {
 	updatedIncomeScheduler := model.UpdateIncomeSchedulerRequest{
 		Name:        "New Name",
 		Description: "New Description",
-		Sum:         1010,
+		Sum:         money.FromMinorUnits(101000),
 		Spec:        scheduler.WEEKLY,
 	}
 
@@ -153,7 +191,7 @@ func (i *IncomeSchedulerRepositoryTestSuite) Test_Update() {
 			Name:        "New Name",
 			Description: "New Description",
 			Date:        incomeScheduler.Date,
-			Sum:         1010,
+			Sum:         money.FromMinorUnits(101000),
 			HouseId:     incomeScheduler.HouseId,
 			House:       incomeScheduler.House,
 		},
@@ -161,6 +199,25 @@ func (i *IncomeSchedulerRepositoryTestSuite) Test_Update() {
 	}, actual)
 }
 
+func (i *IncomeSchedulerRepositoryTestSuite) Test_Update_WithoutLimits() {
+	startDate := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(1, 0, 0)
+
+	incomeScheduler := mocks.GenerateIncomeScheduler(i.createdHouse.Id)
+	incomeScheduler.Limits = scheduler.Limits{StartDate: &startDate, EndDate: &endDate, MaxOccurrences: 12}
+	i.CreateEntity(incomeScheduler)
+
+	actual, err := i.repository.Update(incomeScheduler.Id, model.UpdateIncomeSchedulerRequest{
+		Name: incomeScheduler.Name,
+		Sum:  incomeScheduler.Sum,
+		Spec: incomeScheduler.Spec,
+	})
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), scheduler.Limits{}, actual.Limits)
+	assert.Equal(i.T(), incomeScheduler.Name, actual.Name)
+}
+
 func (i *IncomeSchedulerRepositoryTestSuite) createIncomeSchedulerWithNewHouse() model.IncomeScheduler {
 	createdHouse := houseMocks.GenerateHouse(i.createdUser.Id)
 	i.CreateEntity(&createdHouse)
@@ -2,14 +2,21 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/common/database"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/common/int-errors"
+	countries "github.com/VlasovArtem/hob/src/country/service"
+	holidays "github.com/VlasovArtem/hob/src/holiday/service"
 	houseService "github.com/VlasovArtem/hob/src/house/service"
 	incomeModel "github.com/VlasovArtem/hob/src/income/model"
 	"github.com/VlasovArtem/hob/src/income/scheduler/model"
 	"github.com/VlasovArtem/hob/src/income/scheduler/repository"
 	incomeService "github.com/VlasovArtem/hob/src/income/service"
 	"github.com/VlasovArtem/hob/src/scheduler"
+	locks "github.com/VlasovArtem/hob/src/scheduler/lock/service"
+	runModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
+	runs "github.com/VlasovArtem/hob/src/scheduler/run/service"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"time"
@@ -17,21 +24,33 @@ import (
 
 type IncomeSchedulerServiceObject struct {
 	houseService     houseService.HouseService
+	countryService   countries.CountryService
+	holidayService   holidays.HolidayService
 	incomeService    incomeService.IncomeService
 	serviceScheduler scheduler.ServiceScheduler
+	runService       runs.SchedulerRunService
+	lockService      locks.SchedulerLockService
 	repository       repository.IncomeSchedulerRepository
 }
 
 func NewIncomeSchedulerService(
 	houseService houseService.HouseService,
+	countryService countries.CountryService,
+	holidayService holidays.HolidayService,
 	incomeService incomeService.IncomeService,
 	serviceScheduler scheduler.ServiceScheduler,
+	runService runs.SchedulerRunService,
+	lockService locks.SchedulerLockService,
 	repository repository.IncomeSchedulerRepository,
 ) IncomeSchedulerService {
 	return &IncomeSchedulerServiceObject{
 		houseService:     houseService,
+		countryService:   countryService,
+		holidayService:   holidayService,
 		incomeService:    incomeService,
 		serviceScheduler: serviceScheduler,
+		runService:       runService,
+		lockService:      lockService,
 		repository:       repository,
 	}
 }
@@ -39,18 +58,58 @@ func NewIncomeSchedulerService(
 func (i *IncomeSchedulerServiceObject) Initialize(factory dependency.DependenciesProvider) any {
 	return NewIncomeSchedulerService(
 		dependency.FindRequiredDependency[houseService.HouseServiceObject, houseService.HouseService](factory),
+		dependency.FindRequiredDependency[countries.CountryServiceObject, countries.CountryService](factory),
+		dependency.FindRequiredDependency[holidays.HolidayServiceObject, holidays.HolidayService](factory),
 		dependency.FindRequiredDependency[incomeService.IncomeServiceObject, incomeService.IncomeService](factory),
 		dependency.FindRequiredDependency[scheduler.SchedulerServiceObject, scheduler.ServiceScheduler](factory),
+		dependency.FindRequiredDependency[runs.SchedulerRunServiceObject, runs.SchedulerRunService](factory),
+		dependency.FindRequiredDependency[locks.SchedulerLockServiceObject, locks.SchedulerLockService](factory),
 		dependency.FindRequiredDependency[repository.IncomeSchedulerRepositoryObject, repository.IncomeSchedulerRepository](factory),
 	)
 }
 
+// Start executes incomes missed since the last execution and registers all persisted income schedulers in the ServiceScheduler
+func (i *IncomeSchedulerServiceObject) Start() error {
+	if err := i.repository.AssignHouseOwners(); err != nil {
+		log.Error().Err(err).Msg("creators of the income schedulers are not assigned")
+	}
+	if err := i.repository.AssignHouseCurrencies(i.houseService.CountryCurrencies()); err != nil {
+		log.Error().Err(err).Msg("currencies of the income schedulers are not assigned")
+	}
+
+	schedulers, err := i.repository.FindAll()
+	if err != nil {
+		return err
+	}
+
+	for _, incomeScheduler := range schedulers {
+		if _, err = i.serviceScheduler.Add(incomeScheduler.Id, incomeScheduler.TimeZone.Specification(incomeScheduler.Spec), i.schedulerFunc(incomeScheduler.Id)); err != nil {
+			log.Error().Err(err).Msgf("income scheduler %s is not scheduled", incomeScheduler.Id)
+		} else if incomeScheduler.Paused {
+			if err = i.serviceScheduler.Pause(incomeScheduler.Id); err != nil {
+				log.Error().Err(err).Msgf("income scheduler %s is not paused", incomeScheduler.Id)
+			}
+		} else {
+			i.catchUp(incomeScheduler)
+		}
+	}
+
+	log.Info().Msgf("%d income schedulers restored", len(schedulers))
+
+	return nil
+}
+
 type IncomeSchedulerService interface {
 	Add(request model.CreateIncomeSchedulerRequest) (model.IncomeSchedulerDto, error)
-	DeleteById(id uuid.UUID) error
-	Update(id uuid.UUID, request model.UpdateIncomeSchedulerRequest) error
-	FindById(id uuid.UUID) (model.IncomeSchedulerDto, error)
-	FindByHouseId(id uuid.UUID) []model.IncomeSchedulerDto
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateIncomeSchedulerRequest) error
+	FindById(id uuid.UUID, userId uuid.UUID) (model.IncomeSchedulerDto, error)
+	FindByHouseId(id uuid.UUID, userId uuid.UUID) []model.IncomeSchedulerDto
+	Pause(id uuid.UUID, userId uuid.UUID) error
+	Resume(id uuid.UUID, userId uuid.UUID) error
+	Trigger(id uuid.UUID, userId uuid.UUID) error
+	FindRunsById(id uuid.UUID, userId uuid.UUID) ([]runModel.SchedulerRunDto, error)
+	FindNextExecutionsById(id uuid.UUID, userId uuid.UUID, count int) ([]time.Time, error)
 }
 
 func (i *IncomeSchedulerServiceObject) Add(request model.CreateIncomeSchedulerRequest) (response model.IncomeSchedulerDto, err error) {
@@ -59,8 +118,16 @@ func (i *IncomeSchedulerServiceObject) Add(request model.CreateIncomeSchedulerRe
 	}
 
 	entity := request.ToEntity()
+	if entity.Currency, err = i.houseService.ResolveCurrency(&request.HouseId, request.Currency); err != nil {
+		return response, err
+	}
+	if entity.TimeZone == "" {
+		entity.TimeZone = i.defaultTimeZone(*entity.HouseId, entity.UserId)
+	}
+	createdAt := time.Now()
+	entity.LastExecutedAt = &createdAt
 
-	if _, err = i.serviceScheduler.Add(entity.Id, string(entity.Spec), i.schedulerFunc(entity.Income)); err != nil {
+	if _, err = i.serviceScheduler.Add(entity.Id, entity.TimeZone.Specification(entity.Spec), i.schedulerFunc(entity.Id)); err != nil {
 		return response, err
 	}
 
@@ -71,17 +138,24 @@ func (i *IncomeSchedulerServiceObject) Add(request model.CreateIncomeSchedulerRe
 	}
 }
 
-func (i *IncomeSchedulerServiceObject) Update(id uuid.UUID, request model.UpdateIncomeSchedulerRequest) error {
-	if err := i.validateUpdateRequest(id, request); err != nil {
+func (i *IncomeSchedulerServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateIncomeSchedulerRequest) error {
+	if err := i.validateUpdateRequest(id, userId, request); err != nil {
 		return err
 	}
+	if request.Currency != "" {
+		currency, err := i.houseService.ResolveCurrency(nil, request.Currency)
+		if err != nil {
+			return err
+		}
+		request.Currency = currency
+	}
 
 	updatedEntity, err := i.repository.Update(id, request)
 	if err != nil {
 		return err
 	}
 
-	if _, err := i.serviceScheduler.Update(id, string(updatedEntity.Spec), i.schedulerFunc(updatedEntity.Income)); err != nil {
+	if _, err := i.serviceScheduler.Update(id, updatedEntity.TimeZone.Specification(updatedEntity.Spec), i.schedulerFunc(updatedEntity.Id)); err != nil {
 		if err := i.repository.DeleteById(id); err != nil {
 			log.Err(err)
 		}
@@ -91,8 +165,8 @@ func (i *IncomeSchedulerServiceObject) Update(id uuid.UUID, request model.Update
 	return nil
 }
 
-func (i *IncomeSchedulerServiceObject) DeleteById(id uuid.UUID) error {
-	if !i.repository.ExistsById(id) {
+func (i *IncomeSchedulerServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if !i.canModify(id, userId) {
 		return int_errors.NewErrNotFound("income scheduler with id %s not found", id)
 	} else {
 		if err := i.serviceScheduler.Remove(id); err != nil {
@@ -102,19 +176,19 @@ func (i *IncomeSchedulerServiceObject) DeleteById(id uuid.UUID) error {
 	}
 }
 
-func (i *IncomeSchedulerServiceObject) FindById(id uuid.UUID) (response model.IncomeSchedulerDto, err error) {
-	if !i.repository.ExistsById(id) {
-		return response, int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+func (i *IncomeSchedulerServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (response model.IncomeSchedulerDto, err error) {
+	if incomeScheduler, err := i.find(id, userId); err != nil {
+		return response, err
 	} else {
-		if paymentScheduler, err := i.repository.FindById(id); err != nil {
-			return response, err
-		} else {
-			return paymentScheduler.ToDto(), err
-		}
+		return incomeScheduler.ToDto(), err
 	}
 }
 
-func (i *IncomeSchedulerServiceObject) FindByHouseId(id uuid.UUID) []model.IncomeSchedulerDto {
+func (i *IncomeSchedulerServiceObject) FindByHouseId(id uuid.UUID, userId uuid.UUID) []model.IncomeSchedulerDto {
+	if !i.houseService.HasAccess(id, userId) {
+		return make([]model.IncomeSchedulerDto, 0)
+	}
+
 	responses, err := i.repository.FindByHouseId(id)
 	if err != nil {
 		log.Err(err)
@@ -122,50 +196,301 @@ func (i *IncomeSchedulerServiceObject) FindByHouseId(id uuid.UUID) []model.Incom
 	return responses
 }
 
-func (i *IncomeSchedulerServiceObject) schedulerFunc(income incomeModel.Income) func() {
+func (i *IncomeSchedulerServiceObject) Pause(id uuid.UUID, userId uuid.UUID) error {
+	if !i.canModify(id, userId) {
+		return int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+	}
+
+	if err := i.serviceScheduler.Pause(id); err != nil {
+		return err
+	}
+
+	return i.repository.UpdatePaused(id, true)
+}
+
+func (i *IncomeSchedulerServiceObject) Resume(id uuid.UUID, userId uuid.UUID) error {
+	if !i.canModify(id, userId) {
+		return int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+	}
+
+	if err := i.serviceScheduler.Resume(id); err != nil {
+		return err
+	}
+
+	// executions skipped while the scheduler was paused must not be caught up
+	if err := i.repository.UpdateLastExecutedAt(id, time.Now()); err != nil {
+		return err
+	}
+
+	return i.repository.UpdatePaused(id, false)
+}
+
+// Trigger creates the income immediately, exactly as the scheduled execution does. The occurrence is not locked, the
+// trigger is the request of the user handled by a single instance and not the occurrence of the schedule
+func (i *IncomeSchedulerServiceObject) Trigger(id uuid.UUID, userId uuid.UUID) error {
+	incomeScheduler, err := i.find(id, userId)
+	if err != nil {
+		return err
+	}
+	if !i.houseService.CanModify(*incomeScheduler.HouseId, userId) {
+		return int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+	}
+
+	return i.execute(&incomeScheduler, time.Now())
+}
+
+func (i *IncomeSchedulerServiceObject) FindRunsById(id uuid.UUID, userId uuid.UUID) ([]runModel.SchedulerRunDto, error) {
+	if !i.hasAccess(id, userId) {
+		return nil, int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+	}
+
+	return i.runService.FindBySchedulerId(id), nil
+}
+
+// FindNextExecutionsById returns up to count upcoming fire times of the scheduler allowed by its limits
+func (i *IncomeSchedulerServiceObject) FindNextExecutionsById(id uuid.UUID, userId uuid.UUID, count int) ([]time.Time, error) {
+	incomeScheduler, err := i.find(id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := i.serviceScheduler.NextExecutions(string(incomeScheduler.Spec), incomeScheduler.TimeZone, incomeScheduler.Limits.Since(time.Now()), count)
+	if err != nil {
+		return nil, err
+	}
+
+	location := incomeScheduler.TimeZone.Location()
+	for index := range next {
+		next[index] = next[index].In(location)
+	}
+
+	return incomeScheduler.Limits.Trim(next, incomeScheduler.Occurrences), nil
+}
+
+func (i *IncomeSchedulerServiceObject) catchUp(income model.IncomeScheduler) {
+	if income.LastExecutedAt == nil {
+		return
+	}
+
+	missed, err := i.serviceScheduler.MissedExecutions(income.TimeZone.Specification(income.Spec), *income.LastExecutedAt, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msgf("missed executions of the income scheduler %s are not calculated", income.Id)
+		return
+	}
+
+	for _, date := range missed {
+		if !income.IsStarted(date) {
+			continue
+		}
+		if err = i.executeOnce(&income, date); err != nil || income.Paused {
+			return
+		}
+	}
+}
+
+func (i *IncomeSchedulerServiceObject) schedulerFunc(id uuid.UUID) func() {
 	return func() {
-		if _, err := i.incomeService.Add(
-			incomeModel.CreateIncomeRequest{
-				Name:        income.Name,
-				Description: income.Description,
-				Date:        time.Now(),
-				Sum:         income.Sum,
-				HouseId:     income.HouseId,
-			},
-		); err != nil {
-			log.Error().Err(err).Msg("")
+		if incomeScheduler, err := i.repository.FindById(id); err != nil {
+			log.Error().Err(err).Msgf("income scheduler %s not found", id)
+		} else if fireTime, err := i.fireTime(&incomeScheduler); err != nil {
+			log.Error().Err(err).Msgf("execution of the income scheduler %s is skipped", id)
 		} else {
-			log.Info().Msgf("New income added to the house %s via scheduler %s", income.HouseId, income.Id)
+			_ = i.executeOnce(&incomeScheduler, fireTime)
 		}
 	}
 }
 
+// fireTime returns the exact fire time of the running execution. It is the key of the occurrence lock, so the current
+// time of the instance is never used in its place, otherwise the instances would lock different occurrences
+func (i *IncomeSchedulerServiceObject) fireTime(income *model.IncomeScheduler) (time.Time, error) {
+	fireTime, err := i.serviceScheduler.LastExecution(income.TimeZone.Specification(income.Spec), time.Now())
+	if err != nil {
+		return fireTime, err
+	}
+	if fireTime.IsZero() {
+		return fireTime, errors.New(fmt.Sprintf("fire time of the income scheduler %s is not found", income.Id))
+	}
+
+	return fireTime, nil
+}
+
+// executeOnce executes the occurrence unless it is already claimed by another instance of the application
+func (i *IncomeSchedulerServiceObject) executeOnce(income *model.IncomeScheduler, date time.Time) error {
+	if !i.lockService.Acquire(income.Id, date) {
+		return nil
+	}
+
+	return i.execute(income, date)
+}
+
+// execute creates the income at the date in the scheduler time zone if it is allowed by the scheduler limits, the scheduler is deactivated once it is exhausted
+func (i *IncomeSchedulerServiceObject) execute(income *model.IncomeScheduler, date time.Time) error {
+	date = date.In(income.TimeZone.Location())
+
+	if income.IsExhausted(date, income.Occurrences) {
+		i.deactivate(income)
+		return errors.New(fmt.Sprintf("income scheduler %s is exhausted", income.Id))
+	}
+	if !income.IsStarted(date) {
+		return errors.New(fmt.Sprintf("income scheduler %s is not started yet", income.Id))
+	}
+
+	created, err := i.incomeService.AddScheduled(
+		incomeModel.CreateIncomeRequest{
+			Name:        income.Name,
+			Description: income.Description,
+			Date:        i.businessDate(income, date),
+			Sum:         income.Sum,
+			Currency:    income.Currency,
+			HouseId:     income.HouseId,
+		},
+		income.UserId,
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("")
+		i.runService.Failed(income.Id, date, err)
+		return err
+	}
+
+	i.runService.Succeeded(income.Id, date, created.Id)
+	log.Info().Msgf("New income added to the house %s via scheduler %s", income.HouseId, income.Id)
+
+	if err = i.repository.UpdateLastExecutedAt(income.Id, date); err != nil {
+		log.Error().Err(err).Msgf("last execution of the income scheduler %s is not updated", income.Id)
+	}
+	if err = i.repository.IncrementOccurrences(income.Id); err != nil {
+		log.Error().Err(err).Msgf("occurrences of the income scheduler %s are not updated", income.Id)
+	}
+
+	income.Occurrences++
+	if income.IsExhausted(date, income.Occurrences) {
+		i.deactivate(income)
+	}
+
+	return nil
+}
+
+// defaultTimeZone returns the time zone of the house country, the server local time zone is used if it is not resolved
+func (i *IncomeSchedulerServiceObject) defaultTimeZone(houseId uuid.UUID, userId uuid.UUID) scheduler.TimeZone {
+	house, err := i.houseService.FindById(houseId, userId)
+	if err != nil {
+		log.Error().Err(err).Msgf("time zone of the house %s is not resolved", houseId)
+		return ""
+	}
+
+	country, err := i.countryService.FindCountryByCode(house.CountryCode)
+	if err != nil {
+		log.Error().Err(err).Msgf("time zone of the house %s is not resolved", houseId)
+		return ""
+	}
+
+	return scheduler.TimeZone(country.TimeZone)
+}
+
+// businessDate moves the date to the business day of the house country according to the scheduler adjustment
+func (i *IncomeSchedulerServiceObject) businessDate(income *model.IncomeScheduler, date time.Time) time.Time {
+	if income.Adjustment.OrDefault() == scheduler.NoAdjustment {
+		return date
+	}
+
+	house, err := i.houseService.FindById(*income.HouseId, income.UserId)
+	if err != nil {
+		log.Error().Err(err).Msgf("date of the income scheduler %s is not adjusted", income.Id)
+		return date
+	}
+
+	return i.holidayService.Adjust(house.CountryCode, date, income.Adjustment)
+}
+
+func (i *IncomeSchedulerServiceObject) deactivate(income *model.IncomeScheduler) {
+	income.Paused = true
+
+	if err := i.serviceScheduler.Pause(income.Id); err != nil {
+		log.Error().Err(err).Msgf("income scheduler %s is not paused", income.Id)
+	}
+	if err := i.repository.UpdatePaused(income.Id, true); err != nil {
+		log.Error().Err(err).Msgf("income scheduler %s is not deactivated", income.Id)
+	}
+
+	log.Info().Msgf("income scheduler %s is exhausted and deactivated", income.Id)
+}
+
 func (i *IncomeSchedulerServiceObject) validateCreateRequest(request model.CreateIncomeSchedulerRequest) error {
 	if request.Sum <= 0 {
 		return errors.New("sum should not be zero of negative")
 	}
-	if !i.houseService.ExistsById(request.HouseId) {
+	if err := request.Limits.Validate(); err != nil {
+		return err
+	}
+	if err := request.TimeZone.Validate(); err != nil {
+		return err
+	}
+	if err := request.Adjustment.Validate(); err != nil {
+		return err
+	}
+	if !i.houseService.CanModify(request.HouseId, request.UserId) {
 		return int_errors.NewErrNotFound("house with id %s not found", request.HouseId)
 	}
 
 	if request.Spec == "" {
 		return errors.New("scheduler configuration not provided")
 	}
+	if err := scheduler.ValidateSpecification(request.Spec); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func (i *IncomeSchedulerServiceObject) validateUpdateRequest(id uuid.UUID, request model.UpdateIncomeSchedulerRequest) error {
+func (i *IncomeSchedulerServiceObject) validateUpdateRequest(id uuid.UUID, userId uuid.UUID, request model.UpdateIncomeSchedulerRequest) error {
 	if request.Sum <= 0 {
 		return errors.New("sum should not be zero of negative")
 	}
-	if !i.repository.ExistsById(id) {
+	if err := request.Limits.Validate(); err != nil {
+		return err
+	}
+	if err := request.TimeZone.Validate(); err != nil {
+		return err
+	}
+	if err := request.Adjustment.Validate(); err != nil {
+		return err
+	}
+	if !i.canModify(id, userId) {
 		return int_errors.NewErrNotFound("income scheduler with id %s not found", id)
 	}
 
 	if request.Spec == "" {
 		return errors.New("scheduler configuration not provided")
 	}
+	if err := scheduler.ValidateSpecification(request.Spec); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+// find returns the income scheduler of the house available to the user
+func (i *IncomeSchedulerServiceObject) find(id uuid.UUID, userId uuid.UUID) (model.IncomeScheduler, error) {
+	incomeScheduler, err := i.repository.FindById(id)
+	if err != nil {
+		return incomeScheduler, database.HandlerFindError(err, fmt.Sprintf("income scheduler with id %s not found", id))
+	}
+	if incomeScheduler.HouseId == nil || !i.houseService.HasAccess(*incomeScheduler.HouseId, userId) {
+		return model.IncomeScheduler{}, int_errors.NewErrNotFound("income scheduler with id %s not found", id)
+	}
+
+	return incomeScheduler, nil
+}
+
+func (i *IncomeSchedulerServiceObject) hasAccess(id uuid.UUID, userId uuid.UUID) bool {
+	_, err := i.find(id, userId)
+
+	return err == nil
+}
+
+// canModify checks that the income scheduler belongs to the house the user is allowed to change
+func (i *IncomeSchedulerServiceObject) canModify(id uuid.UUID, userId uuid.UUID) bool {
+	incomeScheduler, err := i.repository.FindById(id)
+
+	return err == nil && incomeScheduler.HouseId != nil && i.houseService.CanModify(*incomeScheduler.HouseId, userId)
+}
@@ -2,27 +2,45 @@ package service
 
 import (
 	"errors"
+	"fmt"
 	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	countryMocks "github.com/VlasovArtem/hob/src/country/mocks"
+	countryModel "github.com/VlasovArtem/hob/src/country/model"
+	holidayMocks "github.com/VlasovArtem/hob/src/holiday/mocks"
+	holidayModel "github.com/VlasovArtem/hob/src/holiday/model"
+	holidays "github.com/VlasovArtem/hob/src/holiday/service"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
 	incomeMocks "github.com/VlasovArtem/hob/src/income/mocks"
 	incomeModel "github.com/VlasovArtem/hob/src/income/model"
 	"github.com/VlasovArtem/hob/src/income/scheduler/mocks"
 	"github.com/VlasovArtem/hob/src/income/scheduler/model"
+	scheduler2 "github.com/VlasovArtem/hob/src/scheduler"
+	lockMocks "github.com/VlasovArtem/hob/src/scheduler/lock/mocks"
 	schedulerMocks "github.com/VlasovArtem/hob/src/scheduler/mocks"
+	runMocks "github.com/VlasovArtem/hob/src/scheduler/run/mocks"
+	runModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 type IncomeSchedulerServiceTestSuite struct {
 	testhelper.MockTestSuite[IncomeSchedulerService]
 	houses              *houseMocks.HouseService
+	countries           *countryMocks.CountryService
+	holidays            *holidayMocks.HolidayService
 	incomes             *incomeMocks.IncomeService
 	schedulers          *schedulerMocks.ServiceScheduler
+	runs                *runMocks.SchedulerRunService
+	locks               *lockMocks.SchedulerLockService
 	schedulerRepository *mocks.IncomeSchedulerRepository
 }
 
@@ -30,20 +48,43 @@ func TestIncomeSchedulerServiceTestSuite(t *testing.T) {
 	ts := &IncomeSchedulerServiceTestSuite{}
 	ts.TestObjectGenerator = func() IncomeSchedulerService {
 		ts.houses = new(houseMocks.HouseService)
+		ts.countries = new(countryMocks.CountryService)
+		ts.holidays = new(holidayMocks.HolidayService)
 		ts.incomes = new(incomeMocks.IncomeService)
 		ts.schedulers = new(schedulerMocks.ServiceScheduler)
+		ts.runs = new(runMocks.SchedulerRunService)
+		ts.locks = new(lockMocks.SchedulerLockService)
 		ts.schedulerRepository = new(mocks.IncomeSchedulerRepository)
-		return NewIncomeSchedulerService(ts.houses, ts.incomes, ts.schedulers, ts.schedulerRepository)
+		return NewIncomeSchedulerService(ts.houses, ts.countries, ts.holidays, ts.incomes, ts.schedulers, ts.runs, ts.locks, ts.schedulerRepository)
 	}
 
 	suite.Run(t, ts)
 }
 
+const kyivDailySpec = "CRON_TZ=Europe/Kyiv @daily"
+
+func (i *IncomeSchedulerServiceTestSuite) withHouseInKyiv(houseId uuid.UUID) {
+	i.houses.On("FindById", houseId, mocks.UserId).Return(houseModel.HouseDto{Id: houseId, CountryCode: "UA"}, nil)
+	i.countries.On("FindCountryByCode", "UA").Return(countryModel.Country{Code: "UA", TimeZone: "Europe/Kyiv"}, nil)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) withAccess(id uuid.UUID) {
+	entity := mocks.GenerateIncomeScheduler(uuid.New())
+	entity.Id = id
+
+	i.schedulerRepository.On("FindById", id).Return(entity, nil)
+	i.houses.On("HasAccess", *entity.HouseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", *entity.HouseId, mocks.UserId).Return(true)
+}
+
 func (i *IncomeSchedulerServiceTestSuite) Test_Add() {
 	request := mocks.GenerateCreateIncomeSchedulerRequest()
 
-	i.houses.On("ExistsById", request.HouseId).Return(true)
-	i.schedulers.On("Add", mock.AnythingOfType("uuid.UUID"), "@daily", mock.Anything).Return(cron.EntryID(0), nil)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", request.HouseId, request.UserId).Return(true)
+	i.locks.On("Acquire", mock.Anything, mock.Anything).Return(true)
+	i.withHouseInKyiv(request.HouseId)
+	i.schedulers.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).Return(cron.EntryID(0), nil)
 	i.schedulerRepository.On("Create", mock.Anything).Return(
 		func(meter model.IncomeScheduler) model.IncomeScheduler {
 			return meter
@@ -55,32 +96,107 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Add() {
 
 	expectedEntity := request.ToEntity()
 	expectedEntity.Id = payment.Id
+	expectedEntity.TimeZone = "Europe/Kyiv"
 	expectedResponse := expectedEntity.ToDto()
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), expectedResponse, payment)
-	i.schedulers.AssertCalled(i.T(), "Add", expectedEntity.Id, "@daily", mock.Anything)
+	i.schedulers.AssertCalled(i.T(), "Add", expectedEntity.Id, kyivDailySpec, mock.Anything)
+
+	createdIncome := incomeModel.IncomeDto{Id: uuid.New()}
 
-	i.incomes.On("Add", mock.Anything).Return(incomeModel.IncomeDto{}, nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(createdIncome, nil)
+	i.runs.On("Succeeded", expectedEntity.Id, mock.AnythingOfType("time.Time"), createdIncome.Id).Return()
+	i.schedulerRepository.On("FindById", expectedEntity.Id).Return(expectedEntity, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", expectedEntity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", expectedEntity.Id).Return(nil)
+	fireTime := time.Now().Add(-time.Second)
+	i.schedulers.On("LastExecution", kyivDailySpec, mock.AnythingOfType("time.Time")).Return(fireTime, nil)
 
 	function := i.schedulers.Calls[0].Arguments.Get(2).(func())
 	function()
 
 	createIncomeRequest := i.incomes.Calls[0].Arguments.Get(0).(incomeModel.CreateIncomeRequest)
 
+	i.locks.AssertCalled(i.T(), "Acquire", expectedEntity.Id, fireTime)
 	assert.Equal(i.T(), incomeModel.CreateIncomeRequest{
 		Name:        "Test Income",
 		Description: "Test Income Description",
 		HouseId:     createIncomeRequest.HouseId,
 		Date:        createIncomeRequest.Date,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 	}, createIncomeRequest)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdateLastExecutedAt", expectedEntity.Id, createIncomeRequest.Date)
+	i.runs.AssertCalled(i.T(), "Succeeded", expectedEntity.Id, createIncomeRequest.Date, createdIncome.Id)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithErrorDuringExecution() {
+	request := mocks.GenerateCreateIncomeSchedulerRequest()
+	expectedError := errors.New("error")
+
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", request.HouseId, request.UserId).Return(true)
+	i.locks.On("Acquire", mock.Anything, mock.Anything).Return(true)
+	i.withHouseInKyiv(request.HouseId)
+	i.schedulers.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).Return(cron.EntryID(0), nil)
+	i.schedulerRepository.On("Create", mock.Anything).Return(
+		func(meter model.IncomeScheduler) model.IncomeScheduler {
+			return meter
+		},
+		nil,
+	)
+
+	income, err := i.TestO.Add(request)
+
+	assert.Nil(i.T(), err)
+
+	i.schedulerRepository.On("FindById", income.Id).Return(request.ToEntity(), nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(incomeModel.IncomeDto{}, expectedError)
+	i.runs.On("Failed", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("time.Time"), expectedError).Return()
+	i.schedulers.On("LastExecution", mock.Anything, mock.AnythingOfType("time.Time")).Return(time.Now().Add(-time.Second), nil)
+
+	function := i.schedulers.Calls[0].Arguments.Get(2).(func())
+	function()
+
+	createIncomeRequest := i.incomes.Calls[0].Arguments.Get(0).(incomeModel.CreateIncomeRequest)
+
+	i.runs.AssertCalled(i.T(), "Failed", mock.AnythingOfType("uuid.UUID"), createIncomeRequest.Date, expectedError)
+	i.schedulerRepository.AssertNotCalled(i.T(), "UpdateLastExecutedAt", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithoutFireTime() {
+	request := mocks.GenerateCreateIncomeSchedulerRequest()
+
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", request.HouseId, request.UserId).Return(true)
+	i.withHouseInKyiv(request.HouseId)
+	i.schedulers.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).Return(cron.EntryID(0), nil)
+	i.schedulerRepository.On("Create", mock.Anything).Return(
+		func(meter model.IncomeScheduler) model.IncomeScheduler {
+			return meter
+		},
+		nil,
+	)
+
+	income, err := i.TestO.Add(request)
+
+	assert.Nil(i.T(), err)
+
+	i.schedulerRepository.On("FindById", income.Id).Return(request.ToEntity(), nil)
+	i.schedulers.On("LastExecution", mock.Anything, mock.AnythingOfType("time.Time")).Return(time.Time{}, nil)
+
+	function := i.schedulers.Calls[0].Arguments.Get(2).(func())
+	function()
+
+	i.locks.AssertNotCalled(i.T(), "Acquire", mock.Anything, mock.Anything)
+	i.incomes.AssertNotCalled(i.T(), "AddScheduled", mock.Anything, mock.Anything)
 }
 
 func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithHouseNotExists() {
 	request := mocks.GenerateCreateIncomeSchedulerRequest()
 
-	i.houses.On("ExistsById", request.HouseId).Return(false)
+	i.houses.On("CanModify", request.HouseId, request.UserId).Return(false)
 
 	payment, err := i.TestO.Add(request)
 
@@ -92,7 +208,7 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithHouseNotExists() {
 func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithInvalidSpec() {
 	request := mocks.GenerateCreateIncomeSchedulerRequest()
 
-	i.houses.On("ExistsById", request.HouseId).
+	i.houses.On("CanModify", request.HouseId, request.UserId).
 		Return(true)
 	i.schedulers.On("Create", mock.AnythingOfType("uuid.UUID"), "@daily", mock.Anything).
 		Return(cron.EntryID(0), nil)
@@ -108,9 +224,11 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithInvalidSpec() {
 func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithErrorDuringScheduling() {
 	request := mocks.GenerateCreateIncomeSchedulerRequest()
 
-	i.houses.On("ExistsById", request.HouseId).
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", request.HouseId, request.UserId).
 		Return(true)
-	i.schedulers.On("Add", mock.AnythingOfType("uuid.UUID"), "@daily", mock.Anything).
+	i.withHouseInKyiv(request.HouseId)
+	i.schedulers.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).
 		Return(cron.EntryID(0), errors.New("error"))
 
 	payment, err := i.TestO.Add(request)
@@ -119,19 +237,106 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithErrorDuringScheduling() {
 	assert.Equal(i.T(), model.IncomeSchedulerDto{}, payment)
 }
 
+func (i *IncomeSchedulerServiceTestSuite) Test_Start() {
+	first := mocks.GenerateIncomeScheduler(uuid.New())
+	second := mocks.GenerateIncomeScheduler(uuid.New())
+
+	i.schedulerRepository.On("AssignHouseOwners").Return(nil)
+	i.houses.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	i.schedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	i.schedulerRepository.On("FindAll").Return([]model.IncomeScheduler{first, second}, nil)
+	i.schedulers.On("Add", first.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	i.schedulers.On("Add", second.Id, "@daily", mock.Anything).Return(cron.EntryID(0), errors.New("error"))
+
+	err := i.TestO.(*IncomeSchedulerServiceObject).Start()
+
+	assert.Nil(i.T(), err)
+	i.schedulers.AssertCalled(i.T(), "Add", first.Id, "@daily", mock.Anything)
+	i.schedulers.AssertCalled(i.T(), "Add", second.Id, "@daily", mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Start_WithMissedExecutions() {
+	lastExecutedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.Local)
+	first := time.Date(2022, time.January, 2, 0, 0, 0, 0, time.Local)
+	second := time.Date(2022, time.January, 3, 0, 0, 0, 0, time.Local)
+
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	scheduler.LastExecutedAt = &lastExecutedAt
+
+	i.schedulerRepository.On("AssignHouseOwners").Return(nil)
+	i.houses.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	i.schedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	i.schedulerRepository.On("FindAll").Return([]model.IncomeScheduler{scheduler}, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	i.schedulers.On("MissedExecutions", "@daily", lastExecutedAt, mock.AnythingOfType("time.Time")).Return([]time.Time{first, second}, nil)
+	i.schedulers.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(incomeModel.IncomeDto{}, nil)
+	i.runs.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), mock.AnythingOfType("uuid.UUID")).Return()
+	i.locks.On("Acquire", mock.Anything, mock.Anything).Return(true)
+
+	err := i.TestO.(*IncomeSchedulerServiceObject).Start()
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), first, i.incomes.Calls[0].Arguments.Get(0).(incomeModel.CreateIncomeRequest).Date)
+	assert.Equal(i.T(), second, i.incomes.Calls[1].Arguments.Get(0).(incomeModel.CreateIncomeRequest).Date)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdateLastExecutedAt", scheduler.Id, first)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdateLastExecutedAt", scheduler.Id, second)
+	i.runs.AssertNumberOfCalls(i.T(), "Succeeded", 2)
+	i.schedulers.AssertCalled(i.T(), "Add", scheduler.Id, "@daily", mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Start_WithErrorFromRepository() {
+	expectedError := errors.New("error")
+
+	i.schedulerRepository.On("AssignHouseOwners").Return(nil)
+	i.houses.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	i.schedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	i.schedulerRepository.On("FindAll").Return(nil, expectedError)
+
+	err := i.TestO.(*IncomeSchedulerServiceObject).Start()
+
+	assert.Equal(i.T(), expectedError, err)
+	i.schedulers.AssertNotCalled(i.T(), "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_FindRunsById() {
+	id := uuid.New()
+	runs := []runModel.SchedulerRunDto{runMocks.GenerateSchedulerRun(id).ToDto()}
+
+	i.withAccess(id)
+	i.runs.On("FindBySchedulerId", id).Return(runs)
+
+	actual, err := i.TestO.FindRunsById(id, mocks.UserId)
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), runs, actual)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_FindRunsById_WithMissingRecord() {
+	id := uuid.New()
+
+	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, gorm.ErrRecordNotFound)
+
+	actual, err := i.TestO.FindRunsById(id, mocks.UserId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", id), err)
+	assert.Nil(i.T(), actual)
+}
+
 func (i *IncomeSchedulerServiceTestSuite) Test_DeleteById() {
 
 	id := uuid.New()
 
-	i.schedulerRepository.On("ExistsById", id).Return(true)
+	i.withAccess(id)
 	i.schedulers.On("Remove", id).Return(nil)
 	i.schedulerRepository.On("DeleteById", id).Return(nil)
 
-	err := i.TestO.DeleteById(id)
+	err := i.TestO.DeleteById(id, mocks.UserId)
 
 	assert.Nil(i.T(), err)
 
-	i.schedulerRepository.AssertCalled(i.T(), "ExistsById", id)
+	i.schedulerRepository.AssertCalled(i.T(), "FindById", id)
 	i.schedulers.AssertCalled(i.T(), "Remove", id)
 	i.schedulerRepository.AssertCalled(i.T(), "DeleteById", id)
 }
@@ -140,31 +345,45 @@ func (i *IncomeSchedulerServiceTestSuite) Test_DeleteById_WithNotExistsScheduler
 
 	id := uuid.New()
 
-	i.schedulerRepository.On("ExistsById", id).Return(false)
+	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, gorm.ErrRecordNotFound)
 
-	err := i.TestO.DeleteById(id)
+	err := i.TestO.DeleteById(id, mocks.UserId)
 
 	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", id), err)
 
-	i.schedulerRepository.AssertCalled(i.T(), "ExistsById", id)
+	i.schedulerRepository.AssertCalled(i.T(), "FindById", id)
 	i.schedulers.AssertNotCalled(i.T(), "Remove", id)
 	i.schedulerRepository.AssertNotCalled(i.T(), "DeleteById", id)
 }
 
+func (i *IncomeSchedulerServiceTestSuite) Test_DeleteById_WithViewer() {
+	entity := mocks.GenerateIncomeScheduler(uuid.New())
+
+	i.schedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	i.houses.On("CanModify", *entity.HouseId, mocks.UserId).Return(false)
+
+	err := i.TestO.DeleteById(entity.Id, mocks.UserId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", entity.Id), err)
+
+	i.schedulers.AssertNotCalled(i.T(), "Remove", entity.Id)
+	i.schedulerRepository.AssertNotCalled(i.T(), "DeleteById", entity.Id)
+}
+
 func (i *IncomeSchedulerServiceTestSuite) Test_DeleteById_WithErrorDuringDeleteByIdScheduler() {
 
 	id := uuid.New()
 	expectedError := errors.New("test")
 
-	i.schedulerRepository.On("ExistsById", id).Return(true)
+	i.withAccess(id)
 	i.schedulers.On("Remove", id).Return(expectedError)
 	i.schedulerRepository.On("DeleteById", id).Return(nil)
 
-	err := i.TestO.DeleteById(id)
+	err := i.TestO.DeleteById(id, mocks.UserId)
 
 	assert.Nil(i.T(), err)
 
-	i.schedulerRepository.AssertCalled(i.T(), "ExistsById", id)
+	i.schedulerRepository.AssertCalled(i.T(), "FindById", id)
 	i.schedulers.AssertCalled(i.T(), "Remove", id)
 	i.schedulerRepository.AssertCalled(i.T(), "DeleteById", id)
 }
@@ -173,10 +392,10 @@ func (i *IncomeSchedulerServiceTestSuite) Test_FindById() {
 
 	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
 
-	i.schedulerRepository.On("ExistsById", scheduler.Id).Return(true)
+	i.houses.On("HasAccess", *scheduler.HouseId, mocks.UserId).Return(true)
 	i.schedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
 
-	actual, err := i.TestO.FindById(scheduler.Id)
+	actual, err := i.TestO.FindById(scheduler.Id, mocks.UserId)
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), scheduler.ToDto(), actual)
@@ -185,24 +404,36 @@ func (i *IncomeSchedulerServiceTestSuite) Test_FindById() {
 func (i *IncomeSchedulerServiceTestSuite) Test_FindById_WithNotExistingId() {
 	id := uuid.New()
 
-	i.schedulerRepository.On("ExistsById", id).Return(false)
+	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, gorm.ErrRecordNotFound)
 
-	actual, err := i.TestO.FindById(id)
+	actual, err := i.TestO.FindById(id, mocks.UserId)
 
 	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", id), err)
 	assert.Equal(i.T(), model.IncomeSchedulerDto{}, actual)
 
-	i.schedulerRepository.AssertNotCalled(i.T(), "FindById", id)
+	i.houses.AssertNotCalled(i.T(), "HasAccess", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_FindById_WithoutAccess() {
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	userId := uuid.New()
+
+	i.houses.On("HasAccess", *scheduler.HouseId, userId).Return(false)
+	i.schedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+
+	actual, err := i.TestO.FindById(scheduler.Id, userId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", scheduler.Id), err)
+	assert.Equal(i.T(), model.IncomeSchedulerDto{}, actual)
 }
 
 func (i *IncomeSchedulerServiceTestSuite) Test_FindById_WithErrorFromDatabase() {
 	id := uuid.New()
 	expectedError := errors.New("error")
 
-	i.schedulerRepository.On("ExistsById", id).Return(true)
 	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, expectedError)
 
-	actual, err := i.TestO.FindById(id)
+	actual, err := i.TestO.FindById(id, mocks.UserId)
 
 	assert.Equal(i.T(), expectedError, err)
 	assert.Equal(i.T(), model.IncomeSchedulerDto{}, actual)
@@ -214,22 +445,34 @@ func (i *IncomeSchedulerServiceTestSuite) Test_FindByHouseId() {
 
 	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
 
+	i.houses.On("HasAccess", *scheduler.HouseId, mocks.UserId).Return(true)
 	i.schedulerRepository.On("FindByHouseId", *scheduler.HouseId).Return([]model.IncomeSchedulerDto{scheduler.ToDto()}, nil)
 
-	actual := i.TestO.FindByHouseId(*scheduler.HouseId)
+	actual := i.TestO.FindByHouseId(*scheduler.HouseId, mocks.UserId)
 
 	assert.Equal(i.T(), []model.IncomeSchedulerDto{scheduler.ToDto()}, actual)
 }
 
 func (i *IncomeSchedulerServiceTestSuite) Test_FindByHouseId_WithNotExistingRecords() {
 	id := uuid.New()
+	i.houses.On("HasAccess", id, mocks.UserId).Return(true)
 	i.schedulerRepository.On("FindByHouseId", id).Return([]model.IncomeSchedulerDto{}, errors.New("test"))
 
-	actual := i.TestO.FindByHouseId(id)
+	actual := i.TestO.FindByHouseId(id, mocks.UserId)
 
 	assert.Equal(i.T(), []model.IncomeSchedulerDto{}, actual)
 }
 
+func (i *IncomeSchedulerServiceTestSuite) Test_FindByHouseId_WithoutAccess() {
+	id := uuid.New()
+	i.houses.On("HasAccess", id, mocks.UserId).Return(false)
+
+	actual := i.TestO.FindByHouseId(id, mocks.UserId)
+
+	assert.Equal(i.T(), []model.IncomeSchedulerDto{}, actual)
+	i.schedulerRepository.AssertNotCalled(i.T(), "FindByHouseId", id)
+}
+
 func (i *IncomeSchedulerServiceTestSuite) Test_Update() {
 	id, request := mocks.GenerateUpdateIncomeSchedulerRequest()
 	scheduler := model.IncomeScheduler{
@@ -242,15 +485,16 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Update() {
 		Spec: request.Spec,
 	}
 
-	i.schedulerRepository.On("ExistsById", id).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.withAccess(id)
 	i.schedulerRepository.On("Update", id, request).Return(scheduler, nil)
 	i.schedulers.On("Update", id, string(request.Spec), mock.Anything).Return(cron.EntryID(0), nil)
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, mocks.UserId, request)
 
 	assert.Nil(i.T(), err)
 
-	i.schedulerRepository.AssertCalled(i.T(), "ExistsById", id)
+	i.schedulerRepository.AssertCalled(i.T(), "FindById", id)
 	i.schedulerRepository.AssertCalled(i.T(), "Update", id, request)
 	i.schedulers.AssertCalled(i.T(), "Update", id, string(request.Spec), mock.Anything)
 	i.schedulerRepository.AssertNotCalled(i.T(), "DeleteById", id)
@@ -259,26 +503,26 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Update() {
 func (i *IncomeSchedulerServiceTestSuite) Test_Update_WithNotExists() {
 	id, request := mocks.GenerateUpdateIncomeSchedulerRequest()
 
-	i.schedulerRepository.On("ExistsById", id).Return(false)
+	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, gorm.ErrRecordNotFound)
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", id), err)
 
-	i.schedulerRepository.AssertCalled(i.T(), "ExistsById", id)
+	i.schedulerRepository.AssertCalled(i.T(), "FindById", id)
 	i.schedulerRepository.AssertNotCalled(i.T(), "Update", id, request)
 	i.schedulers.AssertNotCalled(i.T(), "Update", id, string(request.Spec), mock.Anything)
 	i.schedulerRepository.AssertNotCalled(i.T(), "DeleteById", id)
 }
 
 func (i *IncomeSchedulerServiceTestSuite) Test_Update_WithInvalidSum() {
-	sums := []float32{-1, 0}
+	sums := []money.Money{-1, 0}
 
 	for _, sum := range sums {
 		id, request := mocks.GenerateUpdateIncomeSchedulerRequest()
 		request.Sum = sum
 
-		err := i.TestO.Update(id, request)
+		err := i.TestO.Update(id, mocks.UserId, request)
 
 		assert.Equal(i.T(), errors.New("sum should not be zero of negative"), err)
 
@@ -294,13 +538,13 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Update_WithInvalidScheduler() {
 	id, request := mocks.GenerateUpdateIncomeSchedulerRequest()
 	request.Spec = ""
 
-	i.schedulerRepository.On("ExistsById", id).Return(true)
+	i.withAccess(id)
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(i.T(), errors.New("scheduler configuration not provided"), err)
 
-	i.schedulerRepository.AssertCalled(i.T(), "ExistsById", id)
+	i.schedulerRepository.AssertCalled(i.T(), "FindById", id)
 	i.schedulerRepository.AssertNotCalled(i.T(), "Update", id, request)
 	i.schedulers.AssertNotCalled(i.T(), "Update", id, string(request.Spec), mock.Anything)
 	i.schedulerRepository.AssertNotCalled(i.T(), "DeleteById", id)
@@ -310,14 +554,15 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Update_WithErrorFromRepository()
 
 	id, request := mocks.GenerateUpdateIncomeSchedulerRequest()
 
-	i.schedulerRepository.On("ExistsById", id).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.withAccess(id)
 	i.schedulerRepository.On("Update", id, request).Return(model.IncomeScheduler{}, errors.New("test"))
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(i.T(), errors.New("test"), err)
 
-	i.schedulerRepository.AssertCalled(i.T(), "ExistsById", id)
+	i.schedulerRepository.AssertCalled(i.T(), "FindById", id)
 	i.schedulerRepository.AssertCalled(i.T(), "Update", id, request)
 	i.schedulers.AssertNotCalled(i.T(), "Update", id, string(request.Spec), mock.Anything)
 	i.schedulerRepository.AssertNotCalled(i.T(), "DeleteById", id)
@@ -336,17 +581,519 @@ func (i *IncomeSchedulerServiceTestSuite) Test_Update_WithErrorFromScheduler() {
 		Spec: request.Spec,
 	}
 
-	i.schedulerRepository.On("ExistsById", id).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.withAccess(id)
 	i.schedulerRepository.On("Update", id, request).Return(scheduler, nil)
 	i.schedulers.On("Update", id, string(request.Spec), mock.Anything).Return(cron.EntryID(0), errors.New("test2"))
 	i.schedulerRepository.On("DeleteById", id).Return(nil)
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(i.T(), errors.New("test2"), err)
 
-	i.schedulerRepository.AssertCalled(i.T(), "ExistsById", id)
+	i.schedulerRepository.AssertCalled(i.T(), "FindById", id)
 	i.schedulerRepository.AssertCalled(i.T(), "Update", id, request)
 	i.schedulers.AssertCalled(i.T(), "Update", id, string(request.Spec), mock.Anything)
 	i.schedulerRepository.AssertCalled(i.T(), "DeleteById", id)
 }
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Start_WithPausedScheduler() {
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	scheduler.Paused = true
+
+	i.schedulerRepository.On("AssignHouseOwners").Return(nil)
+	i.houses.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	i.schedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	i.schedulerRepository.On("FindAll").Return([]model.IncomeScheduler{scheduler}, nil)
+	i.schedulers.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	i.schedulers.On("Pause", scheduler.Id).Return(nil)
+
+	err := i.TestO.(*IncomeSchedulerServiceObject).Start()
+
+	assert.Nil(i.T(), err)
+	i.schedulers.AssertCalled(i.T(), "Pause", scheduler.Id)
+	i.schedulers.AssertNotCalled(i.T(), "MissedExecutions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Pause() {
+	id := uuid.New()
+
+	i.withAccess(id)
+	i.schedulers.On("Pause", id).Return(nil)
+	i.schedulerRepository.On("UpdatePaused", id, true).Return(nil)
+
+	err := i.TestO.Pause(id, mocks.UserId)
+
+	assert.Nil(i.T(), err)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdatePaused", id, true)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Pause_WithMissingRecord() {
+	id := uuid.New()
+
+	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, gorm.ErrRecordNotFound)
+
+	err := i.TestO.Pause(id, mocks.UserId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", id), err)
+	i.schedulers.AssertNotCalled(i.T(), "Pause", id)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Pause_WithErrorFromScheduler() {
+	id := uuid.New()
+	expectedError := errors.New("error")
+
+	i.withAccess(id)
+	i.schedulers.On("Pause", id).Return(expectedError)
+
+	err := i.TestO.Pause(id, mocks.UserId)
+
+	assert.Equal(i.T(), expectedError, err)
+	i.schedulerRepository.AssertNotCalled(i.T(), "UpdatePaused", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Resume() {
+	id := uuid.New()
+
+	i.withAccess(id)
+	i.schedulers.On("Resume", id).Return(nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("UpdatePaused", id, false).Return(nil)
+
+	err := i.TestO.Resume(id, mocks.UserId)
+
+	assert.Nil(i.T(), err)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdateLastExecutedAt", id, mock.AnythingOfType("time.Time"))
+	i.schedulerRepository.AssertCalled(i.T(), "UpdatePaused", id, false)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Resume_WithMissingRecord() {
+	id := uuid.New()
+
+	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, gorm.ErrRecordNotFound)
+
+	err := i.TestO.Resume(id, mocks.UserId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", id), err)
+	i.schedulers.AssertNotCalled(i.T(), "Resume", id)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Resume_WithErrorFromScheduler() {
+	id := uuid.New()
+	expectedError := errors.New("error")
+
+	i.withAccess(id)
+	i.schedulers.On("Resume", id).Return(expectedError)
+
+	err := i.TestO.Resume(id, mocks.UserId)
+
+	assert.Equal(i.T(), expectedError, err)
+	i.schedulerRepository.AssertNotCalled(i.T(), "UpdatePaused", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger() {
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	created := incomeModel.IncomeDto{Id: uuid.New()}
+
+	i.houses.On("HasAccess", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(created, nil)
+	i.runs.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := i.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Nil(i.T(), err)
+	i.incomes.AssertNumberOfCalls(i.T(), "AddScheduled", 1)
+	i.runs.AssertCalled(i.T(), "Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), created.Id)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger_WithErrorDuringExecution() {
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	expectedError := errors.New("error")
+
+	i.houses.On("HasAccess", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(incomeModel.IncomeDto{}, expectedError)
+	i.runs.On("Failed", scheduler.Id, mock.AnythingOfType("time.Time"), expectedError).Return()
+
+	err := i.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Equal(i.T(), expectedError, err)
+	i.schedulerRepository.AssertNotCalled(i.T(), "UpdateLastExecutedAt", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger_WithMissingRecord() {
+	id := uuid.New()
+
+	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, gorm.ErrRecordNotFound)
+
+	err := i.TestO.Trigger(id, mocks.UserId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", id), err)
+	i.incomes.AssertNotCalled(i.T(), "AddScheduled", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithInvalidLimits() {
+	request := mocks.GenerateCreateIncomeSchedulerRequest()
+	request.MaxOccurrences = -1
+
+	payment, err := i.TestO.Add(request)
+
+	assert.Equal(i.T(), errors.New("max occurrences should not be negative"), err)
+	assert.Empty(i.T(), payment)
+	i.schedulerRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Update_WithInvalidDateRange() {
+	id, request := mocks.GenerateUpdateIncomeSchedulerRequest()
+	startDate := time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	request.StartDate = &startDate
+	request.EndDate = &endDate
+
+	err := i.TestO.Update(id, mocks.UserId, request)
+
+	assert.Equal(i.T(), errors.New("end date should be after start date"), err)
+	i.schedulerRepository.AssertNotCalled(i.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger_WithLastOccurrence() {
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	scheduler.MaxOccurrences = 2
+	scheduler.Occurrences = 1
+	created := incomeModel.IncomeDto{Id: uuid.New()}
+
+	i.houses.On("HasAccess", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	i.schedulerRepository.On("UpdatePaused", scheduler.Id, true).Return(nil)
+	i.schedulers.On("Pause", scheduler.Id).Return(nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(created, nil)
+	i.runs.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := i.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Nil(i.T(), err)
+	i.incomes.AssertNumberOfCalls(i.T(), "AddScheduled", 1)
+	i.schedulers.AssertCalled(i.T(), "Pause", scheduler.Id)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdatePaused", scheduler.Id, true)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger_WithEndDateInThePast() {
+	endDate := time.Now().Add(-time.Hour)
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	scheduler.EndDate = &endDate
+
+	i.houses.On("HasAccess", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	i.schedulerRepository.On("UpdatePaused", scheduler.Id, true).Return(nil)
+	i.schedulers.On("Pause", scheduler.Id).Return(nil)
+
+	err := i.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Equal(i.T(), errors.New(fmt.Sprintf("income scheduler %s is exhausted", scheduler.Id)), err)
+	i.incomes.AssertNotCalled(i.T(), "AddScheduled", mock.Anything, mock.Anything)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdatePaused", scheduler.Id, true)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger_WithStartDateInTheFuture() {
+	startDate := time.Now().Add(time.Hour)
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	scheduler.StartDate = &startDate
+
+	i.houses.On("HasAccess", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", *scheduler.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+
+	err := i.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Equal(i.T(), errors.New(fmt.Sprintf("income scheduler %s is not started yet", scheduler.Id)), err)
+	i.incomes.AssertNotCalled(i.T(), "AddScheduled", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Start_WithMissedExecutionsOutsideOfLimits() {
+	lastExecutedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.Local)
+	first := time.Date(2022, time.January, 2, 0, 0, 0, 0, time.Local)
+	second := time.Date(2022, time.January, 3, 0, 0, 0, 0, time.Local)
+	third := time.Date(2022, time.January, 4, 0, 0, 0, 0, time.Local)
+
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	scheduler.LastExecutedAt = &lastExecutedAt
+	scheduler.StartDate = &second
+	scheduler.MaxOccurrences = 1
+
+	i.schedulerRepository.On("AssignHouseOwners").Return(nil)
+	i.houses.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	i.schedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	i.schedulerRepository.On("FindAll").Return([]model.IncomeScheduler{scheduler}, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, second).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	i.schedulerRepository.On("UpdatePaused", scheduler.Id, true).Return(nil)
+	i.schedulers.On("MissedExecutions", "@daily", lastExecutedAt, mock.AnythingOfType("time.Time")).Return([]time.Time{first, second, third}, nil)
+	i.schedulers.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	i.schedulers.On("Pause", scheduler.Id).Return(nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(incomeModel.IncomeDto{}, nil)
+	i.runs.On("Succeeded", scheduler.Id, second, mock.AnythingOfType("uuid.UUID")).Return()
+	i.locks.On("Acquire", mock.Anything, mock.Anything).Return(true)
+
+	err := i.TestO.(*IncomeSchedulerServiceObject).Start()
+
+	assert.Nil(i.T(), err)
+	i.incomes.AssertNumberOfCalls(i.T(), "AddScheduled", 1)
+	i.schedulers.AssertCalled(i.T(), "Pause", scheduler.Id)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdatePaused", scheduler.Id, true)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithNotValidSpec() {
+	request := mocks.GenerateCreateIncomeSchedulerRequest()
+	request.Spec = "0 0 32 * *"
+
+	i.houses.On("CanModify", request.HouseId, request.UserId).Return(true)
+
+	income, err := i.TestO.Add(request)
+
+	assert.ErrorIs(i.T(), err, int_errors.ErrResponse{})
+	assert.Contains(i.T(), err.Error(), "scheduler specification '0 0 32 * *' is not valid")
+	assert.Equal(i.T(), model.IncomeSchedulerDto{}, income)
+	i.schedulerRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Update_WithNotValidSpec() {
+	id, request := mocks.GenerateUpdateIncomeSchedulerRequest()
+	request.Spec = "invalid"
+
+	i.withAccess(id)
+
+	err := i.TestO.Update(id, mocks.UserId, request)
+
+	assert.ErrorIs(i.T(), err, int_errors.ErrResponse{})
+	i.schedulerRepository.AssertNotCalled(i.T(), "Update", id, request)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_FindNextExecutionsById() {
+	entity := mocks.GenerateIncomeScheduler(uuid.New())
+	next := []time.Time{time.Now().Add(time.Hour), time.Now().Add(2 * time.Hour)}
+
+	i.houses.On("HasAccess", *entity.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	i.schedulers.On("NextExecutions", string(entity.Spec), entity.TimeZone, mock.AnythingOfType("time.Time"), 2).Return(next, nil)
+
+	actual, err := i.TestO.FindNextExecutionsById(entity.Id, mocks.UserId, 2)
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), next, actual)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_FindNextExecutionsById_WithLimits() {
+	startDate := time.Now().Add(24 * time.Hour)
+	entity := mocks.GenerateIncomeScheduler(uuid.New())
+	entity.StartDate = &startDate
+	entity.MaxOccurrences = 3
+	entity.Occurrences = 2
+	next := []time.Time{startDate, startDate.Add(time.Hour)}
+
+	i.houses.On("HasAccess", *entity.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	i.schedulers.On("NextExecutions", string(entity.Spec), entity.TimeZone, startDate.Add(-time.Nanosecond), 2).Return(next, nil)
+
+	actual, err := i.TestO.FindNextExecutionsById(entity.Id, mocks.UserId, 2)
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), next[:1], actual)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_FindNextExecutionsById_WithTimeZone() {
+	serviceScheduler := scheduler2.NewSchedulerService(scheduler2.NewDefaultSchedulerConfiguration())
+	defer serviceScheduler.Stop()
+	service := NewIncomeSchedulerService(i.houses, i.countries, i.holidays, i.incomes, serviceScheduler, i.runs, i.locks, i.schedulerRepository)
+
+	entity := mocks.GenerateIncomeScheduler(uuid.New())
+	entity.Spec = "0 9 * * *"
+	entity.TimeZone = "Europe/Kyiv"
+
+	i.houses.On("HasAccess", *entity.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+
+	actual, err := service.FindNextExecutionsById(entity.Id, mocks.UserId, 2)
+
+	assert.Nil(i.T(), err)
+	assert.Len(i.T(), actual, 2)
+	for _, next := range actual {
+		assert.Equal(i.T(), "Europe/Kyiv", next.Location().String())
+		assert.Equal(i.T(), 9, next.Hour())
+		assert.Equal(i.T(), 0, next.Minute())
+	}
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_FindNextExecutionsById_WithMissingRecord() {
+	id := uuid.New()
+
+	i.schedulerRepository.On("FindById", id).Return(model.IncomeScheduler{}, gorm.ErrRecordNotFound)
+
+	actual, err := i.TestO.FindNextExecutionsById(id, mocks.UserId, 2)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income scheduler with id %s not found", id), err)
+	assert.Nil(i.T(), actual)
+	i.schedulers.AssertNotCalled(i.T(), "NextExecutions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithTimeZone() {
+	request := mocks.GenerateCreateIncomeSchedulerRequest()
+	request.TimeZone = "America/New_York"
+
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", request.HouseId, request.UserId).Return(true)
+	i.schedulers.On("Add", mock.AnythingOfType("uuid.UUID"), "CRON_TZ=America/New_York @daily", mock.Anything).Return(cron.EntryID(0), nil)
+	i.schedulerRepository.On("Create", mock.Anything).Return(
+		func(income model.IncomeScheduler) model.IncomeScheduler {
+			return income
+		},
+		nil,
+	)
+
+	income, err := i.TestO.Add(request)
+
+	assert.Nil(i.T(), err)
+	assert.EqualValues(i.T(), "America/New_York", income.TimeZone)
+	i.houses.AssertNotCalled(i.T(), "FindById", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithInvalidTimeZone() {
+	request := mocks.GenerateCreateIncomeSchedulerRequest()
+	request.TimeZone = "Europe/Unknown"
+
+	income, err := i.TestO.Add(request)
+
+	assert.Equal(i.T(), errors.New("time zone Europe/Unknown is not valid"), err)
+	assert.Equal(i.T(), model.IncomeSchedulerDto{}, income)
+	i.schedulerRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger_WithTimeZone() {
+	entity := mocks.GenerateIncomeScheduler(uuid.New())
+	entity.TimeZone = "Europe/Kyiv"
+	created := incomeModel.IncomeDto{Id: uuid.New()}
+
+	i.houses.On("HasAccess", *entity.HouseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", *entity.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(created, nil)
+	i.runs.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := i.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(i.T(), err)
+
+	createIncomeRequest := i.incomes.Calls[0].Arguments.Get(0).(incomeModel.CreateIncomeRequest)
+
+	assert.Equal(i.T(), "Europe/Kyiv", createIncomeRequest.Date.Location().String())
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger_WithBusinessDayAdjustment() {
+	houseId := uuid.New()
+	entity := mocks.GenerateIncomeScheduler(houseId)
+	entity.Adjustment = scheduler2.PreviousBusinessDay
+	created := incomeModel.IncomeDto{Id: uuid.New()}
+	adjusted := time.Date(2023, time.April, 14, 0, 0, 0, 0, time.UTC)
+
+	i.houses.On("HasAccess", *entity.HouseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", *entity.HouseId, mocks.UserId).Return(true)
+	i.schedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	i.houses.On("FindById", houseId, mocks.UserId).Return(houseModel.HouseDto{Id: houseId, CountryCode: "UA"}, nil)
+	i.holidays.On("Adjust", "UA", mock.AnythingOfType("time.Time"), scheduler2.PreviousBusinessDay).Return(adjusted)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(created, nil)
+	i.runs.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := i.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(i.T(), err)
+
+	createIncomeRequest := i.incomes.Calls[0].Arguments.Get(0).(incomeModel.CreateIncomeRequest)
+	fireTime := i.runs.Calls[0].Arguments.Get(1).(time.Time)
+
+	assert.Equal(i.T(), adjusted, createIncomeRequest.Date)
+	i.schedulerRepository.AssertCalled(i.T(), "UpdateLastExecutedAt", entity.Id, fireTime)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Trigger_WithNextBusinessDayAfterHoliday() {
+	today := time.Now().In(scheduler2.TimeZone("Europe/Kyiv").Location())
+	holidayService := holidays.NewHolidayService([]holidayModel.CountryHolidays{
+		{Code: "UA", Holidays: []holidayModel.Holiday{{Date: today.Format("2006-01-02"), Name: "Test Holiday"}}},
+	})
+	service := NewIncomeSchedulerService(i.houses, i.countries, holidayService, i.incomes, i.schedulers, i.runs, i.locks, i.schedulerRepository)
+
+	houseId := uuid.New()
+	entity := mocks.GenerateIncomeScheduler(houseId)
+	entity.TimeZone = "Europe/Kyiv"
+	entity.Adjustment = scheduler2.NextBusinessDay
+	created := incomeModel.IncomeDto{Id: uuid.New()}
+
+	i.houses.On("HasAccess", houseId, mocks.UserId).Return(true)
+	i.houses.On("CanModify", houseId, mocks.UserId).Return(true)
+	i.houses.On("FindById", houseId, mocks.UserId).Return(houseModel.HouseDto{Id: houseId, CountryCode: "UA"}, nil)
+	i.schedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(created, nil)
+	i.runs.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := service.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(i.T(), err)
+
+	createIncomeRequest := i.incomes.Calls[0].Arguments.Get(0).(incomeModel.CreateIncomeRequest)
+
+	assert.True(i.T(), createIncomeRequest.Date.After(time.Now()))
+	assert.True(i.T(), holidayService.IsBusinessDay("UA", createIncomeRequest.Date))
+	i.incomes.AssertNotCalled(i.T(), "Add", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Add_WithNotSupportedAdjustment() {
+	request := mocks.GenerateCreateIncomeSchedulerRequest()
+	request.Adjustment = "following"
+
+	income, err := i.TestO.Add(request)
+
+	assert.Equal(i.T(), errors.New("business day adjustment following is not supported"), err)
+	assert.Equal(i.T(), model.IncomeSchedulerDto{}, income)
+}
+
+func (i *IncomeSchedulerServiceTestSuite) Test_Start_WithMissedExecutionLockedByAnotherInstance() {
+	lastExecutedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.Local)
+	first := time.Date(2022, time.January, 2, 0, 0, 0, 0, time.Local)
+	second := time.Date(2022, time.January, 3, 0, 0, 0, 0, time.Local)
+
+	scheduler := mocks.GenerateIncomeScheduler(uuid.New())
+	scheduler.LastExecutedAt = &lastExecutedAt
+
+	i.schedulerRepository.On("AssignHouseOwners").Return(nil)
+	i.houses.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	i.schedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	i.schedulerRepository.On("FindAll").Return([]model.IncomeScheduler{scheduler}, nil)
+	i.schedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	i.schedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	i.schedulers.On("MissedExecutions", "@daily", lastExecutedAt, mock.AnythingOfType("time.Time")).Return([]time.Time{first, second}, nil)
+	i.schedulers.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	i.incomes.On("AddScheduled", mock.Anything, mocks.UserId).Return(incomeModel.IncomeDto{}, nil)
+	i.runs.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), mock.AnythingOfType("uuid.UUID")).Return()
+	i.locks.On("Acquire", scheduler.Id, first).Return(false)
+	i.locks.On("Acquire", scheduler.Id, second).Return(true)
+
+	err := i.TestO.(*IncomeSchedulerServiceObject).Start()
+
+	assert.Nil(i.T(), err)
+	i.incomes.AssertNumberOfCalls(i.T(), "AddScheduled", 1)
+	assert.Equal(i.T(), second, i.incomes.Calls[0].Arguments.Get(0).(incomeModel.CreateIncomeRequest).Date)
+	i.schedulerRepository.AssertNotCalled(i.T(), "UpdateLastExecutedAt", scheduler.Id, first)
+}
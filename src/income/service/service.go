@@ -3,6 +3,7 @@ package service
 import (
 	"errors"
 	"fmt"
+	categoryService "github.com/VlasovArtem/hob/src/category/service"
 	"github.com/VlasovArtem/hob/src/common"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/common/int-errors"
@@ -10,6 +11,8 @@ import (
 	houseService "github.com/VlasovArtem/hob/src/house/service"
 	"github.com/VlasovArtem/hob/src/income/model"
 	"github.com/VlasovArtem/hob/src/income/repository"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+	tagService "github.com/VlasovArtem/hob/src/tag/service"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
@@ -17,20 +20,26 @@ import (
 )
 
 type IncomeServiceObject struct {
-	houseService houseService.HouseService
-	groupService groupService.GroupService
-	repository   repository.IncomeRepository
+	houseService    houseService.HouseService
+	groupService    groupService.GroupService
+	categoryService categoryService.CategoryService
+	tagService      tagService.TagService
+	repository      repository.IncomeRepository
 }
 
 func NewIncomeService(
 	houseService houseService.HouseService,
 	groupService groupService.GroupService,
+	categoryService categoryService.CategoryService,
+	tagService tagService.TagService,
 	repository repository.IncomeRepository,
 ) IncomeService {
 	return &IncomeServiceObject{
-		houseService: houseService,
-		groupService: groupService,
-		repository:   repository,
+		houseService:    houseService,
+		groupService:    groupService,
+		categoryService: categoryService,
+		tagService:      tagService,
+		repository:      repository,
 	}
 }
 
@@ -38,43 +47,75 @@ func (i *IncomeServiceObject) Initialize(factory dependency.DependenciesProvider
 	return NewIncomeService(
 		dependency.FindRequiredDependency[houseService.HouseServiceObject, houseService.HouseService](factory),
 		dependency.FindRequiredDependency[groupService.GroupServiceObject, groupService.GroupService](factory),
+		dependency.FindRequiredDependency[categoryService.CategoryServiceObject, categoryService.CategoryService](factory),
+		dependency.FindRequiredDependency[tagService.TagServiceObject, tagService.TagService](factory),
 		dependency.FindRequiredDependency[repository.IncomeRepositoryObject, repository.IncomeRepository](factory),
 	)
 }
 
+// Start assigns the currency of the house country to the incomes persisted before the currency was stored
+func (i *IncomeServiceObject) Start() error {
+	if err := i.repository.AssignHouseCurrencies(i.houseService.CountryCurrencies()); err != nil {
+		log.Error().Err(err).Msg("currencies of the incomes are not assigned")
+	}
+	return nil
+}
+
 type IncomeService interface {
-	Add(request model.CreateIncomeRequest) (model.IncomeDto, error)
-	AddBatch(request model.CreateIncomeBatchRequest) ([]model.IncomeDto, error)
-	FindById(id uuid.UUID) (model.IncomeDto, error)
-	FindByHouseId(id uuid.UUID, limit int, offset int, from, to *time.Time) []model.IncomeDto
-	FindByGroupIds(ids []uuid.UUID, limit int, offset int, from, to *time.Time) []model.IncomeDto
+	Add(request model.CreateIncomeRequest, userId uuid.UUID) (model.IncomeDto, error)
+	AddScheduled(request model.CreateIncomeRequest, userId uuid.UUID) (model.IncomeDto, error)
+	AddBatch(request model.CreateIncomeBatchRequest, userId uuid.UUID) ([]model.IncomeDto, error)
+	FindById(id uuid.UUID, userId uuid.UUID) (model.IncomeDto, error)
+	FindByHouseId(id uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time, tags tagModel.Filter) []model.IncomeDto
+	FindByGroupIds(ids []uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time) []model.IncomeDto
 	ExistsById(id uuid.UUID) bool
-	DeleteById(id uuid.UUID) error
-	Update(id uuid.UUID, request model.UpdateIncomeRequest) error
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateIncomeRequest) error
+}
+
+func (i *IncomeServiceObject) Add(request model.CreateIncomeRequest, userId uuid.UUID) (model.IncomeDto, error) {
+	return i.add(request, userId, false)
+}
+
+// AddScheduled adds the income created by the scheduler, the date is allowed to be after the current date as the scheduler
+// moves the date falling on a non-business day to the next business day
+func (i *IncomeServiceObject) AddScheduled(request model.CreateIncomeRequest, userId uuid.UUID) (model.IncomeDto, error) {
+	return i.add(request, userId, true)
 }
 
-func (i *IncomeServiceObject) Add(request model.CreateIncomeRequest) (response model.IncomeDto, err error) {
+func (i *IncomeServiceObject) add(request model.CreateIncomeRequest, userId uuid.UUID, allowFutureDate bool) (response model.IncomeDto, err error) {
 	if request.HouseId == nil && len(request.GroupIds) == 0 {
 		return response, errors.New("houseId or groupId must be set")
 	}
-	if request.HouseId != nil && !i.houseService.ExistsById(*request.HouseId) {
+	if request.HouseId != nil && !i.houseService.CanModify(*request.HouseId, userId) {
 		return response, int_errors.NewErrNotFound("house with id %s not found", request.HouseId)
 	}
-	if len(request.GroupIds) != 0 && !i.groupService.ExistsByIds(request.GroupIds) {
+	if len(request.GroupIds) != 0 && !i.groupService.CanModify(request.GroupIds, userId) {
 		return response, int_errors.NewErrNotFound("not all group with ids %s found", common.Join(request.GroupIds, ","))
 	}
-	if request.Date.After(time.Now()) {
+	if request.CategoryId != nil && !i.categoryService.ExistsByIdAndUserId(*request.CategoryId, userId) {
+		return response, int_errors.NewErrNotFound("category with id %s not found", request.CategoryId)
+	}
+	if len(request.TagIds) != 0 && !i.tagService.ExistsByIdsAndUserId(request.TagIds, userId) {
+		return response, int_errors.NewErrNotFound("tags with ids %s not found", common.Join(request.TagIds, ","))
+	}
+	if !allowFutureDate && request.Date.After(time.Now()) {
 		return response, errors.New("date should not be after current date")
 	}
 
-	if entity, err := i.repository.Create(request.ToEntity()); err != nil {
+	entity := request.ToEntity()
+	if entity.Currency, err = i.houseService.ResolveCurrency(request.HouseId, request.Currency); err != nil {
+		return response, err
+	}
+
+	if entity, err := i.repository.Create(entity); err != nil {
 		return response, err
 	} else {
 		return entity.ToDto(), nil
 	}
 }
 
-func (i *IncomeServiceObject) AddBatch(request model.CreateIncomeBatchRequest) (response []model.IncomeDto, err error) {
+func (i *IncomeServiceObject) AddBatch(request model.CreateIncomeBatchRequest, userId uuid.UUID) (response []model.IncomeDto, err error) {
 	if len(request.Incomes) == 0 {
 		return make([]model.IncomeDto, 0), nil
 	}
@@ -105,7 +146,7 @@ func (i *IncomeServiceObject) AddBatch(request model.CreateIncomeBatchRequest) (
 	builder := int_errors.NewBuilder()
 
 	for houseId := range houseIds {
-		if !i.houseService.ExistsById(houseId) {
+		if !i.houseService.CanModify(houseId, userId) {
 			builder.WithDetail(fmt.Sprintf("house with id %s not found", houseId))
 		}
 	}
@@ -116,7 +157,7 @@ func (i *IncomeServiceObject) AddBatch(request model.CreateIncomeBatchRequest) (
 		groupIds = append(groupIds, groupId)
 	}
 
-	if len(groupIds) != 0 && !i.groupService.ExistsByIds(groupIds) {
+	if len(groupIds) != 0 && !i.groupService.CanModify(groupIds, userId) {
 		builder.WithDetail(fmt.Sprintf("not all group with ids %s found", common.Join(groupIds, ",")))
 	}
 
@@ -124,6 +165,12 @@ func (i *IncomeServiceObject) AddBatch(request model.CreateIncomeBatchRequest) (
 		if income.Date.After(time.Now()) {
 			builder.WithDetail("date should not be after current date")
 		}
+		if income.CategoryId != nil && !i.categoryService.ExistsByIdAndUserId(*income.CategoryId, userId) {
+			builder.WithDetail(fmt.Sprintf("category with id %s not found", income.CategoryId))
+		}
+		if len(income.TagIds) != 0 && !i.tagService.ExistsByIdsAndUserId(income.TagIds, userId) {
+			builder.WithDetail(fmt.Sprintf("tags with ids %s not found", common.Join(income.TagIds, ",")))
+		}
 	}
 
 	if builder.HasErrors() {
@@ -134,6 +181,18 @@ func (i *IncomeServiceObject) AddBatch(request model.CreateIncomeBatchRequest) (
 		return income.ToEntity()
 	})
 
+	for index := range entities {
+		if currency, err := i.houseService.ResolveCurrency(entities[index].HouseId, entities[index].Currency); err != nil {
+			builder.WithDetail(err.Error())
+		} else {
+			entities[index].Currency = currency
+		}
+	}
+
+	if builder.HasErrors() {
+		return nil, int_errors.NewErrResponse(builder.WithMessage("Create income batch failed"))
+	}
+
 	if repositoryResponse, err := i.repository.CreateBatch(entities); err != nil {
 		return nil, err
 	} else {
@@ -141,19 +200,26 @@ func (i *IncomeServiceObject) AddBatch(request model.CreateIncomeBatchRequest) (
 	}
 }
 
-func (i *IncomeServiceObject) FindById(id uuid.UUID) (response model.IncomeDto, err error) {
+func (i *IncomeServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (response model.IncomeDto, err error) {
 	if entity, err := i.repository.FindById(id); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return response, int_errors.NewErrNotFound("income with id %s not found", id)
 		}
 		return response, err
+	} else if !i.isAvailable(entity, userId) {
+		return response, int_errors.NewErrNotFound("income with id %s not found", id)
 	} else {
 		return entity.ToDto(), nil
 	}
 }
 
-func (i *IncomeServiceObject) FindByHouseId(id uuid.UUID, limit int, offset int, from, to *time.Time) []model.IncomeDto {
-	response, err := i.repository.FindByHouseId(id, limit, offset, from, to)
+// FindByHouseId returns the incomes of the house and its groups, the incomes are filtered by the tags
+func (i *IncomeServiceObject) FindByHouseId(id uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time, tags tagModel.Filter) []model.IncomeDto {
+	if !i.houseService.HasAccess(id, userId) {
+		return make([]model.IncomeDto, 0)
+	}
+
+	response, err := i.repository.FindByHouseId(id, limit, offset, from, to, tags)
 
 	if err != nil {
 		log.Err(err).Msg("failed to find incomes by house id")
@@ -162,7 +228,11 @@ func (i *IncomeServiceObject) FindByHouseId(id uuid.UUID, limit int, offset int,
 	return response
 }
 
-func (i *IncomeServiceObject) FindByGroupIds(ids []uuid.UUID, limit int, offset int, from, to *time.Time) []model.IncomeDto {
+func (i *IncomeServiceObject) FindByGroupIds(ids []uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time) []model.IncomeDto {
+	if !i.groupService.ExistsByIdsAndUserId(ids, userId) {
+		return make([]model.IncomeDto, 0)
+	}
+
 	response, err := i.repository.FindByGroupIds(ids, limit, offset, from, to)
 
 	if err != nil {
@@ -176,22 +246,78 @@ func (i *IncomeServiceObject) ExistsById(id uuid.UUID) bool {
 	return i.repository.ExistsById(id)
 }
 
-func (i *IncomeServiceObject) DeleteById(id uuid.UUID) error {
-	if !i.ExistsById(id) {
+func (i *IncomeServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if !i.canModify(id, userId) {
 		return int_errors.NewErrNotFound("income with id %s not found", id)
 	}
 	return i.repository.DeleteById(id)
 }
 
-func (i *IncomeServiceObject) Update(id uuid.UUID, request model.UpdateIncomeRequest) error {
-	if !i.ExistsById(id) {
+func (i *IncomeServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateIncomeRequest) error {
+	if !i.canModify(id, userId) {
 		return int_errors.NewErrNotFound("income with id %s not found", id)
 	}
-	if len(request.GroupIds) != 0 && !i.groupService.ExistsByIds(request.GroupIds) {
+	if len(request.GroupIds) != 0 && !i.groupService.CanModify(request.GroupIds, userId) {
 		return int_errors.NewErrNotFound("not all group with ids %s found", common.Join(request.GroupIds, ","))
 	}
-	if request.Date.After(time.Now()) {
+	if request.CategoryId != nil && !i.categoryService.ExistsByIdAndUserId(*request.CategoryId, userId) {
+		return int_errors.NewErrNotFound("category with id %s not found", request.CategoryId)
+	}
+	if len(request.TagIds) != 0 && !i.tagService.ExistsByIdsAndUserId(request.TagIds, userId) {
+		return int_errors.NewErrNotFound("tags with ids %s not found", common.Join(request.TagIds, ","))
+	}
+	if request.Date.After(time.Now()) && !i.keepsDate(id, request.Date) {
 		return errors.New("date should not be after current date")
 	}
+	if request.Currency != "" {
+		currency, err := i.houseService.ResolveCurrency(nil, request.Currency)
+		if err != nil {
+			return err
+		}
+		request.Currency = currency
+	}
 	return i.repository.Update(id, request)
 }
+
+// keepsDate checks that the date of the income is not changed, the income added by the scheduler keeps the date after the
+// current date if it is moved to the next business day
+func (i *IncomeServiceObject) keepsDate(id uuid.UUID, date time.Time) bool {
+	entity, err := i.repository.FindById(id)
+
+	return err == nil && entity.Date.Equal(date)
+}
+
+// canModify checks that the income belongs to the house or to one of the groups the user is allowed to change
+func (i *IncomeServiceObject) canModify(id uuid.UUID, userId uuid.UUID) bool {
+	entity, err := i.repository.FindById(id)
+	if err != nil {
+		return false
+	}
+
+	if entity.HouseId != nil && i.houseService.CanModify(*entity.HouseId, userId) {
+		return true
+	}
+
+	for _, group := range entity.Groups {
+		if i.groupService.CanModify([]uuid.UUID{group.Id}, userId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAvailable checks that the income belongs to the house or to one of the groups available to the user
+func (i *IncomeServiceObject) isAvailable(entity model.Income, userId uuid.UUID) bool {
+	if entity.HouseId != nil && i.houseService.HasAccess(*entity.HouseId, userId) {
+		return true
+	}
+
+	for _, group := range entity.Groups {
+		if i.groupService.ExistsByIdsAndUserId([]uuid.UUID{group.Id}, userId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,12 +3,18 @@ package service
 import (
 	"errors"
 	"fmt"
+	categoryMocks "github.com/VlasovArtem/hob/src/category/mocks"
 	"github.com/VlasovArtem/hob/src/common"
 	"github.com/VlasovArtem/hob/src/common/int-errors"
 	groupMocks "github.com/VlasovArtem/hob/src/group/mocks"
+	groupModel "github.com/VlasovArtem/hob/src/group/model"
+	holidays "github.com/VlasovArtem/hob/src/holiday/service"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
 	"github.com/VlasovArtem/hob/src/income/mocks"
 	"github.com/VlasovArtem/hob/src/income/model"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	tagMocks "github.com/VlasovArtem/hob/src/tag/mocks"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -25,6 +31,8 @@ type IncomeServiceTestSuite struct {
 	testhelper.MockTestSuite[IncomeService]
 	houses           *houseMocks.HouseService
 	groups           *groupMocks.GroupService
+	categories       *categoryMocks.CategoryService
+	tags             *tagMocks.TagService
 	incomeRepository *mocks.IncomeRepository
 }
 
@@ -34,71 +42,126 @@ func TestIncomeServiceTestSuite(t *testing.T) {
 		ts.houses = new(houseMocks.HouseService)
 		ts.incomeRepository = new(mocks.IncomeRepository)
 		ts.groups = new(groupMocks.GroupService)
-		return NewIncomeService(ts.houses, ts.groups, ts.incomeRepository)
+		ts.categories = new(categoryMocks.CategoryService)
+		ts.tags = new(tagMocks.TagService)
+		return NewIncomeService(ts.houses, ts.groups, ts.categories, ts.tags, ts.incomeRepository)
 	}
 
 	suite.Run(t, ts)
 }
 
+func (i *IncomeServiceTestSuite) mockAccess(id uuid.UUID, userId uuid.UUID) {
+	houseId := uuid.New()
+
+	i.incomeRepository.On("FindById", id).Return(model.Income{HouseId: &houseId}, nil)
+	i.houses.On("HasAccess", houseId, userId).Return(true)
+}
+
+func (i *IncomeServiceTestSuite) mockModify(id uuid.UUID, userId uuid.UUID) {
+	houseId := uuid.New()
+
+	i.incomeRepository.On("FindById", id).Return(model.Income{HouseId: &houseId}, nil)
+	i.houses.On("CanModify", houseId, userId).Return(true)
+}
+
 func (i *IncomeServiceTestSuite) Test_Add() {
+	userId := uuid.New()
 	var savedIncome model.Income
 	request := mocks.GenerateCreateIncomeRequest()
 
-	i.houses.On("ExistsById", *request.HouseId).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", *request.HouseId, userId).Return(true)
 	i.incomeRepository.On("Create", mock.Anything).Return(func(income model.Income) model.Income {
 		savedIncome = income
 
 		return income
 	}, nil)
-	i.groups.On("ExistsByIds", mock.Anything).Return(true)
+	i.groups.On("CanModify", mock.Anything, userId).Return(true)
 
-	income, err := i.TestO.Add(request)
+	income, err := i.TestO.Add(request, userId)
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), savedIncome.ToDto(), income)
 }
 
 func (i *IncomeServiceTestSuite) Test_Add_WithoutHouseIdAndWithGroups() {
+	userId := uuid.New()
 	var savedIncome model.Income
 	request := mocks.GenerateCreateIncomeRequest()
 	request.HouseId = nil
 	request.GroupIds = []uuid.UUID{uuid.New()}
 
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	i.incomeRepository.On("Create", mock.Anything).Return(func(income model.Income) model.Income {
 		savedIncome = income
 
 		return income
 	}, nil)
-	i.groups.On("ExistsByIds", mock.Anything).Return(true)
+	i.groups.On("CanModify", mock.Anything, userId).Return(true)
 
-	income, err := i.TestO.Add(request)
+	income, err := i.TestO.Add(request, userId)
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), savedIncome.ToDto(), income)
 
-	i.houses.AssertNotCalled(i.T(), "ExistsById", mock.Anything)
+	i.houses.AssertNotCalled(i.T(), "CanModify", mock.Anything, mock.Anything)
+}
+
+func (i *IncomeServiceTestSuite) Test_Add_WithoutHouseIdAndCurrency() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateIncomeRequest()
+	request.HouseId = nil
+	request.Currency = ""
+	request.GroupIds = []uuid.UUID{uuid.New()}
+
+	i.houses.On("ResolveCurrency", (*uuid.UUID)(nil), "").Return("", errors.New("currency is missing"))
+	i.groups.On("CanModify", mock.Anything, userId).Return(true)
+
+	income, err := i.TestO.Add(request, userId)
+
+	assert.Equal(i.T(), errors.New("currency is missing"), err)
+	assert.Equal(i.T(), model.IncomeDto{}, income)
+
+	i.incomeRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
+}
+
+func (i *IncomeServiceTestSuite) Test_Add_WithHouseCurrency() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateIncomeRequest()
+	request.Currency = ""
+
+	i.houses.On("CanModify", *request.HouseId, userId).Return(true)
+	i.houses.On("ResolveCurrency", request.HouseId, "").Return("EUR", nil)
+	i.incomeRepository.On("Create", mock.Anything).Return(func(income model.Income) model.Income { return income }, nil)
+
+	income, err := i.TestO.Add(request, userId)
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), "EUR", income.Currency)
 }
 
 func (i *IncomeServiceTestSuite) Test_Add_WithoutHouseIdAndGroups() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeRequest()
 	request.HouseId = nil
 	request.GroupIds = []uuid.UUID{}
 
-	_, err := i.TestO.Add(request)
+	_, err := i.TestO.Add(request, userId)
 
 	assert.EqualError(i.T(), err, "houseId or groupId must be set")
 
-	i.groups.AssertNotCalled(i.T(), "ExistsByIds", mock.Anything)
-	i.houses.AssertNotCalled(i.T(), "ExistsById", mock.Anything)
+	i.groups.AssertNotCalled(i.T(), "CanModify", mock.Anything, mock.Anything)
+	i.houses.AssertNotCalled(i.T(), "CanModify", mock.Anything, mock.Anything)
 	i.incomeRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
 }
 
 func (i *IncomeServiceTestSuite) Test_Add_WithHouseNotExists() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeRequest()
 
-	i.houses.On("ExistsById", *request.HouseId).Return(false)
+	i.houses.On("CanModify", *request.HouseId, userId).Return(false)
 
-	payment, err := i.TestO.Add(request)
+	payment, err := i.TestO.Add(request, userId)
 
 	assert.Equal(i.T(), int_errors.NewErrNotFound("house with id %s not found", request.HouseId), err)
 	assert.Equal(i.T(), model.IncomeDto{}, payment)
@@ -107,25 +170,28 @@ func (i *IncomeServiceTestSuite) Test_Add_WithHouseNotExists() {
 }
 
 func (i *IncomeServiceTestSuite) Test_Add_WithErrorFromRepository() {
+	userId := uuid.New()
 	expectedError := errors.New("error")
 	request := mocks.GenerateCreateIncomeRequest()
 
-	i.houses.On("ExistsById", *request.HouseId).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", *request.HouseId, userId).Return(true)
 	i.incomeRepository.On("Create", mock.Anything).Return(model.Income{}, expectedError)
 
-	income, err := i.TestO.Add(request)
+	income, err := i.TestO.Add(request, userId)
 
 	assert.Equal(i.T(), expectedError, err)
 	assert.Equal(i.T(), model.IncomeDto{}, income)
 }
 
 func (i *IncomeServiceTestSuite) Test_Add_WithDateAfterCurrentDate() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeRequest()
 	request.Date = time.Now().Add(time.Hour)
 
-	i.houses.On("ExistsById", *request.HouseId).Return(true)
+	i.houses.On("CanModify", *request.HouseId, userId).Return(true)
 
-	payment, err := i.TestO.Add(request)
+	payment, err := i.TestO.Add(request, userId)
 
 	assert.Equal(i.T(), errors.New("date should not be after current date"), err)
 	assert.Equal(i.T(), model.IncomeDto{}, payment)
@@ -133,14 +199,34 @@ func (i *IncomeServiceTestSuite) Test_Add_WithDateAfterCurrentDate() {
 	i.incomeRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
 }
 
+func (i *IncomeServiceTestSuite) Test_AddScheduled_WithNextBusinessDay() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateIncomeRequest()
+	sunday := time.Now().AddDate(0, 0, 7-int(time.Now().Weekday()))
+	request.Date = holidays.NewHolidayService(nil).Adjust("UA", sunday, scheduler.NextBusinessDay)
+
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", *request.HouseId, userId).Return(true)
+	i.incomeRepository.On("Create", mock.Anything).Return(func(income model.Income) model.Income {
+		return income
+	}, nil)
+
+	income, err := i.TestO.AddScheduled(request, userId)
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), time.Monday, income.Date.Weekday())
+	assert.True(i.T(), income.Date.After(time.Now()))
+}
+
 func (i *IncomeServiceTestSuite) Test_Add_WithGroupsNotFound() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeRequest()
 	request.GroupIds = []uuid.UUID{uuid.New()}
 
-	i.houses.On("ExistsById", *request.HouseId).Return(true)
-	i.groups.On("ExistsByIds", mock.Anything).Return(false)
+	i.houses.On("CanModify", *request.HouseId, userId).Return(true)
+	i.groups.On("CanModify", mock.Anything, userId).Return(false)
 
-	income, err := i.TestO.Add(request)
+	income, err := i.TestO.Add(request, userId)
 
 	assert.Equal(i.T(), int_errors.NewErrNotFound("not all group with ids %s found", common.Join(request.GroupIds, ",")), err)
 	assert.Equal(i.T(), model.IncomeDto{}, income)
@@ -148,23 +234,58 @@ func (i *IncomeServiceTestSuite) Test_Add_WithGroupsNotFound() {
 	i.incomeRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
 }
 
+func (i *IncomeServiceTestSuite) Test_Add_WithCategoryNotFound() {
+	userId, categoryId := uuid.New(), uuid.New()
+	request := mocks.GenerateCreateIncomeRequest()
+	request.CategoryId = &categoryId
+
+	i.houses.On("CanModify", *request.HouseId, userId).Return(true)
+	i.categories.On("ExistsByIdAndUserId", categoryId, userId).Return(false)
+
+	income, err := i.TestO.Add(request, userId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("category with id %s not found", request.CategoryId), err)
+	assert.Equal(i.T(), model.IncomeDto{}, income)
+
+	i.incomeRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
+}
+
+func (i *IncomeServiceTestSuite) Test_Add_WithTagsNotFound() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateIncomeRequest()
+	request.TagIds = []uuid.UUID{uuid.New()}
+
+	i.houses.On("CanModify", *request.HouseId, userId).Return(true)
+	i.tags.On("ExistsByIdsAndUserId", request.TagIds, userId).Return(false)
+
+	income, err := i.TestO.Add(request, userId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("tags with ids %s not found", common.Join(request.TagIds, ",")), err)
+	assert.Equal(i.T(), model.IncomeDto{}, income)
+
+	i.incomeRepository.AssertNotCalled(i.T(), "Create", mock.Anything)
+}
+
 func (i *IncomeServiceTestSuite) Test_AddBatch() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeBatchRequest(2)
 	repositoryResponse := common.MapSlice(request.Incomes, func(income model.CreateIncomeRequest) model.Income {
 		return income.ToEntity()
 	})
 
-	i.houses.On("ExistsById", mock.Anything).Return(true)
-	i.groups.On("ExistsByIds", mock.Anything).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", mock.Anything, userId).Return(true)
+	i.groups.On("CanModify", mock.Anything, userId).Return(true)
 	i.incomeRepository.On("CreateBatch", mock.Anything).Return(repositoryResponse, nil)
 
-	batch, err := i.TestO.AddBatch(request)
+	batch, err := i.TestO.AddBatch(request, userId)
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), common.MapSlice(repositoryResponse, model.IncomeToDto), batch)
 }
 
 func (i *IncomeServiceTestSuite) Test_AddBatch_WithDefaultDetails() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeBatchRequest(2)
 	request.Incomes[0].HouseId = nil
 	request.Incomes[0].GroupIds = []uuid.UUID{uuid.New()}
@@ -172,56 +293,60 @@ func (i *IncomeServiceTestSuite) Test_AddBatch_WithDefaultDetails() {
 		return income.ToEntity()
 	})
 
-	i.houses.On("ExistsById", mock.Anything).Return(true)
-	i.groups.On("ExistsByIds", mock.Anything).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.houses.On("CanModify", mock.Anything, userId).Return(true)
+	i.groups.On("CanModify", mock.Anything, userId).Return(true)
 	i.incomeRepository.On("CreateBatch", mock.Anything).Return(repositoryResponse, nil)
 
-	batch, err := i.TestO.AddBatch(request)
+	batch, err := i.TestO.AddBatch(request, userId)
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), common.MapSlice(repositoryResponse, model.IncomeToDto), batch)
 }
 
 func (i *IncomeServiceTestSuite) Test_AddBatch_WithMissingGroupIdsAndHouseId() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeBatchRequest(1)
 	request.Incomes[0].HouseId = nil
 	request.Incomes[0].GroupIds = []uuid.UUID{}
 
-	i.houses.On("ExistsById", mock.Anything).Return(true)
-	i.groups.On("ExistsByIds", mock.Anything).Return(true)
+	i.houses.On("CanModify", mock.Anything, userId).Return(true)
+	i.groups.On("CanModify", mock.Anything, userId).Return(true)
 
-	_, err := i.TestO.AddBatch(request)
+	_, err := i.TestO.AddBatch(request, userId)
 
 	assert.EqualError(i.T(), err, "houseId or groupId must be set")
 	i.incomeRepository.AssertNotCalled(i.T(), "CreateBatch", mock.Anything)
 }
 
 func (i *IncomeServiceTestSuite) Test_AddBatch_WithEmptyData() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeBatchRequest(0)
 
-	batch, err := i.TestO.AddBatch(request)
+	batch, err := i.TestO.AddBatch(request, userId)
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), make([]model.IncomeDto, 0), batch)
 
-	i.houses.AssertNotCalled(i.T(), "ExistsById", mock.Anything)
-	i.groups.AssertNotCalled(i.T(), "ExistsByIds", mock.Anything)
+	i.houses.AssertNotCalled(i.T(), "CanModify", mock.Anything, mock.Anything)
+	i.groups.AssertNotCalled(i.T(), "CanModify", mock.Anything, mock.Anything)
 	i.incomeRepository.AssertNotCalled(i.T(), "CreateBatch", mock.Anything)
 }
 
 func (i *IncomeServiceTestSuite) Test_AddBatch_WithInvalidData() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateIncomeBatchRequest(3)
 	request.Incomes[0].Date = time.Now().Add(time.Hour)
 	request.Incomes[2].GroupIds = []uuid.UUID{uuid.New()}
 
-	i.houses.On("ExistsById", *request.Incomes[0].HouseId).Return(true)
-	i.houses.On("ExistsById", *request.Incomes[1].HouseId).Return(false)
-	i.houses.On("ExistsById", *request.Incomes[2].HouseId).Return(true)
-	i.groups.On("ExistsByIds", request.Incomes[0].GroupIds).Return(true)
-	i.groups.On("ExistsByIds", request.Incomes[1].GroupIds).Return(true)
-	i.groups.On("ExistsByIds", request.Incomes[2].GroupIds).Return(false)
+	i.houses.On("CanModify", *request.Incomes[0].HouseId, userId).Return(true)
+	i.houses.On("CanModify", *request.Incomes[1].HouseId, userId).Return(false)
+	i.houses.On("CanModify", *request.Incomes[2].HouseId, userId).Return(true)
+	i.groups.On("CanModify", request.Incomes[0].GroupIds, userId).Return(true)
+	i.groups.On("CanModify", request.Incomes[1].GroupIds, userId).Return(true)
+	i.groups.On("CanModify", request.Incomes[2].GroupIds, userId).Return(false)
 
-	actual, err := i.TestO.AddBatch(request)
+	actual, err := i.TestO.AddBatch(request, userId)
 
 	var expectedResult []model.IncomeDto
 
@@ -242,23 +367,53 @@ func (i *IncomeServiceTestSuite) Test_AddBatch_WithInvalidData() {
 }
 
 func (i *IncomeServiceTestSuite) Test_FindById() {
+	userId := uuid.New()
 	houseId := uuid.New()
 	income := mocks.GenerateIncome(&houseId)
 
 	i.incomeRepository.On("FindById", income.Id).Return(income, nil)
+	i.houses.On("HasAccess", houseId, userId).Return(true)
+
+	actual, err := i.TestO.FindById(income.Id, userId)
+
+	assert.Nil(i.T(), err)
+	assert.Equal(i.T(), income.ToDto(), actual)
+}
+
+func (i *IncomeServiceTestSuite) Test_FindById_WithGroupAccess() {
+	userId := uuid.New()
+	income := mocks.GenerateIncome(nil)
+	income.Groups = []groupModel.Group{{Id: uuid.New()}}
+
+	i.incomeRepository.On("FindById", income.Id).Return(income, nil)
+	i.groups.On("ExistsByIdsAndUserId", []uuid.UUID{income.Groups[0].Id}, userId).Return(true)
 
-	actual, err := i.TestO.FindById(income.Id)
+	actual, err := i.TestO.FindById(income.Id, userId)
 
 	assert.Nil(i.T(), err)
 	assert.Equal(i.T(), income.ToDto(), actual)
 }
 
+func (i *IncomeServiceTestSuite) Test_FindById_WithoutAccess() {
+	userId := uuid.New()
+	houseId := uuid.New()
+	income := mocks.GenerateIncome(&houseId)
+
+	i.incomeRepository.On("FindById", income.Id).Return(income, nil)
+	i.houses.On("HasAccess", houseId, userId).Return(false)
+
+	actual, err := i.TestO.FindById(income.Id, userId)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income with id %s not found", income.Id), err)
+	assert.Equal(i.T(), model.IncomeDto{}, actual)
+}
+
 func (i *IncomeServiceTestSuite) Test_FindById_WithNotExistingId() {
 	id := uuid.New()
 
 	i.incomeRepository.On("FindById", id).Return(model.Income{}, gorm.ErrRecordNotFound)
 
-	actual, err := i.TestO.FindById(id)
+	actual, err := i.TestO.FindById(id, uuid.New())
 
 	assert.Equal(i.T(), int_errors.NewErrNotFound("income with id %s not found", id), err)
 	assert.Equal(i.T(), model.IncomeDto{}, actual)
@@ -270,34 +425,51 @@ func (i *IncomeServiceTestSuite) Test_FindById_WithError() {
 
 	i.incomeRepository.On("FindById", id).Return(model.Income{}, expectedError)
 
-	actual, err := i.TestO.FindById(id)
+	actual, err := i.TestO.FindById(id, uuid.New())
 
 	assert.Equal(i.T(), expectedError, err)
 	assert.Equal(i.T(), model.IncomeDto{}, actual)
 }
 
 func (i *IncomeServiceTestSuite) Test_FindByHouseId() {
+	userId := uuid.New()
 	income := []model.IncomeDto{mocks.GenerateIncomeDto()}
 
-	i.incomeRepository.On("FindByHouseId", *income[0].HouseId, 10, 0, nilTime, nilTime).Return(income, nil)
+	i.houses.On("HasAccess", *income[0].HouseId, userId).Return(true)
+	i.incomeRepository.On("FindByHouseId", *income[0].HouseId, 10, 0, nilTime, nilTime, tagModel.Filter{}).Return(income, nil)
 
-	actual := i.TestO.FindByHouseId(*income[0].HouseId, 10, 0, nilTime, nilTime)
+	actual := i.TestO.FindByHouseId(*income[0].HouseId, userId, 10, 0, nilTime, nilTime, tagModel.Filter{})
 
 	assert.Equal(i.T(), income, actual)
 }
 
 func (i *IncomeServiceTestSuite) Test_FindByHouseId_WithNotExistingRecords() {
+	userId := uuid.New()
 	var income []model.IncomeDto
 
 	houseId := uuid.New()
 
-	i.incomeRepository.On("FindByHouseId", houseId, 10, 0, nilTime, nilTime).Return(income, nil)
+	i.houses.On("HasAccess", houseId, userId).Return(true)
+	i.incomeRepository.On("FindByHouseId", houseId, 10, 0, nilTime, nilTime, tagModel.Filter{}).Return(income, nil)
 
-	actual := i.TestO.FindByHouseId(houseId, 10, 0, nilTime, nilTime)
+	actual := i.TestO.FindByHouseId(houseId, userId, 10, 0, nilTime, nilTime, tagModel.Filter{})
 
 	assert.Equal(i.T(), income, actual)
 }
 
+func (i *IncomeServiceTestSuite) Test_FindByHouseId_WithoutAccess() {
+	userId := uuid.New()
+	houseId := uuid.New()
+
+	i.houses.On("HasAccess", houseId, userId).Return(false)
+
+	actual := i.TestO.FindByHouseId(houseId, userId, 10, 0, nilTime, nilTime, tagModel.Filter{})
+
+	assert.Equal(i.T(), []model.IncomeDto{}, actual)
+
+	i.incomeRepository.AssertNotCalled(i.T(), "FindByHouseId", houseId, 10, 0, nilTime, nilTime, tagModel.Filter{})
+}
+
 func (i *IncomeServiceTestSuite) Test_ExistsById() {
 	id := uuid.New()
 
@@ -315,78 +487,164 @@ func (i *IncomeServiceTestSuite) Test_ExistsById_WithNotExists() {
 }
 
 func (i *IncomeServiceTestSuite) Test_DeleteById() {
+	userId := uuid.New()
 	id := uuid.New()
 
-	i.incomeRepository.On("ExistsById", id).Return(true)
+	i.mockModify(id, userId)
 	i.incomeRepository.On("DeleteById", id).Return(nil)
 
-	assert.Nil(i.T(), i.TestO.DeleteById(id))
+	assert.Nil(i.T(), i.TestO.DeleteById(id, userId))
 }
 
 func (i *IncomeServiceTestSuite) Test_DeleteById_WithNotExists() {
+	userId := uuid.New()
 	id := uuid.New()
 
-	i.incomeRepository.On("ExistsById", id).Return(false)
+	i.incomeRepository.On("FindById", id).Return(model.Income{}, gorm.ErrRecordNotFound)
 
-	assert.Equal(i.T(), int_errors.NewErrNotFound("income with id %s not found", id), i.TestO.DeleteById(id))
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income with id %s not found", id), i.TestO.DeleteById(id, userId))
 
 	i.incomeRepository.AssertNotCalled(i.T(), "DeleteById", id)
 }
 
+func (i *IncomeServiceTestSuite) Test_DeleteById_WithoutAccess() {
+	userId := uuid.New()
+	id, houseId := uuid.New(), uuid.New()
+
+	i.incomeRepository.On("FindById", id).Return(model.Income{HouseId: &houseId}, nil)
+	i.houses.On("CanModify", houseId, userId).Return(false)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("income with id %s not found", id), i.TestO.DeleteById(id, userId))
+
+	i.incomeRepository.AssertNotCalled(i.T(), "DeleteById", id)
+}
+
+func (i *IncomeServiceTestSuite) Test_DeleteById_WithGroupEditor() {
+	userId := uuid.New()
+	income := mocks.GenerateIncome(nil)
+	income.Groups = []groupModel.Group{{Id: uuid.New()}}
+
+	i.incomeRepository.On("FindById", income.Id).Return(income, nil)
+	i.groups.On("CanModify", []uuid.UUID{income.Groups[0].Id}, userId).Return(true)
+	i.incomeRepository.On("DeleteById", income.Id).Return(nil)
+
+	assert.Nil(i.T(), i.TestO.DeleteById(income.Id, userId))
+}
+
 func (i *IncomeServiceTestSuite) Test_Update() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateIncomeRequest()
 
-	i.incomeRepository.On("ExistsById", id).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.mockModify(id, userId)
 	i.incomeRepository.On("Update", id, request).Return(nil)
 
-	assert.Nil(i.T(), i.TestO.Update(id, request))
+	assert.Nil(i.T(), i.TestO.Update(id, userId, request))
 
 	i.incomeRepository.AssertCalled(i.T(), "Update", id, request)
 }
 
 func (i *IncomeServiceTestSuite) Test_Update_WithErrorFromDatabase() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateIncomeRequest()
 
-	i.incomeRepository.On("ExistsById", id).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	i.mockModify(id, userId)
 	i.incomeRepository.On("Update", id, request).Return(errors.New("test"))
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, userId, request)
 	assert.Equal(i.T(), errors.New("test"), err)
 }
 
 func (i *IncomeServiceTestSuite) Test_Update_WithNotExists() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateIncomeRequest()
 
-	i.incomeRepository.On("ExistsById", id).Return(false)
+	i.incomeRepository.On("FindById", id).Return(model.Income{}, gorm.ErrRecordNotFound)
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, userId, request)
 	assert.Equal(i.T(), int_errors.NewErrNotFound("income with id %s not found", id), err)
 
 	i.incomeRepository.AssertNotCalled(i.T(), "Update", id, request)
 }
 
 func (i *IncomeServiceTestSuite) Test_Update_WithDateAfterCurrentDate() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateIncomeRequest()
 	request.Date = time.Now().Add(time.Hour)
 
-	i.incomeRepository.On("ExistsById", id).Return(true)
+	i.mockModify(id, userId)
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, userId, request)
 	assert.Equal(i.T(), errors.New("date should not be after current date"), err)
 
 	i.incomeRepository.AssertNotCalled(i.T(), "Update", id, request)
 }
 
+func (i *IncomeServiceTestSuite) Test_Update_WithUnchangedDateAfterCurrentDate() {
+	userId, houseId := uuid.New(), uuid.New()
+	id, request := mocks.GenerateUpdateIncomeRequest()
+	request.Date = time.Now().AddDate(0, 0, 1)
+
+	i.incomeRepository.On("FindById", id).Return(model.Income{HouseId: &houseId, Date: request.Date}, nil)
+	i.houses.On("CanModify", houseId, userId).Return(true)
+	i.houses.On("ResolveCurrency", mock.Anything, mock.Anything).Return("UAH", nil)
+	i.incomeRepository.On("Update", id, mock.Anything).Return(nil)
+
+	assert.Nil(i.T(), i.TestO.Update(id, userId, request))
+}
+
 func (i *IncomeServiceTestSuite) Test_Update_WithGroupsIdsNotFound() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateIncomeRequest()
 	request.GroupIds = []uuid.UUID{uuid.New()}
 
-	i.incomeRepository.On("ExistsById", id).Return(true)
-	i.groups.On("ExistsByIds", mock.Anything).Return(false)
+	i.mockModify(id, userId)
+	i.groups.On("CanModify", mock.Anything, userId).Return(false)
 
-	err := i.TestO.Update(id, request)
+	err := i.TestO.Update(id, userId, request)
 
 	assert.Equal(i.T(), int_errors.NewErrNotFound("not all group with ids %s found", common.Join(request.GroupIds, ",")), err)
 
 	i.incomeRepository.AssertNotCalled(i.T(), "Update", id, request)
 }
+
+func (i *IncomeServiceTestSuite) Test_Update_WithCategoryNotFound() {
+	userId, categoryId := uuid.New(), uuid.New()
+	id, request := mocks.GenerateUpdateIncomeRequest()
+	request.CategoryId = &categoryId
+
+	i.mockModify(id, userId)
+	i.categories.On("ExistsByIdAndUserId", categoryId, userId).Return(false)
+
+	err := i.TestO.Update(id, userId, request)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("category with id %s not found", request.CategoryId), err)
+
+	i.incomeRepository.AssertNotCalled(i.T(), "Update", id, request)
+}
+
+func (i *IncomeServiceTestSuite) Test_Update_WithTagsNotFound() {
+	userId := uuid.New()
+	id, request := mocks.GenerateUpdateIncomeRequest()
+	request.TagIds = []uuid.UUID{uuid.New()}
+
+	i.mockModify(id, userId)
+	i.tags.On("ExistsByIdsAndUserId", request.TagIds, userId).Return(false)
+
+	err := i.TestO.Update(id, userId, request)
+
+	assert.Equal(i.T(), int_errors.NewErrNotFound("tags with ids %s not found", common.Join(request.TagIds, ",")), err)
+
+	i.incomeRepository.AssertNotCalled(i.T(), "Update", id, request)
+}
+
+func (i *IncomeServiceTestSuite) Test_Start() {
+	currencies := map[string]string{"UA": "UAH"}
+
+	i.houses.On("CountryCurrencies").Return(currencies)
+	i.incomeRepository.On("AssignHouseCurrencies", currencies).Return(nil)
+
+	assert.Nil(i.T(), i.TestO.(*IncomeServiceObject).Start())
+	i.incomeRepository.AssertCalled(i.T(), "AssignHouseCurrencies", currencies)
+}
@@ -41,11 +41,17 @@ type MeterHandler interface {
 
 func (m *MeterHandlerObject) Add() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreateMeterRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Created(m.meterService.Add(body)).
+				Created(m.meterService.Add(body, userId)).
 				Perform()
 		}
 	}
@@ -53,11 +59,11 @@ func (m *MeterHandlerObject) Add() http.HandlerFunc {
 
 func (m *MeterHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(m.meterService.FindById(id)).
+				Ok(m.meterService.FindById(id, userId)).
 				Perform()
 		}
 	}
@@ -65,14 +71,14 @@ func (m *MeterHandlerObject) FindById() http.HandlerFunc {
 
 func (m *MeterHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdateMeterRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
 				rest.NewAPIResponse(writer).
-					Error(m.meterService.Update(id, body)).
+					Error(m.meterService.Update(id, userId, body)).
 					Perform()
 			}
 		}
@@ -81,11 +87,11 @@ func (m *MeterHandlerObject) Update() http.HandlerFunc {
 
 func (m *MeterHandlerObject) Delete() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				NoContent(m.meterService.DeleteById(id)).
+				NoContent(m.meterService.DeleteById(id, userId)).
 				Perform()
 		}
 	}
@@ -93,11 +99,11 @@ func (m *MeterHandlerObject) Delete() http.HandlerFunc {
 
 func (m *MeterHandlerObject) FindByPaymentId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(m.meterService.FindByPaymentId(id)).
+				Ok(m.meterService.FindByPaymentId(id, userId)).
 				Perform()
 		}
 	}
@@ -31,13 +31,15 @@ func TestMeterHandlerTestSuite(t *testing.T) {
 }
 
 func (m *MeterHandlerTestSuite) Test_AddMeter() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateMeterRequest()
 
-	m.meters.On("Add", request).Return(request.ToEntity().ToDto(), nil)
+	m.meters.On("Add", request, userId).Return(request.ToEntity().ToDto(), nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters").
 		WithMethod("POST").
+		WithUser(userId).
 		WithHandler(m.TestO.Add()).
 		WithBody(request)
 
@@ -63,20 +65,23 @@ func (m *MeterHandlerTestSuite) Test_AddMeter_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(m.TestO.Add())
 
 	testRequest.Verify(m.T(), http.StatusBadRequest)
 }
 
 func (m *MeterHandlerTestSuite) Test_AddMeter_WithErrorFromService() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateMeterRequest()
 
 	err := errors.New("error")
-	m.meters.On("Add", request).Return(model.MeterDto{}, err)
+	m.meters.On("Add", request, userId).Return(model.MeterDto{}, err)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters").
 		WithMethod("POST").
+		WithUser(userId).
 		WithHandler(m.TestO.Add()).
 		WithBody(request)
 
@@ -86,16 +91,18 @@ func (m *MeterHandlerTestSuite) Test_AddMeter_WithErrorFromService() {
 }
 
 func (m *MeterHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
 	id := uuid.New()
 
 	meterResponse := mocks.GenerateMeterResponse(id)
 
-	m.meters.On("FindById", id).
+	m.meters.On("FindById", id, userId).
 		Return(meterResponse, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(m.TestO.FindById()).
 		WithVar("id", id.String())
 
@@ -109,16 +116,18 @@ func (m *MeterHandlerTestSuite) Test_FindById() {
 }
 
 func (m *MeterHandlerTestSuite) Test_FindById_WithError() {
+	userId := uuid.New()
 	id := uuid.New()
 
 	expected := errors.New("error")
 
-	m.meters.On("FindById", id).
+	m.meters.On("FindById", id, userId).
 		Return(model.MeterDto{}, expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(m.TestO.FindById()).
 		WithVar("id", id.String())
 
@@ -131,6 +140,7 @@ func (m *MeterHandlerTestSuite) Test_FindById_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(m.TestO.FindById()).
 		WithVar("id", "id")
 
@@ -140,16 +150,18 @@ func (m *MeterHandlerTestSuite) Test_FindById_WithInvalidParameter() {
 }
 
 func (m *MeterHandlerTestSuite) Test_FindByPaymentId() {
+	userId := uuid.New()
 	id := uuid.New()
 
 	meterResponse := mocks.GenerateMeterResponse(id)
 
-	m.meters.On("FindByPaymentId", id).
+	m.meters.On("FindByPaymentId", id, userId).
 		Return(meterResponse, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/payment/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(m.TestO.FindByPaymentId()).
 		WithVar("id", id.String())
 
@@ -163,16 +175,18 @@ func (m *MeterHandlerTestSuite) Test_FindByPaymentId() {
 }
 
 func (m *MeterHandlerTestSuite) Test_FindByPaymentId_WithError() {
+	userId := uuid.New()
 	id := uuid.New()
 
 	expected := errors.New("error")
 
-	m.meters.On("FindByPaymentId", id).
+	m.meters.On("FindByPaymentId", id, userId).
 		Return(model.MeterDto{}, expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/payment/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(m.TestO.FindByPaymentId()).
 		WithVar("id", id.String())
 
@@ -185,6 +199,7 @@ func (m *MeterHandlerTestSuite) Test_FindByPaymentId_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/payment/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(m.TestO.FindByPaymentId()).
 		WithVar("id", "id")
 
@@ -194,13 +209,15 @@ func (m *MeterHandlerTestSuite) Test_FindByPaymentId_WithInvalidParameter() {
 }
 
 func (m *MeterHandlerTestSuite) Test_Update() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateMeterRequest()
 
-	m.meters.On("Update", id, request).Return(nil)
+	m.meters.On("Update", id, userId, request).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(m.TestO.Update()).
 		WithBody(request).
 		WithVar("id", id.String())
@@ -214,6 +231,7 @@ func (m *MeterHandlerTestSuite) Test_Update_WithInvalidId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(m.TestO.Update()).
 		WithBody(request).
 		WithVar("id", "id")
@@ -222,13 +240,14 @@ func (m *MeterHandlerTestSuite) Test_Update_WithInvalidId() {
 
 	assert.Equal(m.T(), "the id is not valid id\n", string(responseByteArray))
 
-	m.meters.AssertNotCalled(m.T(), "Update", mock.Anything, mock.Anything)
+	m.meters.AssertNotCalled(m.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (m *MeterHandlerTestSuite) Test_Update_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(m.TestO.Update()).
 		WithVar("id", uuid.New().String())
 
@@ -236,15 +255,17 @@ func (m *MeterHandlerTestSuite) Test_Update_WithInvalidRequest() {
 }
 
 func (m *MeterHandlerTestSuite) Test_Update_WithErrorFromService() {
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdateMeterRequest()
 
 	expected := errors.New("error")
 
-	m.meters.On("Update", id, request).Return(expected)
+	m.meters.On("Update", id, userId, request).Return(expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(m.TestO.Update()).
 		WithVar("id", id.String()).
 		WithBody(request)
@@ -255,13 +276,15 @@ func (m *MeterHandlerTestSuite) Test_Update_WithErrorFromService() {
 }
 
 func (m *MeterHandlerTestSuite) Test_Delete() {
+	userId := uuid.New()
 	id := uuid.New()
 
-	m.meters.On("DeleteById", id).Return(nil)
+	m.meters.On("DeleteById", id, userId).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("DELETE").
+		WithUser(userId).
 		WithHandler(m.TestO.Delete()).
 		WithVar("id", id.String())
 
@@ -272,6 +295,7 @@ func (m *MeterHandlerTestSuite) Test_Delete_WithMissingParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(m.TestO.Delete())
 
 	responseByteArray := testRequest.Verify(m.T(), http.StatusBadRequest)
@@ -283,6 +307,7 @@ func (m *MeterHandlerTestSuite) Test_Delete_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/meters/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(m.TestO.Delete()).
 		WithVar("id", "id")
 
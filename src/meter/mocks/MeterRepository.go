@@ -84,6 +84,29 @@ func (_m *MeterRepository) FindById(id uuid.UUID) (model.Meter, error) {
 	return r0, r1
 }
 
+// FindByNameAndHouseId provides a mock function with given fields: name, houseId, limit
+func (_m *MeterRepository) FindByNameAndHouseId(name string, houseId uuid.UUID, limit int) ([]model.Meter, error) {
+	ret := _m.Called(name, houseId, limit)
+
+	var r0 []model.Meter
+	if rf, ok := ret.Get(0).(func(string, uuid.UUID, int) []model.Meter); ok {
+		r0 = rf(name, houseId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Meter)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uuid.UUID, int) error); ok {
+		r1 = rf(name, houseId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByPaymentId provides a mock function with given fields: paymentId
 func (_m *MeterRepository) FindByPaymentId(paymentId uuid.UUID) (model.Meter, error) {
 	ret := _m.Called(paymentId)
@@ -14,20 +14,20 @@ type MeterService struct {
 	mock.Mock
 }
 
-// Add provides a mock function with given fields: request
-func (_m *MeterService) Add(request model.CreateMeterRequest) (model.MeterDto, error) {
-	ret := _m.Called(request)
+// Add provides a mock function with given fields: request, userId
+func (_m *MeterService) Add(request model.CreateMeterRequest, userId uuid.UUID) (model.MeterDto, error) {
+	ret := _m.Called(request, userId)
 
 	var r0 model.MeterDto
-	if rf, ok := ret.Get(0).(func(model.CreateMeterRequest) model.MeterDto); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(model.CreateMeterRequest, uuid.UUID) model.MeterDto); ok {
+		r0 = rf(request, userId)
 	} else {
 		r0 = ret.Get(0).(model.MeterDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(model.CreateMeterRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(model.CreateMeterRequest, uuid.UUID) error); ok {
+		r1 = rf(request, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +35,13 @@ func (_m *MeterService) Add(request model.CreateMeterRequest) (model.MeterDto, e
 	return r0, r1
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *MeterService) DeleteById(id uuid.UUID) error {
-	ret := _m.Called(id)
+// DeleteById provides a mock function with given fields: id, userId
+func (_m *MeterService) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,20 +49,20 @@ func (_m *MeterService) DeleteById(id uuid.UUID) error {
 	return r0
 }
 
-// FindById provides a mock function with given fields: id
-func (_m *MeterService) FindById(id uuid.UUID) (model.MeterDto, error) {
-	ret := _m.Called(id)
+// FindById provides a mock function with given fields: id, userId
+func (_m *MeterService) FindById(id uuid.UUID, userId uuid.UUID) (model.MeterDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.MeterDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.MeterDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.MeterDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.MeterDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,20 +70,20 @@ func (_m *MeterService) FindById(id uuid.UUID) (model.MeterDto, error) {
 	return r0, r1
 }
 
-// FindByPaymentId provides a mock function with given fields: id
-func (_m *MeterService) FindByPaymentId(id uuid.UUID) (model.MeterDto, error) {
-	ret := _m.Called(id)
+// FindByPaymentId provides a mock function with given fields: id, userId
+func (_m *MeterService) FindByPaymentId(id uuid.UUID, userId uuid.UUID) (model.MeterDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.MeterDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.MeterDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.MeterDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.MeterDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -91,13 +91,36 @@ func (_m *MeterService) FindByPaymentId(id uuid.UUID) (model.MeterDto, error) {
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *MeterService) Update(id uuid.UUID, request model.UpdateMeterRequest) error {
-	ret := _m.Called(id, request)
+// FindLatestByNameAndHouseId provides a mock function with given fields: name, houseId, count
+func (_m *MeterService) FindLatestByNameAndHouseId(name string, houseId uuid.UUID, count int) ([]model.MeterDto, error) {
+	ret := _m.Called(name, houseId, count)
+
+	var r0 []model.MeterDto
+	if rf, ok := ret.Get(0).(func(string, uuid.UUID, int) []model.MeterDto); ok {
+		r0 = rf(name, houseId, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.MeterDto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uuid.UUID, int) error); ok {
+		r1 = rf(name, houseId, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: id, userId, request
+func (_m *MeterService) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateMeterRequest) error {
+	ret := _m.Called(id, userId, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdateMeterRequest) error); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, model.UpdateMeterRequest) error); ok {
+		r0 = rf(id, userId, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -35,6 +35,7 @@ type MeterRepository interface {
 	ExistsById(id uuid.UUID) bool
 	FindById(id uuid.UUID) (model.Meter, error)
 	FindByPaymentId(paymentId uuid.UUID) (model.Meter, error)
+	FindByNameAndHouseId(name string, houseId uuid.UUID, limit int) ([]model.Meter, error)
 	DeleteById(id uuid.UUID) error
 	Update(id uuid.UUID, meter model.Meter) error
 }
@@ -58,6 +59,18 @@ func (m *MeterRepositoryObject) FindByPaymentId(id uuid.UUID) (response model.Me
 	return response, m.database.FirstBy(&response, "payment_id = ?", id)
 }
 
+func (m *MeterRepositoryObject) FindByNameAndHouseId(name string, houseId uuid.UUID, limit int) (response []model.Meter, err error) {
+	err = m.database.Modeled().
+		Joins("JOIN payments ON payments.id = meters.payment_id").
+		Where("meters.name = ? AND payments.house_id = ?", name, houseId).
+		Order("payments.date desc").
+		Limit(limit).
+		Find(&response).
+		Error
+
+	return response, err
+}
+
 func (m *MeterRepositoryObject) DeleteById(id uuid.UUID) error {
 	return m.database.Delete(id)
 }
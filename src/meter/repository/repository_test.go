@@ -121,6 +121,31 @@ func (m *MeterRepositoryTestSuite) Test_FindByPaymentId_WithMissingId() {
 	assert.Equal(m.T(), model.Meter{}, meterResponse)
 }
 
+func (m *MeterRepositoryTestSuite) Test_FindByNameAndHouseId() {
+	previous := m.createMeter()
+
+	latestPayment := paymentMocks.GeneratePayment(m.createdHouse.Id, m.createdUser.Id, m.createdProvider.Id)
+	latestPayment.Date = latestPayment.Date.AddDate(0, 1, 0)
+	m.CreateEntity(&latestPayment)
+
+	latest := meterMocks.GenerateMeter(latestPayment.Id)
+	m.CreateEntity(latest)
+
+	meters, err := m.repository.FindByNameAndHouseId(latest.Name, m.createdHouse.Id, 2)
+
+	assert.Nil(m.T(), err)
+	assert.Equal(m.T(), []model.Meter{latest, previous}, meters)
+}
+
+func (m *MeterRepositoryTestSuite) Test_FindByNameAndHouseId_WithMissingHouse() {
+	m.createMeter()
+
+	meters, err := m.repository.FindByNameAndHouseId("Name", uuid.New(), 2)
+
+	assert.Nil(m.T(), err)
+	assert.Empty(m.T(), meters)
+}
+
 func (m *MeterRepositoryTestSuite) Test_ExistsById() {
 	payment := m.createMeter()
 
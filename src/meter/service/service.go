@@ -28,15 +28,16 @@ func (m *MeterServiceObject) Initialize(factory dependency.DependenciesProvider)
 }
 
 type MeterService interface {
-	Add(request model.CreateMeterRequest) (model.MeterDto, error)
-	Update(id uuid.UUID, request model.UpdateMeterRequest) error
-	DeleteById(id uuid.UUID) error
-	FindById(id uuid.UUID) (model.MeterDto, error)
-	FindByPaymentId(id uuid.UUID) (model.MeterDto, error)
+	Add(request model.CreateMeterRequest, userId uuid.UUID) (model.MeterDto, error)
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateMeterRequest) error
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+	FindById(id uuid.UUID, userId uuid.UUID) (model.MeterDto, error)
+	FindByPaymentId(id uuid.UUID, userId uuid.UUID) (model.MeterDto, error)
+	FindLatestByNameAndHouseId(name string, houseId uuid.UUID, count int) ([]model.MeterDto, error)
 }
 
-func (m *MeterServiceObject) Add(request model.CreateMeterRequest) (response model.MeterDto, err error) {
-	if !m.paymentService.ExistsById(request.PaymentId) {
+func (m *MeterServiceObject) Add(request model.CreateMeterRequest, userId uuid.UUID) (response model.MeterDto, err error) {
+	if !m.paymentService.CanModify(request.PaymentId, userId) {
 		return response, fmt.Errorf("payment with id %s not found", request.PaymentId)
 	}
 
@@ -47,34 +48,66 @@ func (m *MeterServiceObject) Add(request model.CreateMeterRequest) (response mod
 	}
 }
 
-func (m *MeterServiceObject) Update(id uuid.UUID, request model.UpdateMeterRequest) error {
-	if !m.repository.ExistsById(id) {
+func (m *MeterServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateMeterRequest) error {
+	if !m.canModify(id, userId) {
 		return int_errors.NewErrNotFound("meter with id %s not found", id)
 	}
 
 	return m.repository.Update(id, request.ToEntity())
 }
 
-func (m *MeterServiceObject) DeleteById(id uuid.UUID) error {
-	if !m.repository.ExistsById(id) {
+func (m *MeterServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if !m.canModify(id, userId) {
 		return int_errors.NewErrNotFound("meter with id %s not found", id)
 	}
 
 	return m.repository.DeleteById(id)
 }
 
-func (m *MeterServiceObject) FindById(id uuid.UUID) (dto model.MeterDto, err error) {
+func (m *MeterServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (dto model.MeterDto, err error) {
 	if meter, err := m.repository.FindById(id); err != nil {
 		return dto, database.HandlerFindError(err, "meter with id %s in not exists", id)
+	} else if !m.hasPaymentAccess(meter.PaymentId, userId) {
+		return dto, int_errors.NewErrNotFound("meter with id %s in not exists", id)
 	} else {
 		return meter.ToDto(), err
 	}
 }
 
-func (m *MeterServiceObject) FindByPaymentId(id uuid.UUID) (dto model.MeterDto, err error) {
+func (m *MeterServiceObject) FindByPaymentId(id uuid.UUID, userId uuid.UUID) (dto model.MeterDto, err error) {
+	if !m.hasPaymentAccess(id, userId) {
+		return dto, int_errors.NewErrNotFound("meter with payment id %s in not exists", id)
+	}
 	if meter, err := m.repository.FindByPaymentId(id); err != nil {
 		return dto, database.HandlerFindError(err, "meter with payment id %s in not exists", id)
 	} else {
 		return meter.ToDto(), err
 	}
 }
+
+// FindLatestByNameAndHouseId returns up to count meters with the name ordered from the latest payment date
+func (m *MeterServiceObject) FindLatestByNameAndHouseId(name string, houseId uuid.UUID, count int) (response []model.MeterDto, err error) {
+	meters, err := m.repository.FindByNameAndHouseId(name, houseId, count)
+	if err != nil {
+		return nil, err
+	}
+
+	response = make([]model.MeterDto, 0, len(meters))
+	for _, meter := range meters {
+		response = append(response, meter.ToDto())
+	}
+	return response, nil
+}
+
+// canModify checks that the meter belongs to the payment the user is allowed to change
+func (m *MeterServiceObject) canModify(id uuid.UUID, userId uuid.UUID) bool {
+	meter, err := m.repository.FindById(id)
+
+	return err == nil && m.paymentService.CanModify(meter.PaymentId, userId)
+}
+
+func (m *MeterServiceObject) hasPaymentAccess(paymentId uuid.UUID, userId uuid.UUID) bool {
+	_, err := m.paymentService.FindById(paymentId, userId)
+
+	return err == nil
+}
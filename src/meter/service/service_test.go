@@ -7,6 +7,7 @@ import (
 	meterMocks "github.com/VlasovArtem/hob/src/meter/mocks"
 	"github.com/VlasovArtem/hob/src/meter/model"
 	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -33,12 +34,25 @@ func TestMeterServiceTestSuite(t *testing.T) {
 	suite.Run(t, ts)
 }
 
+func (m *MeterServiceTestSuite) mockPaymentAccess(paymentId uuid.UUID, userId uuid.UUID, available bool) {
+	if available {
+		m.payments.On("FindById", paymentId, userId).Return(paymentModel.PaymentDto{Id: paymentId}, nil)
+	} else {
+		m.payments.On("FindById", paymentId, userId).Return(paymentModel.PaymentDto{}, int_errors.NewErrNotFound("payment with id %s not found", paymentId))
+	}
+}
+
+func (m *MeterServiceTestSuite) mockPaymentModify(paymentId uuid.UUID, userId uuid.UUID, allowed bool) {
+	m.payments.On("CanModify", paymentId, userId).Return(allowed)
+}
+
 func (m *MeterServiceTestSuite) Test_Add() {
 	var savedMeter model.Meter
 
 	request := meterMocks.GenerateCreateMeterRequest()
+	userId := uuid.New()
 
-	m.payments.On("ExistsById", request.PaymentId).Return(true)
+	m.mockPaymentModify(request.PaymentId, userId, true)
 	m.meterRepository.On("Create", mock.Anything).Return(
 		func(meter model.Meter) model.Meter {
 			savedMeter = meter
@@ -48,7 +62,7 @@ func (m *MeterServiceTestSuite) Test_Add() {
 		nil,
 	)
 
-	meter, err := m.TestO.Add(request)
+	meter, err := m.TestO.Add(request, userId)
 
 	assert.Nil(m.T(), err)
 	assert.Equal(m.T(), savedMeter.ToDto(), meter)
@@ -56,10 +70,11 @@ func (m *MeterServiceTestSuite) Test_Add() {
 
 func (m *MeterServiceTestSuite) Test_Add_WithNotExistingPayment() {
 	request := meterMocks.GenerateCreateMeterRequest()
+	userId := uuid.New()
 
-	m.payments.On("ExistsById", request.PaymentId).Return(false)
+	m.mockPaymentModify(request.PaymentId, userId, false)
 
-	meter, err := m.TestO.Add(request)
+	meter, err := m.TestO.Add(request, userId)
 
 	assert.Equal(m.T(), fmt.Sprintf("payment with id %s not found", request.PaymentId.String()), err.Error())
 	assert.Equal(m.T(), model.MeterDto{}, meter)
@@ -68,11 +83,12 @@ func (m *MeterServiceTestSuite) Test_Add_WithNotExistingPayment() {
 func (m *MeterServiceTestSuite) Test_Add_WithErrorFromRepository() {
 	expectedError := errors.New("error")
 	request := meterMocks.GenerateCreateMeterRequest()
+	userId := uuid.New()
 
-	m.payments.On("ExistsById", request.PaymentId).Return(true)
+	m.mockPaymentModify(request.PaymentId, userId, true)
 	m.meterRepository.On("Create", mock.Anything).Return(model.Meter{}, expectedError)
 
-	meter, err := m.TestO.Add(request)
+	meter, err := m.TestO.Add(request, userId)
 
 	assert.Equal(m.T(), expectedError, err)
 	assert.Equal(m.T(), model.MeterDto{}, meter)
@@ -80,21 +96,24 @@ func (m *MeterServiceTestSuite) Test_Add_WithErrorFromRepository() {
 
 func (m *MeterServiceTestSuite) Test_Update() {
 	id, request := meterMocks.GenerateUpdateMeterRequest()
+	paymentId, userId := uuid.New(), uuid.New()
 
-	m.meterRepository.On("ExistsById", id).Return(true)
+	m.meterRepository.On("FindById", id).Return(model.Meter{PaymentId: paymentId}, nil)
+	m.mockPaymentModify(paymentId, userId, true)
 	m.meterRepository.On("Update", id, request.ToEntity()).Return(nil)
 
-	err := m.TestO.Update(id, request)
+	err := m.TestO.Update(id, userId, request)
 
 	assert.Nil(m.T(), err)
 }
 
 func (m *MeterServiceTestSuite) Test_Update_WithMissingId() {
 	id, request := meterMocks.GenerateUpdateMeterRequest()
+	userId := uuid.New()
 
-	m.meterRepository.On("ExistsById", id).Return(false)
+	m.meterRepository.On("FindById", id).Return(model.Meter{}, gorm.ErrRecordNotFound)
 
-	err := m.TestO.Update(id, request)
+	err := m.TestO.Update(id, userId, request)
 
 	assert.Equal(m.T(), int_errors.NewErrNotFound("meter with id %s not found", id), err)
 
@@ -103,32 +122,35 @@ func (m *MeterServiceTestSuite) Test_Update_WithMissingId() {
 
 func (m *MeterServiceTestSuite) Test_Update_WithErrorFromRepository() {
 	id, request := meterMocks.GenerateUpdateMeterRequest()
+	paymentId, userId := uuid.New(), uuid.New()
 
-	m.meterRepository.On("ExistsById", id).Return(true)
+	m.meterRepository.On("FindById", id).Return(model.Meter{PaymentId: paymentId}, nil)
+	m.mockPaymentModify(paymentId, userId, true)
 	m.meterRepository.On("Update", id, request.ToEntity()).Return(errors.New("test"))
 
-	err := m.TestO.Update(id, request)
+	err := m.TestO.Update(id, userId, request)
 
 	assert.Equal(m.T(), errors.New("test"), err)
 }
 
 func (m *MeterServiceTestSuite) Test_DeleteById() {
-	id := uuid.New()
+	id, paymentId, userId := uuid.New(), uuid.New(), uuid.New()
 
-	m.meterRepository.On("ExistsById", id).Return(true)
+	m.meterRepository.On("FindById", id).Return(model.Meter{PaymentId: paymentId}, nil)
+	m.mockPaymentModify(paymentId, userId, true)
 	m.meterRepository.On("DeleteById", id).Return(nil)
 
-	err := m.TestO.DeleteById(id)
+	err := m.TestO.DeleteById(id, userId)
 
 	assert.Nil(m.T(), err)
 }
 
 func (m *MeterServiceTestSuite) Test_DeleteById_WithMissingId() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
-	m.meterRepository.On("ExistsById", id).Return(false)
+	m.meterRepository.On("FindById", id).Return(model.Meter{}, gorm.ErrRecordNotFound)
 
-	err := m.TestO.DeleteById(id)
+	err := m.TestO.DeleteById(id, userId)
 
 	assert.Equal(m.T(), int_errors.NewErrNotFound("meter with id %s not found", id), err)
 
@@ -136,35 +158,64 @@ func (m *MeterServiceTestSuite) Test_DeleteById_WithMissingId() {
 }
 
 func (m *MeterServiceTestSuite) Test_DeleteById_WithErrorFromRepository() {
-	id := uuid.New()
+	id, paymentId, userId := uuid.New(), uuid.New(), uuid.New()
 
-	m.meterRepository.On("ExistsById", id).Return(true)
+	m.meterRepository.On("FindById", id).Return(model.Meter{PaymentId: paymentId}, nil)
+	m.mockPaymentModify(paymentId, userId, true)
 	m.meterRepository.On("DeleteById", id).Return(errors.New("test"))
 
-	err := m.TestO.DeleteById(id)
+	err := m.TestO.DeleteById(id, userId)
 
 	assert.Equal(m.T(), errors.New("test"), err)
 }
 
+func (m *MeterServiceTestSuite) Test_DeleteById_WithoutAccess() {
+	id, paymentId, userId := uuid.New(), uuid.New(), uuid.New()
+
+	m.meterRepository.On("FindById", id).Return(model.Meter{PaymentId: paymentId}, nil)
+	m.mockPaymentModify(paymentId, userId, false)
+
+	err := m.TestO.DeleteById(id, userId)
+
+	assert.Equal(m.T(), int_errors.NewErrNotFound("meter with id %s not found", id), err)
+
+	m.meterRepository.AssertNotCalled(m.T(), "DeleteById", id)
+}
+
 func (m *MeterServiceTestSuite) Test_FindById() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
 	expected := meterMocks.GenerateMeter(uuid.New())
 
 	m.meterRepository.On("FindById", id).Return(expected, nil)
+	m.mockPaymentAccess(expected.PaymentId, userId, true)
 
-	actual, err := m.TestO.FindById(id)
+	actual, err := m.TestO.FindById(id, userId)
 
 	assert.Nil(m.T(), err)
 	assert.Equal(m.T(), expected.ToDto(), actual)
 }
 
+func (m *MeterServiceTestSuite) Test_FindById_WithoutAccess() {
+	id, userId := uuid.New(), uuid.New()
+
+	expected := meterMocks.GenerateMeter(uuid.New())
+
+	m.meterRepository.On("FindById", id).Return(expected, nil)
+	m.mockPaymentAccess(expected.PaymentId, userId, false)
+
+	actual, err := m.TestO.FindById(id, userId)
+
+	assert.Equal(m.T(), int_errors.NewErrNotFound("meter with id %s in not exists", id), err)
+	assert.Equal(m.T(), model.MeterDto{}, actual)
+}
+
 func (m *MeterServiceTestSuite) Test_FindById_WithMissingId() {
 	id := uuid.New()
 
 	m.meterRepository.On("FindById", id).Return(model.Meter{}, gorm.ErrRecordNotFound)
 
-	actual, err := m.TestO.FindById(id)
+	actual, err := m.TestO.FindById(id, uuid.New())
 
 	assert.Equal(m.T(), int_errors.NewErrNotFound("meter with id %s in not exists", id), err)
 	assert.Equal(m.T(), model.MeterDto{}, actual)
@@ -176,44 +227,71 @@ func (m *MeterServiceTestSuite) Test_FindById_WithError() {
 
 	m.meterRepository.On("FindById", id).Return(model.Meter{}, expectedError)
 
-	actual, err := m.TestO.FindById(id)
+	actual, err := m.TestO.FindById(id, uuid.New())
 
 	assert.Equal(m.T(), expectedError, err)
 	assert.Equal(m.T(), model.MeterDto{}, actual)
 }
 
 func (m *MeterServiceTestSuite) Test_FindByPaymentId() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
 	expected := meterMocks.GenerateMeter(uuid.New())
 
+	m.mockPaymentAccess(id, userId, true)
 	m.meterRepository.On("FindByPaymentId", id).Return(expected, nil)
 
-	actual, err := m.TestO.FindByPaymentId(id)
+	actual, err := m.TestO.FindByPaymentId(id, userId)
 
 	assert.Nil(m.T(), err)
 	assert.Equal(m.T(), expected.ToDto(), actual)
 }
 
 func (m *MeterServiceTestSuite) Test_FindByPaymentId_WithMissingId() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 
+	m.mockPaymentAccess(id, userId, true)
 	m.meterRepository.On("FindByPaymentId", id).Return(model.Meter{}, gorm.ErrRecordNotFound)
 
-	actual, err := m.TestO.FindByPaymentId(id)
+	actual, err := m.TestO.FindByPaymentId(id, userId)
 
 	assert.Equal(m.T(), int_errors.NewErrNotFound("meter with payment id %s in not exists", id), err)
 	assert.Equal(m.T(), model.MeterDto{}, actual)
 }
 
 func (m *MeterServiceTestSuite) Test_FindByPaymentId_WithError() {
-	id := uuid.New()
+	id, userId := uuid.New(), uuid.New()
 	expectedError := errors.New("error")
 
+	m.mockPaymentAccess(id, userId, true)
 	m.meterRepository.On("FindByPaymentId", id).Return(model.Meter{}, expectedError)
 
-	actual, err := m.TestO.FindByPaymentId(id)
+	actual, err := m.TestO.FindByPaymentId(id, userId)
 
 	assert.Equal(m.T(), expectedError, err)
 	assert.Equal(m.T(), model.MeterDto{}, actual)
 }
+
+func (m *MeterServiceTestSuite) Test_FindLatestByNameAndHouseId() {
+	houseId := uuid.New()
+	meter := meterMocks.GenerateMeter(uuid.New())
+
+	m.meterRepository.On("FindByNameAndHouseId", meter.Name, houseId, 2).Return([]model.Meter{meter}, nil)
+
+	actual, err := m.TestO.FindLatestByNameAndHouseId(meter.Name, houseId, 2)
+
+	assert.Nil(m.T(), err)
+	assert.Equal(m.T(), []model.MeterDto{meter.ToDto()}, actual)
+}
+
+func (m *MeterServiceTestSuite) Test_FindLatestByNameAndHouseId_WithError() {
+	houseId := uuid.New()
+	expectedError := errors.New("error")
+
+	m.meterRepository.On("FindByNameAndHouseId", "Name", houseId, 2).Return(nil, expectedError)
+
+	actual, err := m.TestO.FindLatestByNameAndHouseId("Name", houseId, 2)
+
+	assert.Equal(m.T(), expectedError, err)
+	assert.Nil(m.T(), actual)
+}
@@ -0,0 +1,97 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/rs/zerolog/log"
+	"os"
+	"sync"
+	"time"
+)
+
+// NotifierType defines how the notifications are delivered to the users
+type NotifierType string
+
+const (
+	// LogNotifierType writes the notifications to the application log
+	LogNotifierType NotifierType = "log"
+	// FileNotifierType appends the notifications to the file
+	FileNotifierType NotifierType = "file"
+)
+
+type NotifierConfiguration struct {
+	Type NotifierType
+	// File is the path of the file the notifications are appended to by the FileNotifierType
+	File string
+}
+
+func NewDefaultNotifierConfiguration() NotifierConfiguration {
+	return NotifierConfiguration{
+		Type: LogNotifierType,
+		File: "notifications.log",
+	}
+}
+
+func ParseNotifierType(value string) (NotifierType, error) {
+	switch notifierType := NotifierType(value); notifierType {
+	case LogNotifierType, FileNotifierType:
+		return notifierType, nil
+	default:
+		return "", errors.New(fmt.Sprintf("notifier type %s is not supported", value))
+	}
+}
+
+type Notification struct {
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+type Notifier interface {
+	Notify(notification Notification) error
+}
+
+type NotifierObject struct{}
+
+func (n *NotifierObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewNotifier(factory.FindRequiredByObject(NotifierConfiguration{}).(NotifierConfiguration))
+}
+
+func NewNotifier(configuration NotifierConfiguration) Notifier {
+	switch configuration.Type {
+	case FileNotifierType:
+		return &fileNotifier{file: configuration.File}
+	default:
+		return &logNotifier{}
+	}
+}
+
+type logNotifier struct{}
+
+func (l *logNotifier) Notify(notification Notification) error {
+	log.Info().
+		Str("recipient", notification.Recipient).
+		Str("subject", notification.Subject).
+		Msg(notification.Body)
+	return nil
+}
+
+type fileNotifier struct {
+	file  string
+	mutex sync.Mutex
+}
+
+func (f *fileNotifier) Notify(notification Notification) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	file, err := os.OpenFile(f.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\t%s\n", time.Now().Format(time.RFC3339), notification.Recipient, notification.Subject, notification.Body)
+	return err
+}
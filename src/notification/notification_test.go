@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_ParseNotifierType(t *testing.T) {
+	for _, value := range []string{"log", "file"} {
+		notifierType, err := ParseNotifierType(value)
+
+		assert.Nil(t, err)
+		assert.Equal(t, NotifierType(value), notifierType)
+	}
+
+	notifierType, err := ParseNotifierType("invalid")
+
+	assert.Equal(t, errors.New("notifier type invalid is not supported"), err)
+	assert.Equal(t, NotifierType(""), notifierType)
+}
+
+func Test_NewNotifier(t *testing.T) {
+	assert.IsType(t, &logNotifier{}, NewNotifier(NewDefaultNotifierConfiguration()))
+	assert.IsType(t, &fileNotifier{}, NewNotifier(NotifierConfiguration{Type: FileNotifierType, File: "file"}))
+}
+
+func Test_LogNotifier(t *testing.T) {
+	assert.Nil(t, NewNotifier(NewDefaultNotifierConfiguration()).Notify(generateNotification()))
+}
+
+func Test_FileNotifier(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "notifications.log")
+	notifier := NewNotifier(NotifierConfiguration{Type: FileNotifierType, File: file})
+
+	assert.Nil(t, notifier.Notify(generateNotification()))
+	assert.Nil(t, notifier.Notify(generateNotification()))
+
+	content, err := os.ReadFile(file)
+
+	assert.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+
+	assert.Len(t, lines, 2)
+	assert.True(t, strings.HasSuffix(lines[0], "\tmail@mail.com\tSubject\tBody"))
+}
+
+func Test_FileNotifier_WithMissingDirectory(t *testing.T) {
+	notifier := NewNotifier(NotifierConfiguration{Type: FileNotifierType, File: filepath.Join(t.TempDir(), "missing", "notifications.log")})
+
+	assert.NotNil(t, notifier.Notify(generateNotification()))
+}
+
+func generateNotification() Notification {
+	return Notification{
+		Recipient: "mail@mail.com",
+		Subject:   "Subject",
+		Body:      "Body",
+	}
+}
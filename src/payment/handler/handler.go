@@ -5,6 +5,8 @@ import (
 	"github.com/VlasovArtem/hob/src/common/rest"
 	"github.com/VlasovArtem/hob/src/payment/model"
 	paymentService "github.com/VlasovArtem/hob/src/payment/service"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"net/http"
 )
@@ -49,9 +51,17 @@ type PaymentHandler interface {
 
 func (p *PaymentHandlerObject) Add() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreatePaymentRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			body.UserId = userId
+
 			rest.NewAPIResponse(writer).
 				Created(p.paymentService.Add(body)).
 				Perform()
@@ -61,9 +71,19 @@ func (p *PaymentHandlerObject) Add() http.HandlerFunc {
 
 func (p *PaymentHandlerObject) AddBatch() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreatePaymentBatchRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			for i := range body.Payments {
+				body.Payments[i].UserId = userId
+			}
+
 			rest.NewAPIResponse(writer).
 				Created(p.paymentService.AddBatch(body)).
 				Perform()
@@ -73,11 +93,11 @@ func (p *PaymentHandlerObject) AddBatch() http.HandlerFunc {
 
 func (p *PaymentHandlerObject) Delete() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				NoContent(p.paymentService.DeleteById(id)).
+				NoContent(p.paymentService.DeleteById(id, userId)).
 				Perform()
 		}
 	}
@@ -85,14 +105,14 @@ func (p *PaymentHandlerObject) Delete() http.HandlerFunc {
 
 func (p *PaymentHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdatePaymentRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
 				rest.NewAPIResponse(writer).
-					Error(p.paymentService.Update(id, body)).
+					Error(p.paymentService.Update(id, userId, body)).
 					Perform()
 			}
 		}
@@ -101,11 +121,11 @@ func (p *PaymentHandlerObject) Update() http.HandlerFunc {
 
 func (p *PaymentHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(p.paymentService.FindById(id)).
+				Ok(p.paymentService.FindById(id, userId)).
 				Perform()
 		}
 	}
@@ -113,14 +133,25 @@ func (p *PaymentHandlerObject) FindById() http.HandlerFunc {
 
 func (p *PaymentHandlerObject) FindByHouseId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			categoryId, err := rest.GetQueryParamOrDefaultReference[uuid.UUID](request, "categoryId", nil)
+			if err != nil {
+				rest.HandleWithError(writer, err)
+				return
+			}
+			tagIds, allTags, err := rest.GetRequestTagging(request)
+			if err != nil {
+				rest.HandleWithError(writer, err)
+				return
+			}
+
 			limit, offset := rest.GetRequestPaging(request, 25, 0)
 			from, to := rest.GetRequestFiltering(request)
 
 			rest.NewAPIResponse(writer).
-				Body(p.paymentService.FindByHouseId(id, limit, offset, from, to)).
+				Body(p.paymentService.FindByHouseId(id, userId, limit, offset, from, to, categoryId, tagModel.Filter{Ids: tagIds, All: allTags})).
 				Perform()
 		}
 	}
@@ -128,14 +159,25 @@ func (p *PaymentHandlerObject) FindByHouseId() http.HandlerFunc {
 
 func (p *PaymentHandlerObject) FindByUserId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, err := rest.GetUserIdRequestParameter(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			categoryId, err := rest.GetQueryParamOrDefaultReference[uuid.UUID](request, "categoryId", nil)
+			if err != nil {
+				rest.HandleWithError(writer, err)
+				return
+			}
+			tagIds, allTags, err := rest.GetRequestTagging(request)
+			if err != nil {
+				rest.HandleWithError(writer, err)
+				return
+			}
+
 			limit, offset := rest.GetRequestPaging(request, 25, 0)
 			from, to := rest.GetRequestFiltering(request)
 
 			rest.NewAPIResponse(writer).
-				Body(p.paymentService.FindByUserId(id, limit, offset, from, to)).
+				Body(p.paymentService.FindByUserId(id, limit, offset, from, to, categoryId, tagModel.Filter{Ids: tagIds, All: allTags})).
 				Perform()
 		}
 	}
@@ -143,14 +185,20 @@ func (p *PaymentHandlerObject) FindByUserId() http.HandlerFunc {
 
 func (p *PaymentHandlerObject) FindByProviderId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			tagIds, allTags, err := rest.GetRequestTagging(request)
+			if err != nil {
+				rest.HandleWithError(writer, err)
+				return
+			}
+
 			limit, offset := rest.GetRequestPaging(request, 25, 0)
 			from, to := rest.GetRequestFiltering(request)
 
 			rest.NewAPIResponse(writer).
-				Body(p.paymentService.FindByProviderId(id, limit, offset, from, to)).
+				Body(p.paymentService.FindByProviderId(id, userId, limit, offset, from, to, tagModel.Filter{Ids: tagIds, All: allTags})).
 				Perform()
 		}
 	}
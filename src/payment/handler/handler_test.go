@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"github.com/VlasovArtem/hob/src/common"
 	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/payment/mocks"
 	"github.com/VlasovArtem/hob/src/payment/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,7 +20,10 @@ import (
 	"time"
 )
 
-var nilTime *time.Time
+var (
+	nilTime       *time.Time
+	nilCategoryId *uuid.UUID
+)
 
 type PaymentHandlerTestSuite struct {
 	testhelper.MockTestSuite[PaymentHandler]
@@ -43,6 +48,7 @@ func (p *PaymentHandlerTestSuite) Test_Add() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(p.TestO.Add()).
 		WithBody(request)
 
@@ -60,7 +66,7 @@ func (p *PaymentHandlerTestSuite) Test_Add() {
 		UserId:      mocks.UserId,
 		ProviderId:  &mocks.ProviderId,
 		Date:        mocks.Date,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
 	}, actual)
 }
 
@@ -73,6 +79,7 @@ func (p *PaymentHandlerTestSuite) Test_Add_WithProviderIdNil() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(p.TestO.Add()).
 		WithBody(request)
 
@@ -90,7 +97,7 @@ func (p *PaymentHandlerTestSuite) Test_Add_WithProviderIdNil() {
 		UserId:      mocks.UserId,
 		ProviderId:  nil,
 		Date:        mocks.Date,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
 	}, actual)
 }
 
@@ -98,6 +105,7 @@ func (p *PaymentHandlerTestSuite) Test_Add_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.Add())
 
 	testRequest.Verify(p.T(), http.StatusBadRequest)
@@ -113,6 +121,7 @@ func (p *PaymentHandlerTestSuite) Test_Add_WithErrorFromService() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(p.TestO.Add()).
 		WithBody(request)
 
@@ -131,6 +140,7 @@ func (p *PaymentHandlerTestSuite) Test_AddBatch() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/batch").
 		WithMethod("POST").
+		WithUser(request.Payments[0].UserId).
 		WithHandler(p.TestO.AddBatch()).
 		WithBody(request)
 
@@ -150,7 +160,7 @@ func (p *PaymentHandlerTestSuite) Test_AddBatch() {
 			UserId:      mocks.UserId,
 			ProviderId:  &mocks.ProviderId,
 			Date:        mocks.Date,
-			Sum:         1000,
+			Sum:         money.FromMinorUnits(100000),
 		},
 	}, actual)
 }
@@ -159,6 +169,7 @@ func (p *PaymentHandlerTestSuite) Test_AddBatch_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/batch").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.AddBatch())
 
 	testRequest.Verify(p.T(), http.StatusBadRequest)
@@ -177,6 +188,7 @@ func (p *PaymentHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/batch").
 		WithMethod("POST").
+		WithUser(request.Payments[0].UserId).
 		WithHandler(p.TestO.AddBatch()).
 		WithBody(request)
 
@@ -189,14 +201,16 @@ func (p *PaymentHandlerTestSuite) Test_AddBatch_WithErrorFromService() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_Update() {
+	userId := uuid.New()
 	request := mocks.GenerateUpdatePaymentRequest()
 	id := uuid.New()
 
-	p.payments.On("Update", id, request).Return(nil)
+	p.payments.On("Update", id, userId, request).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(p.TestO.Update()).
 		WithBody(request).
 		WithVar("id", id.String())
@@ -210,6 +224,7 @@ func (p *PaymentHandlerTestSuite) Test_Update_WithInvalidId() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.Update()).
 		WithBody(request).
 		WithVar("id", "id")
@@ -218,13 +233,14 @@ func (p *PaymentHandlerTestSuite) Test_Update_WithInvalidId() {
 
 	assert.Equal(p.T(), "the id is not valid id\n", string(responseByteArray))
 
-	p.payments.AssertNotCalled(p.T(), "Update", mock.Anything, mock.Anything)
+	p.payments.AssertNotCalled(p.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (p *PaymentHandlerTestSuite) Test_Update_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.Update()).
 		WithVar("id", uuid.New().String())
 
@@ -232,16 +248,18 @@ func (p *PaymentHandlerTestSuite) Test_Update_WithInvalidRequest() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_Update_WithErrorFromService() {
+	userId := uuid.New()
 	request := mocks.GenerateUpdatePaymentRequest()
 	id := uuid.New()
 
 	expected := errors.New("error")
 
-	p.payments.On("Update", id, request).Return(expected)
+	p.payments.On("Update", id, userId, request).Return(expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(p.TestO.Update()).
 		WithVar("id", id.String()).
 		WithBody(request)
@@ -251,14 +269,38 @@ func (p *PaymentHandlerTestSuite) Test_Update_WithErrorFromService() {
 	assert.Equal(p.T(), fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
 }
 
+func (p *PaymentHandlerTestSuite) Test_Update_WithNotFoundFromService() {
+	userId := uuid.New()
+	request := mocks.GenerateUpdatePaymentRequest()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("payment with id %s not found", id)
+
+	p.payments.On("Update", id, userId, request).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payments/{id}").
+		WithMethod("PUT").
+		WithUser(userId).
+		WithHandler(p.TestO.Update()).
+		WithVar("id", id.String()).
+		WithBody(request)
+
+	responseByteArray := testRequest.Verify(p.T(), http.StatusNotFound)
+
+	assert.Equal(p.T(), fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
 func (p *PaymentHandlerTestSuite) Test_Delete() {
+	userId := uuid.New()
 	id := uuid.New()
 
-	p.payments.On("DeleteById", id).Return(nil)
+	p.payments.On("DeleteById", id, userId).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("DELETE").
+		WithUser(userId).
 		WithHandler(p.TestO.Delete()).
 		WithVar("id", id.String())
 
@@ -269,6 +311,7 @@ func (p *PaymentHandlerTestSuite) Test_Delete_WithMissingParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.Delete())
 
 	responseByteArray := testRequest.Verify(p.T(), http.StatusBadRequest)
@@ -280,6 +323,7 @@ func (p *PaymentHandlerTestSuite) Test_Delete_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.Delete()).
 		WithVar("id", "id")
 
@@ -288,15 +332,37 @@ func (p *PaymentHandlerTestSuite) Test_Delete_WithInvalidParameter() {
 	assert.Equal(p.T(), "the id is not valid id\n", string(responseByteArray))
 }
 
+func (p *PaymentHandlerTestSuite) Test_Delete_WithNotFoundFromService() {
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("payment with id %s not found", id)
+
+	p.payments.On("DeleteById", id, userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payments/{id}").
+		WithMethod("DELETE").
+		WithUser(userId).
+		WithHandler(p.TestO.Delete()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(p.T(), http.StatusNotFound)
+
+	assert.Equal(p.T(), fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
 func (p *PaymentHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
 	paymentResponse := mocks.GeneratePaymentResponse()
 
-	p.payments.On("FindById", paymentResponse.Id).
+	p.payments.On("FindById", paymentResponse.Id, userId).
 		Return(paymentResponse, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindById()).
 		WithVar("id", paymentResponse.Id.String())
 
@@ -310,16 +376,18 @@ func (p *PaymentHandlerTestSuite) Test_FindById() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindById_WithError() {
+	userId := uuid.New()
 	id := uuid.New()
 
 	expected := errors.New("error")
 
-	p.payments.On("FindById", id).
+	p.payments.On("FindById", id, userId).
 		Return(model.PaymentDto{}, expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindById()).
 		WithVar("id", id.String())
 
@@ -332,6 +400,7 @@ func (p *PaymentHandlerTestSuite) Test_FindById_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.FindById()).
 		WithVar("id", "id")
 
@@ -341,18 +410,20 @@ func (p *PaymentHandlerTestSuite) Test_FindById_WithInvalidParameter() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindByHouseId() {
+	userId := uuid.New()
 	response := mocks.GeneratePaymentResponse()
 
 	from, fromString, to, toString := createFromAndTo()
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByHouseId", response.Id, 10, 1, from, to).
+	p.payments.On("FindByHouseId", response.Id, userId, 10, 1, from, to, nilCategoryId, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/house/{id}?limit={limit}&offset={offset}&from={from}&to={to}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindByHouseId()).
 		WithVar("id", response.Id.String()).
 		WithParameter("limit", "10").
@@ -370,17 +441,19 @@ func (p *PaymentHandlerTestSuite) Test_FindByHouseId() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithFrom() {
+	userId := uuid.New()
 	response := mocks.GeneratePaymentResponse()
 	from, fromString, _, _ := createFromAndTo()
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByHouseId", response.Id, 10, 1, from, nilTime).
+	p.payments.On("FindByHouseId", response.Id, userId, 10, 1, from, nilTime, nilCategoryId, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/house/{id}?limit={limit}&offset={offset}&from={from}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindByHouseId()).
 		WithVar("id", response.Id.String()).
 		WithParameter("limit", "10").
@@ -397,16 +470,18 @@ func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithFrom() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WIthDefaultLimitAndOffset() {
+	userId := uuid.New()
 	response := mocks.GeneratePaymentResponse()
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByHouseId", response.Id, 25, 0, nilTime, nilTime).
+	p.payments.On("FindByHouseId", response.Id, userId, 25, 0, nilTime, nilTime, nilCategoryId, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/house/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindByHouseId()).
 		WithVar("id", response.Id.String())
 
@@ -420,16 +495,18 @@ func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WIthDefaultLimitAndOffset()
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithEmptyResponse() {
+	userId := uuid.New()
 	id := uuid.New()
 
 	paymentResponses := []model.PaymentDto{}
 
-	p.payments.On("FindByHouseId", id, 25, 0, nilTime, nilTime).
+	p.payments.On("FindByHouseId", id, userId, 25, 0, nilTime, nilTime, nilCategoryId, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/house/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindByHouseId()).
 		WithVar("id", id.String())
 
@@ -442,10 +519,84 @@ func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithEmptyResponse() {
 	assert.Equal(p.T(), paymentResponses, actual)
 }
 
+func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithCategoryId() {
+	userId, categoryId := uuid.New(), uuid.New()
+	response := mocks.GeneratePaymentResponse()
+
+	paymentResponses := []model.PaymentDto{response}
+
+	p.payments.On("FindByHouseId", response.Id, userId, 25, 0, nilTime, nilTime, &categoryId, tagModel.Filter{}).
+		Return(paymentResponses, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payments/house/{id}?categoryId={categoryId}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(p.TestO.FindByHouseId()).
+		WithVar("id", response.Id.String()).
+		WithParameter("categoryId", categoryId.String())
+
+	testRequest.Verify(p.T(), http.StatusOK)
+
+	p.payments.AssertCalled(p.T(), "FindByHouseId", response.Id, userId, 25, 0, nilTime, nilTime, &categoryId, tagModel.Filter{})
+}
+
+func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithInvalidCategoryId() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payments/house/{id}?categoryId={categoryId}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(p.TestO.FindByHouseId()).
+		WithVar("id", uuid.New().String()).
+		WithParameter("categoryId", "category")
+
+	responseByteArray := testRequest.Verify(p.T(), http.StatusBadRequest)
+
+	assert.Equal(p.T(), "the id is not valid UUID\n", string(responseByteArray))
+}
+
+func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithTags() {
+	userId, firstTagId, secondTagId := uuid.New(), uuid.New(), uuid.New()
+	response := mocks.GeneratePaymentResponse()
+	tags := tagModel.Filter{Ids: []uuid.UUID{firstTagId, secondTagId}, All: true}
+
+	p.payments.On("FindByHouseId", response.Id, userId, 25, 0, nilTime, nilTime, nilCategoryId, tags).
+		Return([]model.PaymentDto{response}, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payments/house/{id}?tagIds={tagIds}&tagMatch={tagMatch}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(p.TestO.FindByHouseId()).
+		WithVar("id", response.Id.String()).
+		WithParameter("tagIds", firstTagId.String()+","+secondTagId.String()).
+		WithParameter("tagMatch", "all")
+
+	testRequest.Verify(p.T(), http.StatusOK)
+
+	p.payments.AssertCalled(p.T(), "FindByHouseId", response.Id, userId, 25, 0, nilTime, nilTime, nilCategoryId, tags)
+}
+
+func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithInvalidTagMatch() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payments/house/{id}?tagIds={tagIds}&tagMatch={tagMatch}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(p.TestO.FindByHouseId()).
+		WithVar("id", uuid.New().String()).
+		WithParameter("tagIds", uuid.New().String()).
+		WithParameter("tagMatch", "some")
+
+	responseByteArray := testRequest.Verify(p.T(), http.StatusBadRequest)
+
+	assert.Equal(p.T(), "tag match 'some' is not valid, the valid values are 'any' and 'all'\n", string(responseByteArray))
+}
+
 func (p *PaymentHandlerTestSuite) Test_FindByHouseId_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/house/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.FindByHouseId()).
 		WithVar("id", "id")
 
@@ -460,12 +611,13 @@ func (p *PaymentHandlerTestSuite) Test_FindByUserId() {
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByUserId", response.Id, 10, 1, from, to).
+	p.payments.On("FindByUserId", response.Id, 10, 1, from, to, nilCategoryId, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/user/{id}?limit={limit}&offset={offset}&from={from}&to={to}").
 		WithMethod("GET").
+		WithUser(response.Id).
 		WithHandler(p.TestO.FindByUserId()).
 		WithVar("id", response.Id.String()).
 		WithParameter("limit", "10").
@@ -488,12 +640,13 @@ func (p *PaymentHandlerTestSuite) Test_FindByUserId_WithFrom() {
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByUserId", response.Id, 10, 1, from, nilTime).
+	p.payments.On("FindByUserId", response.Id, 10, 1, from, nilTime, nilCategoryId, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/user/{id}?limit={limit}&offset={offset}&from={from}").
 		WithMethod("GET").
+		WithUser(response.Id).
 		WithHandler(p.TestO.FindByUserId()).
 		WithVar("id", response.Id.String()).
 		WithParameter("limit", "10").
@@ -514,12 +667,13 @@ func (p *PaymentHandlerTestSuite) Test_FindByUserId_WithDefaultLimitAndOffset()
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByUserId", response.Id, 25, 0, nilTime, nilTime).
+	p.payments.On("FindByUserId", response.Id, 25, 0, nilTime, nilTime, nilCategoryId, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/user/{id}").
 		WithMethod("GET").
+		WithUser(response.Id).
 		WithHandler(p.TestO.FindByUserId()).
 		WithVar("id", response.Id.String())
 
@@ -537,12 +691,13 @@ func (p *PaymentHandlerTestSuite) Test_FindByUserId_WithEmptyResponse() {
 
 	var paymentResponses []model.PaymentDto
 
-	p.payments.On("FindByUserId", id, 25, 0, nilTime, nilTime).
+	p.payments.On("FindByUserId", id, 25, 0, nilTime, nilTime, nilCategoryId, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/user/{id}?limit={limit}&offset={offset}").
 		WithMethod("GET").
+		WithUser(id).
 		WithHandler(p.TestO.FindByUserId()).
 		WithVar("id", id.String())
 
@@ -559,6 +714,7 @@ func (p *PaymentHandlerTestSuite) Test_FindByUserId_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/user/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.FindByUserId()).
 		WithVar("id", "id")
 
@@ -568,17 +724,19 @@ func (p *PaymentHandlerTestSuite) Test_FindByUserId_WithInvalidParameter() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindByProviderId() {
+	userId := uuid.New()
 	response := mocks.GeneratePaymentResponse()
 	from, fromString, to, toString := createFromAndTo()
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByProviderId", response.Id, 10, 1, from, to).
+	p.payments.On("FindByProviderId", response.Id, userId, 10, 1, from, to, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/provider/{id}?limit={limit}&offset={offset}&from={from}&to={to}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindByProviderId()).
 		WithVar("id", response.Id.String()).
 		WithParameter("limit", "10").
@@ -596,17 +754,19 @@ func (p *PaymentHandlerTestSuite) Test_FindByProviderId() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindByProviderId_WithFrom() {
+	userId := uuid.New()
 	response := mocks.GeneratePaymentResponse()
 	from, fromString, _, _ := createFromAndTo()
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByProviderId", response.Id, 10, 1, from, nilTime).
+	p.payments.On("FindByProviderId", response.Id, userId, 10, 1, from, nilTime, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/provider/{id}?limit={limit}&offset={offset}&from={from}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindByProviderId()).
 		WithVar("id", response.Id.String()).
 		WithParameter("limit", "10").
@@ -623,16 +783,18 @@ func (p *PaymentHandlerTestSuite) Test_FindByProviderId_WithFrom() {
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindByProviderId_WithDefaultLimitAndOffset() {
+	userId := uuid.New()
 	response := mocks.GeneratePaymentResponse()
 
 	paymentResponses := []model.PaymentDto{response}
 
-	p.payments.On("FindByProviderId", response.Id, 25, 0, nilTime, nilTime).
+	p.payments.On("FindByProviderId", response.Id, userId, 25, 0, nilTime, nilTime, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/provider/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindByProviderId()).
 		WithVar("id", response.Id.String())
 
@@ -646,16 +808,18 @@ func (p *PaymentHandlerTestSuite) Test_FindByProviderId_WithDefaultLimitAndOffse
 }
 
 func (p *PaymentHandlerTestSuite) Test_FindByProviderId_WithEmptyResponse() {
+	userId := uuid.New()
 	id := uuid.New()
 
 	var paymentResponses []model.PaymentDto
 
-	p.payments.On("FindByProviderId", id, 25, 0, nilTime, nilTime).
+	p.payments.On("FindByProviderId", id, userId, 25, 0, nilTime, nilTime, tagModel.Filter{}).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/provider/{id}?limit={limit}&offset={offset}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindByProviderId()).
 		WithVar("id", id.String())
 
@@ -672,7 +836,8 @@ func (p *PaymentHandlerTestSuite) Test_FindByProviderId_WithInvalidParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payments/provider/{id}").
 		WithMethod("GET").
-		WithHandler(p.TestO.FindByUserId()).
+		WithUser(uuid.New()).
+		WithHandler(p.TestO.FindByProviderId()).
 		WithVar("id", "id")
 
 	responseByteArray := testRequest.Verify(p.T(), http.StatusBadRequest)
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/payment/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
@@ -23,7 +24,8 @@ func GenerateCreatePaymentRequest() model.CreatePaymentRequest {
 		UserId:      UserId,
 		ProviderId:  &ProviderId,
 		Date:        Date,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 	}
 }
 
@@ -42,7 +44,8 @@ func GenerateUpdatePaymentRequest() model.UpdatePaymentRequest {
 		Name:        "Test Payment",
 		Description: "Test Payment Description",
 		Date:        Date,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 		ProviderId:  &ProviderId,
 	}
 }
@@ -55,7 +58,8 @@ func GeneratePayment(houseId uuid.UUID, userId uuid.UUID, providerId uuid.UUID)
 		HouseId:     houseId,
 		UserId:      userId,
 		Date:        Date,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 		ProviderId:  &providerId,
 	}
 }
@@ -69,6 +73,7 @@ func GeneratePaymentResponse() model.PaymentDto {
 		UserId:      UserId,
 		ProviderId:  &ProviderId,
 		Date:        Date,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 	}
 }
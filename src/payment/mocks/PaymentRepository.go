@@ -3,9 +3,12 @@
 package mocks
 
 import (
-	model "github.com/VlasovArtem/hob/src/payment/model"
 	mock "github.com/stretchr/testify/mock"
 
+	model "github.com/VlasovArtem/hob/src/payment/model"
+
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -16,6 +19,20 @@ type PaymentRepository struct {
 	mock.Mock
 }
 
+// AssignHouseCurrencies provides a mock function with given fields: currencies
+func (_m *PaymentRepository) AssignHouseCurrencies(currencies map[string]string) error {
+	ret := _m.Called(currencies)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string) error); ok {
+		r0 = rf(currencies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: entity
 func (_m *PaymentRepository) Create(entity model.Payment) (model.Payment, error) {
 	ret := _m.Called(entity)
@@ -102,13 +119,29 @@ func (_m *PaymentRepository) ExistsById(id uuid.UUID) bool {
 	return r0
 }
 
-// FindByHouseId provides a mock function with given fields: houseId, limit, offset, from, to
-func (_m *PaymentRepository) FindByHouseId(houseId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.PaymentDto {
-	ret := _m.Called(houseId, limit, offset, from, to)
+// FindAmounts provides a mock function with given fields: houseId, from, to, providerId, categoryIds
+func (_m *PaymentRepository) FindAmounts(houseId uuid.UUID, from time.Time, to time.Time, providerId *uuid.UUID, categoryIds []uuid.UUID) []model.Amount {
+	ret := _m.Called(houseId, from, to, providerId, categoryIds)
+
+	var r0 []model.Amount
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time, time.Time, *uuid.UUID, []uuid.UUID) []model.Amount); ok {
+		r0 = rf(houseId, from, to, providerId, categoryIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Amount)
+		}
+	}
+
+	return r0
+}
+
+// FindByHouseId provides a mock function with given fields: houseId, limit, offset, from, to, categoryIds, tags
+func (_m *PaymentRepository) FindByHouseId(houseId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time, categoryIds []uuid.UUID, tags tagModel.Filter) []model.PaymentDto {
+	ret := _m.Called(houseId, limit, offset, from, to, categoryIds, tags)
 
 	var r0 []model.PaymentDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time) []model.PaymentDto); ok {
-		r0 = rf(houseId, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time, []uuid.UUID, tagModel.Filter) []model.PaymentDto); ok {
+		r0 = rf(houseId, limit, offset, from, to, categoryIds, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentDto)
@@ -139,13 +172,13 @@ func (_m *PaymentRepository) FindById(id uuid.UUID) (model.Payment, error) {
 	return r0, r1
 }
 
-// FindByProviderId provides a mock function with given fields: providerId, limit, offset, from, to
-func (_m *PaymentRepository) FindByProviderId(providerId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.PaymentDto {
-	ret := _m.Called(providerId, limit, offset, from, to)
+// FindByProviderId provides a mock function with given fields: providerId, userId, limit, offset, from, to, tags
+func (_m *PaymentRepository) FindByProviderId(providerId uuid.UUID, userId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time, tags tagModel.Filter) []model.PaymentDto {
+	ret := _m.Called(providerId, userId, limit, offset, from, to, tags)
 
 	var r0 []model.PaymentDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time) []model.PaymentDto); ok {
-		r0 = rf(providerId, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int, int, *time.Time, *time.Time, tagModel.Filter) []model.PaymentDto); ok {
+		r0 = rf(providerId, userId, limit, offset, from, to, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentDto)
@@ -155,13 +188,13 @@ func (_m *PaymentRepository) FindByProviderId(providerId uuid.UUID, limit int, o
 	return r0
 }
 
-// FindByUserId provides a mock function with given fields: userId, limit, offset, from, to
-func (_m *PaymentRepository) FindByUserId(userId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.PaymentDto {
-	ret := _m.Called(userId, limit, offset, from, to)
+// FindByUserId provides a mock function with given fields: userId, limit, offset, from, to, categoryIds, tags
+func (_m *PaymentRepository) FindByUserId(userId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time, categoryIds []uuid.UUID, tags tagModel.Filter) []model.PaymentDto {
+	ret := _m.Called(userId, limit, offset, from, to, categoryIds, tags)
 
 	var r0 []model.PaymentDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time) []model.PaymentDto); ok {
-		r0 = rf(userId, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time, []uuid.UUID, tagModel.Filter) []model.PaymentDto); ok {
+		r0 = rf(userId, limit, offset, from, to, categoryIds, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentDto)
@@ -171,6 +204,52 @@ func (_m *PaymentRepository) FindByUserId(userId uuid.UUID, limit int, offset in
 	return r0
 }
 
+// FindMonthlyTotals provides a mock function with given fields: houseId, providerId, currency, from, to
+func (_m *PaymentRepository) FindMonthlyTotals(houseId uuid.UUID, providerId uuid.UUID, currency string, from time.Time, to time.Time) ([]model.MonthlyTotal, error) {
+	ret := _m.Called(houseId, providerId, currency, from, to)
+
+	var r0 []model.MonthlyTotal
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, string, time.Time, time.Time) []model.MonthlyTotal); ok {
+		r0 = rf(houseId, providerId, currency, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.MonthlyTotal)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, string, time.Time, time.Time) error); ok {
+		r1 = rf(houseId, providerId, currency, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SumByHouseId provides a mock function with given fields: houseId, from, to, groupBy
+func (_m *PaymentRepository) SumByHouseId(houseId uuid.UUID, from *time.Time, to *time.Time, groupBy model.GroupBy) ([]model.Total, error) {
+	ret := _m.Called(houseId, from, to, groupBy)
+
+	var r0 []model.Total
+	if rf, ok := ret.Get(0).(func(uuid.UUID, *time.Time, *time.Time, model.GroupBy) []model.Total); ok {
+		r0 = rf(houseId, from, to, groupBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Total)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, *time.Time, *time.Time, model.GroupBy) error); ok {
+		r1 = rf(houseId, from, to, groupBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: entity
 func (_m *PaymentRepository) Update(entity model.Payment) error {
 	ret := _m.Called(entity)
@@ -6,6 +6,8 @@ import (
 	model "github.com/VlasovArtem/hob/src/payment/model"
 	mock "github.com/stretchr/testify/mock"
 
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -60,13 +62,27 @@ func (_m *PaymentService) AddBatch(request model.CreatePaymentBatchRequest) ([]m
 	return r0, r1
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *PaymentService) DeleteById(id uuid.UUID) error {
-	ret := _m.Called(id)
+// CanModify provides a mock function with given fields: id, userId
+func (_m *PaymentService) CanModify(id uuid.UUID, userId uuid.UUID) bool {
+	ret := _m.Called(id, userId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// DeleteById provides a mock function with given fields: id, userId
+func (_m *PaymentService) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -88,13 +104,13 @@ func (_m *PaymentService) ExistsById(id uuid.UUID) bool {
 	return r0
 }
 
-// FindByHouseId provides a mock function with given fields: id, limit, offset, from, to
-func (_m *PaymentService) FindByHouseId(id uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.PaymentDto {
-	ret := _m.Called(id, limit, offset, from, to)
+// FindByHouseId provides a mock function with given fields: id, userId, limit, offset, from, to, categoryId, tags
+func (_m *PaymentService) FindByHouseId(id uuid.UUID, userId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time, categoryId *uuid.UUID, tags tagModel.Filter) []model.PaymentDto {
+	ret := _m.Called(id, userId, limit, offset, from, to, categoryId, tags)
 
 	var r0 []model.PaymentDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time) []model.PaymentDto); ok {
-		r0 = rf(id, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int, int, *time.Time, *time.Time, *uuid.UUID, tagModel.Filter) []model.PaymentDto); ok {
+		r0 = rf(id, userId, limit, offset, from, to, categoryId, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentDto)
@@ -104,20 +120,20 @@ func (_m *PaymentService) FindByHouseId(id uuid.UUID, limit int, offset int, fro
 	return r0
 }
 
-// FindById provides a mock function with given fields: id
-func (_m *PaymentService) FindById(id uuid.UUID) (model.PaymentDto, error) {
-	ret := _m.Called(id)
+// FindById provides a mock function with given fields: id, userId
+func (_m *PaymentService) FindById(id uuid.UUID, userId uuid.UUID) (model.PaymentDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.PaymentDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.PaymentDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.PaymentDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.PaymentDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -125,13 +141,13 @@ func (_m *PaymentService) FindById(id uuid.UUID) (model.PaymentDto, error) {
 	return r0, r1
 }
 
-// FindByProviderId provides a mock function with given fields: id, limit, offset, from, to
-func (_m *PaymentService) FindByProviderId(id uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.PaymentDto {
-	ret := _m.Called(id, limit, offset, from, to)
+// FindByProviderId provides a mock function with given fields: id, userId, limit, offset, from, to, tags
+func (_m *PaymentService) FindByProviderId(id uuid.UUID, userId uuid.UUID, limit int, offset int, from *time.Time, to *time.Time, tags tagModel.Filter) []model.PaymentDto {
+	ret := _m.Called(id, userId, limit, offset, from, to, tags)
 
 	var r0 []model.PaymentDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time) []model.PaymentDto); ok {
-		r0 = rf(id, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int, int, *time.Time, *time.Time, tagModel.Filter) []model.PaymentDto); ok {
+		r0 = rf(id, userId, limit, offset, from, to, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentDto)
@@ -141,13 +157,13 @@ func (_m *PaymentService) FindByProviderId(id uuid.UUID, limit int, offset int,
 	return r0
 }
 
-// FindByUserId provides a mock function with given fields: id, limit, offset, from, to
-func (_m *PaymentService) FindByUserId(id uuid.UUID, limit int, offset int, from *time.Time, to *time.Time) []model.PaymentDto {
-	ret := _m.Called(id, limit, offset, from, to)
+// FindByUserId provides a mock function with given fields: id, limit, offset, from, to, categoryId, tags
+func (_m *PaymentService) FindByUserId(id uuid.UUID, limit int, offset int, from *time.Time, to *time.Time, categoryId *uuid.UUID, tags tagModel.Filter) []model.PaymentDto {
+	ret := _m.Called(id, limit, offset, from, to, categoryId, tags)
 
 	var r0 []model.PaymentDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time) []model.PaymentDto); ok {
-		r0 = rf(id, limit, offset, from, to)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, *time.Time, *time.Time, *uuid.UUID, tagModel.Filter) []model.PaymentDto); ok {
+		r0 = rf(id, limit, offset, from, to, categoryId, tags)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentDto)
@@ -157,13 +173,13 @@ func (_m *PaymentService) FindByUserId(id uuid.UUID, limit int, offset int, from
 	return r0
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *PaymentService) Update(id uuid.UUID, request model.UpdatePaymentRequest) error {
-	ret := _m.Called(id, request)
+// Update provides a mock function with given fields: id, userId, request
+func (_m *PaymentService) Update(id uuid.UUID, userId uuid.UUID, request model.UpdatePaymentRequest) error {
+	ret := _m.Called(id, userId, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdatePaymentRequest) error); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, model.UpdatePaymentRequest) error); ok {
+		r0 = rf(id, userId, request)
 	} else {
 		r0 = ret.Error(0)
 	}
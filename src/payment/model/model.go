@@ -1,8 +1,12 @@
 package model
 
 import (
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/money"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
 	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	userModel "github.com/VlasovArtem/hob/src/user/model"
 	"github.com/google/uuid"
 	"time"
@@ -15,11 +19,18 @@ type Payment struct {
 	HouseId     uuid.UUID
 	UserId      uuid.UUID
 	Date        time.Time
-	Sum         float32
-	User        userModel.User   `gorm:"foreignKey:UserId"`
-	House       houseModel.House `gorm:"foreignKey:HouseId"`
-	ProviderId  *uuid.UUID
-	Provider    providerModel.Provider `gorm:"foreignKey:ProviderId"`
+	Sum         money.Money
+	// Currency is the ISO 4217 code of the sum
+	Currency string
+	// Pending marks a draft payment whose sum is not known yet
+	Pending    bool
+	User       userModel.User   `gorm:"foreignKey:UserId"`
+	House      houseModel.House `gorm:"foreignKey:HouseId"`
+	ProviderId *uuid.UUID
+	Provider   providerModel.Provider `gorm:"foreignKey:ProviderId"`
+	CategoryId *uuid.UUID
+	Category   categoryModel.Category `gorm:"foreignKey:CategoryId"`
+	Tags       []tagModel.Tag         `gorm:"many2many:payment_tags"`
 }
 
 type CreatePaymentRequest struct {
@@ -28,8 +39,12 @@ type CreatePaymentRequest struct {
 	HouseId     uuid.UUID
 	UserId      uuid.UUID
 	ProviderId  *uuid.UUID
+	CategoryId  *uuid.UUID
+	TagIds      []uuid.UUID
 	Date        time.Time
-	Sum         float32
+	Sum         money.Money
+	Currency    string
+	Pending     bool
 }
 
 type CreatePaymentBatchRequest struct {
@@ -40,8 +55,11 @@ type UpdatePaymentRequest struct {
 	Name        string
 	Description string
 	Date        time.Time
-	Sum         float32
+	Sum         money.Money
+	Currency    string
 	ProviderId  *uuid.UUID
+	CategoryId  *uuid.UUID
+	TagIds      []uuid.UUID
 }
 
 type PaymentDto struct {
@@ -51,8 +69,53 @@ type PaymentDto struct {
 	HouseId     uuid.UUID
 	UserId      uuid.UUID
 	ProviderId  *uuid.UUID
+	CategoryId  *uuid.UUID
+	Tags        []tagModel.TagDto
 	Date        time.Time
-	Sum         float32
+	Sum         money.Money
+	Currency    string
+	Pending     bool
+}
+
+// GroupBy is the field the sums of the payments are grouped by
+type GroupBy string
+
+const (
+	GroupByMonth    GroupBy = "month"
+	GroupByProvider GroupBy = "provider"
+	GroupByCategory GroupBy = "category"
+	// GroupByDate keeps the dates of the sums for the conversion with the exchange rates of the dates
+	GroupByDate GroupBy = "date"
+	// GroupByTag sums the tagged payments by the tag, the payment with several tags is counted in each of them
+	GroupByTag GroupBy = "tag"
+)
+
+// Total is the sum of the payments of the group in the currency aggregated in the database, only the field of the group
+// is set
+type Total struct {
+	Month      *time.Time
+	Date       *time.Time
+	TagId      *uuid.UUID
+	TagName    string
+	ProviderId *uuid.UUID
+	CategoryId *uuid.UUID
+	Currency   string
+	Sum        money.Money
+}
+
+// Amount is the sum of the payment in the currency on the date
+type Amount struct {
+	Sum      money.Money
+	Currency string
+	Date     time.Time
+}
+
+// MonthlyTotal is the sum of the payments of the month, the average is the rolling average of the 12 months ending with
+// the month
+type MonthlyTotal struct {
+	Month   time.Time
+	Sum     money.Money
+	Average money.Money
 }
 
 func (p Payment) ToDto() PaymentDto {
@@ -63,8 +126,12 @@ func (p Payment) ToDto() PaymentDto {
 		HouseId:     p.HouseId,
 		UserId:      p.UserId,
 		ProviderId:  p.ProviderId,
+		CategoryId:  p.CategoryId,
+		Tags:        common.MapSlice(p.Tags, tagModel.TagToTagDto),
 		Date:        p.Date,
 		Sum:         p.Sum,
+		Currency:    p.Currency,
+		Pending:     p.Pending,
 	}
 }
 
@@ -76,8 +143,12 @@ func (c CreatePaymentRequest) ToEntity() Payment {
 		HouseId:     c.HouseId,
 		UserId:      c.UserId,
 		ProviderId:  c.ProviderId,
+		CategoryId:  c.CategoryId,
+		Tags:        common.MapSlice(c.TagIds, tagModel.TagIdToTag),
 		Date:        c.Date,
 		Sum:         c.Sum,
+		Currency:    c.Currency,
+		Pending:     c.Pending,
 	}
 }
 
@@ -87,9 +158,36 @@ func (u UpdatePaymentRequest) UpdateToEntity(id uuid.UUID) Payment {
 		Name:        u.Name,
 		Description: u.Description,
 		ProviderId:  u.ProviderId,
+		CategoryId:  u.CategoryId,
+		Tags:        common.MapSlice(u.TagIds, tagModel.TagIdToTag),
 		Date:        u.Date,
 		Sum:         u.Sum,
+		Currency:    u.Currency,
+	}
+}
+
+// Column returns the selected expression and the name of the column the sums are grouped by
+func (g GroupBy) Column() (expression string, name string) {
+	switch g {
+	case GroupByProvider:
+		return "provider_id", "provider_id"
+	case GroupByCategory:
+		return "category_id", "category_id"
+	case GroupByDate:
+		return "date", "date"
+	case GroupByTag:
+		return "tags.id AS tag_id, tags.name AS tag_name", "tag_id, tag_name"
+	default:
+		return "date_trunc('month', date) AS month", "month"
+	}
+}
+
+// Join returns the join of the tables the group column is selected from, it is empty if the group is the column of the payments
+func (g GroupBy) Join() string {
+	if g == GroupByTag {
+		return "JOIN payment_tags ON payment_tags.payment_id = payments.id JOIN tags ON tags.id = payment_tags.tag_id"
 	}
+	return ""
 }
 
 func EntityToDto(entity Payment) PaymentDto {
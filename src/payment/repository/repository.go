@@ -1,9 +1,11 @@
 package repository
 
 import (
+	"github.com/VlasovArtem/hob/src/common"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/db"
 	"github.com/VlasovArtem/hob/src/payment/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"time"
@@ -32,36 +34,47 @@ func (p *PaymentRepositoryObject) GetEntity() any {
 	return entity
 }
 
+// UpgradeSchema converts the sums stored as the decimals to the minor units
+func (p *PaymentRepositoryObject) UpgradeSchema() error {
+	return p.database.UpgradeMoneyColumn("sum")
+}
+
 type PaymentRepository interface {
 	Create(entity model.Payment) (model.Payment, error)
 	CreateBatch(entities []model.Payment) ([]model.Payment, error)
 	Delete(id uuid.UUID) error
 	FindById(id uuid.UUID) (model.Payment, error)
-	FindByHouseId(houseId uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto
-	FindByUserId(userId uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto
-	FindByProviderId(providerId uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto
+	FindByHouseId(houseId uuid.UUID, limit int, offset int, from, to *time.Time, categoryIds []uuid.UUID, tags tagModel.Filter) []model.PaymentDto
+	FindByUserId(userId uuid.UUID, limit int, offset int, from, to *time.Time, categoryIds []uuid.UUID, tags tagModel.Filter) []model.PaymentDto
+	FindByProviderId(providerId uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time, tags tagModel.Filter) []model.PaymentDto
+	FindAmounts(houseId uuid.UUID, from, to time.Time, providerId *uuid.UUID, categoryIds []uuid.UUID) []model.Amount
+	SumByHouseId(houseId uuid.UUID, from, to *time.Time, groupBy model.GroupBy) ([]model.Total, error)
+	FindMonthlyTotals(houseId uuid.UUID, providerId uuid.UUID, currency string, from, to time.Time) ([]model.MonthlyTotal, error)
 	ExistsById(id uuid.UUID) bool
 	DeleteById(id uuid.UUID) error
 	Update(entity model.Payment) error
+	AssignHouseCurrencies(currencies map[string]string) error
 }
 
 func (p *PaymentRepositoryObject) Create(entity model.Payment) (model.Payment, error) {
-	return entity, p.database.Create(&entity)
+	return entity, p.database.Create(&entity, "Tags.*")
 }
 
 func (p *PaymentRepositoryObject) CreateBatch(entities []model.Payment) ([]model.Payment, error) {
-	return entities, p.database.Create(&entities)
+	return entities, p.database.Create(&entities, "Tags.*")
 }
 
 func (p *PaymentRepositoryObject) Delete(id uuid.UUID) (err error) {
-	return p.database.Delete(id)
+	return p.DeleteById(id)
 }
 
 func (p *PaymentRepositoryObject) FindById(id uuid.UUID) (response model.Payment, err error) {
-	return response, p.database.Find(&response, id)
+	return response, p.database.Modeled().Preload("Tags").First(&response, id).Error
 }
 
-func (p *PaymentRepositoryObject) FindByHouseId(houseId uuid.UUID, limit int, offset int, from, to *time.Time) (response []model.PaymentDto) {
+// FindByHouseId returns the payments of the house, the payments are filtered by the categories if they are not nil and
+// by the tags
+func (p *PaymentRepositoryObject) FindByHouseId(houseId uuid.UUID, limit int, offset int, from, to *time.Time, categoryIds []uuid.UUID, tags tagModel.Filter) []model.PaymentDto {
 	whereQuery := "house_id = ?"
 	whereArgs := []any{houseId}
 
@@ -73,22 +86,17 @@ func (p *PaymentRepositoryObject) FindByHouseId(houseId uuid.UUID, limit int, of
 		whereArgs = append(whereArgs, from)
 	}
 
-	err := p.database.Modeled().
-		Where(whereQuery, whereArgs...).
-		Order("date desc").
-		Limit(limit).
-		Offset(offset).
-		Find(&response).
-		Error
-
-	if err != nil {
-		log.Err(err).Msg("Error during find payments by house id")
-		return make([]model.PaymentDto, 0)
+	if categoryIds != nil {
+		whereQuery += " AND category_id IN ?"
+		whereArgs = append(whereArgs, categoryIds)
 	}
-	return response
+
+	return p.find(whereQuery, whereArgs, limit, offset, tags, "Error during find payments by house id")
 }
 
-func (p *PaymentRepositoryObject) FindByUserId(userId uuid.UUID, limit int, offset int, from, to *time.Time) (response []model.PaymentDto) {
+// FindByUserId returns the payments of the user, the payments are filtered by the categories if they are not nil and by
+// the tags
+func (p *PaymentRepositoryObject) FindByUserId(userId uuid.UUID, limit int, offset int, from, to *time.Time, categoryIds []uuid.UUID, tags tagModel.Filter) []model.PaymentDto {
 	whereQuery := "user_id = ?"
 	whereArgs := []any{userId}
 
@@ -100,24 +108,18 @@ func (p *PaymentRepositoryObject) FindByUserId(userId uuid.UUID, limit int, offs
 		whereArgs = append(whereArgs, from)
 	}
 
-	err := p.database.Modeled().
-		Where(whereQuery, whereArgs...).
-		Order("date desc").
-		Limit(limit).
-		Offset(offset).
-		Find(&response).
-		Error
-
-	if err != nil {
-		log.Err(err).Msg("Error during find payments by user id")
-		return make([]model.PaymentDto, 0)
+	if categoryIds != nil {
+		whereQuery += " AND category_id IN ?"
+		whereArgs = append(whereArgs, categoryIds)
 	}
-	return response
+
+	return p.find(whereQuery, whereArgs, limit, offset, tags, "Error during find payments by user id")
 }
 
-func (p *PaymentRepositoryObject) FindByProviderId(providerId uuid.UUID, limit int, offset int, from, to *time.Time) (response []model.PaymentDto) {
-	whereQuery := "provider_id = ?"
-	whereArgs := []any{providerId}
+// FindByProviderId returns the payments of the user for the provider, the payments are filtered by the tags
+func (p *PaymentRepositoryObject) FindByProviderId(providerId uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time, tags tagModel.Filter) []model.PaymentDto {
+	whereQuery := "provider_id = ? AND user_id = ?"
+	whereArgs := []any{providerId, userId}
 
 	if from != nil && to != nil {
 		whereQuery += " AND date BETWEEN ? AND ?"
@@ -127,35 +129,150 @@ func (p *PaymentRepositoryObject) FindByProviderId(providerId uuid.UUID, limit i
 		whereArgs = append(whereArgs, from)
 	}
 
+	return p.find(whereQuery, whereArgs, limit, offset, tags, "Error during find payments by provider id")
+}
+
+// find returns the payments with the tags sorted by the date, the payments are filtered by the tags if they are set
+func (p *PaymentRepositoryObject) find(whereQuery string, whereArgs []any, limit int, offset int, tags tagModel.Filter, message string) []model.PaymentDto {
+	if !tags.IsEmpty() {
+		tagsQuery, tagsArgs := tags.Condition("id", "payment_tags", "payment_id")
+		whereQuery += " AND " + tagsQuery
+		whereArgs = append(whereArgs, tagsArgs...)
+	}
+
+	var entities []model.Payment
+
 	err := p.database.Modeled().
 		Where(whereQuery, whereArgs...).
 		Order("date desc").
 		Limit(limit).
 		Offset(offset).
-		Find(&response).
+		Preload("Tags").
+		Find(&entities).
 		Error
 
 	if err != nil {
-		log.Err(err).Msg("Error during find payments by provider id")
+		log.Err(err).Msg(message)
 		return make([]model.PaymentDto, 0)
 	}
-	return response
+	return common.MapSlice(entities, model.EntityToDto)
 }
 
-func (p *PaymentRepositoryObject) findBy(query any, conditions ...any) (response []model.PaymentDto) {
-	_ = p.database.FindBy(&response, query, conditions...)
+// FindAmounts returns the sums of the house payments dated from the start inclusive till the end exclusive, the payments are
+// filtered by the provider and by the categories if they are not nil
+func (p *PaymentRepositoryObject) FindAmounts(houseId uuid.UUID, from, to time.Time, providerId *uuid.UUID, categoryIds []uuid.UUID) []model.Amount {
+	query := p.database.Modeled().Where("house_id = ? AND date >= ? AND date < ?", houseId, from, to)
 
-	return response
+	if providerId != nil {
+		query = query.Where("provider_id = ?", providerId)
+	}
+	if categoryIds != nil {
+		query = query.Where("category_id IN ?", categoryIds)
+	}
+
+	var amounts []model.Amount
+
+	if err := query.Select("sum", "currency", "date").Order("date").Find(&amounts).Error; err != nil {
+		log.Err(err).Msg("Error during find payment amounts by house id")
+		return []model.Amount{}
+	}
+	return amounts
+}
+
+// SumByHouseId returns the sums of the house payments by the group and the currency, the payments are filtered by the
+// period bounds that are set
+func (p *PaymentRepositoryObject) SumByHouseId(houseId uuid.UUID, from, to *time.Time, groupBy model.GroupBy) (totals []model.Total, err error) {
+	query := p.database.Modeled().Where("house_id = ?", houseId)
+
+	if join := groupBy.Join(); join != "" {
+		query = query.Joins(join)
+	}
+
+	if from != nil {
+		query = query.Where("date >= ?", from)
+	}
+	if to != nil {
+		query = query.Where("date <= ?", to)
+	}
+
+	expression, column := groupBy.Column()
+
+	err = query.
+		Select(expression + ", currency, SUM(sum) AS sum").
+		Group(column + ", currency").
+		Order(column + ", currency").
+		Scan(&totals).
+		Error
+
+	if err != nil {
+		return []model.Total{}, err
+	}
+	return totals, nil
+}
+
+// monthlyTotalsQuery sums the provider payments by the months of the period including the months without payments, the
+// months of the year before the period are only used for the rolling average
+const monthlyTotalsQuery = `
+SELECT month, sum, average FROM (
+	SELECT months.month,
+		COALESCE(totals.sum, 0)::bigint AS sum,
+		ROUND(AVG(COALESCE(totals.sum, 0)) OVER (ORDER BY months.month ROWS BETWEEN 11 PRECEDING AND CURRENT ROW))::bigint AS average
+	FROM generate_series(
+		date_trunc('month', CAST(@rollingFrom AS timestamptz)),
+		CAST(@to AS timestamptz) - interval '1 microsecond',
+		interval '1 month'
+	) AS months(month)
+	LEFT JOIN (
+		SELECT date_trunc('month', date) AS month, SUM(sum) AS sum
+		FROM payments
+		WHERE house_id = @houseId AND provider_id = @providerId AND currency = @currency AND date >= @rollingFrom AND date < @to
+		GROUP BY 1
+	) totals ON totals.month = months.month
+) trend
+WHERE month >= date_trunc('month', CAST(@from AS timestamptz))
+ORDER BY month`
+
+// FindMonthlyTotals returns the sums of the provider payments of the house in the currency by the months from the start
+// of the from month to the to date exclusive, every month of the period is returned with the rolling average of 12 months
+func (p *PaymentRepositoryObject) FindMonthlyTotals(houseId uuid.UUID, providerId uuid.UUID, currency string, from, to time.Time) (totals []model.MonthlyTotal, err error) {
+	err = p.database.D().
+		Raw(monthlyTotalsQuery, map[string]any{
+			"houseId":     houseId,
+			"providerId":  providerId,
+			"currency":    currency,
+			"rollingFrom": from.AddDate(0, -11, 0),
+			"from":        from,
+			"to":          to,
+		}).
+		Scan(&totals).
+		Error
+
+	return totals, err
 }
 
 func (p *PaymentRepositoryObject) ExistsById(id uuid.UUID) bool {
 	return p.database.Exists(id)
 }
 
+// DeleteById removes the payment with the references to its tags
 func (p *PaymentRepositoryObject) DeleteById(id uuid.UUID) error {
-	return p.database.Delete(id)
+	return p.database.D().Select("Tags").Delete(&model.Payment{Id: id}).Error
 }
 
+// Update changes the payment and replaces its tags
 func (p *PaymentRepositoryObject) Update(entity model.Payment) error {
-	return p.database.Update(entity.Id, entity, "HouseId", "House", "UserId", "User")
+	if err := p.database.Update(entity.Id, entity, "HouseId", "House", "UserId", "User", "Tags"); err != nil {
+		return err
+	}
+	if err := p.database.DM(&model.Payment{Id: entity.Id}).Omit("Tags.*").Association("Tags").Replace(entity.Tags); err != nil {
+		return err
+	}
+
+	// the sum of the updated payment is confirmed by the user
+	return p.database.Modeled().Where("id = ?", entity.Id).Update("pending", false).Error
+}
+
+// AssignHouseCurrencies sets the currency of the house country to the payments persisted before the currency was stored
+func (p *PaymentRepositoryObject) AssignHouseCurrencies(currencies map[string]string) error {
+	return p.database.AssignHouseCurrencies(currencies)
 }
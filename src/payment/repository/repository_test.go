@@ -2,7 +2,10 @@ package repository
 
 import (
 	"fmt"
+	categoryMocks "github.com/VlasovArtem/hob/src/category/mocks"
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
 	dependencyMocks "github.com/VlasovArtem/hob/src/common/dependency/mocks"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/db"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
@@ -10,6 +13,8 @@ import (
 	"github.com/VlasovArtem/hob/src/payment/model"
 	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
 	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	tagMocks "github.com/VlasovArtem/hob/src/tag/mocks"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper/database"
 	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
 	userModel "github.com/VlasovArtem/hob/src/user/model"
@@ -46,6 +51,9 @@ type PaymentRepositoryTestSuite struct {
 	createdUser     userModel.User
 	createdHouse    houseModel.House
 	createdProvider providerModel.Provider
+	createdCategory categoryModel.Category
+	taxDeductible   tagModel.Tag
+	reimbursable    tagModel.Tag
 }
 
 func (p *PaymentRepositoryTestSuite) SetupSuite() {
@@ -57,14 +65,17 @@ func (p *PaymentRepositoryTestSuite) SetupSuite() {
 		},
 	).
 		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTableCascade(service, "payment_tags")
 			database.TruncateTable(service, model.Payment{})
 		}).
 		AddAfterSuite(func(service db.DatabaseService) {
+			database.TruncateTable(service, tagModel.Tag{})
+			database.TruncateTable(service, categoryModel.Category{})
 			database.TruncateTable(service, providerModel.Provider{})
 			database.TruncateTable(service, houseModel.House{})
 			database.TruncateTable(service, userModel.User{})
 		}).
-		ExecuteMigration(userModel.User{}, houseModel.House{}, providerModel.Provider{}, model.Payment{})
+		ExecuteMigration(userModel.User{}, houseModel.House{}, providerModel.Provider{}, categoryModel.Category{}, tagModel.Tag{}, model.Payment{})
 
 	p.createdUser = userMocks.GenerateUser()
 	p.CreateEntity(&p.createdUser)
@@ -74,6 +85,15 @@ func (p *PaymentRepositoryTestSuite) SetupSuite() {
 
 	p.createdProvider = providerMocks.GenerateProvider(p.createdUser.Id)
 	p.CreateEntity(&p.createdProvider)
+
+	p.createdCategory = categoryMocks.GenerateCategory(p.createdUser.Id, nil, "Utilities")
+	p.CreateEntity(&p.createdCategory)
+
+	p.taxDeductible = tagMocks.GenerateTag(p.createdUser.Id, "tax-deductible")
+	p.CreateEntity(&p.taxDeductible)
+
+	p.reimbursable = tagMocks.GenerateTag(p.createdUser.Id, "reimbursable")
+	p.CreateEntity(&p.reimbursable)
 }
 
 func TestPaymentRepositoryTestSuite(t *testing.T) {
@@ -135,6 +155,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindById() {
 
 	actual, err := p.repository.FindById(payment.Id)
 
+	payment.Tags = []tagModel.Tag{}
 	assert.Nil(p.T(), err)
 	assert.Equal(p.T(), payment, actual)
 }
@@ -150,7 +171,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByUserId() {
 	first := p.createPayment()
 	second := p.createPayment()
 
-	actual := p.repository.FindByUserId(p.createdUser.Id, 2, 0, nil, nil)
+	actual := p.repository.FindByUserId(p.createdUser.Id, 2, 0, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto(), first.ToDto()}, actual)
 }
@@ -164,7 +185,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByUserId_WithFromAndTo() {
 
 	from := time.Now().Add(-time.Hour * 12)
 	to := time.Now()
-	actual := p.repository.FindByUserId(p.createdUser.Id, 2, 0, &from, &to)
+	actual := p.repository.FindByUserId(p.createdUser.Id, 2, 0, &from, &to, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -177,7 +198,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByUserId_WithFrom() {
 	second := p.createPayment()
 
 	from := time.Now().Add(-time.Hour * 12)
-	actual := p.repository.FindByUserId(p.createdUser.Id, 2, 0, &from, nil)
+	actual := p.repository.FindByUserId(p.createdUser.Id, 2, 0, &from, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -186,7 +207,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByUserId_WithLimit() {
 	_ = p.createPayment()
 	second := p.createPayment()
 
-	actual := p.repository.FindByUserId(p.createdUser.Id, 1, 0, nil, nil)
+	actual := p.repository.FindByUserId(p.createdUser.Id, 1, 0, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -195,13 +216,22 @@ func (p *PaymentRepositoryTestSuite) Test_FindByUserId_WithOffset() {
 	first := p.createPayment()
 	_ = p.createPayment()
 
-	actual := p.repository.FindByUserId(p.createdUser.Id, 1, 1, nil, nil)
+	actual := p.repository.FindByUserId(p.createdUser.Id, 1, 1, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{first.ToDto()}, actual)
 }
 
+func (p *PaymentRepositoryTestSuite) Test_FindByUserId_WithCategoryIds() {
+	_ = p.createPayment()
+	categorized := p.createCategorizedPayment()
+
+	actual := p.repository.FindByUserId(p.createdUser.Id, 2, 0, nil, nil, []uuid.UUID{p.createdCategory.Id}, tagModel.Filter{})
+
+	assert.Equal(p.T(), []model.PaymentDto{categorized.ToDto()}, actual)
+}
+
 func (p *PaymentRepositoryTestSuite) Test_FindByUserId_WithMissingUserId() {
-	actual := p.repository.FindByUserId(uuid.New(), 0, 1, nil, nil)
+	actual := p.repository.FindByUserId(uuid.New(), 0, 1, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{}, actual)
 }
@@ -210,7 +240,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByHouseId() {
 	first := p.createPayment()
 	second := p.createPayment()
 
-	actual := p.repository.FindByHouseId(p.createdHouse.Id, 2, 0, nil, nil)
+	actual := p.repository.FindByHouseId(p.createdHouse.Id, 2, 0, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto(), first.ToDto()}, actual)
 }
@@ -225,7 +255,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByHouseId_WithFromAndTo() {
 	from := time.Now().Add(-time.Hour * 12)
 	to := time.Now()
 
-	actual := p.repository.FindByHouseId(p.createdHouse.Id, 2, 0, &from, &to)
+	actual := p.repository.FindByHouseId(p.createdHouse.Id, 2, 0, &from, &to, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -239,7 +269,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByHouseId_WithFrom() {
 
 	from := time.Now().Add(-time.Hour * 12)
 
-	actual := p.repository.FindByHouseId(p.createdHouse.Id, 2, 0, &from, nil)
+	actual := p.repository.FindByHouseId(p.createdHouse.Id, 2, 0, &from, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -248,7 +278,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByHouseId_WithLimit() {
 	_ = p.createPayment()
 	second := p.createPayment()
 
-	actual := p.repository.FindByHouseId(p.createdHouse.Id, 1, 0, nil, nil)
+	actual := p.repository.FindByHouseId(p.createdHouse.Id, 1, 0, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -257,13 +287,41 @@ func (p *PaymentRepositoryTestSuite) Test_FindByHouseId_WithOffset() {
 	first := p.createPayment()
 	_ = p.createPayment()
 
-	actual := p.repository.FindByHouseId(p.createdHouse.Id, 1, 1, nil, nil)
+	actual := p.repository.FindByHouseId(p.createdHouse.Id, 1, 1, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{first.ToDto()}, actual)
 }
 
+func (p *PaymentRepositoryTestSuite) Test_FindByHouseId_WithCategoryIds() {
+	_ = p.createPayment()
+	categorized := p.createCategorizedPayment()
+
+	actual := p.repository.FindByHouseId(p.createdHouse.Id, 2, 0, nil, nil, []uuid.UUID{uuid.New(), p.createdCategory.Id}, tagModel.Filter{})
+
+	assert.Equal(p.T(), []model.PaymentDto{categorized.ToDto()}, actual)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_FindByHouseId_WithAnyTags() {
+	_ = p.createPayment()
+	deductible := p.createTaggedPayment(p.taxDeductible)
+	both := p.createTaggedPayment(p.taxDeductible, p.reimbursable)
+
+	actual := p.repository.FindByHouseId(p.createdHouse.Id, 10, 0, nil, nil, nil, tagModel.Filter{Ids: []uuid.UUID{p.taxDeductible.Id, p.reimbursable.Id}})
+
+	assert.ElementsMatch(p.T(), []model.PaymentDto{deductible.ToDto(), both.ToDto()}, actual)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_FindByHouseId_WithAllTags() {
+	_ = p.createTaggedPayment(p.taxDeductible)
+	both := p.createTaggedPayment(p.taxDeductible, p.reimbursable)
+
+	actual := p.repository.FindByHouseId(p.createdHouse.Id, 10, 0, nil, nil, nil, tagModel.Filter{Ids: []uuid.UUID{p.taxDeductible.Id, p.reimbursable.Id}, All: true})
+
+	assert.Equal(p.T(), []model.PaymentDto{both.ToDto()}, actual)
+}
+
 func (p *PaymentRepositoryTestSuite) Test_FindByHouseId_WithMissingId() {
-	actual := p.repository.FindByHouseId(uuid.New(), 0, 10, nil, nil)
+	actual := p.repository.FindByHouseId(uuid.New(), 0, 10, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{}, actual)
 }
@@ -272,7 +330,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByProviderId() {
 	first := p.createPayment()
 	second := p.createPayment()
 
-	actual := p.repository.FindByProviderId(p.createdProvider.Id, 2, 0, nil, nil)
+	actual := p.repository.FindByProviderId(p.createdProvider.Id, p.createdUser.Id, 2, 0, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto(), first.ToDto()}, actual)
 }
@@ -287,7 +345,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByProviderId_WithFromAndTo() {
 	from := time.Now().Add(-time.Hour * 12)
 	to := time.Now()
 
-	actual := p.repository.FindByProviderId(p.createdProvider.Id, 2, 0, &from, &to)
+	actual := p.repository.FindByProviderId(p.createdProvider.Id, p.createdUser.Id, 2, 0, &from, &to, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -301,7 +359,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByProviderId_WithFrom() {
 
 	from := time.Now().Add(-time.Hour * 12)
 
-	actual := p.repository.FindByProviderId(p.createdProvider.Id, 2, 0, &from, nil)
+	actual := p.repository.FindByProviderId(p.createdProvider.Id, p.createdUser.Id, 2, 0, &from, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -310,7 +368,7 @@ func (p *PaymentRepositoryTestSuite) Test_FindByProviderId_WithLimit() {
 	_ = p.createPayment()
 	second := p.createPayment()
 
-	actual := p.repository.FindByProviderId(p.createdProvider.Id, 1, 0, nil, nil)
+	actual := p.repository.FindByProviderId(p.createdProvider.Id, p.createdUser.Id, 1, 0, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{second.ToDto()}, actual)
 }
@@ -319,17 +377,139 @@ func (p *PaymentRepositoryTestSuite) Test_FindByProviderId_WithOffset() {
 	first := p.createPayment()
 	_ = p.createPayment()
 
-	actual := p.repository.FindByProviderId(p.createdProvider.Id, 1, 1, nil, nil)
+	actual := p.repository.FindByProviderId(p.createdProvider.Id, p.createdUser.Id, 1, 1, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{first.ToDto()}, actual)
 }
 
 func (p *PaymentRepositoryTestSuite) Test_FindByProviderId_WithMissingId() {
-	actual := p.repository.FindByProviderId(uuid.New(), 0, 1, nil, nil)
+	actual := p.repository.FindByProviderId(uuid.New(), p.createdUser.Id, 0, 1, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{}, actual)
 }
 
+func (p *PaymentRepositoryTestSuite) Test_FindAmounts() {
+	payment := p.createPayment()
+	categorized := p.createCategorizedPayment()
+	from := payment.Date.Add(-time.Hour)
+
+	actual := p.repository.FindAmounts(p.createdHouse.Id, from, from.Add(2*time.Hour), nil, nil)
+
+	assert.ElementsMatch(p.T(), []model.Amount{
+		{Sum: payment.Sum, Currency: payment.Currency, Date: payment.Date},
+		{Sum: categorized.Sum, Currency: categorized.Currency, Date: categorized.Date},
+	}, actual)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_FindAmounts_WithProviderAndCategoryIds() {
+	_ = p.createPayment()
+	categorized := p.createCategorizedPayment()
+	from := categorized.Date.Add(-time.Hour)
+
+	actual := p.repository.FindAmounts(p.createdHouse.Id, from, from.Add(2*time.Hour), &p.createdProvider.Id, []uuid.UUID{p.createdCategory.Id})
+
+	assert.Equal(p.T(), []model.Amount{{Sum: categorized.Sum, Currency: categorized.Currency, Date: categorized.Date}}, actual)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_FindAmounts_WithEndOfPeriod() {
+	payment := p.createPayment()
+
+	actual := p.repository.FindAmounts(p.createdHouse.Id, payment.Date.Add(-time.Hour), payment.Date, nil, nil)
+
+	assert.Empty(p.T(), actual)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_SumByHouseId() {
+	payment := p.createPayment()
+	categorized := p.createCategorizedPayment()
+
+	actual, err := p.repository.SumByHouseId(p.createdHouse.Id, nil, nil, model.GroupByCategory)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), []model.Total{
+		{CategoryId: &p.createdCategory.Id, Currency: categorized.Currency, Sum: categorized.Sum},
+		{Currency: payment.Currency, Sum: payment.Sum},
+	}, actual)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_SumByHouseId_WithMonth() {
+	payment := p.createPayment()
+	_ = p.createCategorizedPayment()
+
+	actual, err := p.repository.SumByHouseId(p.createdHouse.Id, nil, nil, model.GroupByMonth)
+
+	assert.Nil(p.T(), err)
+	assert.Len(p.T(), actual, 1)
+	assert.NotNil(p.T(), actual[0].Month)
+	assert.Equal(p.T(), payment.Sum*2, actual[0].Sum)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_SumByHouseId_WithFromAndTo() {
+	payment := p.createPayment()
+	from, to := payment.Date.Add(time.Hour), payment.Date.Add(2*time.Hour)
+
+	actual, err := p.repository.SumByHouseId(p.createdHouse.Id, &from, &to, model.GroupByProvider)
+
+	assert.Nil(p.T(), err)
+	assert.Empty(p.T(), actual)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_SumByHouseId_WithDate() {
+	payment := p.createPayment()
+	_ = p.createCategorizedPayment()
+
+	actual, err := p.repository.SumByHouseId(p.createdHouse.Id, nil, nil, model.GroupByDate)
+
+	assert.Nil(p.T(), err)
+	assert.Len(p.T(), actual, 2)
+	assert.NotNil(p.T(), actual[0].Date)
+	assert.Equal(p.T(), payment.Sum, actual[0].Sum)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_SumByHouseId_WithTag() {
+	deductible := p.createTaggedPayment(p.taxDeductible)
+	_ = p.createTaggedPayment(p.taxDeductible, p.reimbursable)
+	_ = p.createPayment()
+
+	actual, err := p.repository.SumByHouseId(p.createdHouse.Id, nil, nil, model.GroupByTag)
+
+	assert.Nil(p.T(), err)
+	assert.ElementsMatch(p.T(), []model.Total{
+		{TagId: &p.taxDeductible.Id, TagName: p.taxDeductible.Name, Currency: deductible.Currency, Sum: deductible.Sum * 2},
+		{TagId: &p.reimbursable.Id, TagName: p.reimbursable.Name, Currency: deductible.Currency, Sum: deductible.Sum},
+	}, actual)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_FindMonthlyTotals() {
+	payment := p.createPayment()
+	other := p.createPayment()
+	other.Currency = "EUR"
+	p.Database.D().Save(&other)
+
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month()-2, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.Local)
+
+	actual, err := p.repository.FindMonthlyTotals(p.createdHouse.Id, p.createdProvider.Id, payment.Currency, from, to)
+
+	assert.Nil(p.T(), err)
+	assert.Len(p.T(), actual, 3)
+	assert.True(p.T(), from.Equal(actual[0].Month))
+	assert.Equal(p.T(), money.Money(0), actual[0].Sum)
+	assert.Equal(p.T(), payment.Sum, actual[2].Sum)
+	assert.Equal(p.T(), money.FromMinorUnits(int64(payment.Sum)/12), actual[2].Average)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_FindMonthlyTotals_WithMissingProvider() {
+	payment := p.createPayment()
+	from := time.Date(payment.Date.Year(), payment.Date.Month(), 1, 0, 0, 0, 0, time.Local)
+
+	actual, err := p.repository.FindMonthlyTotals(p.createdHouse.Id, uuid.New(), payment.Currency, from, from.AddDate(0, 1, 0))
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), []model.MonthlyTotal{{Month: actual[0].Month}}, actual)
+}
+
 func (p *PaymentRepositoryTestSuite) Test_ExistsById() {
 	payment := p.createPayment()
 
@@ -361,7 +541,8 @@ func (p *PaymentRepositoryTestSuite) Test_Update() {
 		Name:        fmt.Sprintf("%s-new", payment.Name),
 		Description: fmt.Sprintf("%s-new", payment.Description),
 		Date:        mocks.Date,
-		Sum:         payment.Sum + 100.0,
+		Sum:         payment.Sum + money.FromMinorUnits(10000),
+		Currency:    "EUR",
 		HouseId:     payment.HouseId,
 		UserId:      payment.UserId,
 		ProviderId:  payment.ProviderId,
@@ -378,16 +559,36 @@ func (p *PaymentRepositoryTestSuite) Test_Update() {
 		Name:        "Test Payment-new",
 		Description: "Test Payment Description-new",
 		Date:        updatedIncome.Date,
-		Sum:         1100.0,
+		Sum:         money.FromMinorUnits(110000),
+		Currency:    "EUR",
 		HouseId:     payment.HouseId,
 		House:       payment.House,
 		User:        payment.User,
 		UserId:      payment.UserId,
 		ProviderId:  payment.ProviderId,
 		Provider:    payment.Provider,
+		Tags:        []tagModel.Tag{},
 	}, response)
 }
 
+func (p *PaymentRepositoryTestSuite) Test_Update_WithPendingPayment() {
+	payment := mocks.GeneratePayment(p.createdHouse.Id, p.createdUser.Id, p.createdProvider.Id)
+	payment.Sum = 0
+	payment.Pending = true
+	p.CreateEntity(&payment)
+
+	payment.Sum = money.FromMinorUnits(10000)
+
+	err := p.repository.Update(payment)
+
+	assert.Nil(p.T(), err)
+
+	response, err := p.repository.FindById(payment.Id)
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), money.FromMinorUnits(10000), response.Sum)
+	assert.False(p.T(), response.Pending)
+}
+
 func (p *PaymentRepositoryTestSuite) Test_Update_WithMissingId() {
 	assert.Nil(p.T(), p.repository.Update(model.Payment{Id: uuid.New()}))
 }
@@ -402,6 +603,30 @@ func (p *PaymentRepositoryTestSuite) Test_Delete_WithMissingEntity() {
 	assert.Nil(p.T(), p.repository.Delete(uuid.New()))
 }
 
+func (p *PaymentRepositoryTestSuite) Test_AssignHouseCurrencies() {
+	payment := mocks.GeneratePayment(p.createdHouse.Id, p.createdUser.Id, p.createdProvider.Id)
+	payment.Currency = ""
+	p.CreateEntity(&payment)
+
+	assert.Nil(p.T(), p.repository.AssignHouseCurrencies(map[string]string{p.createdHouse.CountryCode: "UAH"}))
+
+	response, err := p.repository.FindById(payment.Id)
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), "UAH", response.Currency)
+}
+
+func (p *PaymentRepositoryTestSuite) Test_AssignHouseCurrencies_WithExistingCurrency() {
+	payment := mocks.GeneratePayment(p.createdHouse.Id, p.createdUser.Id, p.createdProvider.Id)
+	payment.Currency = "EUR"
+	p.CreateEntity(&payment)
+
+	assert.Nil(p.T(), p.repository.AssignHouseCurrencies(map[string]string{p.createdHouse.CountryCode: "UAH"}))
+
+	response, err := p.repository.FindById(payment.Id)
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), "EUR", response.Currency)
+}
+
 func (p *PaymentRepositoryTestSuite) createPayment() model.Payment {
 	payment := mocks.GeneratePayment(p.createdHouse.Id, p.createdUser.Id, p.createdProvider.Id)
 	payment.Date = time.Now().Truncate(time.Microsecond)
@@ -410,3 +635,23 @@ func (p *PaymentRepositoryTestSuite) createPayment() model.Payment {
 
 	return payment
 }
+
+func (p *PaymentRepositoryTestSuite) createCategorizedPayment() model.Payment {
+	payment := mocks.GeneratePayment(p.createdHouse.Id, p.createdUser.Id, p.createdProvider.Id)
+	payment.Date = time.Now().Truncate(time.Microsecond)
+	payment.CategoryId = &p.createdCategory.Id
+
+	p.CreateEntity(payment)
+
+	return payment
+}
+
+func (p *PaymentRepositoryTestSuite) createTaggedPayment(tags ...tagModel.Tag) model.Payment {
+	payment := mocks.GeneratePayment(p.createdHouse.Id, p.createdUser.Id, p.createdProvider.Id)
+	payment.Date = time.Now().Truncate(time.Microsecond)
+	payment.Tags = tags
+
+	p.CreateEntity(&payment)
+
+	return payment
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/VlasovArtem/hob/src/common/rest"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/model"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/service"
+	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/gorilla/mux"
 	"net/http"
 )
@@ -28,9 +29,14 @@ func (p *PaymentSchedulerHandlerObject) Init(router *mux.Router) {
 	subrouter.Path("/{id}").HandlerFunc(p.FindById()).Methods("GET")
 	subrouter.Path("/{id}").HandlerFunc(p.Remove()).Methods("DELETE")
 	subrouter.Path("/{id}").HandlerFunc(p.Update()).Methods("PUT")
+	subrouter.Path("/{id}/pause").HandlerFunc(p.Pause()).Methods("POST")
+	subrouter.Path("/{id}/resume").HandlerFunc(p.Resume()).Methods("POST")
+	subrouter.Path("/{id}/trigger").HandlerFunc(p.Trigger()).Methods("POST")
+	subrouter.Path("/{id}/runs").HandlerFunc(p.FindRunsById()).Methods("GET")
+	subrouter.Path("/{id}/next").HandlerFunc(p.FindNextExecutionsById()).Methods("GET")
 	subrouter.Path("/house/{id}").HandlerFunc(p.FindByHouseId()).Methods("GET")
 	subrouter.Path("/user/{id}").HandlerFunc(p.FindByUserId()).Methods("GET")
-	subrouter.Path("/provider/{id}").HandlerFunc(p.FindByUserId()).Methods("GET")
+	subrouter.Path("/provider/{id}").HandlerFunc(p.FindByProviderId()).Methods("GET")
 }
 
 type PaymentSchedulerHandler interface {
@@ -41,13 +47,26 @@ type PaymentSchedulerHandler interface {
 	FindByUserId() http.HandlerFunc
 	FindByProviderId() http.HandlerFunc
 	Update() http.HandlerFunc
+	Pause() http.HandlerFunc
+	Resume() http.HandlerFunc
+	Trigger() http.HandlerFunc
+	FindRunsById() http.HandlerFunc
+	FindNextExecutionsById() http.HandlerFunc
 }
 
 func (p *PaymentSchedulerHandlerObject) Add() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreatePaymentSchedulerRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			body.UserId = userId
+
 			rest.NewAPIResponse(writer).
 				Created(p.paymentSchedulerService.Add(body)).
 				Perform()
@@ -57,11 +76,11 @@ func (p *PaymentSchedulerHandlerObject) Add() http.HandlerFunc {
 
 func (p *PaymentSchedulerHandlerObject) Remove() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				NoContent(p.paymentSchedulerService.Remove(id)).
+				NoContent(p.paymentSchedulerService.Remove(id, userId)).
 				Perform()
 		}
 	}
@@ -69,14 +88,14 @@ func (p *PaymentSchedulerHandlerObject) Remove() http.HandlerFunc {
 
 func (p *PaymentSchedulerHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdatePaymentSchedulerRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
 				rest.NewAPIResponse(writer).
-					Error(p.paymentSchedulerService.Update(id, body)).
+					Error(p.paymentSchedulerService.Update(id, userId, body)).
 					Perform()
 			}
 		}
@@ -85,11 +104,11 @@ func (p *PaymentSchedulerHandlerObject) Update() http.HandlerFunc {
 
 func (p *PaymentSchedulerHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(p.paymentSchedulerService.FindById(id)).
+				Ok(p.paymentSchedulerService.FindById(id, userId)).
 				Perform()
 		}
 	}
@@ -97,11 +116,11 @@ func (p *PaymentSchedulerHandlerObject) FindById() http.HandlerFunc {
 
 func (p *PaymentSchedulerHandlerObject) FindByHouseId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Body(p.paymentSchedulerService.FindByHouseId(id)).
+				Body(p.paymentSchedulerService.FindByHouseId(id, userId)).
 				Perform()
 		}
 	}
@@ -109,7 +128,7 @@ func (p *PaymentSchedulerHandlerObject) FindByHouseId() http.HandlerFunc {
 
 func (p *PaymentSchedulerHandlerObject) FindByUserId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, err := rest.GetUserIdRequestParameter(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
@@ -121,11 +140,73 @@ func (p *PaymentSchedulerHandlerObject) FindByUserId() http.HandlerFunc {
 
 func (p *PaymentSchedulerHandlerObject) FindByProviderId() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Body(p.paymentSchedulerService.FindByProviderId(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (p *PaymentSchedulerHandlerObject) Pause() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(p.paymentSchedulerService.Pause(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (p *PaymentSchedulerHandlerObject) Resume() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(p.paymentSchedulerService.Resume(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (p *PaymentSchedulerHandlerObject) Trigger() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(p.paymentSchedulerService.Trigger(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (p *PaymentSchedulerHandlerObject) FindRunsById() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(p.paymentSchedulerService.FindRunsById(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (p *PaymentSchedulerHandlerObject) FindNextExecutionsById() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else if count, err := rest.GetQueryParamOrDefault(request, "count", scheduler.DefaultNextExecutions); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Body(p.paymentSchedulerService.FindByProviderId(id)).
+				Ok(p.paymentSchedulerService.FindNextExecutionsById(id, userId, count)).
 				Perform()
 		}
 	}
@@ -4,14 +4,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/mocks"
 	paymentScheduler "github.com/VlasovArtem/hob/src/payment/scheduler/model"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	runMocks "github.com/VlasovArtem/hob/src/scheduler/run/mocks"
+	runModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
+	"time"
 )
 
 var paymentsScheduler *mocks.PaymentSchedulerService
@@ -32,6 +37,7 @@ func Test_Add(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(handler.Add()).
 		WithBody(request)
 
@@ -50,6 +56,7 @@ func Test_Add_WithInvalidRequest(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(handler.Add())
 
 	testRequest.Verify(t, http.StatusBadRequest)
@@ -67,6 +74,7 @@ func Test_Add_WithErrorFromService(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(handler.Add()).
 		WithBody(request)
 
@@ -78,13 +86,15 @@ func Test_Add_WithErrorFromService(t *testing.T) {
 func Test_Remove(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
-	paymentsScheduler.On("Remove", id).Return(nil)
+	paymentsScheduler.On("Remove", id, userId).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("DELETE").
+		WithUser(userId).
 		WithHandler(handler.Remove()).
 		WithVar("id", id.String())
 
@@ -97,6 +107,7 @@ func Test_Remove_WithMissingParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(handler.Remove())
 
 	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
@@ -110,6 +121,7 @@ func Test_Remove_WithInvalidParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("DELETE").
+		WithUser(uuid.New()).
 		WithHandler(handler.Remove()).
 		WithVar("id", "id")
 
@@ -121,16 +133,18 @@ func Test_Remove_WithInvalidParameter(t *testing.T) {
 func Test_FindById(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	paymentResponse := mocks.GeneratePaymentSchedulerResponse(id)
 
-	paymentsScheduler.On("FindById", id).
+	paymentsScheduler.On("FindById", id, userId).
 		Return(paymentResponse, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindById()).
 		WithVar("id", id.String())
 
@@ -146,16 +160,18 @@ func Test_FindById(t *testing.T) {
 func Test_FindById_WithError(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	expected := errors.New("error")
 
-	paymentsScheduler.On("FindById", id).
+	paymentsScheduler.On("FindById", id, userId).
 		Return(paymentScheduler.PaymentSchedulerDto{}, expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindById()).
 		WithVar("id", id.String())
 
@@ -170,6 +186,7 @@ func Test_FindById_WithInvalidParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(handler.FindById()).
 		WithVar("id", "id")
 
@@ -181,18 +198,20 @@ func Test_FindById_WithInvalidParameter(t *testing.T) {
 func Test_FindByHouseId(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	response := mocks.GeneratePaymentSchedulerResponse(id)
 
 	paymentResponses := []paymentScheduler.PaymentSchedulerDto{response}
 
-	paymentsScheduler.On("FindByHouseId", id).
+	paymentsScheduler.On("FindByHouseId", id, userId).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/house/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindByHouseId()).
 		WithVar("id", id.String())
 
@@ -208,16 +227,18 @@ func Test_FindByHouseId(t *testing.T) {
 func Test_FindByHouseId_WithEmptyResponse(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	paymentResponses := []paymentScheduler.PaymentSchedulerDto{}
 
-	paymentsScheduler.On("FindByHouseId", id).
+	paymentsScheduler.On("FindByHouseId", id, userId).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/house/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindByHouseId()).
 		WithVar("id", id.String())
 
@@ -236,6 +257,7 @@ func Test_FindByHouseId_WithInvalidParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/house/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(handler.FindByHouseId()).
 		WithVar("id", "id")
 
@@ -259,6 +281,7 @@ func Test_FindByUserId(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/user/{id}").
 		WithMethod("GET").
+		WithUser(id).
 		WithHandler(handler.FindByUserId()).
 		WithVar("id", id.String())
 
@@ -284,6 +307,7 @@ func Test_FindByUserId_WithEmptyResponse(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/user/{id}").
 		WithMethod("GET").
+		WithUser(id).
 		WithHandler(handler.FindByUserId()).
 		WithVar("id", id.String())
 
@@ -302,6 +326,7 @@ func Test_FindByUserId_WithInvalidParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/user/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(handler.FindByUserId()).
 		WithVar("id", "id")
 
@@ -313,18 +338,20 @@ func Test_FindByUserId_WithInvalidParameter(t *testing.T) {
 func Test_FindByProviderId(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	response := mocks.GeneratePaymentSchedulerResponse(id)
 
 	paymentResponses := []paymentScheduler.PaymentSchedulerDto{response}
 
-	paymentsScheduler.On("FindByProviderId", id).
+	paymentsScheduler.On("FindByProviderId", id, userId).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/provider/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindByProviderId()).
 		WithVar("id", id.String())
 
@@ -340,16 +367,18 @@ func Test_FindByProviderId(t *testing.T) {
 func Test_FindByProviderId_WithEmptyResponse(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id := uuid.New()
 
 	var paymentResponses []paymentScheduler.PaymentSchedulerDto
 
-	paymentsScheduler.On("FindByProviderId", id).
+	paymentsScheduler.On("FindByProviderId", id, userId).
 		Return(paymentResponses, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/provider/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(handler.FindByProviderId()).
 		WithVar("id", id.String())
 
@@ -368,6 +397,7 @@ func Test_FindByProviderId_WithInvalidParameter(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/provider/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(handler.FindByProviderId()).
 		WithVar("id", "id")
 
@@ -379,13 +409,15 @@ func Test_FindByProviderId_WithInvalidParameter(t *testing.T) {
 func Test_Update(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
 
-	paymentsScheduler.On("Update", id, request).Return(nil)
+	paymentsScheduler.On("Update", id, userId, request).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(handler.Update()).
 		WithBody(request).
 		WithVar("id", id.String())
@@ -401,6 +433,7 @@ func Test_Update_WithInvalidId(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(handler.Update()).
 		WithBody(request).
 		WithVar("id", "id")
@@ -409,7 +442,7 @@ func Test_Update_WithInvalidId(t *testing.T) {
 
 	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
 
-	paymentsScheduler.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	paymentsScheduler.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_Update_WithInvalidRequest(t *testing.T) {
@@ -418,6 +451,7 @@ func Test_Update_WithInvalidRequest(t *testing.T) {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(handler.Update()).
 		WithVar("id", uuid.New().String())
 
@@ -427,15 +461,17 @@ func Test_Update_WithInvalidRequest(t *testing.T) {
 func Test_Update_WithErrorFromService(t *testing.T) {
 	handler := handlerGenerator()
 
+	userId := uuid.New()
 	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
 
 	expected := errors.New("error")
 
-	paymentsScheduler.On("Update", id, request).Return(expected)
+	paymentsScheduler.On("Update", id, userId, request).Return(expected)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/payment/scheduler/{id}").
 		WithMethod("PUT").
+		WithUser(userId).
 		WithHandler(handler.Update()).
 		WithVar("id", id.String()).
 		WithBody(request)
@@ -444,3 +480,328 @@ func Test_Update_WithErrorFromService(t *testing.T) {
 
 	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
 }
+
+func Test_Pause(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	paymentsScheduler.On("Pause", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/pause").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Pause()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(t, http.StatusNoContent)
+
+	paymentsScheduler.AssertCalled(t, "Pause", id, userId)
+}
+
+func Test_Pause_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("payment scheduler with id %s not found", id)
+
+	paymentsScheduler.On("Pause", id, userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/pause").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Pause()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_Pause_WithInvalidParameter(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/pause").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(handler.Pause()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
+}
+
+func Test_Resume(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	paymentsScheduler.On("Resume", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/resume").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Resume()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(t, http.StatusNoContent)
+
+	paymentsScheduler.AssertCalled(t, "Resume", id, userId)
+}
+
+func Test_Resume_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("payment scheduler with id %s not found", id)
+
+	paymentsScheduler.On("Resume", id, userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/resume").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Resume()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_Resume_WithInvalidParameter(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/resume").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(handler.Resume()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
+}
+
+func Test_Trigger(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	paymentsScheduler.On("Trigger", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/trigger").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Trigger()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(t, http.StatusNoContent)
+
+	paymentsScheduler.AssertCalled(t, "Trigger", id, userId)
+}
+
+func Test_Trigger_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("payment scheduler with id %s not found", id)
+
+	paymentsScheduler.On("Trigger", id, userId).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/trigger").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(handler.Trigger()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_Trigger_WithInvalidParameter(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/trigger").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(handler.Trigger()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
+}
+
+func Test_FindRunsById(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	runs := []runModel.SchedulerRunDto{runMocks.GenerateSchedulerRun(id).ToDto()}
+
+	paymentsScheduler.On("FindRunsById", id, userId).
+		Return(runs, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/runs").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindRunsById()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusOK)
+
+	var actual []runModel.SchedulerRunDto
+
+	json.Unmarshal(responseByteArray, &actual)
+
+	assert.Equal(t, runs, actual)
+}
+
+func Test_FindRunsById_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("payment scheduler with id %s not found", id)
+
+	paymentsScheduler.On("FindRunsById", id, userId).
+		Return(nil, expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/runs").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindRunsById()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_FindRunsById_WithInvalidParameter(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/runs").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(handler.FindRunsById()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "the id is not valid id\n", string(responseByteArray))
+}
+
+func Test_FindNextExecutionsById(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	next := []time.Time{
+		time.Date(2022, time.February, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, time.March, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	paymentsScheduler.On("FindNextExecutionsById", id, userId, 2).
+		Return(next, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/next?count=2").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindNextExecutionsById()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusOK)
+
+	var actual []time.Time
+
+	json.Unmarshal(responseByteArray, &actual)
+
+	assert.Equal(t, next, actual)
+}
+
+func Test_FindNextExecutionsById_WithDefaultCount(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	paymentsScheduler.On("FindNextExecutionsById", id, userId, scheduler.DefaultNextExecutions).
+		Return([]time.Time{}, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/next").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindNextExecutionsById()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(t, http.StatusOK)
+
+	paymentsScheduler.AssertCalled(t, "FindNextExecutionsById", id, userId, scheduler.DefaultNextExecutions)
+}
+
+func Test_FindNextExecutionsById_WithInvalidCount(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/next?count=invalid").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(handler.FindNextExecutionsById()).
+		WithVar("id", uuid.New().String())
+
+	testRequest.Verify(t, http.StatusBadRequest)
+
+	paymentsScheduler.AssertNotCalled(t, "FindNextExecutionsById", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_FindNextExecutionsById_WithMissingRecord(t *testing.T) {
+	handler := handlerGenerator()
+
+	userId := uuid.New()
+	id := uuid.New()
+
+	expected := int_errors.NewErrNotFound("payment scheduler with id %s not found", id)
+
+	paymentsScheduler.On("FindNextExecutionsById", id, userId, scheduler.DefaultNextExecutions).
+		Return(nil, expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/payment/scheduler/{id}/next").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(handler.FindNextExecutionsById()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(t, http.StatusNotFound)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
@@ -93,6 +93,48 @@ func (_m *PaymentSchedulerHandler) FindByUserId() http.HandlerFunc {
 	return r0
 }
 
+// FindNextExecutionsById provides a mock function with given fields:
+func (_m *PaymentSchedulerHandler) FindNextExecutionsById() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// FindRunsById provides a mock function with given fields:
+func (_m *PaymentSchedulerHandler) FindRunsById() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// Pause provides a mock function with given fields:
+func (_m *PaymentSchedulerHandler) Pause() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
 // Remove provides a mock function with given fields:
 func (_m *PaymentSchedulerHandler) Remove() http.HandlerFunc {
 	ret := _m.Called()
@@ -109,6 +151,34 @@ func (_m *PaymentSchedulerHandler) Remove() http.HandlerFunc {
 	return r0
 }
 
+// Resume provides a mock function with given fields:
+func (_m *PaymentSchedulerHandler) Resume() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
+// Trigger provides a mock function with given fields:
+func (_m *PaymentSchedulerHandler) Trigger() http.HandlerFunc {
+	ret := _m.Called()
+
+	var r0 http.HandlerFunc
+	if rf, ok := ret.Get(0).(func() http.HandlerFunc); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(http.HandlerFunc)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields:
 func (_m *PaymentSchedulerHandler) Update() http.HandlerFunc {
 	ret := _m.Called()
@@ -6,6 +6,8 @@ import (
 	model "github.com/VlasovArtem/hob/src/payment/scheduler/model"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -14,6 +16,20 @@ type PaymentSchedulerRepository struct {
 	mock.Mock
 }
 
+// AssignHouseCurrencies provides a mock function with given fields: currencies
+func (_m *PaymentSchedulerRepository) AssignHouseCurrencies(currencies map[string]string) error {
+	ret := _m.Called(currencies)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string) error); ok {
+		r0 = rf(currencies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: scheduler
 func (_m *PaymentSchedulerRepository) Create(scheduler model.PaymentScheduler) (model.PaymentScheduler, error) {
 	ret := _m.Called(scheduler)
@@ -54,6 +70,29 @@ func (_m *PaymentSchedulerRepository) ExistsById(id uuid.UUID) bool {
 	return r0
 }
 
+// FindAll provides a mock function with given fields:
+func (_m *PaymentSchedulerRepository) FindAll() ([]model.PaymentScheduler, error) {
+	ret := _m.Called()
+
+	var r0 []model.PaymentScheduler
+	if rf, ok := ret.Get(0).(func() []model.PaymentScheduler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PaymentScheduler)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByHouseId provides a mock function with given fields: houseId
 func (_m *PaymentSchedulerRepository) FindByHouseId(houseId uuid.UUID) []model.PaymentSchedulerDto {
 	ret := _m.Called(houseId)
@@ -91,13 +130,13 @@ func (_m *PaymentSchedulerRepository) FindById(id uuid.UUID) (model.PaymentSched
 	return r0, r1
 }
 
-// FindByProviderId provides a mock function with given fields: providerId
-func (_m *PaymentSchedulerRepository) FindByProviderId(providerId uuid.UUID) []model.PaymentSchedulerDto {
-	ret := _m.Called(providerId)
+// FindByProviderId provides a mock function with given fields: providerId, userId
+func (_m *PaymentSchedulerRepository) FindByProviderId(providerId uuid.UUID, userId uuid.UUID) []model.PaymentSchedulerDto {
+	ret := _m.Called(providerId, userId)
 
 	var r0 []model.PaymentSchedulerDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) []model.PaymentSchedulerDto); ok {
-		r0 = rf(providerId)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) []model.PaymentSchedulerDto); ok {
+		r0 = rf(providerId, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentSchedulerDto)
@@ -123,6 +162,20 @@ func (_m *PaymentSchedulerRepository) FindByUserId(userId uuid.UUID) []model.Pay
 	return r0
 }
 
+// IncrementOccurrences provides a mock function with given fields: id
+func (_m *PaymentSchedulerRepository) IncrementOccurrences(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: entity
 func (_m *PaymentSchedulerRepository) Update(id uuid.UUID, request model.UpdatePaymentSchedulerRequest) (model.PaymentScheduler, error) {
 	ret := _m.Called(id, request)
@@ -143,3 +196,45 @@ func (_m *PaymentSchedulerRepository) Update(id uuid.UUID, request model.UpdateP
 
 	return r0, r1
 }
+
+// UpdateLastExecutedAt provides a mock function with given fields: id, executedAt
+func (_m *PaymentSchedulerRepository) UpdateLastExecutedAt(id uuid.UUID, executedAt time.Time) error {
+	ret := _m.Called(id, executedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time) error); ok {
+		r0 = rf(id, executedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLastMeterId provides a mock function with given fields: id, meterId
+func (_m *PaymentSchedulerRepository) UpdateLastMeterId(id uuid.UUID, meterId uuid.UUID) error {
+	ret := _m.Called(id, meterId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, meterId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePaused provides a mock function with given fields: id, paused
+func (_m *PaymentSchedulerRepository) UpdatePaused(id uuid.UUID, paused bool) error {
+	ret := _m.Called(id, paused)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, bool) error); ok {
+		r0 = rf(id, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -6,6 +6,10 @@ import (
 	model "github.com/VlasovArtem/hob/src/payment/scheduler/model"
 	mock "github.com/stretchr/testify/mock"
 
+	runmodel "github.com/VlasovArtem/hob/src/scheduler/run/model"
+
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -35,13 +39,13 @@ func (_m *PaymentSchedulerService) Add(request model.CreatePaymentSchedulerReque
 	return r0, r1
 }
 
-// FindByHouseId provides a mock function with given fields: houseId
-func (_m *PaymentSchedulerService) FindByHouseId(houseId uuid.UUID) []model.PaymentSchedulerDto {
-	ret := _m.Called(houseId)
+// FindByHouseId provides a mock function with given fields: houseId, userId
+func (_m *PaymentSchedulerService) FindByHouseId(houseId uuid.UUID, userId uuid.UUID) []model.PaymentSchedulerDto {
+	ret := _m.Called(houseId, userId)
 
 	var r0 []model.PaymentSchedulerDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) []model.PaymentSchedulerDto); ok {
-		r0 = rf(houseId)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) []model.PaymentSchedulerDto); ok {
+		r0 = rf(houseId, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentSchedulerDto)
@@ -51,20 +55,20 @@ func (_m *PaymentSchedulerService) FindByHouseId(houseId uuid.UUID) []model.Paym
 	return r0
 }
 
-// FindById provides a mock function with given fields: id
-func (_m *PaymentSchedulerService) FindById(id uuid.UUID) (model.PaymentSchedulerDto, error) {
-	ret := _m.Called(id)
+// FindById provides a mock function with given fields: id, userId
+func (_m *PaymentSchedulerService) FindById(id uuid.UUID, userId uuid.UUID) (model.PaymentSchedulerDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.PaymentSchedulerDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.PaymentSchedulerDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.PaymentSchedulerDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.PaymentSchedulerDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,13 +76,13 @@ func (_m *PaymentSchedulerService) FindById(id uuid.UUID) (model.PaymentSchedule
 	return r0, r1
 }
 
-// FindByProviderId provides a mock function with given fields: providerId
-func (_m *PaymentSchedulerService) FindByProviderId(providerId uuid.UUID) []model.PaymentSchedulerDto {
-	ret := _m.Called(providerId)
+// FindByProviderId provides a mock function with given fields: providerId, userId
+func (_m *PaymentSchedulerService) FindByProviderId(providerId uuid.UUID, userId uuid.UUID) []model.PaymentSchedulerDto {
+	ret := _m.Called(providerId, userId)
 
 	var r0 []model.PaymentSchedulerDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) []model.PaymentSchedulerDto); ok {
-		r0 = rf(providerId)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) []model.PaymentSchedulerDto); ok {
+		r0 = rf(providerId, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.PaymentSchedulerDto)
@@ -104,13 +108,101 @@ func (_m *PaymentSchedulerService) FindByUserId(userId uuid.UUID) []model.Paymen
 	return r0
 }
 
-// Remove provides a mock function with given fields: id
-func (_m *PaymentSchedulerService) Remove(id uuid.UUID) error {
-	ret := _m.Called(id)
+// FindNextExecutionsById provides a mock function with given fields: id, userId, count
+func (_m *PaymentSchedulerService) FindNextExecutionsById(id uuid.UUID, userId uuid.UUID, count int) ([]time.Time, error) {
+	ret := _m.Called(id, userId, count)
+
+	var r0 []time.Time
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, int) []time.Time); ok {
+		r0 = rf(id, userId, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = rf(id, userId, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindRunsById provides a mock function with given fields: id, userId
+func (_m *PaymentSchedulerService) FindRunsById(id uuid.UUID, userId uuid.UUID) ([]runmodel.SchedulerRunDto, error) {
+	ret := _m.Called(id, userId)
+
+	var r0 []runmodel.SchedulerRunDto
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) []runmodel.SchedulerRunDto); ok {
+		r0 = rf(id, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]runmodel.SchedulerRunDto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Pause provides a mock function with given fields: id, userId
+func (_m *PaymentSchedulerService) Pause(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Remove provides a mock function with given fields: id, userId
+func (_m *PaymentSchedulerService) Remove(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Resume provides a mock function with given fields: id, userId
+func (_m *PaymentSchedulerService) Resume(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Trigger provides a mock function with given fields: id, userId
+func (_m *PaymentSchedulerService) Trigger(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -118,13 +210,13 @@ func (_m *PaymentSchedulerService) Remove(id uuid.UUID) error {
 	return r0
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *PaymentSchedulerService) Update(id uuid.UUID, request model.UpdatePaymentSchedulerRequest) error {
-	ret := _m.Called(id, request)
+// Update provides a mock function with given fields: id, userId, request
+func (_m *PaymentSchedulerService) Update(id uuid.UUID, userId uuid.UUID, request model.UpdatePaymentSchedulerRequest) error {
+	ret := _m.Called(id, userId, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdatePaymentSchedulerRequest) error); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, model.UpdatePaymentSchedulerRequest) error); ok {
+		r0 = rf(id, userId, request)
 	} else {
 		r0 = ret.Error(0)
 	}
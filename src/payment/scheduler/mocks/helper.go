@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/model"
 	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
@@ -20,7 +21,8 @@ func GenerateCreatePaymentSchedulerRequest() model.CreatePaymentSchedulerRequest
 		HouseId:     HouseId,
 		UserId:      UserId,
 		ProviderId:  ProviderId,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 		Spec:        scheduler.DAILY,
 	}
 }
@@ -30,7 +32,8 @@ func GenerateUpdatePaymentSchedulerRequest() (uuid.UUID, model.UpdatePaymentSche
 		Name:        "Test Payment Updated",
 		Description: "Test Payment Description Updated",
 		ProviderId:  uuid.New(),
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 		Spec:        scheduler.DAILY,
 	}
 }
@@ -43,7 +46,8 @@ func GeneratePaymentScheduler(houseId uuid.UUID, userId uuid.UUID, providerId uu
 		HouseId:     houseId,
 		UserId:      userId,
 		ProviderId:  providerId,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 		Spec:        scheduler.DAILY,
 	}
 }
@@ -56,7 +60,8 @@ func GeneratePaymentSchedulerResponse(id uuid.UUID) model.PaymentSchedulerDto {
 		HouseId:     HouseId,
 		UserId:      UserId,
 		ProviderId:  ProviderId,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 		Spec:        scheduler.DAILY,
 	}
 }
@@ -1,11 +1,14 @@
 package model
 
 import (
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/common/money"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
 	providerModel "github.com/VlasovArtem/hob/src/provider/model"
 	"github.com/VlasovArtem/hob/src/scheduler"
 	userModel "github.com/VlasovArtem/hob/src/user/model"
 	"github.com/google/uuid"
+	"time"
 )
 
 type PaymentScheduler struct {
@@ -14,12 +17,27 @@ type PaymentScheduler struct {
 	Description string
 	HouseId     uuid.UUID
 	UserId      uuid.UUID
-	Sum         float32
-	User        userModel.User   `gorm:"foreignKey:UserId"`
-	House       houseModel.House `gorm:"foreignKey:HouseId"`
-	Spec        scheduler.SchedulingSpecification
-	ProviderId  uuid.UUID
-	Provider    providerModel.Provider `gorm:"foreignKey:ProviderId"`
+	Sum         money.Money
+	// Currency is the ISO 4217 code of the sum
+	Currency   string
+	User       userModel.User   `gorm:"foreignKey:UserId"`
+	House      houseModel.House `gorm:"foreignKey:HouseId"`
+	Spec       scheduler.SchedulingSpecification
+	TimeZone   scheduler.TimeZone
+	Adjustment scheduler.BusinessDayAdjustment
+	ProviderId uuid.UUID
+	Provider   providerModel.Provider `gorm:"foreignKey:ProviderId"`
+	CategoryId *uuid.UUID
+	Category   categoryModel.Category `gorm:"foreignKey:CategoryId"`
+	// MeterName references the house meter, the sum is calculated from its consumption and the provider tariffs if it is set
+	MeterName string
+	// LastMeterId is the meter reading the latest calculated sum is based on, it is not billed again
+	LastMeterId *uuid.UUID
+	// LastExecutedAt is the fire time of the latest successful execution, used to catch up missed executions
+	LastExecutedAt *time.Time
+	Paused         bool
+	Occurrences    int
+	scheduler.Limits
 }
 
 type CreatePaymentSchedulerRequest struct {
@@ -28,16 +46,28 @@ type CreatePaymentSchedulerRequest struct {
 	HouseId     uuid.UUID
 	UserId      uuid.UUID
 	ProviderId  uuid.UUID
-	Sum         float32
+	CategoryId  *uuid.UUID
+	Sum         money.Money
+	Currency    string
+	MeterName   string
 	Spec        scheduler.SchedulingSpecification
+	TimeZone    scheduler.TimeZone
+	Adjustment  scheduler.BusinessDayAdjustment
+	scheduler.Limits
 }
 
 type UpdatePaymentSchedulerRequest struct {
 	Name        string
 	Description string
 	ProviderId  uuid.UUID
-	Sum         float32
+	CategoryId  *uuid.UUID
+	Sum         money.Money
+	Currency    string
+	MeterName   string
 	Spec        scheduler.SchedulingSpecification
+	TimeZone    scheduler.TimeZone
+	Adjustment  scheduler.BusinessDayAdjustment
+	scheduler.Limits
 }
 
 type PaymentSchedulerDto struct {
@@ -47,8 +77,16 @@ type PaymentSchedulerDto struct {
 	HouseId     uuid.UUID
 	UserId      uuid.UUID
 	ProviderId  uuid.UUID
-	Sum         float32
+	CategoryId  *uuid.UUID
+	Sum         money.Money
+	Currency    string
+	MeterName   string
 	Spec        scheduler.SchedulingSpecification
+	TimeZone    scheduler.TimeZone
+	Adjustment  scheduler.BusinessDayAdjustment
+	Paused      bool
+	Occurrences int
+	scheduler.Limits
 }
 
 func (ps PaymentScheduler) ToDto() PaymentSchedulerDto {
@@ -59,8 +97,16 @@ func (ps PaymentScheduler) ToDto() PaymentSchedulerDto {
 		HouseId:     ps.HouseId,
 		UserId:      ps.UserId,
 		ProviderId:  ps.ProviderId,
+		CategoryId:  ps.CategoryId,
 		Sum:         ps.Sum,
+		Currency:    ps.Currency,
+		MeterName:   ps.MeterName,
 		Spec:        ps.Spec,
+		TimeZone:    ps.TimeZone,
+		Adjustment:  ps.Adjustment,
+		Paused:      ps.Paused,
+		Occurrences: ps.Occurrences,
+		Limits:      ps.Limits,
 	}
 }
 
@@ -72,8 +118,14 @@ func (request CreatePaymentSchedulerRequest) ToEntity() PaymentScheduler {
 		HouseId:     request.HouseId,
 		UserId:      request.UserId,
 		ProviderId:  request.ProviderId,
+		CategoryId:  request.CategoryId,
 		Sum:         request.Sum,
+		Currency:    request.Currency,
+		MeterName:   request.MeterName,
 		Spec:        request.Spec,
+		TimeZone:    request.TimeZone,
+		Adjustment:  request.Adjustment,
+		Limits:      request.Limits,
 	}
 }
 
@@ -83,7 +135,13 @@ func (request UpdatePaymentSchedulerRequest) ToEntity(id uuid.UUID) PaymentSched
 		Name:        request.Name,
 		Description: request.Description,
 		ProviderId:  request.ProviderId,
+		CategoryId:  request.CategoryId,
 		Sum:         request.Sum,
+		Currency:    request.Currency,
+		MeterName:   request.MeterName,
 		Spec:        request.Spec,
+		TimeZone:    request.TimeZone,
+		Adjustment:  request.Adjustment,
+		Limits:      request.Limits,
 	}
 }
@@ -4,7 +4,10 @@ import (
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/db"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/model"
+	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
 )
 
 var entity = model.PaymentScheduler{}
@@ -30,15 +33,26 @@ func (p *PaymentSchedulerRepositoryObject) GetEntity() any {
 	return entity
 }
 
+// UpgradeSchema converts the sums stored as the decimals to the minor units
+func (p *PaymentSchedulerRepositoryObject) UpgradeSchema() error {
+	return p.database.UpgradeMoneyColumn("sum")
+}
+
 type PaymentSchedulerRepository interface {
 	Create(scheduler model.PaymentScheduler) (model.PaymentScheduler, error)
 	ExistsById(id uuid.UUID) bool
 	DeleteById(id uuid.UUID)
 	FindById(id uuid.UUID) (model.PaymentScheduler, error)
+	FindAll() ([]model.PaymentScheduler, error)
 	FindByHouseId(houseId uuid.UUID) []model.PaymentSchedulerDto
 	FindByUserId(userId uuid.UUID) []model.PaymentSchedulerDto
-	FindByProviderId(providerId uuid.UUID) []model.PaymentSchedulerDto
+	FindByProviderId(providerId uuid.UUID, userId uuid.UUID) []model.PaymentSchedulerDto
 	Update(id uuid.UUID, request model.UpdatePaymentSchedulerRequest) (model.PaymentScheduler, error)
+	UpdateLastExecutedAt(id uuid.UUID, executedAt time.Time) error
+	UpdatePaused(id uuid.UUID, paused bool) error
+	IncrementOccurrences(id uuid.UUID) error
+	UpdateLastMeterId(id uuid.UUID, meterId uuid.UUID) error
+	AssignHouseCurrencies(currencies map[string]string) error
 }
 
 func (p *PaymentSchedulerRepositoryObject) Create(scheduler model.PaymentScheduler) (model.PaymentScheduler, error) {
@@ -57,6 +71,10 @@ func (p *PaymentSchedulerRepositoryObject) FindById(id uuid.UUID) (response mode
 	return response, p.database.FindById(&response, id)
 }
 
+func (p *PaymentSchedulerRepositoryObject) FindAll() (response []model.PaymentScheduler, err error) {
+	return response, p.database.Modeled().Find(&response).Error
+}
+
 func (p *PaymentSchedulerRepositoryObject) FindByHouseId(houseId uuid.UUID) (response []model.PaymentSchedulerDto) {
 	return p.findBy("house_id = ?", houseId)
 }
@@ -65,8 +83,8 @@ func (p *PaymentSchedulerRepositoryObject) FindByUserId(userId uuid.UUID) (respo
 	return p.findBy("user_id = ?", userId)
 }
 
-func (p *PaymentSchedulerRepositoryObject) FindByProviderId(providerId uuid.UUID) (response []model.PaymentSchedulerDto) {
-	return p.findBy("provider_id = ?", providerId)
+func (p *PaymentSchedulerRepositoryObject) FindByProviderId(providerId uuid.UUID, userId uuid.UUID) (response []model.PaymentSchedulerDto) {
+	return p.findBy("provider_id = ? AND user_id = ?", providerId, userId)
 }
 
 func (p *PaymentSchedulerRepositoryObject) findBy(query any, conditions ...any) (response []model.PaymentSchedulerDto) {
@@ -74,10 +92,39 @@ func (p *PaymentSchedulerRepositoryObject) findBy(query any, conditions ...any)
 	return response
 }
 
-func (p *PaymentSchedulerRepositoryObject) Update(id uuid.UUID, request model.UpdatePaymentSchedulerRequest) (response model.PaymentScheduler, error error) {
-	if err := p.database.Update(id, request); err != nil {
+// Update changes the scheduler, the limits are always written so the start date, the end date and the max occurrences
+// are cleared if they are not set
+func (p *PaymentSchedulerRepositoryObject) Update(id uuid.UUID, request model.UpdatePaymentSchedulerRequest) (response model.PaymentScheduler, err error) {
+	err = p.database.D().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(entity).Where("id = ?", id).Omit("Id").Updates(request).Error; err != nil {
+			return err
+		}
+		return tx.Model(entity).Where("id = ?", id).Select(scheduler.LimitColumns).Updates(request.Limits).Error
+	})
+	if err != nil {
 		return response, err
 	}
 
 	return p.FindById(id)
 }
+
+func (p *PaymentSchedulerRepositoryObject) UpdateLastExecutedAt(id uuid.UUID, executedAt time.Time) error {
+	return p.database.Modeled().Where("id = ?", id).Update("last_executed_at", executedAt).Error
+}
+
+func (p *PaymentSchedulerRepositoryObject) UpdateLastMeterId(id uuid.UUID, meterId uuid.UUID) error {
+	return p.database.Modeled().Where("id = ?", id).Update("last_meter_id", meterId).Error
+}
+
+func (p *PaymentSchedulerRepositoryObject) UpdatePaused(id uuid.UUID, paused bool) error {
+	return p.database.Modeled().Where("id = ?", id).Update("paused", paused).Error
+}
+
+func (p *PaymentSchedulerRepositoryObject) IncrementOccurrences(id uuid.UUID) error {
+	return p.database.Modeled().Where("id = ?", id).Update("occurrences", gorm.Expr("occurrences + 1")).Error
+}
+
+// AssignHouseCurrencies sets the currency of the house country to the payment schedulers persisted before the currency was stored
+func (p *PaymentSchedulerRepositoryObject) AssignHouseCurrencies(currencies map[string]string) error {
+	return p.database.AssignHouseCurrencies(currencies)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/db"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
@@ -18,6 +19,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 type PaymentRepositorySchedulerTestSuite struct {
@@ -114,6 +116,56 @@ func (p *PaymentRepositorySchedulerTestSuite) Test_FindById_WithMissingId() {
 	assert.Equal(p.T(), model.PaymentScheduler{}, actual)
 }
 
+func (p *PaymentRepositorySchedulerTestSuite) Test_FindAll() {
+	payment := p.createPaymentScheduler()
+
+	actual, err := p.repository.FindAll()
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), []model.PaymentScheduler{payment}, actual)
+}
+
+func (p *PaymentRepositorySchedulerTestSuite) Test_UpdatePaused() {
+	payment := p.createPaymentScheduler()
+
+	err := p.repository.UpdatePaused(payment.Id, true)
+
+	assert.Nil(p.T(), err)
+
+	actual, err := p.repository.FindById(payment.Id)
+
+	assert.Nil(p.T(), err)
+	assert.True(p.T(), actual.Paused)
+}
+
+func (p *PaymentRepositorySchedulerTestSuite) Test_UpdateLastExecutedAt() {
+	payment := p.createPaymentScheduler()
+	executedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	err := p.repository.UpdateLastExecutedAt(payment.Id, executedAt)
+
+	assert.Nil(p.T(), err)
+
+	actual, err := p.repository.FindById(payment.Id)
+
+	assert.Nil(p.T(), err)
+	assert.True(p.T(), executedAt.Equal(*actual.LastExecutedAt))
+}
+
+func (p *PaymentRepositorySchedulerTestSuite) Test_UpdateLastMeterId() {
+	payment := p.createPaymentScheduler()
+	meterId := uuid.New()
+
+	err := p.repository.UpdateLastMeterId(payment.Id, meterId)
+
+	assert.Nil(p.T(), err)
+
+	actual, err := p.repository.FindById(payment.Id)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), &meterId, actual.LastMeterId)
+}
+
 func (p *PaymentRepositorySchedulerTestSuite) Test_FindByUserId() {
 	payment := p.createPaymentScheduler()
 
@@ -145,13 +197,13 @@ func (p *PaymentRepositorySchedulerTestSuite) Test_FindByHouseId_WithMissingId()
 func (p *PaymentRepositorySchedulerTestSuite) Test_FindByProviderId() {
 	payment := p.createPaymentScheduler()
 
-	actual := p.repository.FindByProviderId(payment.ProviderId)
+	actual := p.repository.FindByProviderId(payment.ProviderId, payment.UserId)
 
 	assert.Equal(p.T(), []model.PaymentSchedulerDto{payment.ToDto()}, actual)
 }
 
 func (p *PaymentRepositorySchedulerTestSuite) Test_FindByProviderId_WithMissingId() {
-	actual := p.repository.FindByProviderId(uuid.New())
+	actual := p.repository.FindByProviderId(uuid.New(), uuid.New())
 
 	assert.Equal(p.T(), []model.PaymentSchedulerDto{}, actual)
 }
@@ -189,7 +241,7 @@ func (p *PaymentRepositorySchedulerTestSuite) Test_Update() {
 	updatedIncome := model.UpdatePaymentSchedulerRequest{
 		Name:        fmt.Sprintf("%s-new", payment.Name),
 		Description: fmt.Sprintf("%s-new", payment.Description),
-		Sum:         payment.Sum + 100.0,
+		Sum:         payment.Sum + money.FromMinorUnits(10000),
 		Spec:        scheduler.WEEKLY,
 		ProviderId:  newProvider.Id,
 	}
@@ -202,7 +254,7 @@ func (p *PaymentRepositorySchedulerTestSuite) Test_Update() {
 		Id:          payment.Id,
 		Name:        "Test Payment-new",
 		Description: "Test Payment Description-new",
-		Sum:         1100.0,
+		Sum:         money.FromMinorUnits(110000),
 		Spec:        scheduler.WEEKLY,
 		ProviderId:  newProvider.Id,
 		HouseId:     payment.HouseId,
@@ -212,6 +264,26 @@ func (p *PaymentRepositorySchedulerTestSuite) Test_Update() {
 	}, updatePayment)
 }
 
+func (p *PaymentRepositorySchedulerTestSuite) Test_Update_WithoutLimits() {
+	startDate := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(1, 0, 0)
+
+	payment := mocks.GeneratePaymentScheduler(p.createdHouse.Id, p.createdUser.Id, p.createdProvider.Id)
+	payment.Limits = scheduler.Limits{StartDate: &startDate, EndDate: &endDate, MaxOccurrences: 12}
+	p.CreateEntity(payment)
+
+	actual, err := p.repository.Update(payment.Id, model.UpdatePaymentSchedulerRequest{
+		Name:       payment.Name,
+		Sum:        payment.Sum,
+		Spec:       payment.Spec,
+		ProviderId: payment.ProviderId,
+	})
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), scheduler.Limits{}, actual.Limits)
+	assert.Equal(p.T(), payment.Name, actual.Name)
+}
+
 func (p *PaymentRepositorySchedulerTestSuite) createPaymentScheduler() model.PaymentScheduler {
 	payment := mocks.GeneratePaymentScheduler(p.createdHouse.Id, p.createdUser.Id, p.createdProvider.Id)
 
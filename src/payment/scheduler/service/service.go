@@ -3,16 +3,25 @@ package service
 import (
 	"errors"
 	"fmt"
+	categories "github.com/VlasovArtem/hob/src/category/service"
 	"github.com/VlasovArtem/hob/src/common/database"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	intErrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	countries "github.com/VlasovArtem/hob/src/country/service"
+	holidays "github.com/VlasovArtem/hob/src/holiday/service"
 	houses "github.com/VlasovArtem/hob/src/house/service"
+	meterModel "github.com/VlasovArtem/hob/src/meter/model"
+	meters "github.com/VlasovArtem/hob/src/meter/service"
 	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/model"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/repository"
 	payments "github.com/VlasovArtem/hob/src/payment/service"
 	providers "github.com/VlasovArtem/hob/src/provider/service"
 	"github.com/VlasovArtem/hob/src/scheduler"
+	locks "github.com/VlasovArtem/hob/src/scheduler/lock/service"
+	runModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
+	runs "github.com/VlasovArtem/hob/src/scheduler/run/service"
 	users "github.com/VlasovArtem/hob/src/user/service"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -25,26 +34,44 @@ var PaymentSchedulerServiceType = reflect.TypeOf(PaymentSchedulerServiceObject{}
 type PaymentSchedulerServiceObject struct {
 	userService      users.UserService
 	houseService     houses.HouseService
+	countryService   countries.CountryService
+	holidayService   holidays.HolidayService
 	paymentService   payments.PaymentService
 	providerService  providers.ProviderService
+	categoryService  categories.CategoryService
+	meterService     meters.MeterService
 	serviceScheduler scheduler.ServiceScheduler
+	runService       runs.SchedulerRunService
+	lockService      locks.SchedulerLockService
 	repository       repository.PaymentSchedulerRepository
 }
 
 func NewPaymentSchedulerService(
 	userService users.UserService,
 	houseService houses.HouseService,
+	countryService countries.CountryService,
+	holidayService holidays.HolidayService,
 	paymentService payments.PaymentService,
 	providerService providers.ProviderService,
+	categoryService categories.CategoryService,
+	meterService meters.MeterService,
 	serviceScheduler scheduler.ServiceScheduler,
+	runService runs.SchedulerRunService,
+	lockService locks.SchedulerLockService,
 	repository repository.PaymentSchedulerRepository,
 ) PaymentSchedulerService {
 	return &PaymentSchedulerServiceObject{
 		userService:      userService,
 		houseService:     houseService,
+		countryService:   countryService,
+		holidayService:   holidayService,
 		paymentService:   paymentService,
 		providerService:  providerService,
+		categoryService:  categoryService,
+		meterService:     meterService,
 		serviceScheduler: serviceScheduler,
+		runService:       runService,
+		lockService:      lockService,
 		repository:       repository,
 	}
 }
@@ -53,21 +80,60 @@ func (p *PaymentSchedulerServiceObject) Initialize(factory dependency.Dependenci
 	return NewPaymentSchedulerService(
 		dependency.FindRequiredDependency[users.UserServiceObject, users.UserService](factory),
 		dependency.FindRequiredDependency[houses.HouseServiceObject, houses.HouseService](factory),
+		dependency.FindRequiredDependency[countries.CountryServiceObject, countries.CountryService](factory),
+		dependency.FindRequiredDependency[holidays.HolidayServiceObject, holidays.HolidayService](factory),
 		dependency.FindRequiredDependency[payments.PaymentServiceObject, payments.PaymentService](factory),
 		dependency.FindRequiredDependency[providers.ProviderServiceObject, providers.ProviderService](factory),
+		dependency.FindRequiredDependency[categories.CategoryServiceObject, categories.CategoryService](factory),
+		dependency.FindRequiredDependency[meters.MeterServiceObject, meters.MeterService](factory),
 		dependency.FindRequiredDependency[scheduler.SchedulerServiceObject, scheduler.ServiceScheduler](factory),
+		dependency.FindRequiredDependency[runs.SchedulerRunServiceObject, runs.SchedulerRunService](factory),
+		dependency.FindRequiredDependency[locks.SchedulerLockServiceObject, locks.SchedulerLockService](factory),
 		dependency.FindRequiredDependency[repository.PaymentSchedulerRepositoryObject, repository.PaymentSchedulerRepository](factory),
 	)
 }
 
+// Start executes payments missed since the last execution and registers all persisted payment schedulers in the ServiceScheduler
+func (p *PaymentSchedulerServiceObject) Start() error {
+	if err := p.repository.AssignHouseCurrencies(p.houseService.CountryCurrencies()); err != nil {
+		log.Error().Err(err).Msg("currencies of the payment schedulers are not assigned")
+	}
+
+	schedulers, err := p.repository.FindAll()
+	if err != nil {
+		return err
+	}
+
+	for _, paymentScheduler := range schedulers {
+		if _, err = p.serviceScheduler.Add(paymentScheduler.Id, paymentScheduler.TimeZone.Specification(paymentScheduler.Spec), p.schedulerFunc(paymentScheduler.Id)); err != nil {
+			log.Error().Err(err).Msgf("payment scheduler %s is not scheduled", paymentScheduler.Id)
+		} else if paymentScheduler.Paused {
+			if err = p.serviceScheduler.Pause(paymentScheduler.Id); err != nil {
+				log.Error().Err(err).Msgf("payment scheduler %s is not paused", paymentScheduler.Id)
+			}
+		} else {
+			p.catchUp(paymentScheduler)
+		}
+	}
+
+	log.Info().Msgf("%d payment schedulers restored", len(schedulers))
+
+	return nil
+}
+
 type PaymentSchedulerService interface {
 	Add(request model.CreatePaymentSchedulerRequest) (model.PaymentSchedulerDto, error)
-	Remove(id uuid.UUID) error
-	FindById(id uuid.UUID) (model.PaymentSchedulerDto, error)
-	FindByHouseId(houseId uuid.UUID) []model.PaymentSchedulerDto
+	Remove(id uuid.UUID, userId uuid.UUID) error
+	FindById(id uuid.UUID, userId uuid.UUID) (model.PaymentSchedulerDto, error)
+	FindByHouseId(houseId uuid.UUID, userId uuid.UUID) []model.PaymentSchedulerDto
 	FindByUserId(userId uuid.UUID) []model.PaymentSchedulerDto
-	FindByProviderId(providerId uuid.UUID) []model.PaymentSchedulerDto
-	Update(id uuid.UUID, request model.UpdatePaymentSchedulerRequest) error
+	FindByProviderId(providerId uuid.UUID, userId uuid.UUID) []model.PaymentSchedulerDto
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdatePaymentSchedulerRequest) error
+	Pause(id uuid.UUID, userId uuid.UUID) error
+	Resume(id uuid.UUID, userId uuid.UUID) error
+	Trigger(id uuid.UUID, userId uuid.UUID) error
+	FindRunsById(id uuid.UUID, userId uuid.UUID) ([]runModel.SchedulerRunDto, error)
+	FindNextExecutionsById(id uuid.UUID, userId uuid.UUID, count int) ([]time.Time, error)
 }
 
 func (p *PaymentSchedulerServiceObject) Add(request model.CreatePaymentSchedulerRequest) (response model.PaymentSchedulerDto, err error) {
@@ -76,10 +142,18 @@ func (p *PaymentSchedulerServiceObject) Add(request model.CreatePaymentScheduler
 	}
 
 	entity := request.ToEntity()
+	if entity.Currency, err = p.houseService.ResolveCurrency(&request.HouseId, request.Currency); err != nil {
+		return response, err
+	}
+	if entity.TimeZone == "" {
+		entity.TimeZone = p.defaultTimeZone(entity.HouseId, entity.UserId)
+	}
+	createdAt := time.Now()
+	entity.LastExecutedAt = &createdAt
 
 	if entity, err = p.repository.Create(entity); err != nil {
 		return response, err
-	} else if _, err = p.serviceScheduler.Add(entity.Id, string(entity.Spec), p.schedulerFunc(entity)); err != nil {
+	} else if _, err = p.serviceScheduler.Add(entity.Id, entity.TimeZone.Specification(entity.Spec), p.schedulerFunc(entity.Id)); err != nil {
 		p.repository.DeleteById(entity.Id)
 
 		return response, err
@@ -89,26 +163,44 @@ func (p *PaymentSchedulerServiceObject) Add(request model.CreatePaymentScheduler
 }
 
 func (p *PaymentSchedulerServiceObject) validateCreateRequest(request model.CreatePaymentSchedulerRequest) error {
-	if request.Sum <= 0 {
-		return errors.New("sum should not be zero of negative")
+	if err := validateSum(request.Sum, request.MeterName); err != nil {
+		return err
+	}
+	if err := request.Limits.Validate(); err != nil {
+		return err
+	}
+	if err := request.TimeZone.Validate(); err != nil {
+		return err
+	}
+	if err := request.Adjustment.Validate(); err != nil {
+		return err
 	}
 	if !p.userService.ExistsById(request.UserId) {
 		return intErrors.NewErrNotFound("user with id %s in not exists", request.UserId)
 	}
-	if !p.houseService.ExistsById(request.HouseId) {
+	if !p.houseService.CanModify(request.HouseId, request.UserId) {
 		return intErrors.NewErrNotFound("house with id %s in not exists", request.HouseId)
 	}
-	if !p.providerService.ExistsById(request.ProviderId) {
+	if !p.providerService.ExistsByIdAndUserId(request.ProviderId, request.UserId) {
 		return intErrors.NewErrNotFound("provider with id %s in not exists", request.ProviderId)
 	}
+	if request.CategoryId != nil && !p.categoryService.ExistsByIdAndUserId(*request.CategoryId, request.UserId) {
+		return intErrors.NewErrNotFound("category with id %s in not exists", request.CategoryId)
+	}
+	if err := p.validateTariffs(request.ProviderId, request.UserId, request.MeterName); err != nil {
+		return err
+	}
 	if request.Spec == "" {
 		return errors.New("scheduler configuration not provided")
 	}
+	if err := scheduler.ValidateSpecification(request.Spec); err != nil {
+		return err
+	}
 	return nil
 }
 
-func (p *PaymentSchedulerServiceObject) Remove(id uuid.UUID) error {
-	if !p.repository.ExistsById(id) {
+func (p *PaymentSchedulerServiceObject) Remove(id uuid.UUID, userId uuid.UUID) error {
+	if !p.canModify(id, userId) {
 		return intErrors.NewErrNotFound("payment scheduler with id %s not found", id)
 	} else {
 		if err := p.serviceScheduler.Remove(id); err != nil {
@@ -120,15 +212,18 @@ func (p *PaymentSchedulerServiceObject) Remove(id uuid.UUID) error {
 	return nil
 }
 
-func (p *PaymentSchedulerServiceObject) FindById(id uuid.UUID) (response model.PaymentSchedulerDto, err error) {
-	if paymentScheduler, err := p.repository.FindById(id); err != nil {
-		return response, database.HandlerFindError(err, fmt.Sprintf("payment scheduler with id %s not found", id))
+func (p *PaymentSchedulerServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (response model.PaymentSchedulerDto, err error) {
+	if paymentScheduler, err := p.find(id, userId); err != nil {
+		return response, err
 	} else {
 		return paymentScheduler.ToDto(), err
 	}
 }
 
-func (p *PaymentSchedulerServiceObject) FindByHouseId(houseId uuid.UUID) []model.PaymentSchedulerDto {
+func (p *PaymentSchedulerServiceObject) FindByHouseId(houseId uuid.UUID, userId uuid.UUID) []model.PaymentSchedulerDto {
+	if !p.houseService.HasAccess(houseId, userId) {
+		return make([]model.PaymentSchedulerDto, 0)
+	}
 	return p.repository.FindByHouseId(houseId)
 }
 
@@ -136,14 +231,22 @@ func (p *PaymentSchedulerServiceObject) FindByUserId(userId uuid.UUID) []model.P
 	return p.repository.FindByUserId(userId)
 }
 
-func (p *PaymentSchedulerServiceObject) FindByProviderId(providerId uuid.UUID) []model.PaymentSchedulerDto {
-	return p.repository.FindByProviderId(providerId)
+// FindByProviderId returns the payment schedulers of the user for the provider
+func (p *PaymentSchedulerServiceObject) FindByProviderId(providerId uuid.UUID, userId uuid.UUID) []model.PaymentSchedulerDto {
+	return p.repository.FindByProviderId(providerId, userId)
 }
 
-func (p *PaymentSchedulerServiceObject) Update(id uuid.UUID, request model.UpdatePaymentSchedulerRequest) error {
-	if err, _ := p.validateUpdateRequest(id, request); err != nil {
+func (p *PaymentSchedulerServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdatePaymentSchedulerRequest) error {
+	if err, _ := p.validateUpdateRequest(id, userId, request); err != nil {
 		return err
 	}
+	if request.Currency != "" {
+		currency, err := p.houseService.ResolveCurrency(nil, request.Currency)
+		if err != nil {
+			return err
+		}
+		request.Currency = currency
+	}
 
 	updatedEntity, err := p.repository.Update(id, request)
 
@@ -151,7 +254,7 @@ func (p *PaymentSchedulerServiceObject) Update(id uuid.UUID, request model.Updat
 		return err
 	}
 
-	if _, err := p.serviceScheduler.Update(id, string(updatedEntity.Spec), p.schedulerFunc(updatedEntity)); err != nil {
+	if _, err := p.serviceScheduler.Update(id, updatedEntity.TimeZone.Specification(updatedEntity.Spec), p.schedulerFunc(updatedEntity.Id)); err != nil {
 		p.repository.DeleteById(id)
 
 		return err
@@ -159,38 +262,349 @@ func (p *PaymentSchedulerServiceObject) Update(id uuid.UUID, request model.Updat
 	return nil
 }
 
-func (p *PaymentSchedulerServiceObject) validateUpdateRequest(id uuid.UUID, request model.UpdatePaymentSchedulerRequest) (error, bool) {
-	if request.Sum <= 0 {
-		return errors.New("sum should not be zero of negative"), true
+func (p *PaymentSchedulerServiceObject) Pause(id uuid.UUID, userId uuid.UUID) error {
+	if !p.canModify(id, userId) {
+		return intErrors.NewErrNotFound("payment scheduler with id %s not found", id)
 	}
-	if !p.repository.ExistsById(id) {
+
+	if err := p.serviceScheduler.Pause(id); err != nil {
+		return err
+	}
+
+	return p.repository.UpdatePaused(id, true)
+}
+
+func (p *PaymentSchedulerServiceObject) Resume(id uuid.UUID, userId uuid.UUID) error {
+	if !p.canModify(id, userId) {
+		return intErrors.NewErrNotFound("payment scheduler with id %s not found", id)
+	}
+
+	if err := p.serviceScheduler.Resume(id); err != nil {
+		return err
+	}
+
+	// executions skipped while the scheduler was paused must not be caught up
+	if err := p.repository.UpdateLastExecutedAt(id, time.Now()); err != nil {
+		return err
+	}
+
+	return p.repository.UpdatePaused(id, false)
+}
+
+// Trigger creates the payment immediately, exactly as the scheduled execution does. The occurrence is not locked, the
+// trigger is the request of the user handled by a single instance and not the occurrence of the schedule
+func (p *PaymentSchedulerServiceObject) Trigger(id uuid.UUID, userId uuid.UUID) error {
+	paymentScheduler, err := p.find(id, userId)
+	if err != nil {
+		return err
+	}
+	if !p.houseService.CanModify(paymentScheduler.HouseId, userId) {
+		return intErrors.NewErrNotFound("payment scheduler with id %s not found", id)
+	}
+
+	return p.execute(&paymentScheduler, time.Now())
+}
+
+func (p *PaymentSchedulerServiceObject) FindRunsById(id uuid.UUID, userId uuid.UUID) ([]runModel.SchedulerRunDto, error) {
+	if !p.hasAccess(id, userId) {
+		return nil, intErrors.NewErrNotFound("payment scheduler with id %s not found", id)
+	}
+
+	return p.runService.FindBySchedulerId(id), nil
+}
+
+// FindNextExecutionsById returns up to count upcoming fire times of the scheduler allowed by its limits
+func (p *PaymentSchedulerServiceObject) FindNextExecutionsById(id uuid.UUID, userId uuid.UUID, count int) ([]time.Time, error) {
+	paymentScheduler, err := p.find(id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := p.serviceScheduler.NextExecutions(string(paymentScheduler.Spec), paymentScheduler.TimeZone, paymentScheduler.Limits.Since(time.Now()), count)
+	if err != nil {
+		return nil, err
+	}
+
+	location := paymentScheduler.TimeZone.Location()
+	for index := range next {
+		next[index] = next[index].In(location)
+	}
+
+	return paymentScheduler.Limits.Trim(next, paymentScheduler.Occurrences), nil
+}
+
+// find returns the payment scheduler of the house available to the user
+func (p *PaymentSchedulerServiceObject) find(id uuid.UUID, userId uuid.UUID) (model.PaymentScheduler, error) {
+	paymentScheduler, err := p.repository.FindById(id)
+	if err != nil {
+		return paymentScheduler, database.HandlerFindError(err, fmt.Sprintf("payment scheduler with id %s not found", id))
+	}
+	if !p.houseService.HasAccess(paymentScheduler.HouseId, userId) {
+		return model.PaymentScheduler{}, intErrors.NewErrNotFound("payment scheduler with id %s not found", id)
+	}
+
+	return paymentScheduler, nil
+}
+
+func (p *PaymentSchedulerServiceObject) hasAccess(id uuid.UUID, userId uuid.UUID) bool {
+	_, err := p.find(id, userId)
+
+	return err == nil
+}
+
+// canModify checks that the payment scheduler belongs to the house the user is allowed to change
+func (p *PaymentSchedulerServiceObject) canModify(id uuid.UUID, userId uuid.UUID) bool {
+	paymentScheduler, err := p.repository.FindById(id)
+
+	return err == nil && p.houseService.CanModify(paymentScheduler.HouseId, userId)
+}
+
+func (p *PaymentSchedulerServiceObject) validateUpdateRequest(id uuid.UUID, userId uuid.UUID, request model.UpdatePaymentSchedulerRequest) (error, bool) {
+	if err := validateSum(request.Sum, request.MeterName); err != nil {
+		return err, true
+	}
+	if err := request.Limits.Validate(); err != nil {
+		return err, true
+	}
+	if err := request.TimeZone.Validate(); err != nil {
+		return err, true
+	}
+	if err := request.Adjustment.Validate(); err != nil {
+		return err, true
+	}
+	if !p.canModify(id, userId) {
 		return intErrors.NewErrNotFound("payment schedule with id %s not found", id), true
 	}
-	if !p.providerService.ExistsById(request.ProviderId) {
+	if !p.providerService.ExistsByIdAndUserId(request.ProviderId, userId) {
 		return intErrors.NewErrNotFound("provider with id %s not found", request.ProviderId), true
 	}
+	if request.CategoryId != nil && !p.categoryService.ExistsByIdAndUserId(*request.CategoryId, userId) {
+		return intErrors.NewErrNotFound("category with id %s not found", request.CategoryId), true
+	}
+	if err := p.validateTariffs(request.ProviderId, userId, request.MeterName); err != nil {
+		return err, true
+	}
 	if request.Spec == "" {
 		return errors.New("scheduler configuration not provided"), true
 	}
+	if err := scheduler.ValidateSpecification(request.Spec); err != nil {
+		return err, true
+	}
 	return nil, false
 }
 
-func (p *PaymentSchedulerServiceObject) schedulerFunc(payment model.PaymentScheduler) func() {
+// validateSum checks the fixed sum, the sum of the scheduler with the meter is calculated on execution
+func validateSum(sum money.Money, meterName string) error {
+	if meterName == "" && sum <= 0 {
+		return errors.New("sum should not be zero of negative")
+	}
+	if sum < 0 {
+		return errors.New("sum should not be negative")
+	}
+	return nil
+}
+
+func (p *PaymentSchedulerServiceObject) validateTariffs(providerId uuid.UUID, userId uuid.UUID, meterName string) error {
+	if meterName == "" {
+		return nil
+	}
+
+	provider, err := p.providerService.FindById(providerId, userId)
+	if err != nil {
+		return err
+	}
+	if len(provider.Tariffs) == 0 {
+		return errors.New(fmt.Sprintf("provider %s does not have tariffs", provider.Name))
+	}
+	return nil
+}
+
+func (p *PaymentSchedulerServiceObject) catchUp(payment model.PaymentScheduler) {
+	if payment.LastExecutedAt == nil {
+		return
+	}
+
+	missed, err := p.serviceScheduler.MissedExecutions(payment.TimeZone.Specification(payment.Spec), *payment.LastExecutedAt, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msgf("missed executions of the payment scheduler %s are not calculated", payment.Id)
+		return
+	}
+
+	for _, date := range missed {
+		if !payment.IsStarted(date) {
+			continue
+		}
+		if err = p.executeOnce(&payment, date); err != nil || payment.Paused {
+			return
+		}
+	}
+}
+
+func (p *PaymentSchedulerServiceObject) schedulerFunc(id uuid.UUID) func() {
 	return func() {
-		if _, err := p.paymentService.Add(
-			paymentModel.CreatePaymentRequest{
-				Name:        payment.Name,
-				Description: payment.Description,
-				HouseId:     payment.HouseId,
-				UserId:      payment.UserId,
-				ProviderId:  &payment.ProviderId,
-				Date:        time.Now(),
-				Sum:         payment.Sum,
-			},
-		); err != nil {
-			log.Error().Err(err).Msg("")
+		if paymentScheduler, err := p.repository.FindById(id); err != nil {
+			log.Error().Err(err).Msgf("payment scheduler %s not found", id)
+		} else if fireTime, err := p.fireTime(&paymentScheduler); err != nil {
+			log.Error().Err(err).Msgf("execution of the payment scheduler %s is skipped", id)
 		} else {
-			log.Info().Msgf("New payment added to the house %s and user %s via scheduler %s", payment.HouseId, payment.UserId, payment.Id)
+			_ = p.executeOnce(&paymentScheduler, fireTime)
 		}
 	}
 }
+
+// fireTime returns the exact fire time of the running execution. It is the key of the occurrence lock, so the current
+// time of the instance is never used in its place, otherwise the instances would lock different occurrences
+func (p *PaymentSchedulerServiceObject) fireTime(payment *model.PaymentScheduler) (time.Time, error) {
+	fireTime, err := p.serviceScheduler.LastExecution(payment.TimeZone.Specification(payment.Spec), time.Now())
+	if err != nil {
+		return fireTime, err
+	}
+	if fireTime.IsZero() {
+		return fireTime, errors.New(fmt.Sprintf("fire time of the payment scheduler %s is not found", payment.Id))
+	}
+
+	return fireTime, nil
+}
+
+// executeOnce executes the occurrence unless it is already claimed by another instance of the application
+func (p *PaymentSchedulerServiceObject) executeOnce(payment *model.PaymentScheduler, date time.Time) error {
+	if !p.lockService.Acquire(payment.Id, date) {
+		return nil
+	}
+
+	return p.execute(payment, date)
+}
+
+// execute creates the payment at the date in the scheduler time zone if it is allowed by the scheduler limits, the scheduler is deactivated once it is exhausted
+func (p *PaymentSchedulerServiceObject) execute(payment *model.PaymentScheduler, date time.Time) error {
+	date = date.In(payment.TimeZone.Location())
+
+	if payment.IsExhausted(date, payment.Occurrences) {
+		p.deactivate(payment)
+		return errors.New(fmt.Sprintf("payment scheduler %s is exhausted", payment.Id))
+	}
+	if !payment.IsStarted(date) {
+		return errors.New(fmt.Sprintf("payment scheduler %s is not started yet", payment.Id))
+	}
+
+	request := paymentModel.CreatePaymentRequest{
+		Name:        payment.Name,
+		Description: payment.Description,
+		HouseId:     payment.HouseId,
+		UserId:      payment.UserId,
+		ProviderId:  &payment.ProviderId,
+		CategoryId:  payment.CategoryId,
+		Date:        p.businessDate(payment, date),
+		Sum:         payment.Sum,
+		Currency:    payment.Currency,
+	}
+
+	meter, err := p.consumption(payment, &request)
+	if err != nil {
+		log.Error().Err(err).Msg("")
+		p.runService.Failed(payment.Id, date, err)
+		return err
+	}
+
+	created, err := p.paymentService.Add(request)
+	if err != nil {
+		log.Error().Err(err).Msg("")
+		p.runService.Failed(payment.Id, date, err)
+		return err
+	}
+
+	p.runService.Succeeded(payment.Id, date, created.Id)
+	log.Info().Msgf("New payment added to the house %s and user %s via scheduler %s", payment.HouseId, payment.UserId, payment.Id)
+
+	if err = p.repository.UpdateLastExecutedAt(payment.Id, date); err != nil {
+		log.Error().Err(err).Msgf("last execution of the payment scheduler %s is not updated", payment.Id)
+	}
+	if err = p.repository.IncrementOccurrences(payment.Id); err != nil {
+		log.Error().Err(err).Msgf("occurrences of the payment scheduler %s are not updated", payment.Id)
+	}
+	if meter != nil {
+		if err = p.repository.UpdateLastMeterId(payment.Id, meter.Id); err != nil {
+			log.Error().Err(err).Msgf("last meter of the payment scheduler %s is not updated", payment.Id)
+		}
+		payment.LastMeterId = &meter.Id
+	}
+
+	payment.Occurrences++
+	if payment.IsExhausted(date, payment.Occurrences) {
+		p.deactivate(payment)
+	}
+
+	return nil
+}
+
+// consumption calculates the sum of the request from the delta of the latest two meter readings and the provider tariffs.
+// The payment is pending if there is no meter reading since the previous calculation, the used reading is returned otherwise
+func (p *PaymentSchedulerServiceObject) consumption(payment *model.PaymentScheduler, request *paymentModel.CreatePaymentRequest) (*meterModel.MeterDto, error) {
+	if payment.MeterName == "" {
+		return nil, nil
+	}
+
+	readings, err := p.meterService.FindLatestByNameAndHouseId(payment.MeterName, payment.HouseId, 2)
+	if err != nil {
+		return nil, err
+	}
+	if len(readings) < 2 || (payment.LastMeterId != nil && *payment.LastMeterId == readings[0].Id) {
+		request.Sum = 0
+		request.Pending = true
+		return nil, nil
+	}
+
+	provider, err := p.providerService.FindById(payment.ProviderId, payment.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Sum, err = provider.Tariffs.Cost(readings[1].Details, readings[0].Details); err != nil {
+		return nil, err
+	}
+	return &readings[0], nil
+}
+
+// defaultTimeZone returns the time zone of the house country, the server local time zone is used if it is not resolved
+func (p *PaymentSchedulerServiceObject) defaultTimeZone(houseId uuid.UUID, userId uuid.UUID) scheduler.TimeZone {
+	house, err := p.houseService.FindById(houseId, userId)
+	if err != nil {
+		log.Error().Err(err).Msgf("time zone of the house %s is not resolved", houseId)
+		return ""
+	}
+
+	country, err := p.countryService.FindCountryByCode(house.CountryCode)
+	if err != nil {
+		log.Error().Err(err).Msgf("time zone of the house %s is not resolved", houseId)
+		return ""
+	}
+
+	return scheduler.TimeZone(country.TimeZone)
+}
+
+// businessDate moves the date to the business day of the house country according to the scheduler adjustment
+func (p *PaymentSchedulerServiceObject) businessDate(payment *model.PaymentScheduler, date time.Time) time.Time {
+	if payment.Adjustment.OrDefault() == scheduler.NoAdjustment {
+		return date
+	}
+
+	house, err := p.houseService.FindById(payment.HouseId, payment.UserId)
+	if err != nil {
+		log.Error().Err(err).Msgf("date of the payment scheduler %s is not adjusted", payment.Id)
+		return date
+	}
+
+	return p.holidayService.Adjust(house.CountryCode, date, payment.Adjustment)
+}
+
+func (p *PaymentSchedulerServiceObject) deactivate(payment *model.PaymentScheduler) {
+	payment.Paused = true
+
+	if err := p.serviceScheduler.Pause(payment.Id); err != nil {
+		log.Error().Err(err).Msgf("payment scheduler %s is not paused", payment.Id)
+	}
+	if err := p.repository.UpdatePaused(payment.Id, true); err != nil {
+		log.Error().Err(err).Msgf("payment scheduler %s is not deactivated", payment.Id)
+	}
+
+	log.Info().Msgf("payment scheduler %s is exhausted and deactivated", payment.Id)
+}
@@ -2,14 +2,30 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	categoryMocks "github.com/VlasovArtem/hob/src/category/mocks"
 	"github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	countryMocks "github.com/VlasovArtem/hob/src/country/mocks"
+	countryModel "github.com/VlasovArtem/hob/src/country/model"
+	holidayMocks "github.com/VlasovArtem/hob/src/holiday/mocks"
+	holidayModel "github.com/VlasovArtem/hob/src/holiday/model"
+	holidays "github.com/VlasovArtem/hob/src/holiday/service"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	meterMocks "github.com/VlasovArtem/hob/src/meter/mocks"
+	meterModel "github.com/VlasovArtem/hob/src/meter/model"
 	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
 	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/mocks"
 	paymentScheduler "github.com/VlasovArtem/hob/src/payment/scheduler/model"
 	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	lockMocks "github.com/VlasovArtem/hob/src/scheduler/lock/mocks"
 	schedulerMocks "github.com/VlasovArtem/hob/src/scheduler/mocks"
+	runMocks "github.com/VlasovArtem/hob/src/scheduler/run/mocks"
+	runModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
 	"github.com/google/uuid"
@@ -19,15 +35,22 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 type PaymentSchedulerServiceTestSuite struct {
 	testhelper.MockTestSuite[PaymentSchedulerService]
 	userService                *userMocks.UserService
 	houseService               *houseMocks.HouseService
+	countryService             *countryMocks.CountryService
+	holidayService             *holidayMocks.HolidayService
 	paymentService             *paymentMocks.PaymentService
 	serviceScheduler           *schedulerMocks.ServiceScheduler
 	providerService            *providerMocks.ProviderService
+	categoryService            *categoryMocks.CategoryService
+	meterService               *meterMocks.MeterService
+	runService                 *runMocks.SchedulerRunService
+	lockService                *lockMocks.SchedulerLockService
 	paymentSchedulerRepository *mocks.PaymentSchedulerRepository
 }
 
@@ -36,51 +59,86 @@ func TestPaymentSchedulerServiceTestSuite(t *testing.T) {
 	ts.TestObjectGenerator = func() PaymentSchedulerService {
 		ts.userService = new(userMocks.UserService)
 		ts.houseService = new(houseMocks.HouseService)
+		ts.countryService = new(countryMocks.CountryService)
+		ts.holidayService = new(holidayMocks.HolidayService)
 		ts.paymentService = new(paymentMocks.PaymentService)
 		ts.serviceScheduler = new(schedulerMocks.ServiceScheduler)
 		ts.providerService = new(providerMocks.ProviderService)
+		ts.categoryService = new(categoryMocks.CategoryService)
+		ts.meterService = new(meterMocks.MeterService)
+		ts.runService = new(runMocks.SchedulerRunService)
+		ts.lockService = new(lockMocks.SchedulerLockService)
 		ts.paymentSchedulerRepository = new(mocks.PaymentSchedulerRepository)
 
-		return NewPaymentSchedulerService(ts.userService, ts.houseService, ts.paymentService, ts.providerService, ts.serviceScheduler, ts.paymentSchedulerRepository)
+		return NewPaymentSchedulerService(ts.userService, ts.houseService, ts.countryService, ts.holidayService, ts.paymentService, ts.providerService, ts.categoryService, ts.meterService, ts.serviceScheduler, ts.runService, ts.lockService, ts.paymentSchedulerRepository)
 	}
 
 	suite.Run(t, ts)
 }
 
+const kyivDailySpec = "CRON_TZ=Europe/Kyiv @daily"
+
+func (p *PaymentSchedulerServiceTestSuite) withHouseInKyiv() {
+	p.houseService.On("FindById", mocks.HouseId, mocks.UserId).Return(houseModel.HouseDto{Id: mocks.HouseId, CountryCode: "UA"}, nil)
+	p.countryService.On("FindCountryByCode", "UA").Return(countryModel.Country{Code: "UA", TimeZone: "Europe/Kyiv"}, nil)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) withAccess(id uuid.UUID) {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.Id = id
+
+	p.paymentSchedulerRepository.On("FindById", id).Return(entity, nil)
+	p.houseService.On("HasAccess", mocks.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+}
+
 func (p *PaymentSchedulerServiceTestSuite) Test_Add() {
 	request := mocks.GenerateCreatePaymentSchedulerRequest()
 
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.userService.On("ExistsById", mocks.UserId).
 		Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).
 		Return(true)
-	p.providerService.On("ExistsById", mocks.ProviderId).
+	p.withHouseInKyiv()
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).
 		Return(true)
 	p.paymentSchedulerRepository.On("Create", mock.Anything).
 		Return(
 			func(model paymentScheduler.PaymentScheduler) paymentScheduler.PaymentScheduler {
 				return model
 			}, nil)
-	p.serviceScheduler.On("Add", mock.AnythingOfType("uuid.UUID"), "@daily", mock.Anything).
+	p.serviceScheduler.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).
 		Return(cron.EntryID(0), nil)
 
 	payment, err := p.TestO.Add(request)
 
 	expectedEntity := request.ToEntity()
 	expectedEntity.Id = payment.Id
+	expectedEntity.TimeZone = "Europe/Kyiv"
 	expectedResponse := expectedEntity.ToDto()
 
 	assert.Nil(p.T(), err)
 	assert.Equal(p.T(), expectedResponse, payment)
-	p.serviceScheduler.AssertCalled(p.T(), "Add", expectedEntity.Id, "@daily", mock.Anything)
+	p.serviceScheduler.AssertCalled(p.T(), "Add", expectedEntity.Id, kyivDailySpec, mock.Anything)
 
-	p.paymentService.On("Add", mock.Anything).Return(paymentModel.PaymentDto{}, nil)
+	createdPayment := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.paymentService.On("Add", mock.Anything).Return(createdPayment, nil)
+	p.runService.On("Succeeded", expectedEntity.Id, mock.AnythingOfType("time.Time"), createdPayment.Id).Return()
+	p.paymentSchedulerRepository.On("FindById", expectedEntity.Id).Return(expectedEntity, nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", expectedEntity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", expectedEntity.Id).Return(nil)
+	p.lockService.On("Acquire", mock.Anything, mock.Anything).Return(true)
+	fireTime := time.Now().Add(-time.Second)
+	p.serviceScheduler.On("LastExecution", kyivDailySpec, mock.AnythingOfType("time.Time")).Return(fireTime, nil)
 
 	function := p.serviceScheduler.Calls[0].Arguments.Get(2).(func())
 	function()
 
 	createPaymentRequest := p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest)
 
+	p.lockService.AssertCalled(p.T(), "Acquire", expectedEntity.Id, fireTime)
 	assert.Equal(p.T(), paymentModel.CreatePaymentRequest{
 		Name:        "Test Payment",
 		Description: "Test Payment Description",
@@ -88,8 +146,77 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Add() {
 		UserId:      mocks.UserId,
 		ProviderId:  &mocks.ProviderId,
 		Date:        createPaymentRequest.Date,
-		Sum:         1000,
+		Sum:         money.FromMinorUnits(100000),
+		Currency:    "UAH",
 	}, createPaymentRequest)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdateLastExecutedAt", expectedEntity.Id, createPaymentRequest.Date)
+	p.runService.AssertCalled(p.T(), "Succeeded", expectedEntity.Id, createPaymentRequest.Date, createdPayment.Id)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithErrorDuringExecution() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	expectedError := errors.New("error")
+
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.lockService.On("Acquire", mock.Anything, mock.Anything).Return(true)
+	p.withHouseInKyiv()
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("Create", mock.Anything).
+		Return(
+			func(model paymentScheduler.PaymentScheduler) paymentScheduler.PaymentScheduler {
+				return model
+			}, nil)
+	p.serviceScheduler.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).
+		Return(cron.EntryID(0), nil)
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Nil(p.T(), err)
+
+	p.paymentSchedulerRepository.On("FindById", payment.Id).Return(request.ToEntity(), nil)
+	p.paymentService.On("Add", mock.Anything).Return(paymentModel.PaymentDto{}, expectedError)
+	p.runService.On("Failed", mock.AnythingOfType("uuid.UUID"), mock.AnythingOfType("time.Time"), expectedError).Return()
+	p.serviceScheduler.On("LastExecution", mock.Anything, mock.AnythingOfType("time.Time")).Return(time.Now().Add(-time.Second), nil)
+
+	function := p.serviceScheduler.Calls[0].Arguments.Get(2).(func())
+	function()
+
+	createPaymentRequest := p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest)
+
+	p.runService.AssertCalled(p.T(), "Failed", mock.AnythingOfType("uuid.UUID"), createPaymentRequest.Date, expectedError)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdateLastExecutedAt", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithoutFireTime() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.withHouseInKyiv()
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("Create", mock.Anything).
+		Return(
+			func(model paymentScheduler.PaymentScheduler) paymentScheduler.PaymentScheduler {
+				return model
+			}, nil)
+	p.serviceScheduler.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).
+		Return(cron.EntryID(0), nil)
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Nil(p.T(), err)
+
+	p.paymentSchedulerRepository.On("FindById", payment.Id).Return(request.ToEntity(), nil)
+	p.serviceScheduler.On("LastExecution", mock.Anything, mock.AnythingOfType("time.Time")).Return(time.Time{}, nil)
+
+	function := p.serviceScheduler.Calls[0].Arguments.Get(2).(func())
+	function()
+
+	p.lockService.AssertNotCalled(p.T(), "Acquire", mock.Anything, mock.Anything)
+	p.paymentService.AssertNotCalled(p.T(), "Add", mock.Anything)
 }
 
 func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithNegativeSum() {
@@ -127,7 +254,7 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithUserNotExists() {
 
 func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithHouseNotExists() {
 	p.userService.On("ExistsById", mocks.UserId).Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).Return(false)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(false)
 
 	request := mocks.GenerateCreatePaymentSchedulerRequest()
 
@@ -139,8 +266,8 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithHouseNotExists() {
 
 func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithProviderNotExists() {
 	p.userService.On("ExistsById", mocks.UserId).Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).Return(true)
-	p.providerService.On("ExistsById", mocks.ProviderId).Return(false)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(false)
 
 	request := mocks.GenerateCreatePaymentSchedulerRequest()
 
@@ -150,12 +277,48 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithProviderNotExists() {
 	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
 }
 
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithCategoryNotExists() {
+	categoryId := uuid.New()
+
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.categoryService.On("ExistsByIdAndUserId", categoryId, mocks.UserId).Return(false)
+
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.CategoryId = &categoryId
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("category with id %s in not exists", request.CategoryId), err)
+	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithNotSupportedCurrency() {
+	expectedError := int_errors.NewErrNotFound("currency with code %s is not found", "ABC")
+
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", &mocks.HouseId, "ABC").Return("", expectedError)
+
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.Currency = "ABC"
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), expectedError, err)
+	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
 func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithInvalidSpec() {
 	p.userService.On("ExistsById", mocks.UserId).
 		Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).
 		Return(true)
-	p.providerService.On("ExistsById", mocks.ProviderId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
 	p.serviceScheduler.On("Create", mock.AnythingOfType("uuid.UUID"), "@daily", mock.Anything).
 		Return(cron.EntryID(0), nil)
 
@@ -169,17 +332,19 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithInvalidSpec() {
 }
 
 func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithErrorDuringScheduling() {
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.userService.On("ExistsById", mocks.UserId).
 		Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).
 		Return(true)
-	p.providerService.On("ExistsById", mocks.ProviderId).Return(true)
+	p.withHouseInKyiv()
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
 	p.paymentSchedulerRepository.On("Create", mock.Anything).
 		Return(
 			func(model paymentScheduler.PaymentScheduler) paymentScheduler.PaymentScheduler {
 				return model
 			}, nil)
-	p.serviceScheduler.On("Add", mock.AnythingOfType("uuid.UUID"), "@daily", mock.Anything).
+	p.serviceScheduler.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).
 		Return(cron.EntryID(0), errors.New("error"))
 	p.paymentSchedulerRepository.On("DeleteById", mock.AnythingOfType("uuid.UUID")).Return()
 
@@ -194,11 +359,13 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithErrorDuringScheduling()
 }
 
 func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithErrorDuringCreateScheduleEntity() {
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.userService.On("ExistsById", mocks.UserId).
 		Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).
 		Return(true)
-	p.providerService.On("ExistsById", mocks.ProviderId).Return(true)
+	p.withHouseInKyiv()
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
 	p.paymentSchedulerRepository.On("Create", mock.Anything).
 		Return(
 			func(model paymentScheduler.PaymentScheduler) paymentScheduler.PaymentScheduler {
@@ -210,20 +377,106 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithErrorDuringCreateSchedul
 	payment, err := p.TestO.Add(request)
 
 	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "DeleteById", mock.AnythingOfType("uuid.UUID"))
-	p.serviceScheduler.AssertNotCalled(p.T(), "Add", mock.AnythingOfType("uuid.UUID"), "@daily", mock.Anything)
+	p.serviceScheduler.AssertNotCalled(p.T(), "Add", mock.AnythingOfType("uuid.UUID"), mock.Anything, mock.Anything)
 
 	assert.Equal(p.T(), errors.New("error"), err)
 	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
 }
 
+func (p *PaymentSchedulerServiceTestSuite) Test_Start() {
+	p.houseService.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	p.paymentSchedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	first := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	second := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+
+	p.paymentSchedulerRepository.On("FindAll").Return([]paymentScheduler.PaymentScheduler{first, second}, nil)
+	p.serviceScheduler.On("Add", first.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	p.serviceScheduler.On("Add", second.Id, "@daily", mock.Anything).Return(cron.EntryID(0), errors.New("error"))
+
+	err := p.TestO.(*PaymentSchedulerServiceObject).Start()
+
+	assert.Nil(p.T(), err)
+	p.serviceScheduler.AssertCalled(p.T(), "Add", first.Id, "@daily", mock.Anything)
+	p.serviceScheduler.AssertCalled(p.T(), "Add", second.Id, "@daily", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Start_WithMissedExecutions() {
+	p.houseService.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	p.paymentSchedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	lastExecutedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.Local)
+	first := time.Date(2022, time.January, 2, 0, 0, 0, 0, time.Local)
+	second := time.Date(2022, time.January, 3, 0, 0, 0, 0, time.Local)
+
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.LastExecutedAt = &lastExecutedAt
+
+	p.paymentSchedulerRepository.On("FindAll").Return([]paymentScheduler.PaymentScheduler{scheduler}, nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	p.serviceScheduler.On("MissedExecutions", "@daily", lastExecutedAt, mock.AnythingOfType("time.Time")).Return([]time.Time{first, second}, nil)
+	p.serviceScheduler.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	p.paymentService.On("Add", mock.Anything).Return(paymentModel.PaymentDto{}, nil)
+	p.runService.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), mock.AnythingOfType("uuid.UUID")).Return()
+	p.lockService.On("Acquire", mock.Anything, mock.Anything).Return(true)
+
+	err := p.TestO.(*PaymentSchedulerServiceObject).Start()
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), first, p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest).Date)
+	assert.Equal(p.T(), second, p.paymentService.Calls[1].Arguments.Get(0).(paymentModel.CreatePaymentRequest).Date)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdateLastExecutedAt", scheduler.Id, first)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdateLastExecutedAt", scheduler.Id, second)
+	p.runService.AssertNumberOfCalls(p.T(), "Succeeded", 2)
+	p.serviceScheduler.AssertCalled(p.T(), "Add", scheduler.Id, "@daily", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Start_WithErrorDuringMissedExecution() {
+	p.houseService.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	p.paymentSchedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	lastExecutedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.Local)
+	first := time.Date(2022, time.January, 2, 0, 0, 0, 0, time.Local)
+	second := time.Date(2022, time.January, 3, 0, 0, 0, 0, time.Local)
+
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.LastExecutedAt = &lastExecutedAt
+
+	p.paymentSchedulerRepository.On("FindAll").Return([]paymentScheduler.PaymentScheduler{scheduler}, nil)
+	p.serviceScheduler.On("MissedExecutions", "@daily", lastExecutedAt, mock.AnythingOfType("time.Time")).Return([]time.Time{first, second}, nil)
+	p.serviceScheduler.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	p.paymentService.On("Add", mock.Anything).Return(paymentModel.PaymentDto{}, errors.New("error"))
+	p.runService.On("Failed", scheduler.Id, first, errors.New("error")).Return()
+	p.lockService.On("Acquire", mock.Anything, mock.Anything).Return(true)
+
+	err := p.TestO.(*PaymentSchedulerServiceObject).Start()
+
+	assert.Nil(p.T(), err)
+	p.paymentService.AssertNumberOfCalls(p.T(), "Add", 1)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdateLastExecutedAt", mock.Anything, mock.Anything)
+	p.runService.AssertCalled(p.T(), "Failed", scheduler.Id, first, errors.New("error"))
+	p.serviceScheduler.AssertCalled(p.T(), "Add", scheduler.Id, "@daily", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Start_WithErrorFromRepository() {
+	p.houseService.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	p.paymentSchedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	expectedError := errors.New("error")
+
+	p.paymentSchedulerRepository.On("FindAll").Return(nil, expectedError)
+
+	err := p.TestO.(*PaymentSchedulerServiceObject).Start()
+
+	assert.Equal(p.T(), expectedError, err)
+	p.serviceScheduler.AssertNotCalled(p.T(), "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (p *PaymentSchedulerServiceTestSuite) Test_Remove() {
 	id := uuid.New()
 
-	p.paymentSchedulerRepository.On("ExistsById", id).Return(true)
+	p.withAccess(id)
 	p.paymentSchedulerRepository.On("DeleteById", id).Return()
 	p.serviceScheduler.On("Remove", id).Return(nil)
 
-	err := p.TestO.Remove(id)
+	err := p.TestO.Remove(id, mocks.UserId)
 
 	p.paymentSchedulerRepository.AssertCalled(p.T(), "DeleteById", id)
 
@@ -233,12 +486,12 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Remove() {
 func (p *PaymentSchedulerServiceTestSuite) Test_Remove_WithErrorFromScheduler() {
 	id := uuid.New()
 
-	p.paymentSchedulerRepository.On("ExistsById", id).Return(true)
+	p.withAccess(id)
 	p.paymentSchedulerRepository.On("DeleteById", id).Return()
 
 	p.serviceScheduler.On("Remove", id).Return(errors.New("error"))
 
-	err := p.TestO.Remove(id)
+	err := p.TestO.Remove(id, mocks.UserId)
 
 	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "DeleteById", id)
 
@@ -248,32 +501,71 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Remove_WithErrorFromScheduler()
 func (p *PaymentSchedulerServiceTestSuite) Test_Remove_WithMissingRecord() {
 	id := uuid.New()
 
-	p.paymentSchedulerRepository.On("ExistsById", id).Return(false)
+	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, gorm.ErrRecordNotFound)
 
-	err := p.TestO.Remove(id)
+	err := p.TestO.Remove(id, mocks.UserId)
 
 	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "DeleteById", id)
 
 	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", id), err)
 }
 
+func (p *PaymentSchedulerServiceTestSuite) Test_FindRunsById() {
+	id := uuid.New()
+	runs := []runModel.SchedulerRunDto{runMocks.GenerateSchedulerRun(id).ToDto()}
+
+	p.withAccess(id)
+	p.runService.On("FindBySchedulerId", id).Return(runs)
+
+	actual, err := p.TestO.FindRunsById(id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), runs, actual)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_FindRunsById_WithMissingRecord() {
+	id := uuid.New()
+
+	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, gorm.ErrRecordNotFound)
+
+	actual, err := p.TestO.FindRunsById(id, mocks.UserId)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", id), err)
+	assert.Nil(p.T(), actual)
+	p.runService.AssertNotCalled(p.T(), "FindBySchedulerId", id)
+}
+
 func (p *PaymentSchedulerServiceTestSuite) Test_FindById() {
 	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
 
 	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
 
-	actual, err := p.TestO.FindById(scheduler.Id)
+	actual, err := p.TestO.FindById(scheduler.Id, mocks.UserId)
 
 	assert.Nil(p.T(), err)
 	assert.Equal(p.T(), scheduler.ToDto(), actual)
 }
 
+func (p *PaymentSchedulerServiceTestSuite) Test_FindById_WithoutAccess() {
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	userId := uuid.New()
+
+	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	p.houseService.On("HasAccess", scheduler.HouseId, userId).Return(false)
+
+	actual, err := p.TestO.FindById(scheduler.Id, userId)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", scheduler.Id), err)
+	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, actual)
+}
+
 func (p *PaymentSchedulerServiceTestSuite) Test_FindById_WithNotExistingId() {
 	id := uuid.New()
 
 	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, gorm.ErrRecordNotFound)
 
-	actual, err := p.TestO.FindById(id)
+	actual, err := p.TestO.FindById(id, mocks.UserId)
 
 	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", id), err)
 	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, actual)
@@ -285,7 +577,7 @@ func (p *PaymentSchedulerServiceTestSuite) Test_FindById_WithError() {
 
 	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, expectedError)
 
-	actual, err := p.TestO.FindById(id)
+	actual, err := p.TestO.FindById(id, mocks.UserId)
 
 	assert.Equal(p.T(), expectedError, err)
 	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, actual)
@@ -295,9 +587,10 @@ func (p *PaymentSchedulerServiceTestSuite) Test_FindByHouseId() {
 	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
 	dto := scheduler.ToDto()
 
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
 	p.paymentSchedulerRepository.On("FindByHouseId", scheduler.HouseId).Return([]paymentScheduler.PaymentSchedulerDto{dto})
 
-	actual := p.TestO.FindByHouseId(scheduler.HouseId)
+	actual := p.TestO.FindByHouseId(scheduler.HouseId, mocks.UserId)
 
 	assert.Equal(p.T(), []paymentScheduler.PaymentSchedulerDto{dto}, actual)
 }
@@ -305,11 +598,23 @@ func (p *PaymentSchedulerServiceTestSuite) Test_FindByHouseId() {
 func (p *PaymentSchedulerServiceTestSuite) Test_FindByHouseId_WithNotExistingRecords() {
 	id := uuid.New()
 
+	p.houseService.On("HasAccess", id, mocks.UserId).Return(true)
 	p.paymentSchedulerRepository.On("FindByHouseId", id).Return([]paymentScheduler.PaymentSchedulerDto{})
 
-	actual := p.TestO.FindByHouseId(id)
+	actual := p.TestO.FindByHouseId(id, mocks.UserId)
+
+	assert.Equal(p.T(), []paymentScheduler.PaymentSchedulerDto{}, actual)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_FindByHouseId_WithoutAccess() {
+	id := uuid.New()
+
+	p.houseService.On("HasAccess", id, mocks.UserId).Return(false)
+
+	actual := p.TestO.FindByHouseId(id, mocks.UserId)
 
 	assert.Equal(p.T(), []paymentScheduler.PaymentSchedulerDto{}, actual)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "FindByHouseId", id)
 }
 
 func (p *PaymentSchedulerServiceTestSuite) Test_FindByUserId() {
@@ -337,9 +642,9 @@ func (p *PaymentSchedulerServiceTestSuite) Test_FindByProviderId() {
 	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
 	dto := scheduler.ToDto()
 
-	p.paymentSchedulerRepository.On("FindByProviderId", scheduler.ProviderId).Return([]paymentScheduler.PaymentSchedulerDto{dto})
+	p.paymentSchedulerRepository.On("FindByProviderId", scheduler.ProviderId, mocks.UserId).Return([]paymentScheduler.PaymentSchedulerDto{dto})
 
-	actual := p.TestO.FindByProviderId(scheduler.ProviderId)
+	actual := p.TestO.FindByProviderId(scheduler.ProviderId, mocks.UserId)
 
 	assert.Equal(p.T(), []paymentScheduler.PaymentSchedulerDto{dto}, actual)
 }
@@ -347,9 +652,9 @@ func (p *PaymentSchedulerServiceTestSuite) Test_FindByProviderId() {
 func (p *PaymentSchedulerServiceTestSuite) Test_FindByProviderId_WithNotExistingRecords() {
 	id := uuid.New()
 
-	p.paymentSchedulerRepository.On("FindByProviderId", id).Return([]paymentScheduler.PaymentSchedulerDto{})
+	p.paymentSchedulerRepository.On("FindByProviderId", id, mocks.UserId).Return([]paymentScheduler.PaymentSchedulerDto{})
 
-	actual := p.TestO.FindByProviderId(id)
+	actual := p.TestO.FindByProviderId(id, mocks.UserId)
 
 	assert.Equal(p.T(), []paymentScheduler.PaymentSchedulerDto{}, actual)
 }
@@ -359,8 +664,9 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update() {
 	scheduler := mocks.GeneratePaymentScheduler(uuid.New(), uuid.New(), uuid.New())
 	scheduler.Id = id
 
-	p.paymentSchedulerRepository.On("ExistsById", id).Return(true)
-	p.providerService.On("ExistsById", request.ProviderId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.withAccess(id)
+	p.providerService.On("ExistsByIdAndUserId", request.ProviderId, mocks.UserId).Return(true)
 	p.paymentSchedulerRepository.On("Update", id, request).Return(scheduler, nil)
 	p.serviceScheduler.On("Update", mock.AnythingOfType("uuid.UUID"), string(request.Spec), mock.Anything).
 		Return(cron.EntryID(0), nil)
@@ -371,7 +677,7 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update() {
 
 	p.paymentSchedulerRepository.On("FindById", id).Return(entity, nil)
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 
 	assert.Nil(p.T(), err)
 	p.serviceScheduler.AssertCalled(p.T(), "Update", id, string(request.Spec), mock.Anything)
@@ -382,9 +688,9 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update() {
 func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithMissingScheduler() {
 	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
 
-	p.paymentSchedulerRepository.On("ExistsById", id).Return(false)
+	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, gorm.ErrRecordNotFound)
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(p.T(), int_errors.NewErrNotFound("payment schedule with id %s not found", id), err)
 
@@ -396,9 +702,9 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithInvalidSum() {
 	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
 	request.Sum = 0
 
-	p.paymentSchedulerRepository.On("ExistsById", mock.Anything).Return(true)
+	p.withAccess(id)
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(p.T(), errors.New("sum should not be zero of negative"), err)
 
@@ -409,10 +715,10 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithInvalidSum() {
 func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithNotExistsProvider() {
 	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
 
-	p.paymentSchedulerRepository.On("ExistsById", mock.Anything).Return(true)
-	p.providerService.On("ExistsById", request.ProviderId).Return(false)
+	p.withAccess(id)
+	p.providerService.On("ExistsByIdAndUserId", request.ProviderId, mocks.UserId).Return(false)
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(p.T(), int_errors.NewErrNotFound("provider with id %s not found", request.ProviderId), err)
 
@@ -420,14 +726,30 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithNotExistsProvider() {
 	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "DeleteById", mock.Anything)
 }
 
+func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithNotExistsCategory() {
+	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
+	categoryId := uuid.New()
+	request.CategoryId = &categoryId
+
+	p.withAccess(id)
+	p.providerService.On("ExistsByIdAndUserId", request.ProviderId, mocks.UserId).Return(true)
+	p.categoryService.On("ExistsByIdAndUserId", categoryId, mocks.UserId).Return(false)
+
+	err := p.TestO.Update(id, mocks.UserId, request)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("category with id %s not found", request.CategoryId), err)
+
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Update", mock.Anything, mock.Anything)
+}
+
 func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithNotSchedulerSpec() {
 	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
 	request.Spec = ""
 
-	p.paymentSchedulerRepository.On("ExistsById", mock.Anything).Return(true)
-	p.providerService.On("ExistsById", request.ProviderId).Return(true)
+	p.withAccess(id)
+	p.providerService.On("ExistsByIdAndUserId", request.ProviderId, mocks.UserId).Return(true)
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(p.T(), errors.New("scheduler configuration not provided"), err)
 
@@ -438,11 +760,12 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithNotSchedulerSpec() {
 func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithErrorFromUpdate() {
 	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
 
-	p.paymentSchedulerRepository.On("ExistsById", mock.Anything).Return(true)
-	p.providerService.On("ExistsById", request.ProviderId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.withAccess(id)
+	p.providerService.On("ExistsByIdAndUserId", request.ProviderId, mocks.UserId).Return(true)
 	p.paymentSchedulerRepository.On("Update", id, request).Return(paymentScheduler.PaymentScheduler{}, errors.New("error"))
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(p.T(), errors.New("error"), err)
 
@@ -453,8 +776,9 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithErrorFromScheduler()
 	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
 	scheduler := mocks.GeneratePaymentScheduler(uuid.New(), uuid.New(), uuid.New())
 
-	p.paymentSchedulerRepository.On("ExistsById", mock.Anything).Return(true)
-	p.providerService.On("ExistsById", request.ProviderId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.withAccess(id)
+	p.providerService.On("ExistsByIdAndUserId", request.ProviderId, mocks.UserId).Return(true)
 	p.paymentSchedulerRepository.On("Update", id, request).Return(scheduler, nil)
 	p.serviceScheduler.On("Update", mock.AnythingOfType("uuid.UUID"), string(request.Spec), mock.Anything).
 		Return(cron.EntryID(0), errors.New("error"))
@@ -466,7 +790,764 @@ func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithErrorFromScheduler()
 
 	p.paymentSchedulerRepository.On("FindById", id).Return(entity, nil)
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 
 	assert.Equal(p.T(), errors.New("error"), err)
 }
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Start_WithPausedScheduler() {
+	p.houseService.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	p.paymentSchedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.Paused = true
+
+	p.paymentSchedulerRepository.On("FindAll").Return([]paymentScheduler.PaymentScheduler{scheduler}, nil)
+	p.serviceScheduler.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	p.serviceScheduler.On("Pause", scheduler.Id).Return(nil)
+
+	err := p.TestO.(*PaymentSchedulerServiceObject).Start()
+
+	assert.Nil(p.T(), err)
+	p.serviceScheduler.AssertCalled(p.T(), "Pause", scheduler.Id)
+	p.serviceScheduler.AssertNotCalled(p.T(), "MissedExecutions", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Pause() {
+	id := uuid.New()
+
+	p.withAccess(id)
+	p.serviceScheduler.On("Pause", id).Return(nil)
+	p.paymentSchedulerRepository.On("UpdatePaused", id, true).Return(nil)
+
+	err := p.TestO.Pause(id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdatePaused", id, true)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Pause_WithViewer() {
+	id := uuid.New()
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+
+	p.paymentSchedulerRepository.On("FindById", id).Return(entity, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(false)
+
+	err := p.TestO.Pause(id, mocks.UserId)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", id), err)
+	p.serviceScheduler.AssertNotCalled(p.T(), "Pause", id)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Pause_WithMissingRecord() {
+	id := uuid.New()
+
+	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, gorm.ErrRecordNotFound)
+
+	err := p.TestO.Pause(id, mocks.UserId)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", id), err)
+	p.serviceScheduler.AssertNotCalled(p.T(), "Pause", id)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Pause_WithErrorFromScheduler() {
+	id := uuid.New()
+	expectedError := errors.New("error")
+
+	p.withAccess(id)
+	p.serviceScheduler.On("Pause", id).Return(expectedError)
+
+	err := p.TestO.Pause(id, mocks.UserId)
+
+	assert.Equal(p.T(), expectedError, err)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdatePaused", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Resume() {
+	id := uuid.New()
+
+	p.withAccess(id)
+	p.serviceScheduler.On("Resume", id).Return(nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("UpdatePaused", id, false).Return(nil)
+
+	err := p.TestO.Resume(id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdateLastExecutedAt", id, mock.AnythingOfType("time.Time"))
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdatePaused", id, false)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Resume_WithMissingRecord() {
+	id := uuid.New()
+
+	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, gorm.ErrRecordNotFound)
+
+	err := p.TestO.Resume(id, mocks.UserId)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", id), err)
+	p.serviceScheduler.AssertNotCalled(p.T(), "Resume", id)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Resume_WithErrorFromScheduler() {
+	id := uuid.New()
+	expectedError := errors.New("error")
+
+	p.withAccess(id)
+	p.serviceScheduler.On("Resume", id).Return(expectedError)
+
+	err := p.TestO.Resume(id, mocks.UserId)
+
+	assert.Equal(p.T(), expectedError, err)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdatePaused", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger() {
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", scheduler.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+	p.paymentService.AssertNumberOfCalls(p.T(), "Add", 1)
+	p.runService.AssertCalled(p.T(), "Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), created.Id)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithCategory() {
+	categoryId := uuid.New()
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.CategoryId = &categoryId
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", scheduler.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), &categoryId, p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest).CategoryId)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithViewer() {
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", scheduler.HouseId, mocks.UserId).Return(false)
+	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+
+	err := p.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", scheduler.Id), err)
+	p.paymentService.AssertNotCalled(p.T(), "Add", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithErrorDuringExecution() {
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	expectedError := errors.New("error")
+
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", scheduler.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	p.paymentService.On("Add", mock.Anything).Return(paymentModel.PaymentDto{}, expectedError)
+	p.runService.On("Failed", scheduler.Id, mock.AnythingOfType("time.Time"), expectedError).Return()
+
+	err := p.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Equal(p.T(), expectedError, err)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdateLastExecutedAt", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithMissingRecord() {
+	id := uuid.New()
+
+	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, gorm.ErrRecordNotFound)
+
+	err := p.TestO.Trigger(id, mocks.UserId)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", id), err)
+	p.paymentService.AssertNotCalled(p.T(), "Add", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithInvalidLimits() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.MaxOccurrences = -1
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), errors.New("max occurrences should not be negative"), err)
+	assert.Empty(p.T(), payment)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithInvalidDateRange() {
+	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
+	startDate := time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	request.StartDate = &startDate
+	request.EndDate = &endDate
+
+	err := p.TestO.Update(id, mocks.UserId, request)
+
+	assert.Equal(p.T(), errors.New("end date should be after start date"), err)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithLastOccurrence() {
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.MaxOccurrences = 2
+	scheduler.Occurrences = 1
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", scheduler.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	p.paymentSchedulerRepository.On("UpdatePaused", scheduler.Id, true).Return(nil)
+	p.serviceScheduler.On("Pause", scheduler.Id).Return(nil)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+	p.paymentService.AssertNumberOfCalls(p.T(), "Add", 1)
+	p.serviceScheduler.AssertCalled(p.T(), "Pause", scheduler.Id)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdatePaused", scheduler.Id, true)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithEndDateInThePast() {
+	endDate := time.Now().Add(-time.Hour)
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.EndDate = &endDate
+
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", scheduler.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+	p.paymentSchedulerRepository.On("UpdatePaused", scheduler.Id, true).Return(nil)
+	p.serviceScheduler.On("Pause", scheduler.Id).Return(nil)
+
+	err := p.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Equal(p.T(), errors.New(fmt.Sprintf("payment scheduler %s is exhausted", scheduler.Id)), err)
+	p.paymentService.AssertNotCalled(p.T(), "Add", mock.Anything)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdatePaused", scheduler.Id, true)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithStartDateInTheFuture() {
+	startDate := time.Now().Add(time.Hour)
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.StartDate = &startDate
+
+	p.houseService.On("HasAccess", scheduler.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", scheduler.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("FindById", scheduler.Id).Return(scheduler, nil)
+
+	err := p.TestO.Trigger(scheduler.Id, mocks.UserId)
+
+	assert.Equal(p.T(), errors.New(fmt.Sprintf("payment scheduler %s is not started yet", scheduler.Id)), err)
+	p.paymentService.AssertNotCalled(p.T(), "Add", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Start_WithMissedExecutionsOutsideOfLimits() {
+	p.houseService.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	p.paymentSchedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	lastExecutedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.Local)
+	first := time.Date(2022, time.January, 2, 0, 0, 0, 0, time.Local)
+	second := time.Date(2022, time.January, 3, 0, 0, 0, 0, time.Local)
+	third := time.Date(2022, time.January, 4, 0, 0, 0, 0, time.Local)
+
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.LastExecutedAt = &lastExecutedAt
+	scheduler.StartDate = &second
+	scheduler.MaxOccurrences = 1
+
+	p.paymentSchedulerRepository.On("FindAll").Return([]paymentScheduler.PaymentScheduler{scheduler}, nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, second).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	p.paymentSchedulerRepository.On("UpdatePaused", scheduler.Id, true).Return(nil)
+	p.serviceScheduler.On("MissedExecutions", "@daily", lastExecutedAt, mock.AnythingOfType("time.Time")).Return([]time.Time{first, second, third}, nil)
+	p.serviceScheduler.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	p.serviceScheduler.On("Pause", scheduler.Id).Return(nil)
+	p.paymentService.On("Add", mock.Anything).Return(paymentModel.PaymentDto{}, nil)
+	p.runService.On("Succeeded", scheduler.Id, second, mock.AnythingOfType("uuid.UUID")).Return()
+	p.lockService.On("Acquire", mock.Anything, mock.Anything).Return(true)
+
+	err := p.TestO.(*PaymentSchedulerServiceObject).Start()
+
+	assert.Nil(p.T(), err)
+	p.paymentService.AssertNumberOfCalls(p.T(), "Add", 1)
+	p.serviceScheduler.AssertCalled(p.T(), "Pause", scheduler.Id)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdatePaused", scheduler.Id, true)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithNotValidSpec() {
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.Spec = "0 0 32 * *"
+
+	payment, err := p.TestO.Add(request)
+
+	assert.ErrorIs(p.T(), err, int_errors.ErrResponse{})
+	assert.Contains(p.T(), err.Error(), "scheduler specification '0 0 32 * *' is not valid")
+	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Update_WithNotValidSpec() {
+	id, request := mocks.GenerateUpdatePaymentSchedulerRequest()
+	request.Spec = "invalid"
+
+	p.withAccess(id)
+	p.providerService.On("ExistsByIdAndUserId", request.ProviderId, mocks.UserId).Return(true)
+
+	err := p.TestO.Update(id, mocks.UserId, request)
+
+	assert.ErrorIs(p.T(), err, int_errors.ErrResponse{})
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_FindNextExecutionsById() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	next := []time.Time{time.Now().Add(time.Hour), time.Now().Add(2 * time.Hour)}
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.serviceScheduler.On("NextExecutions", string(entity.Spec), entity.TimeZone, mock.AnythingOfType("time.Time"), 2).Return(next, nil)
+
+	actual, err := p.TestO.FindNextExecutionsById(entity.Id, mocks.UserId, 2)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), next, actual)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_FindNextExecutionsById_WithLimits() {
+	startDate := time.Now().Add(24 * time.Hour)
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.StartDate = &startDate
+	entity.MaxOccurrences = 3
+	entity.Occurrences = 2
+	next := []time.Time{startDate, startDate.Add(time.Hour)}
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.serviceScheduler.On("NextExecutions", string(entity.Spec), entity.TimeZone, startDate.Add(-time.Nanosecond), 2).Return(next, nil)
+
+	actual, err := p.TestO.FindNextExecutionsById(entity.Id, mocks.UserId, 2)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), next[:1], actual)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_FindNextExecutionsById_WithTimeZone() {
+	serviceScheduler := scheduler.NewSchedulerService(scheduler.NewDefaultSchedulerConfiguration())
+	defer serviceScheduler.Stop()
+	service := NewPaymentSchedulerService(p.userService, p.houseService, p.countryService, p.holidayService, p.paymentService, p.providerService, p.categoryService, p.meterService, serviceScheduler, p.runService, p.lockService, p.paymentSchedulerRepository)
+
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.Spec = "0 9 * * *"
+	entity.TimeZone = "Europe/Kyiv"
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+
+	actual, err := service.FindNextExecutionsById(entity.Id, mocks.UserId, 2)
+
+	assert.Nil(p.T(), err)
+	assert.Len(p.T(), actual, 2)
+	for _, next := range actual {
+		assert.Equal(p.T(), "Europe/Kyiv", next.Location().String())
+		assert.Equal(p.T(), 9, next.Hour())
+		assert.Equal(p.T(), 0, next.Minute())
+	}
+	assert.Equal(p.T(), 24*time.Hour, actual[1].Sub(actual[0]).Round(time.Hour))
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_FindNextExecutionsById_WithInvalidCount() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	expected := errors.New("count should be between 1 and 100")
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.serviceScheduler.On("NextExecutions", string(entity.Spec), entity.TimeZone, mock.AnythingOfType("time.Time"), 0).Return(nil, expected)
+
+	actual, err := p.TestO.FindNextExecutionsById(entity.Id, mocks.UserId, 0)
+
+	assert.Equal(p.T(), expected, err)
+	assert.Nil(p.T(), actual)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_FindNextExecutionsById_WithMissingRecord() {
+	id := uuid.New()
+
+	p.paymentSchedulerRepository.On("FindById", id).Return(paymentScheduler.PaymentScheduler{}, gorm.ErrRecordNotFound)
+
+	actual, err := p.TestO.FindNextExecutionsById(id, mocks.UserId, 2)
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("payment scheduler with id %s not found", id), err)
+	assert.Nil(p.T(), actual)
+	p.serviceScheduler.AssertNotCalled(p.T(), "NextExecutions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithTimeZone() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.TimeZone = "America/New_York"
+
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("Create", mock.Anything).
+		Return(
+			func(model paymentScheduler.PaymentScheduler) paymentScheduler.PaymentScheduler {
+				return model
+			}, nil)
+	p.serviceScheduler.On("Add", mock.AnythingOfType("uuid.UUID"), "CRON_TZ=America/New_York @daily", mock.Anything).
+		Return(cron.EntryID(0), nil)
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Nil(p.T(), err)
+	assert.EqualValues(p.T(), "America/New_York", payment.TimeZone)
+	p.houseService.AssertNotCalled(p.T(), "FindById", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithInvalidTimeZone() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.TimeZone = "Europe/Unknown"
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), errors.New("time zone Europe/Unknown is not valid"), err)
+	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithTimeZone() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.TimeZone = "Europe/Kyiv"
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+
+	createPaymentRequest := p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest)
+
+	assert.Equal(p.T(), "Europe/Kyiv", createPaymentRequest.Date.Location().String())
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithBusinessDayAdjustment() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.Adjustment = scheduler.NextBusinessDay
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+	adjusted := time.Date(2023, time.April, 18, 0, 0, 0, 0, time.UTC)
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	p.houseService.On("FindById", mocks.HouseId, mocks.UserId).Return(houseModel.HouseDto{Id: mocks.HouseId, CountryCode: "UA"}, nil)
+	p.holidayService.On("Adjust", "UA", mock.AnythingOfType("time.Time"), scheduler.NextBusinessDay).Return(adjusted)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+
+	createPaymentRequest := p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest)
+	fireTime := p.runService.Calls[0].Arguments.Get(1).(time.Time)
+
+	assert.Equal(p.T(), adjusted, createPaymentRequest.Date)
+	assert.NotEqual(p.T(), adjusted, fireTime)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdateLastExecutedAt", entity.Id, fireTime)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithNextBusinessDayAfterHoliday() {
+	today := time.Now().In(scheduler.TimeZone("Europe/Kyiv").Location())
+	holidayService := holidays.NewHolidayService([]holidayModel.CountryHolidays{
+		{Code: "UA", Holidays: []holidayModel.Holiday{{Date: today.Format("2006-01-02"), Name: "Test Holiday"}}},
+	})
+	service := NewPaymentSchedulerService(p.userService, p.houseService, p.countryService, holidayService, p.paymentService, p.providerService, p.categoryService, p.meterService, p.serviceScheduler, p.runService, p.lockService, p.paymentSchedulerRepository)
+
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.TimeZone = "Europe/Kyiv"
+	entity.Adjustment = scheduler.NextBusinessDay
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("FindById", mocks.HouseId, mocks.UserId).Return(houseModel.HouseDto{Id: mocks.HouseId, CountryCode: "UA"}, nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := service.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+
+	createPaymentRequest := p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest)
+
+	assert.True(p.T(), createPaymentRequest.Date.After(time.Now()))
+	assert.True(p.T(), holidayService.IsBusinessDay("UA", createPaymentRequest.Date))
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithBusinessDayAdjustmentAndMissingHouse() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.Adjustment = scheduler.PreviousBusinessDay
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	p.houseService.On("FindById", mocks.HouseId, mocks.UserId).Return(houseModel.HouseDto{}, int_errors.NewErrNotFound("house not found"))
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+
+	createPaymentRequest := p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest)
+
+	assert.Equal(p.T(), p.runService.Calls[0].Arguments.Get(1).(time.Time), createPaymentRequest.Date)
+	p.holidayService.AssertNotCalled(p.T(), "Adjust", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithNotSupportedAdjustment() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.Adjustment = "following"
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), errors.New("business day adjustment following is not supported"), err)
+	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithMeter() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.Sum = 0
+	request.MeterName = "Electricity"
+
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.withHouseInKyiv()
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.providerService.On("FindById", mocks.ProviderId, mocks.UserId).Return(providerModel.ProviderDto{Id: mocks.ProviderId, Tariffs: providerModel.Tariffs{"day": 1.44}}, nil)
+	p.paymentSchedulerRepository.On("Create", mock.Anything).
+		Return(
+			func(model paymentScheduler.PaymentScheduler) paymentScheduler.PaymentScheduler {
+				return model
+			}, nil)
+	p.serviceScheduler.On("Add", mock.AnythingOfType("uuid.UUID"), kyivDailySpec, mock.Anything).Return(cron.EntryID(0), nil)
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), "Electricity", payment.MeterName)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithMeterAndProviderWithoutTariffs() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.MeterName = "Electricity"
+
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.providerService.On("FindById", mocks.ProviderId, mocks.UserId).Return(providerModel.ProviderDto{Id: mocks.ProviderId, Name: "Energy"}, nil)
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), errors.New("provider Energy does not have tariffs"), err)
+	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Add_WithMeterAndNegativeSum() {
+	request := mocks.GenerateCreatePaymentSchedulerRequest()
+	request.Sum = -1
+	request.MeterName = "Electricity"
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), errors.New("sum should not be negative"), err)
+	assert.Equal(p.T(), paymentScheduler.PaymentSchedulerDto{}, payment)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithMeter() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.MeterName = "Electricity"
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+	latest := meterModel.MeterDto{Id: uuid.New(), Details: map[string]float64{"day": 1250, "night": 520}}
+	previous := meterModel.MeterDto{Id: uuid.New(), Details: map[string]float64{"day": 1100, "night": 500}}
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	p.paymentSchedulerRepository.On("UpdateLastMeterId", entity.Id, latest.Id).Return(nil)
+	p.meterService.On("FindLatestByNameAndHouseId", "Electricity", mocks.HouseId, 2).Return([]meterModel.MeterDto{latest, previous}, nil)
+	p.providerService.On("FindById", mocks.ProviderId, mocks.UserId).Return(providerModel.ProviderDto{Id: mocks.ProviderId, Tariffs: providerModel.Tariffs{"day": 2, "night": 1}}, nil)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+
+	createPaymentRequest := p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest)
+
+	assert.Equal(p.T(), money.FromMinorUnits(32000), createPaymentRequest.Sum)
+	assert.False(p.T(), createPaymentRequest.Pending)
+	p.paymentSchedulerRepository.AssertCalled(p.T(), "UpdateLastMeterId", entity.Id, latest.Id)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithMeterWithoutNewReading() {
+	latest := meterModel.MeterDto{Id: uuid.New(), Details: map[string]float64{"day": 1250}}
+	previous := meterModel.MeterDto{Id: uuid.New(), Details: map[string]float64{"day": 1100}}
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.MeterName = "Electricity"
+	entity.LastMeterId = &latest.Id
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	p.meterService.On("FindLatestByNameAndHouseId", "Electricity", mocks.HouseId, 2).Return([]meterModel.MeterDto{latest, previous}, nil)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+
+	createPaymentRequest := p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest)
+
+	assert.Equal(p.T(), money.Money(0), createPaymentRequest.Sum)
+	assert.True(p.T(), createPaymentRequest.Pending)
+	p.providerService.AssertNotCalled(p.T(), "FindById", mock.Anything, mock.Anything)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdateLastMeterId", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithMeterWithoutReadings() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.MeterName = "Electricity"
+	created := paymentModel.PaymentDto{Id: uuid.New()}
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", entity.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", entity.Id).Return(nil)
+	p.meterService.On("FindLatestByNameAndHouseId", "Electricity", mocks.HouseId, 2).Return([]meterModel.MeterDto{}, nil)
+	p.paymentService.On("Add", mock.Anything).Return(created, nil)
+	p.runService.On("Succeeded", entity.Id, mock.AnythingOfType("time.Time"), created.Id).Return()
+
+	err := p.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Nil(p.T(), err)
+	assert.True(p.T(), p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest).Pending)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithMeterAndMissingTariff() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.MeterName = "Electricity"
+	latest := meterModel.MeterDto{Id: uuid.New(), Details: map[string]float64{"night": 520}}
+	previous := meterModel.MeterDto{Id: uuid.New(), Details: map[string]float64{"night": 500}}
+	expectedError := errors.New("tariff for the meter details night is not defined")
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.meterService.On("FindLatestByNameAndHouseId", "Electricity", mocks.HouseId, 2).Return([]meterModel.MeterDto{latest, previous}, nil)
+	p.providerService.On("FindById", mocks.ProviderId, mocks.UserId).Return(providerModel.ProviderDto{Id: mocks.ProviderId, Tariffs: providerModel.Tariffs{"day": 2}}, nil)
+	p.runService.On("Failed", entity.Id, mock.AnythingOfType("time.Time"), expectedError).Return()
+
+	err := p.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Equal(p.T(), expectedError, err)
+	p.paymentService.AssertNotCalled(p.T(), "Add", mock.Anything)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdateLastExecutedAt", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Trigger_WithMeterAndMissingPreviousDetails() {
+	entity := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	entity.MeterName = "Electricity"
+	latest := meterModel.MeterDto{Id: uuid.New(), Details: map[string]float64{"day": 1200, "night": 520}}
+	previous := meterModel.MeterDto{Id: uuid.New(), Details: map[string]float64{"day": 1100}}
+	expectedError := errors.New("meter details night is missing in the previous reading")
+
+	p.paymentSchedulerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.houseService.On("HasAccess", entity.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("CanModify", entity.HouseId, mocks.UserId).Return(true)
+	p.meterService.On("FindLatestByNameAndHouseId", "Electricity", mocks.HouseId, 2).Return([]meterModel.MeterDto{latest, previous}, nil)
+	p.providerService.On("FindById", mocks.ProviderId, mocks.UserId).Return(providerModel.ProviderDto{Id: mocks.ProviderId, Tariffs: providerModel.Tariffs{"day": 2, "night": 1}}, nil)
+	p.runService.On("Failed", entity.Id, mock.AnythingOfType("time.Time"), expectedError).Return()
+
+	err := p.TestO.Trigger(entity.Id, mocks.UserId)
+
+	assert.Equal(p.T(), expectedError, err)
+	p.paymentService.AssertNotCalled(p.T(), "Add", mock.Anything)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdateLastExecutedAt", mock.Anything, mock.Anything)
+}
+
+func (p *PaymentSchedulerServiceTestSuite) Test_Start_WithMissedExecutionLockedByAnotherInstance() {
+	p.houseService.On("CountryCurrencies").Return(map[string]string{"UA": "UAH"})
+	p.paymentSchedulerRepository.On("AssignHouseCurrencies", map[string]string{"UA": "UAH"}).Return(nil)
+	lastExecutedAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.Local)
+	first := time.Date(2022, time.January, 2, 0, 0, 0, 0, time.Local)
+	second := time.Date(2022, time.January, 3, 0, 0, 0, 0, time.Local)
+
+	scheduler := mocks.GeneratePaymentScheduler(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	scheduler.LastExecutedAt = &lastExecutedAt
+
+	p.paymentSchedulerRepository.On("FindAll").Return([]paymentScheduler.PaymentScheduler{scheduler}, nil)
+	p.paymentSchedulerRepository.On("UpdateLastExecutedAt", scheduler.Id, mock.AnythingOfType("time.Time")).Return(nil)
+	p.paymentSchedulerRepository.On("IncrementOccurrences", scheduler.Id).Return(nil)
+	p.serviceScheduler.On("MissedExecutions", "@daily", lastExecutedAt, mock.AnythingOfType("time.Time")).Return([]time.Time{first, second}, nil)
+	p.serviceScheduler.On("Add", scheduler.Id, "@daily", mock.Anything).Return(cron.EntryID(1), nil)
+	p.paymentService.On("Add", mock.Anything).Return(paymentModel.PaymentDto{}, nil)
+	p.runService.On("Succeeded", scheduler.Id, mock.AnythingOfType("time.Time"), mock.AnythingOfType("uuid.UUID")).Return()
+	p.lockService.On("Acquire", scheduler.Id, first).Return(false)
+	p.lockService.On("Acquire", scheduler.Id, second).Return(true)
+
+	err := p.TestO.(*PaymentSchedulerServiceObject).Start()
+
+	assert.Nil(p.T(), err)
+	p.paymentService.AssertNumberOfCalls(p.T(), "Add", 1)
+	assert.Equal(p.T(), second, p.paymentService.Calls[0].Arguments.Get(0).(paymentModel.CreatePaymentRequest).Date)
+	p.paymentSchedulerRepository.AssertNotCalled(p.T(), "UpdateLastExecutedAt", scheduler.Id, first)
+}
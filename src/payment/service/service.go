@@ -3,6 +3,7 @@ package service
 import (
 	"errors"
 	"fmt"
+	categories "github.com/VlasovArtem/hob/src/category/service"
 	"github.com/VlasovArtem/hob/src/common"
 	"github.com/VlasovArtem/hob/src/common/database"
 	"github.com/VlasovArtem/hob/src/common/dependency"
@@ -11,8 +12,11 @@ import (
 	"github.com/VlasovArtem/hob/src/payment/model"
 	"github.com/VlasovArtem/hob/src/payment/repository"
 	providers "github.com/VlasovArtem/hob/src/provider/service"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+	tags "github.com/VlasovArtem/hob/src/tag/service"
 	users "github.com/VlasovArtem/hob/src/user/service"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"time"
 )
 
@@ -20,6 +24,8 @@ type PaymentServiceObject struct {
 	userService       users.UserService
 	houseService      houses.HouseService
 	providerService   providers.ProviderService
+	categoryService   categories.CategoryService
+	tagService        tags.TagService
 	paymentRepository repository.PaymentRepository
 }
 
@@ -27,11 +33,15 @@ func NewPaymentService(
 	userService users.UserService,
 	houseService houses.HouseService,
 	providerService providers.ProviderService,
+	categoryService categories.CategoryService,
+	tagService tags.TagService,
 	paymentRepository repository.PaymentRepository) PaymentService {
 	return &PaymentServiceObject{
 		userService:       userService,
 		houseService:      houseService,
 		providerService:   providerService,
+		categoryService:   categoryService,
+		tagService:        tagService,
 		paymentRepository: paymentRepository,
 	}
 }
@@ -41,37 +51,59 @@ func (p *PaymentServiceObject) Initialize(factory dependency.DependenciesProvide
 		dependency.FindRequiredDependency[users.UserServiceObject, users.UserService](factory),
 		dependency.FindRequiredDependency[houses.HouseServiceObject, houses.HouseService](factory),
 		dependency.FindRequiredDependency[providers.ProviderServiceObject, providers.ProviderService](factory),
+		dependency.FindRequiredDependency[categories.CategoryServiceObject, categories.CategoryService](factory),
+		dependency.FindRequiredDependency[tags.TagServiceObject, tags.TagService](factory),
 		dependency.FindRequiredDependency[repository.PaymentRepositoryObject, repository.PaymentRepository](factory),
 	)
 }
 
+// Start assigns the currency of the house country to the payments persisted before the currency was stored
+func (p *PaymentServiceObject) Start() error {
+	if err := p.paymentRepository.AssignHouseCurrencies(p.houseService.CountryCurrencies()); err != nil {
+		log.Error().Err(err).Msg("currencies of the payments are not assigned")
+	}
+	return nil
+}
+
 type PaymentService interface {
 	Add(request model.CreatePaymentRequest) (model.PaymentDto, error)
 	AddBatch(request model.CreatePaymentBatchRequest) ([]model.PaymentDto, error)
-	FindById(id uuid.UUID) (model.PaymentDto, error)
-	FindByHouseId(id uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto
-	FindByUserId(id uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto
-	FindByProviderId(id uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto
+	FindById(id uuid.UUID, userId uuid.UUID) (model.PaymentDto, error)
+	FindByHouseId(id uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time, categoryId *uuid.UUID, tags tagModel.Filter) []model.PaymentDto
+	FindByUserId(id uuid.UUID, limit int, offset int, from, to *time.Time, categoryId *uuid.UUID, tags tagModel.Filter) []model.PaymentDto
+	FindByProviderId(id uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time, tags tagModel.Filter) []model.PaymentDto
 	ExistsById(id uuid.UUID) bool
-	DeleteById(id uuid.UUID) error
-	Update(id uuid.UUID, request model.UpdatePaymentRequest) error
+	CanModify(id uuid.UUID, userId uuid.UUID) bool
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdatePaymentRequest) error
 }
 
 func (p *PaymentServiceObject) Add(request model.CreatePaymentRequest) (response model.PaymentDto, err error) {
 	if !p.userService.ExistsById(request.UserId) {
-		return response, fmt.Errorf("user with id %s not found", request.UserId)
+		return response, interrors.NewErrNotFound("user with id %s not found", request.UserId)
 	}
-	if !p.houseService.ExistsById(request.HouseId) {
-		return response, fmt.Errorf("house with id %s not found", request.HouseId)
+	if !p.houseService.CanModify(request.HouseId, request.UserId) {
+		return response, interrors.NewErrNotFound("house with id %s not found", request.HouseId)
 	}
 
 	if request.ProviderId != nil {
-		if !p.providerService.ExistsById(*request.ProviderId) {
-			return response, fmt.Errorf("provider with id %s not found", request.ProviderId)
+		if !p.providerService.ExistsByIdAndUserId(*request.ProviderId, request.UserId) {
+			return response, interrors.NewErrNotFound("provider with id %s not found", request.ProviderId)
 		}
 	}
+	if request.CategoryId != nil && !p.categoryService.ExistsByIdAndUserId(*request.CategoryId, request.UserId) {
+		return response, interrors.NewErrNotFound("category with id %s not found", request.CategoryId)
+	}
+	if len(request.TagIds) > 0 && !p.tagService.ExistsByIdsAndUserId(request.TagIds, request.UserId) {
+		return response, interrors.NewErrNotFound("tags with ids %s not found", common.Join(request.TagIds, ","))
+	}
 
-	payment, err := p.paymentRepository.Create(request.ToEntity())
+	entity := request.ToEntity()
+	if entity.Currency, err = p.houseService.ResolveCurrency(&request.HouseId, request.Currency); err != nil {
+		return response, err
+	}
+
+	payment, err := p.paymentRepository.Create(entity)
 
 	return payment.ToDto(), err
 }
@@ -82,14 +114,18 @@ func (p *PaymentServiceObject) AddBatch(request model.CreatePaymentBatchRequest)
 	}
 
 	userIds := make(map[uuid.UUID]bool)
-	houseIds := make(map[uuid.UUID]bool)
-	providerIds := make(map[uuid.UUID]bool)
+	houseIds := make(map[uuid.UUID]uuid.UUID)
+	providerIds := make(map[uuid.UUID]uuid.UUID)
+	categoryIds := make(map[uuid.UUID]uuid.UUID)
 
 	entities := common.MapSlice(request.Payments, func(paymentRequest model.CreatePaymentRequest) model.Payment {
 		userIds[paymentRequest.UserId] = true
-		houseIds[paymentRequest.HouseId] = true
+		houseIds[paymentRequest.HouseId] = paymentRequest.UserId
 		if paymentRequest.ProviderId != nil {
-			providerIds[*paymentRequest.ProviderId] = true
+			providerIds[*paymentRequest.ProviderId] = paymentRequest.UserId
+		}
+		if paymentRequest.CategoryId != nil {
+			categoryIds[*paymentRequest.CategoryId] = paymentRequest.UserId
 		}
 
 		return paymentRequest.ToEntity()
@@ -97,24 +133,48 @@ func (p *PaymentServiceObject) AddBatch(request model.CreatePaymentBatchRequest)
 
 	builder := interrors.NewBuilder()
 
+	for _, paymentRequest := range request.Payments {
+		if len(paymentRequest.TagIds) > 0 && !p.tagService.ExistsByIdsAndUserId(paymentRequest.TagIds, paymentRequest.UserId) {
+			builder.WithDetail(fmt.Sprintf("tags with ids %s not found", common.Join(paymentRequest.TagIds, ",")))
+		}
+	}
+
 	for userId := range userIds {
 		if !p.userService.ExistsById(userId) {
 			builder.WithDetail(fmt.Sprintf("user with id %s not found", userId))
 		}
 	}
 
-	for houseId := range houseIds {
-		if !p.houseService.ExistsById(houseId) {
+	for houseId, userId := range houseIds {
+		if !p.houseService.CanModify(houseId, userId) {
 			builder.WithDetail(fmt.Sprintf("house with id %s not found", houseId))
 		}
 	}
 
-	for providerId := range providerIds {
-		if !p.providerService.ExistsById(providerId) {
+	for providerId, userId := range providerIds {
+		if !p.providerService.ExistsByIdAndUserId(providerId, userId) {
 			builder.WithDetail(fmt.Sprintf("provider with id %s not found", providerId))
 		}
 	}
 
+	for categoryId, userId := range categoryIds {
+		if !p.categoryService.ExistsByIdAndUserId(categoryId, userId) {
+			builder.WithDetail(fmt.Sprintf("category with id %s not found", categoryId))
+		}
+	}
+
+	if builder.HasErrors() {
+		return nil, interrors.NewErrResponse(builder.WithMessage("Create payment batch failed"))
+	}
+
+	for index := range entities {
+		if currency, err := p.houseService.ResolveCurrency(&entities[index].HouseId, entities[index].Currency); err != nil {
+			builder.WithDetail(err.Error())
+		} else {
+			entities[index].Currency = currency
+		}
+	}
+
 	if builder.HasErrors() {
 		return nil, interrors.NewErrResponse(builder.WithMessage("Create payment batch failed"))
 	}
@@ -126,46 +186,104 @@ func (p *PaymentServiceObject) AddBatch(request model.CreatePaymentBatchRequest)
 	}
 }
 
-func (p *PaymentServiceObject) FindById(id uuid.UUID) (model.PaymentDto, error) {
+// FindById returns the payment of the house available to the user
+func (p *PaymentServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (model.PaymentDto, error) {
 	if payment, err := p.paymentRepository.FindById(id); err != nil {
 		return model.PaymentDto{}, database.HandlerFindError(err, fmt.Sprintf("payment with id %s not found", id))
+	} else if !p.houseService.HasAccess(payment.HouseId, userId) {
+		return model.PaymentDto{}, interrors.NewErrNotFound("payment with id %s not found", id)
 	} else {
 		return payment.ToDto(), nil
 	}
 }
 
-func (p *PaymentServiceObject) FindByHouseId(houseId uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto {
-	return p.paymentRepository.FindByHouseId(houseId, limit, offset, from, to)
+// FindByHouseId returns the payments of the house, the payments are filtered by the category with its subcategories if it is set
+// and by the tags
+func (p *PaymentServiceObject) FindByHouseId(houseId uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time, categoryId *uuid.UUID, tags tagModel.Filter) []model.PaymentDto {
+	if !p.houseService.HasAccess(houseId, userId) {
+		return make([]model.PaymentDto, 0)
+	}
+	categoryIds, err := p.categoryIds(categoryId, userId)
+	if err != nil {
+		return make([]model.PaymentDto, 0)
+	}
+	return p.paymentRepository.FindByHouseId(houseId, limit, offset, from, to, categoryIds, tags)
 }
 
-func (p *PaymentServiceObject) FindByUserId(userId uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto {
-	return p.paymentRepository.FindByUserId(userId, limit, offset, from, to)
+// FindByUserId returns the payments of the user, the payments are filtered by the category with its subcategories if it is set
+// and by the tags
+func (p *PaymentServiceObject) FindByUserId(userId uuid.UUID, limit int, offset int, from, to *time.Time, categoryId *uuid.UUID, tags tagModel.Filter) []model.PaymentDto {
+	categoryIds, err := p.categoryIds(categoryId, userId)
+	if err != nil {
+		return make([]model.PaymentDto, 0)
+	}
+	return p.paymentRepository.FindByUserId(userId, limit, offset, from, to, categoryIds, tags)
 }
 
-func (p *PaymentServiceObject) FindByProviderId(id uuid.UUID, limit int, offset int, from, to *time.Time) []model.PaymentDto {
-	return p.paymentRepository.FindByProviderId(id, limit, offset, from, to)
+// FindByProviderId returns the payments of the user for the provider, the payments are filtered by the tags
+func (p *PaymentServiceObject) FindByProviderId(id uuid.UUID, userId uuid.UUID, limit int, offset int, from, to *time.Time, tags tagModel.Filter) []model.PaymentDto {
+	return p.paymentRepository.FindByProviderId(id, userId, limit, offset, from, to, tags)
 }
 
 func (p *PaymentServiceObject) ExistsById(id uuid.UUID) bool {
 	return p.paymentRepository.ExistsById(id)
 }
 
-func (p *PaymentServiceObject) DeleteById(id uuid.UUID) error {
-	if !p.ExistsById(id) {
-		return fmt.Errorf("payment with id %s not found", id)
+func (p *PaymentServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if !p.CanModify(id, userId) {
+		return interrors.NewErrNotFound("payment with id %s not found", id)
 	}
 	return p.paymentRepository.DeleteById(id)
 }
 
-func (p *PaymentServiceObject) Update(id uuid.UUID, request model.UpdatePaymentRequest) error {
-	if !p.ExistsById(id) {
-		return fmt.Errorf("payment with id %s not found", id)
+func (p *PaymentServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdatePaymentRequest) error {
+	if !p.CanModify(id, userId) {
+		return interrors.NewErrNotFound("payment with id %s not found", id)
 	}
-	if request.ProviderId != nil && !p.providerService.ExistsById(*request.ProviderId) {
-		return fmt.Errorf("provider with id %s not found", request.ProviderId)
+	if request.ProviderId != nil && !p.providerService.ExistsByIdAndUserId(*request.ProviderId, userId) {
+		return interrors.NewErrNotFound("provider with id %s not found", request.ProviderId)
 	}
-	if request.Date.After(time.Now()) {
+	if request.CategoryId != nil && !p.categoryService.ExistsByIdAndUserId(*request.CategoryId, userId) {
+		return interrors.NewErrNotFound("category with id %s not found", request.CategoryId)
+	}
+	if len(request.TagIds) > 0 && !p.tagService.ExistsByIdsAndUserId(request.TagIds, userId) {
+		return interrors.NewErrNotFound("tags with ids %s not found", common.Join(request.TagIds, ","))
+	}
+	if request.Date.After(time.Now()) && !p.keepsDate(id, request.Date) {
 		return errors.New("date should not be after current date")
 	}
-	return p.paymentRepository.Update(request.UpdateToEntity(id))
+
+	entity := request.UpdateToEntity(id)
+	if request.Currency != "" {
+		currency, err := p.houseService.ResolveCurrency(nil, request.Currency)
+		if err != nil {
+			return err
+		}
+		entity.Currency = currency
+	}
+
+	return p.paymentRepository.Update(entity)
+}
+
+// CanModify checks that the payment belongs to the house the user is allowed to change
+func (p *PaymentServiceObject) CanModify(id uuid.UUID, userId uuid.UUID) bool {
+	payment, err := p.paymentRepository.FindById(id)
+
+	return err == nil && p.houseService.CanModify(payment.HouseId, userId)
+}
+
+// keepsDate checks that the date of the payment is not changed, the payment added by the scheduler keeps the date after the
+// current date if it is moved to the next business day
+func (p *PaymentServiceObject) keepsDate(id uuid.UUID, date time.Time) bool {
+	payment, err := p.paymentRepository.FindById(id)
+
+	return err == nil && payment.Date.Equal(date)
+}
+
+// categoryIds returns the ids of the category and its subcategories, the nil ids disable the filtering by the category
+func (p *PaymentServiceObject) categoryIds(categoryId *uuid.UUID, userId uuid.UUID) ([]uuid.UUID, error) {
+	if categoryId == nil {
+		return nil, nil
+	}
+	return p.categoryService.FindSubcategoryIds(*categoryId, userId)
 }
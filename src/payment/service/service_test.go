@@ -3,12 +3,15 @@ package service
 import (
 	"errors"
 	"fmt"
+	categoryMocks "github.com/VlasovArtem/hob/src/category/mocks"
 	"github.com/VlasovArtem/hob/src/common"
 	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
 	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
 	"github.com/VlasovArtem/hob/src/payment/mocks"
 	"github.com/VlasovArtem/hob/src/payment/model"
 	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
+	tagMocks "github.com/VlasovArtem/hob/src/tag/mocks"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
 	"github.com/google/uuid"
@@ -20,13 +23,18 @@ import (
 	"time"
 )
 
-var nilTime *time.Time
+var (
+	nilTime        *time.Time
+	nilCategoryIds []uuid.UUID
+)
 
 type PaymentServiceTestSuite struct {
 	testhelper.MockTestSuite[PaymentService]
 	userService       *userMocks.UserService
 	houseService      *houseMocks.HouseService
 	providerService   *providerMocks.ProviderService
+	categoryService   *categoryMocks.CategoryService
+	tagService        *tagMocks.TagService
 	paymentRepository *mocks.PaymentRepository
 }
 
@@ -36,9 +44,11 @@ func TestIncomeServiceTestSuite(t *testing.T) {
 		ts.userService = new(userMocks.UserService)
 		ts.houseService = new(houseMocks.HouseService)
 		ts.providerService = new(providerMocks.ProviderService)
+		ts.categoryService = new(categoryMocks.CategoryService)
+		ts.tagService = new(tagMocks.TagService)
 		ts.paymentRepository = new(mocks.PaymentRepository)
 
-		return NewPaymentService(ts.userService, ts.houseService, ts.providerService, ts.paymentRepository)
+		return NewPaymentService(ts.userService, ts.houseService, ts.providerService, ts.categoryService, ts.tagService, ts.paymentRepository)
 	}
 
 	suite.Run(t, ts)
@@ -46,8 +56,9 @@ func TestIncomeServiceTestSuite(t *testing.T) {
 
 func (p *PaymentServiceTestSuite) Test_Add() {
 	p.userService.On("ExistsById", mocks.UserId).Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).Return(true)
-	p.providerService.On("ExistsById", mocks.ProviderId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.paymentRepository.On("Create", mock.Anything).Return(
 		func(payment model.Payment) model.Payment { return payment },
 		nil,
@@ -67,7 +78,8 @@ func (p *PaymentServiceTestSuite) Test_Add() {
 
 func (p *PaymentServiceTestSuite) Test_Add_WithProviderIdNil() {
 	p.userService.On("ExistsById", mocks.UserId).Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.paymentRepository.On("Create", mock.Anything).Return(
 		func(payment model.Payment) model.Payment { return payment },
 		nil,
@@ -85,7 +97,7 @@ func (p *PaymentServiceTestSuite) Test_Add_WithProviderIdNil() {
 	assert.Nil(p.T(), err)
 	assert.Equal(p.T(), expectedResponse, payment)
 
-	p.providerService.AssertNotCalled(p.T(), "ExistsById", mock.Anything)
+	p.providerService.AssertNotCalled(p.T(), "ExistsByIdAndUserId", mock.Anything, mock.Anything)
 }
 
 func (p *PaymentServiceTestSuite) Test_Add_WithUserNotExists() {
@@ -95,32 +107,130 @@ func (p *PaymentServiceTestSuite) Test_Add_WithUserNotExists() {
 
 	payment, err := p.TestO.Add(request)
 
-	assert.Equal(p.T(), fmt.Errorf("user with id %s not found", request.UserId), err)
+	assert.Equal(p.T(), interrors.NewErrNotFound("user with id %s not found", request.UserId), err)
 	assert.Equal(p.T(), model.PaymentDto{}, payment)
 }
 
 func (p *PaymentServiceTestSuite) Test_Add_WithHouseNotExists() {
 	p.userService.On("ExistsById", mocks.UserId).Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).Return(false)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(false)
 
 	request := mocks.GenerateCreatePaymentRequest()
 
 	payment, err := p.TestO.Add(request)
 
-	assert.Equal(p.T(), fmt.Errorf("house with id %s not found", request.HouseId), err)
+	assert.Equal(p.T(), interrors.NewErrNotFound("house with id %s not found", request.HouseId), err)
 	assert.Equal(p.T(), model.PaymentDto{}, payment)
 }
 
 func (p *PaymentServiceTestSuite) Test_Add_WithProviderNotExists() {
 	p.userService.On("ExistsById", mocks.UserId).Return(true)
-	p.houseService.On("ExistsById", mocks.HouseId).Return(true)
-	p.providerService.On("ExistsById", mocks.ProviderId).Return(false)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(false)
+
+	request := mocks.GenerateCreatePaymentRequest()
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), interrors.NewErrNotFound("provider with id %s not found", request.ProviderId), err)
+	assert.Equal(p.T(), model.PaymentDto{}, payment)
+
+	p.paymentRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
+func (p *PaymentServiceTestSuite) Test_Add_WithHouseCurrency() {
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", &mocks.HouseId, "").Return("EUR", nil)
+	p.paymentRepository.On("Create", mock.Anything).Return(
+		func(payment model.Payment) model.Payment { return payment },
+		nil,
+	)
+
+	request := mocks.GenerateCreatePaymentRequest()
+	request.Currency = ""
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), "EUR", payment.Currency)
+}
+
+func (p *PaymentServiceTestSuite) Test_Add_WithNotSupportedCurrency() {
+	expectedError := interrors.NewErrNotFound("currency with code %s is not found", "ABC")
+
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", &mocks.HouseId, "ABC").Return("", expectedError)
+
+	request := mocks.GenerateCreatePaymentRequest()
+	request.Currency = "ABC"
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), expectedError, err)
+	assert.Equal(p.T(), model.PaymentDto{}, payment)
+
+	p.paymentRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
+func (p *PaymentServiceTestSuite) Test_Add_WithCategoryNotExists() {
+	categoryId := uuid.New()
+
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.categoryService.On("ExistsByIdAndUserId", categoryId, mocks.UserId).Return(false)
 
 	request := mocks.GenerateCreatePaymentRequest()
+	request.CategoryId = &categoryId
 
 	payment, err := p.TestO.Add(request)
 
-	assert.Equal(p.T(), fmt.Errorf("provider with id %s not found", request.ProviderId), err)
+	assert.Equal(p.T(), interrors.NewErrNotFound("category with id %s not found", request.CategoryId), err)
+	assert.Equal(p.T(), model.PaymentDto{}, payment)
+
+	p.paymentRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+}
+
+func (p *PaymentServiceTestSuite) Test_Add_WithTags() {
+	tagIds := []uuid.UUID{uuid.New(), uuid.New()}
+
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.tagService.On("ExistsByIdsAndUserId", tagIds, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.paymentRepository.On("Create", mock.Anything).Return(
+		func(payment model.Payment) model.Payment { return payment },
+		nil,
+	)
+
+	request := mocks.GenerateCreatePaymentRequest()
+	request.TagIds = tagIds
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), []tagModel.TagDto{{Id: tagIds[0]}, {Id: tagIds[1]}}, payment.Tags)
+}
+
+func (p *PaymentServiceTestSuite) Test_Add_WithTagsNotExists() {
+	tagIds := []uuid.UUID{uuid.New()}
+
+	p.userService.On("ExistsById", mocks.UserId).Return(true)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mocks.ProviderId, mocks.UserId).Return(true)
+	p.tagService.On("ExistsByIdsAndUserId", tagIds, mocks.UserId).Return(false)
+
+	request := mocks.GenerateCreatePaymentRequest()
+	request.TagIds = tagIds
+
+	payment, err := p.TestO.Add(request)
+
+	assert.Equal(p.T(), interrors.NewErrNotFound("tags with ids %s not found", tagIds[0]), err)
 	assert.Equal(p.T(), model.PaymentDto{}, payment)
 
 	p.paymentRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
@@ -133,8 +243,9 @@ func (p *PaymentServiceTestSuite) Test_AddBatch() {
 	})
 
 	p.userService.On("ExistsById", mock.Anything).Return(true)
-	p.houseService.On("ExistsById", mock.Anything).Return(true)
-	p.providerService.On("ExistsById", mock.Anything).Return(true)
+	p.houseService.On("CanModify", mock.Anything, mock.Anything).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", mock.Anything, mock.Anything).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.paymentRepository.On("CreateBatch", mock.Anything).Return(repositoryResponse, nil)
 
 	batch, err := p.TestO.AddBatch(request)
@@ -151,8 +262,9 @@ func (p *PaymentServiceTestSuite) Test_AddBatch_WithProviderIdNil() {
 	})
 
 	p.userService.On("ExistsById", mock.Anything).Return(true)
-	p.houseService.On("ExistsById", mock.Anything).Return(true)
-	p.providerService.On("ExistsById", *request.Payments[1].ProviderId).Return(true)
+	p.houseService.On("CanModify", mock.Anything, mock.Anything).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.Payments[1].ProviderId, request.Payments[1].UserId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.paymentRepository.On("CreateBatch", mock.Anything).Return(repositoryResponse, nil)
 
 	batch, err := p.TestO.AddBatch(request)
@@ -160,7 +272,7 @@ func (p *PaymentServiceTestSuite) Test_AddBatch_WithProviderIdNil() {
 	assert.Nil(p.T(), err)
 	assert.Equal(p.T(), common.MapSlice(repositoryResponse, model.EntityToDto), batch)
 
-	p.providerService.AssertNumberOfCalls(p.T(), "ExistsById", 1)
+	p.providerService.AssertNumberOfCalls(p.T(), "ExistsByIdAndUserId", 1)
 }
 
 func (p *PaymentServiceTestSuite) Test_AddBatch_WithEmptyData() {
@@ -172,8 +284,8 @@ func (p *PaymentServiceTestSuite) Test_AddBatch_WithEmptyData() {
 	assert.Equal(p.T(), make([]model.PaymentDto, 0), batch)
 
 	p.userService.AssertNotCalled(p.T(), "ExistsById", mock.Anything)
-	p.houseService.AssertNotCalled(p.T(), "ExistsById", mock.Anything)
-	p.providerService.AssertNotCalled(p.T(), "ExistsById", mock.Anything)
+	p.houseService.AssertNotCalled(p.T(), "CanModify", mock.Anything, mock.Anything)
+	p.providerService.AssertNotCalled(p.T(), "ExistsByIdAndUserId", mock.Anything, mock.Anything)
 	p.paymentRepository.AssertNotCalled(p.T(), "CreateBatch", mock.Anything)
 }
 
@@ -187,12 +299,12 @@ func (p *PaymentServiceTestSuite) Test_AddBatch_WithInvalidData() {
 	p.userService.On("ExistsById", request.Payments[0].UserId).Return(false)
 	p.userService.On("ExistsById", request.Payments[1].UserId).Return(true)
 	p.userService.On("ExistsById", request.Payments[2].UserId).Return(true)
-	p.houseService.On("ExistsById", request.Payments[0].HouseId).Return(true)
-	p.houseService.On("ExistsById", request.Payments[1].HouseId).Return(false)
-	p.houseService.On("ExistsById", request.Payments[2].HouseId).Return(true)
-	p.providerService.On("ExistsById", *request.Payments[0].ProviderId).Return(true)
-	p.providerService.On("ExistsById", *request.Payments[1].ProviderId).Return(true)
-	p.providerService.On("ExistsById", *request.Payments[2].ProviderId).Return(false)
+	p.houseService.On("CanModify", request.Payments[0].HouseId, request.Payments[0].UserId).Return(true)
+	p.houseService.On("CanModify", request.Payments[1].HouseId, request.Payments[1].UserId).Return(false)
+	p.houseService.On("CanModify", request.Payments[2].HouseId, request.Payments[2].UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.Payments[0].ProviderId, request.Payments[0].UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.Payments[1].ProviderId, request.Payments[1].UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.Payments[2].ProviderId, request.Payments[2].UserId).Return(false)
 
 	actual, err := p.TestO.AddBatch(request)
 
@@ -218,19 +330,33 @@ func (p *PaymentServiceTestSuite) Test_FindById() {
 	payment := mocks.GeneratePayment(mocks.HouseId, mocks.UserId, mocks.ProviderId)
 
 	p.paymentRepository.On("FindById", payment.Id).Return(payment, nil)
+	p.houseService.On("HasAccess", payment.HouseId, payment.UserId).Return(true)
 
-	actual, err := p.TestO.FindById(payment.Id)
+	actual, err := p.TestO.FindById(payment.Id, payment.UserId)
 
 	assert.Nil(p.T(), err)
 	assert.Equal(p.T(), payment.ToDto(), actual)
 }
 
+func (p *PaymentServiceTestSuite) Test_FindById_WithoutAccess() {
+	payment := mocks.GeneratePayment(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+	userId := uuid.New()
+
+	p.paymentRepository.On("FindById", payment.Id).Return(payment, nil)
+	p.houseService.On("HasAccess", payment.HouseId, userId).Return(false)
+
+	actual, err := p.TestO.FindById(payment.Id, userId)
+
+	assert.Equal(p.T(), interrors.NewErrNotFound("payment with id %s not found", payment.Id), err)
+	assert.Equal(p.T(), model.PaymentDto{}, actual)
+}
+
 func (p *PaymentServiceTestSuite) Test_FindById_WithNotExistingId() {
 	id := uuid.New()
 
 	p.paymentRepository.On("FindById", id).Return(model.Payment{}, gorm.ErrRecordNotFound)
 
-	actual, err := p.TestO.FindById(id)
+	actual, err := p.TestO.FindById(id, uuid.New())
 
 	assert.Equal(p.T(), interrors.NewErrNotFound("payment with id %s not found", id), err)
 	assert.Equal(p.T(), model.PaymentDto{}, actual)
@@ -242,7 +368,7 @@ func (p *PaymentServiceTestSuite) Test_FindById_WithError() {
 
 	p.paymentRepository.On("FindById", id).Return(model.Payment{}, err2)
 
-	actual, err := p.TestO.FindById(id)
+	actual, err := p.TestO.FindById(id, uuid.New())
 
 	assert.Equal(p.T(), err2, err)
 	assert.Equal(p.T(), model.PaymentDto{}, actual)
@@ -251,35 +377,91 @@ func (p *PaymentServiceTestSuite) Test_FindById_WithError() {
 func (p *PaymentServiceTestSuite) Test_FindByHouseId() {
 	payment := mocks.GeneratePayment(mocks.HouseId, mocks.UserId, mocks.ProviderId)
 
-	houseId := uuid.New()
+	houseId, userId := uuid.New(), uuid.New()
 
 	dto := payment.ToDto()
-	p.paymentRepository.On("FindByHouseId", houseId, 0, 1, nilTime, nilTime).Return([]model.PaymentDto{dto})
+	p.houseService.On("HasAccess", houseId, userId).Return(true)
+	p.paymentRepository.On("FindByHouseId", houseId, 0, 1, nilTime, nilTime, nilCategoryIds, tagModel.Filter{}).Return([]model.PaymentDto{dto})
 
-	payments := p.TestO.FindByHouseId(houseId, 0, 1, nil, nil)
+	payments := p.TestO.FindByHouseId(houseId, userId, 0, 1, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{dto}, payments)
 }
 
+func (p *PaymentServiceTestSuite) Test_FindByHouseId_WithCategoryId() {
+	payment := mocks.GeneratePayment(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+
+	houseId, userId, categoryId := uuid.New(), uuid.New(), uuid.New()
+	categoryIds := []uuid.UUID{categoryId, uuid.New()}
+
+	dto := payment.ToDto()
+	p.houseService.On("HasAccess", houseId, userId).Return(true)
+	p.categoryService.On("FindSubcategoryIds", categoryId, userId).Return(categoryIds, nil)
+	p.paymentRepository.On("FindByHouseId", houseId, 0, 1, nilTime, nilTime, categoryIds, tagModel.Filter{}).Return([]model.PaymentDto{dto})
+
+	payments := p.TestO.FindByHouseId(houseId, userId, 0, 1, nil, nil, &categoryId, tagModel.Filter{})
+
+	assert.Equal(p.T(), []model.PaymentDto{dto}, payments)
+}
+
+func (p *PaymentServiceTestSuite) Test_FindByHouseId_WithNotExistingCategory() {
+	houseId, userId, categoryId := uuid.New(), uuid.New(), uuid.New()
+
+	p.houseService.On("HasAccess", houseId, userId).Return(true)
+	p.categoryService.On("FindSubcategoryIds", categoryId, userId).Return(nil, interrors.NewErrNotFound("category with id %s not found", categoryId))
+
+	payments := p.TestO.FindByHouseId(houseId, userId, 0, 1, nil, nil, &categoryId, tagModel.Filter{})
+
+	assert.Equal(p.T(), []model.PaymentDto{}, payments)
+	p.paymentRepository.AssertNotCalled(p.T(), "FindByHouseId", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (p *PaymentServiceTestSuite) Test_FindByHouseId_WithNotExistingRecords() {
-	houseId := uuid.New()
+	houseId, userId := uuid.New(), uuid.New()
 
-	p.paymentRepository.On("FindByHouseId", houseId, 0, 1, nilTime, nilTime).Return([]model.PaymentDto{})
+	p.houseService.On("HasAccess", houseId, userId).Return(true)
+	p.paymentRepository.On("FindByHouseId", houseId, 0, 1, nilTime, nilTime, nilCategoryIds, tagModel.Filter{}).Return([]model.PaymentDto{})
 
-	payments := p.TestO.FindByHouseId(houseId, 0, 1, nil, nil)
+	payments := p.TestO.FindByHouseId(houseId, userId, 0, 1, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{}, payments)
 }
 
+func (p *PaymentServiceTestSuite) Test_FindByHouseId_WithoutAccess() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	p.houseService.On("HasAccess", houseId, userId).Return(false)
+
+	payments := p.TestO.FindByHouseId(houseId, userId, 0, 1, nil, nil, nil, tagModel.Filter{})
+
+	assert.Equal(p.T(), []model.PaymentDto{}, payments)
+	p.paymentRepository.AssertNotCalled(p.T(), "FindByHouseId", houseId, 0, 1, nilTime, nilTime, nilCategoryIds, tagModel.Filter{})
+}
+
 func (p *PaymentServiceTestSuite) Test_FindByUserId() {
 	payment := mocks.GeneratePayment(mocks.HouseId, mocks.UserId, mocks.ProviderId)
 
 	userId := uuid.New()
 
 	dto := payment.ToDto()
-	p.paymentRepository.On("FindByUserId", userId, 0, 1, nilTime, nilTime).Return([]model.PaymentDto{dto})
+	p.paymentRepository.On("FindByUserId", userId, 0, 1, nilTime, nilTime, nilCategoryIds, tagModel.Filter{}).Return([]model.PaymentDto{dto})
+
+	payments := p.TestO.FindByUserId(userId, 0, 1, nil, nil, nil, tagModel.Filter{})
+
+	assert.Equal(p.T(), []model.PaymentDto{dto}, payments)
+}
+
+func (p *PaymentServiceTestSuite) Test_FindByUserId_WithCategoryId() {
+	payment := mocks.GeneratePayment(mocks.HouseId, mocks.UserId, mocks.ProviderId)
+
+	userId, categoryId := uuid.New(), uuid.New()
+	categoryIds := []uuid.UUID{categoryId}
+
+	dto := payment.ToDto()
+	p.categoryService.On("FindSubcategoryIds", categoryId, userId).Return(categoryIds, nil)
+	p.paymentRepository.On("FindByUserId", userId, 0, 1, nilTime, nilTime, categoryIds, tagModel.Filter{}).Return([]model.PaymentDto{dto})
 
-	payments := p.TestO.FindByUserId(userId, 0, 1, nil, nil)
+	payments := p.TestO.FindByUserId(userId, 0, 1, nil, nil, &categoryId, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{dto}, payments)
 }
@@ -287,9 +469,9 @@ func (p *PaymentServiceTestSuite) Test_FindByUserId() {
 func (p *PaymentServiceTestSuite) Test_FindByUserId_WithNotExistingRecords() {
 	userId := uuid.New()
 
-	p.paymentRepository.On("FindByUserId", userId, 0, 1, nilTime, nilTime).Return([]model.PaymentDto{})
+	p.paymentRepository.On("FindByUserId", userId, 0, 1, nilTime, nilTime, nilCategoryIds, tagModel.Filter{}).Return([]model.PaymentDto{})
 
-	payments := p.TestO.FindByUserId(userId, 0, 1, nil, nil)
+	payments := p.TestO.FindByUserId(userId, 0, 1, nil, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{}, payments)
 }
@@ -297,22 +479,20 @@ func (p *PaymentServiceTestSuite) Test_FindByUserId_WithNotExistingRecords() {
 func (p *PaymentServiceTestSuite) Test_FindByProviderId() {
 	payment := mocks.GeneratePayment(mocks.HouseId, mocks.UserId, mocks.ProviderId)
 
-	userId := uuid.New()
-
 	dto := payment.ToDto()
-	p.paymentRepository.On("FindByProviderId", userId, 0, 1, nilTime, nilTime).Return([]model.PaymentDto{dto})
+	p.paymentRepository.On("FindByProviderId", mocks.ProviderId, mocks.UserId, 0, 1, nilTime, nilTime, tagModel.Filter{}).Return([]model.PaymentDto{dto})
 
-	payments := p.TestO.FindByProviderId(userId, 0, 1, nil, nil)
+	payments := p.TestO.FindByProviderId(mocks.ProviderId, mocks.UserId, 0, 1, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{dto}, payments)
 }
 
 func (p *PaymentServiceTestSuite) Test_FindByProviderId_WithNotExistingRecords() {
-	userId := uuid.New()
+	providerId, userId := uuid.New(), uuid.New()
 
-	p.paymentRepository.On("FindByProviderId", userId, 0, 1, nilTime, nilTime).Return([]model.PaymentDto{})
+	p.paymentRepository.On("FindByProviderId", providerId, userId, 0, 1, nilTime, nilTime, tagModel.Filter{}).Return([]model.PaymentDto{})
 
-	payments := p.TestO.FindByProviderId(userId, 0, 1, nil, nil)
+	payments := p.TestO.FindByProviderId(providerId, userId, 0, 1, nil, nil, tagModel.Filter{})
 
 	assert.Equal(p.T(), []model.PaymentDto{}, payments)
 }
@@ -336,39 +516,74 @@ func (p *PaymentServiceTestSuite) Test_ExistsById_WithNotExists() {
 func (p *PaymentServiceTestSuite) Test_DeleteById() {
 	id := uuid.New()
 
-	p.paymentRepository.On("ExistsById", id).Return(true)
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
 	p.paymentRepository.On("DeleteById", id).Return(nil)
 
-	assert.Nil(p.T(), p.TestO.DeleteById(id))
+	assert.Nil(p.T(), p.TestO.DeleteById(id, mocks.UserId))
 }
 
 func (p *PaymentServiceTestSuite) Test_DeleteById_WithNotExists() {
 	id := uuid.New()
 
-	p.paymentRepository.On("ExistsById", id).Return(false)
+	p.paymentRepository.On("FindById", id).Return(model.Payment{}, gorm.ErrRecordNotFound)
 
-	assert.Equal(p.T(), fmt.Errorf("payment with id %s not found", id), p.TestO.DeleteById(id))
+	assert.Equal(p.T(), interrors.NewErrNotFound("payment with id %s not found", id), p.TestO.DeleteById(id, mocks.UserId))
 
 	p.paymentRepository.AssertNotCalled(p.T(), "DeleteById", id)
 }
 
+func (p *PaymentServiceTestSuite) Test_DeleteById_WithoutAccess() {
+	id := uuid.New()
+
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(false)
+
+	assert.Equal(p.T(), interrors.NewErrNotFound("payment with id %s not found", id), p.TestO.DeleteById(id, mocks.UserId))
+
+	p.paymentRepository.AssertNotCalled(p.T(), "DeleteById", id)
+}
+
+func (p *PaymentServiceTestSuite) Test_CanModify() {
+	id := uuid.New()
+
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+
+	assert.True(p.T(), p.TestO.CanModify(id, mocks.UserId))
+}
+
+func (p *PaymentServiceTestSuite) Test_CanModify_WithMissingPayment() {
+	id := uuid.New()
+
+	p.paymentRepository.On("FindById", id).Return(model.Payment{}, gorm.ErrRecordNotFound)
+
+	assert.False(p.T(), p.TestO.CanModify(id, mocks.UserId))
+
+	p.houseService.AssertNotCalled(p.T(), "CanModify", mock.Anything, mock.Anything)
+}
+
 func (p *PaymentServiceTestSuite) Test_Update() {
 	request := mocks.GenerateUpdatePaymentRequest()
 	id := uuid.New()
 
-	p.paymentRepository.On("ExistsById", id).Return(true)
-	p.providerService.On("ExistsById", *request.ProviderId).Return(true)
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.ProviderId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.paymentRepository.On("Update", mock.Anything).Return(nil)
 
-	assert.Nil(p.T(), p.TestO.Update(id, request))
+	assert.Nil(p.T(), p.TestO.Update(id, mocks.UserId, request))
 
 	p.paymentRepository.AssertCalled(p.T(), "Update", model.Payment{
 		Id:          id,
 		Name:        request.Name,
 		Description: request.Description,
 		ProviderId:  request.ProviderId,
+		Tags:        []tagModel.Tag{},
 		Date:        request.Date,
 		Sum:         request.Sum,
+		Currency:    request.Currency,
 	})
 }
 
@@ -376,22 +591,41 @@ func (p *PaymentServiceTestSuite) Test_Update_WithErrorFromDatabase() {
 	request := mocks.GenerateUpdatePaymentRequest()
 	id := uuid.New()
 
-	p.paymentRepository.On("ExistsById", id).Return(true)
-	p.providerService.On("ExistsById", *request.ProviderId).Return(true)
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.ProviderId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
 	p.paymentRepository.On("Update", mock.Anything).Return(errors.New("test"))
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 	assert.Equal(p.T(), errors.New("test"), err)
 }
 
+func (p *PaymentServiceTestSuite) Test_Update_WithNotSupportedCurrency() {
+	request := mocks.GenerateUpdatePaymentRequest()
+	request.Currency = "ABC"
+	id := uuid.New()
+	expectedError := interrors.NewErrNotFound("currency with code %s is not found", "ABC")
+
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.ProviderId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "ABC").Return("", expectedError)
+
+	err := p.TestO.Update(id, mocks.UserId, request)
+	assert.Equal(p.T(), expectedError, err)
+
+	p.paymentRepository.AssertNotCalled(p.T(), "Update", mock.Anything)
+}
+
 func (p *PaymentServiceTestSuite) Test_Update_WithNotExists() {
 	request := mocks.GenerateUpdatePaymentRequest()
 	id := uuid.New()
 
-	p.paymentRepository.On("ExistsById", id).Return(false)
+	p.paymentRepository.On("FindById", id).Return(model.Payment{}, gorm.ErrRecordNotFound)
 
-	err := p.TestO.Update(id, request)
-	assert.Equal(p.T(), fmt.Errorf("payment with id %s not found", id), err)
+	err := p.TestO.Update(id, mocks.UserId, request)
+	assert.Equal(p.T(), interrors.NewErrNotFound("payment with id %s not found", id), err)
 
 	p.paymentRepository.AssertNotCalled(p.T(), "Update", mock.Anything)
 }
@@ -401,24 +635,91 @@ func (p *PaymentServiceTestSuite) Test_Update_WithDateAfterCurrentDate() {
 	request.Date = time.Now().Add(time.Hour)
 	id := uuid.New()
 
-	p.paymentRepository.On("ExistsById", id).Return(true)
-	p.providerService.On("ExistsById", *request.ProviderId).Return(true)
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.ProviderId, mocks.UserId).Return(true)
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, mocks.UserId, request)
 	assert.Equal(p.T(), errors.New("date should not be after current date"), err)
 
 	p.paymentRepository.AssertNotCalled(p.T(), "Update", mock.Anything)
 }
 
+func (p *PaymentServiceTestSuite) Test_Update_WithUnchangedDateAfterCurrentDate() {
+	request := mocks.GenerateUpdatePaymentRequest()
+	request.Date = time.Now().AddDate(0, 0, 1)
+	id := uuid.New()
+
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId, Date: request.Date}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.ProviderId, mocks.UserId).Return(true)
+	p.houseService.On("ResolveCurrency", mock.Anything, "UAH").Return("UAH", nil)
+	p.paymentRepository.On("Update", mock.Anything).Return(nil)
+
+	assert.Nil(p.T(), p.TestO.Update(id, mocks.UserId, request))
+}
+
 func (p *PaymentServiceTestSuite) Test_Update_WithProviderNotExists() {
 	request := mocks.GenerateUpdatePaymentRequest()
 	id := uuid.New()
 
-	p.paymentRepository.On("ExistsById", id).Return(true)
-	p.providerService.On("ExistsById", *request.ProviderId).Return(false)
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.ProviderId, mocks.UserId).Return(false)
 
-	err := p.TestO.Update(id, request)
-	assert.Equal(p.T(), fmt.Errorf("provider with id %s not found", request.ProviderId), err)
+	err := p.TestO.Update(id, mocks.UserId, request)
+	assert.Equal(p.T(), interrors.NewErrNotFound("provider with id %s not found", request.ProviderId), err)
 
 	p.paymentRepository.AssertNotCalled(p.T(), "Update", mock.Anything)
 }
+
+func (p *PaymentServiceTestSuite) Test_Update_WithCategoryNotExists() {
+	request := mocks.GenerateUpdatePaymentRequest()
+	categoryId := uuid.New()
+	request.CategoryId = &categoryId
+	id := uuid.New()
+
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.ProviderId, mocks.UserId).Return(true)
+	p.categoryService.On("ExistsByIdAndUserId", categoryId, mocks.UserId).Return(false)
+
+	err := p.TestO.Update(id, mocks.UserId, request)
+	assert.Equal(p.T(), interrors.NewErrNotFound("category with id %s not found", request.CategoryId), err)
+
+	p.paymentRepository.AssertNotCalled(p.T(), "Update", mock.Anything)
+}
+
+func (p *PaymentServiceTestSuite) Test_Update_WithTagsNotExists() {
+	request := mocks.GenerateUpdatePaymentRequest()
+	tagIds := []uuid.UUID{uuid.New()}
+	request.TagIds = tagIds
+	id := uuid.New()
+
+	p.paymentRepository.On("FindById", id).Return(model.Payment{HouseId: mocks.HouseId}, nil)
+	p.houseService.On("CanModify", mocks.HouseId, mocks.UserId).Return(true)
+	p.providerService.On("ExistsByIdAndUserId", *request.ProviderId, mocks.UserId).Return(true)
+	p.tagService.On("ExistsByIdsAndUserId", tagIds, mocks.UserId).Return(false)
+
+	err := p.TestO.Update(id, mocks.UserId, request)
+	assert.Equal(p.T(), interrors.NewErrNotFound("tags with ids %s not found", tagIds[0]), err)
+
+	p.paymentRepository.AssertNotCalled(p.T(), "Update", mock.Anything)
+}
+
+func (p *PaymentServiceTestSuite) Test_Start() {
+	currencies := map[string]string{"UA": "UAH"}
+
+	p.houseService.On("CountryCurrencies").Return(currencies)
+	p.paymentRepository.On("AssignHouseCurrencies", currencies).Return(nil)
+
+	assert.Nil(p.T(), p.TestO.(*PaymentServiceObject).Start())
+	p.paymentRepository.AssertCalled(p.T(), "AssignHouseCurrencies", currencies)
+}
+
+func (p *PaymentServiceTestSuite) Test_Start_WithErrorFromRepository() {
+	p.houseService.On("CountryCurrencies").Return(map[string]string{})
+	p.paymentRepository.On("AssignHouseCurrencies", mock.Anything).Return(errors.New("error"))
+
+	assert.Nil(p.T(), p.TestO.(*PaymentServiceObject).Start())
+}
@@ -5,7 +5,6 @@ import (
 	"github.com/VlasovArtem/hob/src/common/rest"
 	"github.com/VlasovArtem/hob/src/provider/model"
 	"github.com/VlasovArtem/hob/src/provider/service"
-	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"net/http"
 )
@@ -50,9 +49,17 @@ type ProviderHandler interface {
 
 func (p *ProviderHandlerObject) Add() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
 		if body, err := rest.ReadRequestBody[model.CreateProviderRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
+			body.UserId = userId
+
 			rest.NewAPIResponse(writer).
 				Created(p.providerService.Add(body)).
 				Perform()
@@ -62,13 +69,13 @@ func (p *ProviderHandlerObject) Add() http.HandlerFunc {
 
 func (p *ProviderHandlerObject) Delete() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 
 			return
 		} else {
 			rest.NewAPIResponse(writer).
-				NoContent(p.providerService.Delete(id)).
+				NoContent(p.providerService.Delete(id, userId)).
 				Perform()
 		}
 	}
@@ -76,14 +83,14 @@ func (p *ProviderHandlerObject) Delete() http.HandlerFunc {
 
 func (p *ProviderHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdateProviderRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
 				rest.NewAPIResponse(writer).
-					Error(p.providerService.Update(id, body)).
+					Error(p.providerService.Update(id, userId, body)).
 					Perform()
 			}
 		}
@@ -92,11 +99,11 @@ func (p *ProviderHandlerObject) Update() http.HandlerFunc {
 
 func (p *ProviderHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				Ok(p.providerService.FindById(id)).
+				Ok(p.providerService.FindById(id, userId)).
 				Perform()
 		}
 	}
@@ -104,7 +111,7 @@ func (p *ProviderHandlerObject) FindById() http.HandlerFunc {
 
 func (p *ProviderHandlerObject) FindBy() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		userId, err := rest.GetQueryParam[uuid.UUID](request, "userId")
+		userId, err := rest.GetUserId(request)
 		if err != nil {
 			rest.HandleWithError(writer, err)
 			return
@@ -38,6 +38,7 @@ func (p *ProviderHandlerTestSuite) Test_Add() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/providers").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(p.TestO.Add()).
 		WithBody(request)
 
@@ -59,6 +60,7 @@ func (p *ProviderHandlerTestSuite) Test_Add_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/providers").
 		WithMethod("POST").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.Add())
 
 	testRequest.Verify(p.T(), http.StatusBadRequest)
@@ -74,6 +76,7 @@ func (p *ProviderHandlerTestSuite) Test_Add_WithErrorFromService() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/providers").
 		WithMethod("POST").
+		WithUser(request.UserId).
 		WithHandler(p.TestO.Add()).
 		WithBody(request)
 
@@ -83,14 +86,16 @@ func (p *ProviderHandlerTestSuite) Test_Add_WithErrorFromService() {
 }
 
 func (p *ProviderHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
 	request := mocks.GenerateCreateProviderRequest()
 	expected := request.ToEntity().ToDto()
 
-	p.providerService.On("FindById", expected.Id).Return(expected, nil)
+	p.providerService.On("FindById", expected.Id, userId).Return(expected, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/providers/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindById()).
 		WithVar("id", expected.Id.String())
 
@@ -106,11 +111,14 @@ func (p *ProviderHandlerTestSuite) Test_FindById() {
 }
 
 func (p *ProviderHandlerTestSuite) Test_FindById_WithErrorFromService() {
-	p.providerService.On("FindById", mock.Anything).Return(model.ProviderDto{}, errors.New("test"))
+	userId := uuid.New()
+
+	p.providerService.On("FindById", mock.Anything, userId).Return(model.ProviderDto{}, errors.New("test"))
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/providers/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindById()).
 		WithVar("id", uuid.New().String())
 
@@ -120,11 +128,14 @@ func (p *ProviderHandlerTestSuite) Test_FindById_WithErrorFromService() {
 }
 
 func (p *ProviderHandlerTestSuite) Test_FindById_WithNotFoundErrorFromService() {
-	p.providerService.On("FindById", mock.Anything).Return(model.ProviderDto{}, int_errors.NewErrNotFound("test"))
+	userId := uuid.New()
+
+	p.providerService.On("FindById", mock.Anything, userId).Return(model.ProviderDto{}, int_errors.NewErrNotFound("test"))
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/providers/{id}").
 		WithMethod("GET").
+		WithUser(userId).
 		WithHandler(p.TestO.FindById()).
 		WithVar("id", uuid.New().String())
 
@@ -137,6 +148,7 @@ func (p *ProviderHandlerTestSuite) Test_FindById_WithMissingParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/providers/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.FindById())
 
 	content := testRequest.Verify(p.T(), http.StatusBadRequest)
@@ -148,6 +160,7 @@ func (p *ProviderHandlerTestSuite) Test_FindById_WithInvalidUUID() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/providers/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(p.TestO.FindById()).
 		WithVar("id", "id")
 
@@ -163,10 +176,10 @@ func (p *ProviderHandlerTestSuite) Test_FindBy() {
 	p.providerService.On("FindByNameLikeAndUserId", "Name", userId, 1, 15).Return(expected)
 
 	testRequest := testhelper.NewTestRequest().
-		WithURL("https://test.com/api/v1/providers?userId={userId}&page=1&size=15&name=Name").
+		WithURL("https://test.com/api/v1/providers?page=1&size=15&name=Name").
 		WithMethod("GET").
-		WithHandler(p.TestO.FindBy()).
-		WithParameter("userId", userId.String())
+		WithUser(userId).
+		WithHandler(p.TestO.FindBy())
 
 	content := testRequest.Verify(p.T(), http.StatusOK)
 
@@ -179,21 +192,16 @@ func (p *ProviderHandlerTestSuite) Test_FindBy() {
 	assert.Equal(p.T(), expected, actual)
 }
 
-func (p *ProviderHandlerTestSuite) Test_FindBy_WithInvalidId() {
-	expected := []model.ProviderDto{mocks.GenerateProviderDto()}
-	userId := expected[0].UserId
-
-	p.providerService.On("FindByNameLikeAndUserId", "Name", userId, 1, 15).Return(expected)
-
+func (p *ProviderHandlerTestSuite) Test_FindBy_WithoutUser() {
 	testRequest := testhelper.NewTestRequest().
-		WithURL("https://test.com/api/v1/providers?userId={userId}&page=1&size=15&name=Name").
+		WithURL("https://test.com/api/v1/providers?page=1&size=15&name=Name").
 		WithMethod("GET").
-		WithHandler(p.TestO.FindBy()).
-		WithParameter("userId", "id")
+		WithHandler(p.TestO.FindBy())
 
-	content := testRequest.Verify(p.T(), http.StatusBadRequest)
+	content := testRequest.Verify(p.T(), http.StatusUnauthorized)
 
-	assert.Equal(p.T(), "the id is not valid UUID\n", string(content))
+	assert.Equal(p.T(), "user is not authenticated\n", string(content))
+	p.providerService.AssertNotCalled(p.T(), "FindByNameLikeAndUserId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (p *ProviderHandlerTestSuite) Test_FindBy_WithDefaultValues() {
@@ -203,10 +211,10 @@ func (p *ProviderHandlerTestSuite) Test_FindBy_WithDefaultValues() {
 	p.providerService.On("FindByNameLikeAndUserId", "", userId, 0, 25).Return(expected)
 
 	testRequest := testhelper.NewTestRequest().
-		WithURL("https://test.com/api/v1/providers?userId={userId}").
+		WithURL("https://test.com/api/v1/providers").
 		WithMethod("GET").
-		WithHandler(p.TestO.FindBy()).
-		WithParameter("userId", userId.String())
+		WithUser(userId).
+		WithHandler(p.TestO.FindBy())
 
 	_ = testRequest.Verify(p.T(), http.StatusOK)
 }
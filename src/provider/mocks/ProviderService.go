@@ -35,13 +35,13 @@ func (_m *ProviderService) Add(request model.CreateProviderRequest) (model.Provi
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *ProviderService) Delete(id uuid.UUID) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: id, userId
+func (_m *ProviderService) Delete(id uuid.UUID, userId uuid.UUID) error {
+	ret := _m.Called(id, userId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -63,20 +63,34 @@ func (_m *ProviderService) ExistsById(id uuid.UUID) bool {
 	return r0
 }
 
-// FindById provides a mock function with given fields: id
-func (_m *ProviderService) FindById(id uuid.UUID) (model.ProviderDto, error) {
-	ret := _m.Called(id)
+// ExistsByIdAndUserId provides a mock function with given fields: id, userId
+func (_m *ProviderService) ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool {
+	ret := _m.Called(id, userId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(id, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FindById provides a mock function with given fields: id, userId
+func (_m *ProviderService) FindById(id uuid.UUID, userId uuid.UUID) (model.ProviderDto, error) {
+	ret := _m.Called(id, userId)
 
 	var r0 model.ProviderDto
-	if rf, ok := ret.Get(0).(func(uuid.UUID) model.ProviderDto); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID) model.ProviderDto); ok {
+		r0 = rf(id, userId)
 	} else {
 		r0 = ret.Get(0).(model.ProviderDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(id, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,13 +130,13 @@ func (_m *ProviderService) FindByUserId(id uuid.UUID) []model.ProviderDto {
 	return r0
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *ProviderService) Update(id uuid.UUID, request model.UpdateProviderRequest) error {
-	ret := _m.Called(id, request)
+// Update provides a mock function with given fields: id, userId, request
+func (_m *ProviderService) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateProviderRequest) error {
+	ret := _m.Called(id, userId, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdateProviderRequest) error); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, uuid.UUID, model.UpdateProviderRequest) error); ok {
+		r0 = rf(id, userId, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -9,6 +9,7 @@ type Provider struct {
 	Id      uuid.UUID `gorm:"primarykey;type:uuid"`
 	Name    string    `gorm:"index:idx_name_userid,unique"`
 	Details string
+	Tariffs Tariffs
 	UserId  uuid.UUID      `gorm:"index:idx_name_userid,unique"`
 	User    userModel.User `gorm:"foreignKey:UserId"`
 }
@@ -16,18 +17,21 @@ type Provider struct {
 type CreateProviderRequest struct {
 	Name    string
 	Details string
+	Tariffs Tariffs
 	UserId  uuid.UUID
 }
 
 type UpdateProviderRequest struct {
 	Name    string
 	Details string
+	Tariffs Tariffs
 }
 
 type ProviderDto struct {
 	Id      uuid.UUID
 	Name    string
 	Details string
+	Tariffs Tariffs
 	UserId  uuid.UUID
 }
 
@@ -36,6 +40,7 @@ func (p Provider) ToDto() ProviderDto {
 		Id:      p.Id,
 		Name:    p.Name,
 		Details: p.Details,
+		Tariffs: p.Tariffs,
 		UserId:  p.UserId,
 	}
 }
@@ -45,6 +50,7 @@ func (c CreateProviderRequest) ToEntity() Provider {
 		Id:      uuid.New(),
 		Name:    c.Name,
 		Details: c.Details,
+		Tariffs: c.Tariffs,
 		UserId:  c.UserId,
 	}
 }
@@ -54,5 +60,6 @@ func (u UpdateProviderRequest) ToEntity(id uuid.UUID) Provider {
 		Id:      id,
 		Name:    u.Name,
 		Details: u.Details,
+		Tariffs: u.Tariffs,
 	}
 }
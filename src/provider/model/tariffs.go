@@ -0,0 +1,64 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/common/money"
+)
+
+// Tariffs are the prices per unit of consumption by the meter details key (ex. day, night)
+type Tariffs map[string]float64
+
+func (t Tariffs) GormDataType() string {
+	return "bytes"
+}
+
+func (t Tariffs) Value() (driver.Value, error) {
+	if t == nil {
+		return nil, nil
+	}
+	return json.Marshal(t)
+}
+
+func (t *Tariffs) Scan(value any) error {
+	if value == nil {
+		*t = nil
+		return nil
+	}
+
+	var content []byte
+	switch v := value.(type) {
+	case []byte:
+		content = v
+	case string:
+		content = []byte(v)
+	default:
+		return errors.New(fmt.Sprintf("tariffs could not be scanned from %T", value))
+	}
+
+	return json.Unmarshal(content, t)
+}
+
+// Cost calculates the price of the consumption between the previous and the latest meter details rounded to the minor units,
+// the consumption of the details key missing in the previous details is unknown
+func (t Tariffs) Cost(previous, latest map[string]float64) (money.Money, error) {
+	var cost float64
+	for key, value := range latest {
+		previousValue, ok := previous[key]
+		if !ok {
+			return 0, errors.New(fmt.Sprintf("meter details %s is missing in the previous reading", key))
+		}
+		delta := value - previousValue
+		if delta < 0 {
+			return 0, errors.New(fmt.Sprintf("meter details %s decreased from %.2f to %.2f", key, previousValue, value))
+		}
+		tariff, ok := t[key]
+		if !ok {
+			return 0, errors.New(fmt.Sprintf("tariff for the meter details %s is not defined", key))
+		}
+		cost += delta * tariff
+	}
+	return money.FromFloat(cost), nil
+}
@@ -26,9 +26,10 @@ func (p *ProviderServiceObject) Initialize(factory dependency.DependenciesProvid
 type ProviderService interface {
 	Add(request model.CreateProviderRequest) (dto model.ProviderDto, err error)
 	ExistsById(id uuid.UUID) bool
-	Update(id uuid.UUID, request model.UpdateProviderRequest) error
-	Delete(id uuid.UUID) error
-	FindById(id uuid.UUID) (dto model.ProviderDto, err error)
+	ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateProviderRequest) error
+	Delete(id uuid.UUID, userId uuid.UUID) error
+	FindById(id uuid.UUID, userId uuid.UUID) (dto model.ProviderDto, err error)
 	FindByUserId(id uuid.UUID) []model.ProviderDto
 	FindByNameLikeAndUserId(namePattern string, userId uuid.UUID, page, size int) []model.ProviderDto
 }
@@ -45,12 +46,15 @@ func (p *ProviderServiceObject) Add(request model.CreateProviderRequest) (dto mo
 	}
 }
 
-func (p *ProviderServiceObject) FindById(id uuid.UUID) (dto model.ProviderDto, err error) {
+// FindById returns the provider of the user or the provider shared with all the users
+func (p *ProviderServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (dto model.ProviderDto, err error) {
 	if provider, err := p.repository.FindById(id); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return dto, notFoundError(id)
 		}
 		return dto, err
+	} else if !isReadable(provider, userId) {
+		return dto, notFoundError(id)
 	} else {
 		return provider.ToDto(), err
 	}
@@ -64,8 +68,8 @@ func (p *ProviderServiceObject) FindByNameLikeAndUserId(namePattern string, user
 	return p.repository.FindByNameLikeAndUserId(namePattern, page, size, userId)
 }
 
-func (p *ProviderServiceObject) Update(id uuid.UUID, request model.UpdateProviderRequest) error {
-	if !p.repository.ExistsById(id) {
+func (p *ProviderServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateProviderRequest) error {
+	if !p.isOwner(id, userId) {
 		return notFoundError(id)
 	}
 	return p.repository.Update(request.ToEntity(id))
@@ -75,13 +79,31 @@ func (p *ProviderServiceObject) ExistsById(id uuid.UUID) bool {
 	return p.repository.ExistsById(id)
 }
 
-func (p *ProviderServiceObject) Delete(id uuid.UUID) error {
-	if !p.repository.ExistsById(id) {
+// ExistsByIdAndUserId checks that the provider is available to the user
+func (p *ProviderServiceObject) ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool {
+	provider, err := p.repository.FindById(id)
+
+	return err == nil && isReadable(provider, userId)
+}
+
+func (p *ProviderServiceObject) Delete(id uuid.UUID, userId uuid.UUID) error {
+	if !p.isOwner(id, userId) {
 		return notFoundError(id)
 	}
 	return p.repository.Delete(id)
 }
 
+// isOwner checks that the provider could be changed by the user, the shared providers are read only
+func (p *ProviderServiceObject) isOwner(id uuid.UUID, userId uuid.UUID) bool {
+	provider, err := p.repository.FindById(id)
+
+	return err == nil && provider.UserId == userId
+}
+
+func isReadable(provider model.Provider, userId uuid.UUID) bool {
+	return provider.UserId == userId || provider.UserId == repository.DefaultUser
+}
+
 func notFoundError(id uuid.UUID) error {
 	return int_errors.NewErrNotFound("provider with id %s not found", id)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/VlasovArtem/hob/src/common/int-errors"
 	"github.com/VlasovArtem/hob/src/provider/mocks"
 	"github.com/VlasovArtem/hob/src/provider/model"
+	"github.com/VlasovArtem/hob/src/provider/repository"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -80,18 +81,40 @@ func (p *ProviderServiceTestSuite) Test_FindById() {
 
 	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
 
-	dto, err := p.TestO.FindById(entity.Id)
+	dto, err := p.TestO.FindById(entity.Id, entity.UserId)
 
 	assert.Nil(p.T(), err)
 	assert.Equal(p.T(), entity.ToDto(), dto)
 }
 
+func (p *ProviderServiceTestSuite) Test_FindById_WithDefaultUser() {
+	entity := mocks.GenerateProvider(repository.DefaultUser)
+
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
+
+	dto, err := p.TestO.FindById(entity.Id, uuid.New())
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), entity.ToDto(), dto)
+}
+
+func (p *ProviderServiceTestSuite) Test_FindById_WithAnotherUser() {
+	entity := mocks.GenerateProvider(uuid.New())
+
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
+
+	dto, err := p.TestO.FindById(entity.Id, uuid.New())
+
+	assert.Equal(p.T(), int_errors.NewErrNotFound("provider with id %s not found", entity.Id), err)
+	assert.Equal(p.T(), model.ProviderDto{}, dto)
+}
+
 func (p *ProviderServiceTestSuite) Test_FindById_WithNotExistsRecord() {
 	id := uuid.New()
 
 	p.providerRepository.On("FindById", id).Return(model.Provider{}, gorm.ErrRecordNotFound)
 
-	response, err := p.TestO.FindById(id)
+	response, err := p.TestO.FindById(id, uuid.New())
 
 	assert.Equal(p.T(), int_errors.NewErrNotFound("provider with id %s not found", id), err)
 	assert.Equal(p.T(), model.ProviderDto{}, response)
@@ -103,12 +126,29 @@ func (p *ProviderServiceTestSuite) Test_FindById_WithError() {
 
 	p.providerRepository.On("FindById", id).Return(model.Provider{}, expectedError)
 
-	response, err := p.TestO.FindById(id)
+	response, err := p.TestO.FindById(id, uuid.New())
 
 	assert.Equal(p.T(), expectedError, err)
 	assert.Equal(p.T(), model.ProviderDto{}, response)
 }
 
+func (p *ProviderServiceTestSuite) Test_ExistsByIdAndUserId() {
+	entity := mocks.GenerateProvider(uuid.New())
+
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
+
+	assert.True(p.T(), p.TestO.ExistsByIdAndUserId(entity.Id, entity.UserId))
+	assert.False(p.T(), p.TestO.ExistsByIdAndUserId(entity.Id, uuid.New()))
+}
+
+func (p *ProviderServiceTestSuite) Test_ExistsByIdAndUserId_WithNotExistsRecord() {
+	id := uuid.New()
+
+	p.providerRepository.On("FindById", id).Return(model.Provider{}, gorm.ErrRecordNotFound)
+
+	assert.False(p.T(), p.TestO.ExistsByIdAndUserId(id, uuid.New()))
+}
+
 func (p *ProviderServiceTestSuite) Test_FindByNameLikeAndUserIds() {
 	expected := mocks.GenerateProvider(uuid.New())
 
@@ -150,15 +190,15 @@ func (p *ProviderServiceTestSuite) Test_FindByUserId_WithEmptyResponse() {
 
 func (p *ProviderServiceTestSuite) Test_Update() {
 	request := mocks.GenerateUpdateProviderRequest()
-	id := uuid.New()
+	entity := mocks.GenerateProvider(uuid.New())
 
-	p.providerRepository.On("ExistsById", id).Return(true)
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
 	p.providerRepository.On("Update", mock.Anything).Return(nil)
 
-	assert.Nil(p.T(), p.TestO.Update(id, request))
+	assert.Nil(p.T(), p.TestO.Update(entity.Id, entity.UserId, request))
 
 	p.providerRepository.AssertCalled(p.T(), "Update", model.Provider{
-		Id:      id,
+		Id:      entity.Id,
 		Name:    request.Name,
 		Details: request.Details,
 	})
@@ -166,12 +206,12 @@ func (p *ProviderServiceTestSuite) Test_Update() {
 
 func (p *ProviderServiceTestSuite) Test_Update_WithErrorFromDatabase() {
 	request := mocks.GenerateUpdateProviderRequest()
-	id := uuid.New()
+	entity := mocks.GenerateProvider(uuid.New())
 
-	p.providerRepository.On("ExistsById", id).Return(true)
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
 	p.providerRepository.On("Update", mock.Anything).Return(errors.New("test"))
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(entity.Id, entity.UserId, request)
 	assert.Equal(p.T(), errors.New("test"), err)
 }
 
@@ -179,45 +219,68 @@ func (p *ProviderServiceTestSuite) Test_Update_WithNotExists() {
 	request := mocks.GenerateUpdateProviderRequest()
 	id := uuid.New()
 
-	p.providerRepository.On("ExistsById", id).Return(false)
+	p.providerRepository.On("FindById", id).Return(model.Provider{}, gorm.ErrRecordNotFound)
 
-	err := p.TestO.Update(id, request)
+	err := p.TestO.Update(id, uuid.New(), request)
 	assert.Equal(p.T(), int_errors.NewErrNotFound("provider with id %s not found", id), err)
 
 	p.providerRepository.AssertNotCalled(p.T(), "Update", mock.Anything)
 }
 
+func (p *ProviderServiceTestSuite) Test_Update_WithDefaultUser() {
+	request := mocks.GenerateUpdateProviderRequest()
+	entity := mocks.GenerateProvider(repository.DefaultUser)
+
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
+
+	err := p.TestO.Update(entity.Id, uuid.New(), request)
+	assert.Equal(p.T(), int_errors.NewErrNotFound("provider with id %s not found", entity.Id), err)
+
+	p.providerRepository.AssertNotCalled(p.T(), "Update", mock.Anything)
+}
+
 func (p *ProviderServiceTestSuite) Test_Delete() {
-	id := uuid.New()
+	entity := mocks.GenerateProvider(uuid.New())
 
-	p.providerRepository.On("ExistsById", id).Return(true)
-	p.providerRepository.On("Delete", id).Return(nil)
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.providerRepository.On("Delete", entity.Id).Return(nil)
 
-	err := p.TestO.Delete(id)
+	err := p.TestO.Delete(entity.Id, entity.UserId)
 	assert.Nil(p.T(), err)
 
-	p.providerRepository.AssertCalled(p.T(), "Delete", id)
+	p.providerRepository.AssertCalled(p.T(), "Delete", entity.Id)
 }
 
 func (p *ProviderServiceTestSuite) Test_Delete_WithError() {
-	id := uuid.New()
+	entity := mocks.GenerateProvider(uuid.New())
 
-	p.providerRepository.On("ExistsById", id).Return(true)
-	p.providerRepository.On("Delete", id).Return(errors.New("error"))
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
+	p.providerRepository.On("Delete", entity.Id).Return(errors.New("error"))
 
-	err := p.TestO.Delete(id)
+	err := p.TestO.Delete(entity.Id, entity.UserId)
 	assert.Equal(p.T(), errors.New("error"), err)
 
-	p.providerRepository.AssertCalled(p.T(), "Delete", id)
+	p.providerRepository.AssertCalled(p.T(), "Delete", entity.Id)
 }
 
 func (p *ProviderServiceTestSuite) Test_Delete_WithNotExists() {
 	id := uuid.New()
 
-	p.providerRepository.On("ExistsById", id).Return(false)
+	p.providerRepository.On("FindById", id).Return(model.Provider{}, gorm.ErrRecordNotFound)
 
-	err := p.TestO.Delete(id)
+	err := p.TestO.Delete(id, uuid.New())
 	assert.Equal(p.T(), int_errors.NewErrNotFound("provider with id %s not found", id), err)
 
 	p.providerRepository.AssertNotCalled(p.T(), "Delete", mock.Anything)
 }
+
+func (p *ProviderServiceTestSuite) Test_Delete_WithAnotherUser() {
+	entity := mocks.GenerateProvider(uuid.New())
+
+	p.providerRepository.On("FindById", entity.Id).Return(entity, nil)
+
+	err := p.TestO.Delete(entity.Id, uuid.New())
+	assert.Equal(p.T(), int_errors.NewErrNotFound("provider with id %s not found", entity.Id), err)
+
+	p.providerRepository.AssertNotCalled(p.T(), "Delete", mock.Anything)
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/VlasovArtem/hob/src/report/service"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type ReportHandlerObject struct {
+	reportService service.ReportService
+}
+
+func NewReportHandler(reportService service.ReportService) ReportHandler {
+	return &ReportHandlerObject{reportService}
+}
+
+func (r *ReportHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewReportHandler(dependency.FindRequiredDependency[service.ReportServiceObject, service.ReportService](factory))
+}
+
+func (r *ReportHandlerObject) Init(router *mux.Router) {
+	reportRouter := router.PathPrefix("/api/v1/houses").Subrouter()
+
+	reportRouter.Path("/{id}/totals").HandlerFunc(r.FindByHouseId()).Methods("GET")
+}
+
+type ReportHandler interface {
+	FindByHouseId() http.HandlerFunc
+}
+
+func (r *ReportHandlerObject) FindByHouseId() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			from, to := rest.GetRequestFiltering(request)
+
+			rest.NewAPIResponse(writer).
+				Ok(r.reportService.FindByHouseId(id, userId, from, to)).
+				Perform()
+		}
+	}
+}
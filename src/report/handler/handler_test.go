@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"encoding/json"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/VlasovArtem/hob/src/report/mocks"
+	"github.com/VlasovArtem/hob/src/report/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type ReportHandlerTestSuite struct {
+	testhelper.MockTestSuite[ReportHandler]
+	reports *mocks.ReportService
+}
+
+func TestReportHandlerTestSuite(t *testing.T) {
+	testingSuite := &ReportHandlerTestSuite{}
+	testingSuite.TestObjectGenerator = func() ReportHandler {
+		testingSuite.reports = new(mocks.ReportService)
+		return NewReportHandler(testingSuite.reports)
+	}
+
+	suite.Run(t, testingSuite)
+}
+
+func (r *ReportHandlerTestSuite) Test_FindByHouseId() {
+	id, userId := uuid.New(), uuid.New()
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+	expected := model.ReportDto{
+		Totals:       []model.TotalDto{{Currency: "EUR", Payments: money.FromMinorUnits(1000), Incomes: money.FromMinorUnits(2000)}},
+		BaseCurrency: &model.TotalDto{Currency: "UAH", Payments: money.FromMinorUnits(31500), Incomes: money.FromMinorUnits(63000)},
+	}
+
+	r.reports.On("FindByHouseId", id, userId, &from, &to).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/totals?from={from}&to={to}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(r.TestO.FindByHouseId()).
+		WithVar("id", id.String()).
+		WithParameter("from", from.Format(time.RFC3339)).
+		WithParameter("to", to.Format(time.RFC3339))
+
+	responseByteArray := testRequest.Verify(r.T(), http.StatusOK)
+
+	actual := model.ReportDto{}
+
+	json.Unmarshal(responseByteArray, &actual)
+
+	assert.Equal(r.T(), expected, actual)
+}
+
+func (r *ReportHandlerTestSuite) Test_FindByHouseId_WithErrorFromService() {
+	id, userId := uuid.New(), uuid.New()
+
+	r.reports.On("FindByHouseId", id, userId, (*time.Time)(nil), (*time.Time)(nil)).
+		Return(model.ReportDto{}, interrors.NewErrNotFound("exchange rate of EUR to UAH on 2022-01-01 is not found"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/totals").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(r.TestO.FindByHouseId()).
+		WithVar("id", id.String())
+
+	responseByteArray := testRequest.Verify(r.T(), http.StatusNotFound)
+
+	assert.Equal(r.T(), "exchange rate of EUR to UAH on 2022-01-01 is not found\n", string(responseByteArray))
+}
+
+func (r *ReportHandlerTestSuite) Test_FindByHouseId_WithInvalidParameter() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/totals").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(r.TestO.FindByHouseId()).
+		WithVar("id", "id")
+
+	responseByteArray := testRequest.Verify(r.T(), http.StatusBadRequest)
+
+	assert.Equal(r.T(), "the id is not valid id\n", string(responseByteArray))
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/google/uuid"
+)
+
+// TotalDto is the sum of the payments and the incomes in the currency
+type TotalDto struct {
+	Currency string
+	Payments money.Money
+	Incomes  money.Money
+}
+
+// TagTotalDto is the sum of the tagged payments and incomes in the original currencies, the payment or the income with
+// several tags is counted in each of them
+type TagTotalDto struct {
+	TagId  uuid.UUID
+	Name   string
+	Totals []TotalDto
+}
+
+type ReportDto struct {
+	// Totals are calculated in the original currencies of the payments and incomes
+	Totals []TotalDto
+	// BaseCurrency is the total converted with the exchange rates of the payment and income dates, it is missing if the
+	// user has not chosen the base currency
+	BaseCurrency *TotalDto
+	// Tags are the totals of the tags sorted by the name
+	Tags []TagTotalDto
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	exchangeModel "github.com/VlasovArtem/hob/src/exchange/model"
+	exchangeService "github.com/VlasovArtem/hob/src/exchange/service"
+	houseService "github.com/VlasovArtem/hob/src/house/service"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	incomes "github.com/VlasovArtem/hob/src/income/repository"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	payments "github.com/VlasovArtem/hob/src/payment/repository"
+	"github.com/VlasovArtem/hob/src/report/model"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/google/uuid"
+	"sort"
+	"time"
+)
+
+type ReportServiceObject struct {
+	userService         userService.UserService
+	houseService        houseService.HouseService
+	paymentRepository   payments.PaymentRepository
+	incomeRepository    incomes.IncomeRepository
+	exchangeRateService exchangeService.ExchangeRateService
+}
+
+func NewReportService(
+	userService userService.UserService,
+	houseService houseService.HouseService,
+	paymentRepository payments.PaymentRepository,
+	incomeRepository incomes.IncomeRepository,
+	exchangeRateService exchangeService.ExchangeRateService,
+) ReportService {
+	return &ReportServiceObject{
+		userService:         userService,
+		houseService:        houseService,
+		paymentRepository:   paymentRepository,
+		incomeRepository:    incomeRepository,
+		exchangeRateService: exchangeRateService,
+	}
+}
+
+func (r *ReportServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewReportService(
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[houseService.HouseServiceObject, houseService.HouseService](factory),
+		dependency.FindRequiredDependency[payments.PaymentRepositoryObject, payments.PaymentRepository](factory),
+		dependency.FindRequiredDependency[incomes.IncomeRepositoryObject, incomes.IncomeRepository](factory),
+		dependency.FindRequiredDependency[exchangeService.ExchangeRateServiceObject, exchangeService.ExchangeRateService](factory),
+	)
+}
+
+type ReportService interface {
+	FindByHouseId(houseId uuid.UUID, userId uuid.UUID, from, to *time.Time) (model.ReportDto, error)
+}
+
+// FindByHouseId returns the totals of the house payments and incomes for the period, the totals are converted to the base
+// currency of the user if it is chosen. The sums are aggregated in the database by the date, so the conversion uses the
+// exchange rates of the dates, and by the tag
+func (r *ReportServiceObject) FindByHouseId(houseId uuid.UUID, userId uuid.UUID, from, to *time.Time) (response model.ReportDto, err error) {
+	if !r.houseService.HasAccess(houseId, userId) {
+		return response, interrors.NewErrNotFound("house with id %s not found", houseId)
+	}
+
+	user, err := r.userService.FindById(userId)
+	if err != nil {
+		return response, err
+	}
+
+	paymentTotals, err := r.paymentRepository.SumByHouseId(houseId, from, to, paymentModel.GroupByDate)
+	if err != nil {
+		return response, err
+	}
+	incomeTotals, err := r.incomeRepository.SumByHouseId(houseId, from, to, incomeModel.GroupByDate)
+	if err != nil {
+		return response, err
+	}
+	paymentTags, err := r.paymentRepository.SumByHouseId(houseId, from, to, paymentModel.GroupByTag)
+	if err != nil {
+		return response, err
+	}
+	incomeTags, err := r.incomeRepository.SumByHouseId(houseId, from, to, incomeModel.GroupByTag)
+	if err != nil {
+		return response, err
+	}
+
+	payments := common.MapSlice(paymentTotals, paymentAmount)
+	incomes := common.MapSlice(incomeTotals, incomeAmount)
+
+	response.Totals = totals(payments, incomes)
+	response.Tags = tagTotals(common.MapSlice(paymentTags, paymentTag), common.MapSlice(incomeTags, incomeTag))
+
+	if user.BaseCurrency == "" {
+		return response, nil
+	}
+
+	baseCurrency := model.TotalDto{Currency: user.BaseCurrency}
+
+	if baseCurrency.Payments, err = r.exchangeRateService.Total(userId, payments, user.BaseCurrency); err != nil {
+		return model.ReportDto{}, err
+	}
+	if baseCurrency.Incomes, err = r.exchangeRateService.Total(userId, incomes, user.BaseCurrency); err != nil {
+		return model.ReportDto{}, err
+	}
+
+	response.BaseCurrency = &baseCurrency
+
+	return response, nil
+}
+
+// totals sums up the amounts by the currency, the totals are sorted by the currency
+func totals(payments []exchangeModel.Amount, incomes []exchangeModel.Amount) []model.TotalDto {
+	paymentSums := sumByCurrency(payments)
+	incomeSums := sumByCurrency(incomes)
+
+	currencies := make(map[string]bool)
+	for currency := range paymentSums {
+		currencies[currency] = true
+	}
+	for currency := range incomeSums {
+		currencies[currency] = true
+	}
+
+	response := make([]model.TotalDto, 0, len(currencies))
+	for currency := range currencies {
+		response = append(response, model.TotalDto{
+			Currency: currency,
+			Payments: paymentSums[currency],
+			Incomes:  incomeSums[currency],
+		})
+	}
+
+	sort.Slice(response, func(i, j int) bool { return response[i].Currency < response[j].Currency })
+
+	return response
+}
+
+// tagAmount is the sum of the payments or incomes of the tag in the currency
+type tagAmount struct {
+	tagId  uuid.UUID
+	name   string
+	amount exchangeModel.Amount
+}
+
+// tagTotals collects the sums of the tags, the totals are sorted by the tag name
+func tagTotals(payments []tagAmount, incomes []tagAmount) []model.TagTotalDto {
+	type tagged struct {
+		name     string
+		payments []exchangeModel.Amount
+		incomes  []exchangeModel.Amount
+	}
+
+	byTag := make(map[uuid.UUID]*tagged)
+	find := func(total tagAmount) *tagged {
+		if _, ok := byTag[total.tagId]; !ok {
+			byTag[total.tagId] = &tagged{name: total.name}
+		}
+		return byTag[total.tagId]
+	}
+
+	for _, payment := range payments {
+		total := find(payment)
+		total.payments = append(total.payments, payment.amount)
+	}
+	for _, income := range incomes {
+		total := find(income)
+		total.incomes = append(total.incomes, income.amount)
+	}
+
+	response := make([]model.TagTotalDto, 0, len(byTag))
+	for tagId, value := range byTag {
+		response = append(response, model.TagTotalDto{
+			TagId:  tagId,
+			Name:   value.name,
+			Totals: totals(value.payments, value.incomes),
+		})
+	}
+
+	sort.Slice(response, func(i, j int) bool { return response[i].Name < response[j].Name })
+
+	return response
+}
+
+func sumByCurrency(amounts []exchangeModel.Amount) map[string]money.Money {
+	sums := make(map[string]money.Money)
+	for _, amount := range amounts {
+		sums[amount.Currency] += amount.Sum
+	}
+	return sums
+}
+
+func paymentAmount(total paymentModel.Total) exchangeModel.Amount {
+	return exchangeModel.Amount{Sum: total.Sum, Currency: total.Currency, Date: *total.Date}
+}
+
+func incomeAmount(total incomeModel.Total) exchangeModel.Amount {
+	return exchangeModel.Amount{Sum: total.Sum, Currency: total.Currency, Date: *total.Date}
+}
+
+func paymentTag(total paymentModel.Total) tagAmount {
+	return tagAmount{tagId: *total.TagId, name: total.TagName, amount: exchangeModel.Amount{Sum: total.Sum, Currency: total.Currency}}
+}
+
+func incomeTag(total incomeModel.Total) tagAmount {
+	return tagAmount{tagId: *total.TagId, name: total.TagName, amount: exchangeModel.Amount{Sum: total.Sum, Currency: total.Currency}}
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"errors"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	exchangeMocks "github.com/VlasovArtem/hob/src/exchange/mocks"
+	exchangeModel "github.com/VlasovArtem/hob/src/exchange/model"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	incomeMocks "github.com/VlasovArtem/hob/src/income/mocks"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	"github.com/VlasovArtem/hob/src/report/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type ReportServiceTestSuite struct {
+	testhelper.MockTestSuite[ReportService]
+	userService         *userMocks.UserService
+	houseService        *houseMocks.HouseService
+	paymentRepository   *paymentMocks.PaymentRepository
+	incomeRepository    *incomeMocks.IncomeRepository
+	exchangeRateService *exchangeMocks.ExchangeRateService
+}
+
+func TestReportServiceTestSuite(t *testing.T) {
+	ts := &ReportServiceTestSuite{}
+	ts.TestObjectGenerator = func() ReportService {
+		ts.userService = new(userMocks.UserService)
+		ts.houseService = new(houseMocks.HouseService)
+		ts.paymentRepository = new(paymentMocks.PaymentRepository)
+		ts.incomeRepository = new(incomeMocks.IncomeRepository)
+		ts.exchangeRateService = new(exchangeMocks.ExchangeRateService)
+
+		return NewReportService(ts.userService, ts.houseService, ts.paymentRepository, ts.incomeRepository, ts.exchangeRateService)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (r *ReportServiceTestSuite) Test_FindByHouseId() {
+	houseId, userId := uuid.New(), uuid.New()
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	payments, incomes := generateTotals()
+
+	r.houseService.On("HasAccess", houseId, userId).Return(true)
+	r.userService.On("FindById", userId).Return(userModel.UserDto{Id: userId, BaseCurrency: "UAH"}, nil)
+	r.withTotals(houseId, &from, payments, incomes)
+	r.withTags(houseId, &from, []paymentModel.Total{}, []incomeModel.Total{})
+	r.exchangeRateService.On("Total", userId, []exchangeModel.Amount{
+		{Sum: payments[0].Sum, Currency: "UAH", Date: *payments[0].Date},
+		{Sum: payments[1].Sum, Currency: "EUR", Date: *payments[1].Date},
+		{Sum: payments[2].Sum, Currency: "UAH", Date: *payments[2].Date},
+	}, "UAH").Return(money.FromMinorUnits(400000), nil)
+	r.exchangeRateService.On("Total", userId, []exchangeModel.Amount{
+		{Sum: incomes[0].Sum, Currency: "USD", Date: *incomes[0].Date},
+	}, "UAH").Return(money.FromMinorUnits(280000), nil)
+
+	actual, err := r.TestO.FindByHouseId(houseId, userId, &from, nil)
+
+	assert.Nil(r.T(), err)
+	assert.Equal(r.T(), model.ReportDto{
+		Totals: []model.TotalDto{
+			{Currency: "EUR", Payments: money.FromMinorUnits(5000)},
+			{Currency: "UAH", Payments: money.FromMinorUnits(200000)},
+			{Currency: "USD", Incomes: money.FromMinorUnits(10000)},
+		},
+		BaseCurrency: &model.TotalDto{
+			Currency: "UAH",
+			Payments: money.FromMinorUnits(400000),
+			Incomes:  money.FromMinorUnits(280000),
+		},
+		Tags: []model.TagTotalDto{},
+	}, actual)
+}
+
+func (r *ReportServiceTestSuite) Test_FindByHouseId_WithTags() {
+	houseId, userId := uuid.New(), uuid.New()
+	payments, incomes := generateTotals()
+	landlord, deductible := uuid.New(), uuid.New()
+
+	r.houseService.On("HasAccess", houseId, userId).Return(true)
+	r.userService.On("FindById", userId).Return(userModel.UserDto{Id: userId}, nil)
+	r.withTotals(houseId, nil, payments, incomes)
+	r.withTags(houseId, nil, []paymentModel.Total{
+		{TagId: &deductible, TagName: "tax-deductible", Currency: "EUR", Sum: money.FromMinorUnits(5000)},
+		{TagId: &deductible, TagName: "tax-deductible", Currency: "UAH", Sum: money.FromMinorUnits(100000)},
+		{TagId: &landlord, TagName: "landlord", Currency: "UAH", Sum: money.FromMinorUnits(100000)},
+	}, []incomeModel.Total{
+		{TagId: &landlord, TagName: "landlord", Currency: "USD", Sum: money.FromMinorUnits(10000)},
+	})
+
+	actual, err := r.TestO.FindByHouseId(houseId, userId, nil, nil)
+
+	assert.Nil(r.T(), err)
+	assert.Equal(r.T(), []model.TagTotalDto{
+		{
+			TagId: landlord,
+			Name:  "landlord",
+			Totals: []model.TotalDto{
+				{Currency: "UAH", Payments: money.FromMinorUnits(100000)},
+				{Currency: "USD", Incomes: money.FromMinorUnits(10000)},
+			},
+		},
+		{
+			TagId: deductible,
+			Name:  "tax-deductible",
+			Totals: []model.TotalDto{
+				{Currency: "EUR", Payments: money.FromMinorUnits(5000)},
+				{Currency: "UAH", Payments: money.FromMinorUnits(100000)},
+			},
+		},
+	}, actual.Tags)
+}
+
+func (r *ReportServiceTestSuite) Test_FindByHouseId_WithoutBaseCurrency() {
+	houseId, userId := uuid.New(), uuid.New()
+	payments, incomes := generateTotals()
+
+	r.houseService.On("HasAccess", houseId, userId).Return(true)
+	r.userService.On("FindById", userId).Return(userModel.UserDto{Id: userId}, nil)
+	r.withTotals(houseId, nil, payments, incomes)
+	r.withTags(houseId, nil, []paymentModel.Total{}, []incomeModel.Total{})
+
+	actual, err := r.TestO.FindByHouseId(houseId, userId, nil, nil)
+
+	assert.Nil(r.T(), err)
+	assert.Len(r.T(), actual.Totals, 3)
+	assert.Nil(r.T(), actual.BaseCurrency)
+	r.exchangeRateService.AssertNotCalled(r.T(), "Total", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (r *ReportServiceTestSuite) Test_FindByHouseId_WithoutAccess() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	r.houseService.On("HasAccess", houseId, userId).Return(false)
+
+	actual, err := r.TestO.FindByHouseId(houseId, userId, nil, nil)
+
+	assert.Equal(r.T(), interrors.NewErrNotFound("house with id %s not found", houseId), err)
+	assert.Equal(r.T(), model.ReportDto{}, actual)
+	r.paymentRepository.AssertNotCalled(r.T(), "SumByHouseId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (r *ReportServiceTestSuite) Test_FindByHouseId_WithMissingRate() {
+	houseId, userId := uuid.New(), uuid.New()
+	payments, incomes := generateTotals()
+	expectedError := interrors.NewErrNotFound("exchange rate of EUR to UAH on 2022-01-01 is not found")
+
+	r.houseService.On("HasAccess", houseId, userId).Return(true)
+	r.userService.On("FindById", userId).Return(userModel.UserDto{Id: userId, BaseCurrency: "UAH"}, nil)
+	r.withTotals(houseId, nil, payments, incomes)
+	r.withTags(houseId, nil, []paymentModel.Total{}, []incomeModel.Total{})
+	r.exchangeRateService.On("Total", userId, mock.Anything, "UAH").Return(money.Money(0), expectedError)
+
+	actual, err := r.TestO.FindByHouseId(houseId, userId, nil, nil)
+
+	assert.Equal(r.T(), expectedError, err)
+	assert.Equal(r.T(), model.ReportDto{}, actual)
+}
+
+func (r *ReportServiceTestSuite) Test_FindByHouseId_WithErrorFromPaymentRepository() {
+	houseId, userId := uuid.New(), uuid.New()
+	expectedError := errors.New("error")
+
+	r.houseService.On("HasAccess", houseId, userId).Return(true)
+	r.userService.On("FindById", userId).Return(userModel.UserDto{Id: userId}, nil)
+	r.paymentRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), paymentModel.GroupByDate).Return(nil, expectedError)
+
+	actual, err := r.TestO.FindByHouseId(houseId, userId, nil, nil)
+
+	assert.Equal(r.T(), expectedError, err)
+	assert.Equal(r.T(), model.ReportDto{}, actual)
+	r.incomeRepository.AssertNotCalled(r.T(), "SumByHouseId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (r *ReportServiceTestSuite) Test_FindByHouseId_WithErrorFromIncomeRepository() {
+	houseId, userId := uuid.New(), uuid.New()
+	payments, incomes := generateTotals()
+	expectedError := errors.New("error")
+
+	r.houseService.On("HasAccess", houseId, userId).Return(true)
+	r.userService.On("FindById", userId).Return(userModel.UserDto{Id: userId}, nil)
+	r.withTotals(houseId, nil, payments, incomes)
+	r.paymentRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), paymentModel.GroupByTag).Return([]paymentModel.Total{}, nil)
+	r.incomeRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), incomeModel.GroupByTag).Return(nil, expectedError)
+
+	actual, err := r.TestO.FindByHouseId(houseId, userId, nil, nil)
+
+	assert.Equal(r.T(), expectedError, err)
+	assert.Equal(r.T(), model.ReportDto{}, actual)
+}
+
+func (r *ReportServiceTestSuite) Test_FindByHouseId_WithErrorFromUserService() {
+	houseId, userId := uuid.New(), uuid.New()
+	expectedError := errors.New("error")
+
+	r.houseService.On("HasAccess", houseId, userId).Return(true)
+	r.userService.On("FindById", userId).Return(userModel.UserDto{}, expectedError)
+
+	actual, err := r.TestO.FindByHouseId(houseId, userId, nil, nil)
+
+	assert.Equal(r.T(), expectedError, err)
+	assert.Equal(r.T(), model.ReportDto{}, actual)
+}
+
+func (r *ReportServiceTestSuite) withTotals(houseId uuid.UUID, from *time.Time, payments []paymentModel.Total, incomes []incomeModel.Total) {
+	r.paymentRepository.On("SumByHouseId", houseId, from, (*time.Time)(nil), paymentModel.GroupByDate).Return(payments, nil)
+	r.incomeRepository.On("SumByHouseId", houseId, from, (*time.Time)(nil), incomeModel.GroupByDate).Return(incomes, nil)
+}
+
+func (r *ReportServiceTestSuite) withTags(houseId uuid.UUID, from *time.Time, payments []paymentModel.Total, incomes []incomeModel.Total) {
+	r.paymentRepository.On("SumByHouseId", houseId, from, (*time.Time)(nil), paymentModel.GroupByTag).Return(payments, nil)
+	r.incomeRepository.On("SumByHouseId", houseId, from, (*time.Time)(nil), incomeModel.GroupByTag).Return(incomes, nil)
+}
+
+func generateTotals() ([]paymentModel.Total, []incomeModel.Total) {
+	first := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	second, third := first.AddDate(0, 0, 1), first.AddDate(0, 0, 2)
+
+	return []paymentModel.Total{
+		{Date: &first, Currency: "UAH", Sum: money.FromMinorUnits(100000)},
+		{Date: &second, Currency: "EUR", Sum: money.FromMinorUnits(5000)},
+		{Date: &third, Currency: "UAH", Sum: money.FromMinorUnits(100000)},
+	}, []incomeModel.Total{
+		{Date: &first, Currency: "USD", Sum: money.FromMinorUnits(10000)},
+	}
+}
@@ -0,0 +1,32 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+)
+
+// BusinessDayAdjustment defines how the date of the execution falling on a weekend or a public holiday is moved
+type BusinessDayAdjustment string
+
+const (
+	NoAdjustment        BusinessDayAdjustment = "none"
+	NextBusinessDay     BusinessDayAdjustment = "next"
+	PreviousBusinessDay BusinessDayAdjustment = "previous"
+)
+
+func (a BusinessDayAdjustment) Validate() error {
+	switch a {
+	case "", NoAdjustment, NextBusinessDay, PreviousBusinessDay:
+		return nil
+	default:
+		return errors.New(fmt.Sprintf("business day adjustment %s is not supported", a))
+	}
+}
+
+// OrDefault returns the adjustment or NoAdjustment if it is not set
+func (a BusinessDayAdjustment) OrDefault() BusinessDayAdjustment {
+	if a == "" {
+		return NoAdjustment
+	}
+	return a
+}
@@ -0,0 +1,22 @@
+package scheduler
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_BusinessDayAdjustment_Validate(t *testing.T) {
+	for _, adjustment := range []BusinessDayAdjustment{"", NoAdjustment, NextBusinessDay, PreviousBusinessDay} {
+		assert.Nil(t, adjustment.Validate())
+	}
+}
+
+func Test_BusinessDayAdjustment_Validate_WithNotSupported(t *testing.T) {
+	assert.Equal(t, errors.New("business day adjustment following is not supported"), BusinessDayAdjustment("following").Validate())
+}
+
+func Test_BusinessDayAdjustment_OrDefault(t *testing.T) {
+	assert.Equal(t, NoAdjustment, BusinessDayAdjustment("").OrDefault())
+	assert.Equal(t, NextBusinessDay, NextBusinessDay.OrDefault())
+}
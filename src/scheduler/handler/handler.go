@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	"github.com/gorilla/mux"
+	"net/http"
+	"time"
+)
+
+type SchedulerHandlerObject struct {
+	serviceScheduler scheduler.ServiceScheduler
+}
+
+func NewSchedulerHandler(serviceScheduler scheduler.ServiceScheduler) SchedulerHandler {
+	return &SchedulerHandlerObject{serviceScheduler}
+}
+
+func (s *SchedulerHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewSchedulerHandler(dependency.FindRequiredDependency[scheduler.SchedulerServiceObject, scheduler.ServiceScheduler](factory))
+}
+
+func (s *SchedulerHandlerObject) Init(router *mux.Router) {
+	subrouter := router.PathPrefix("/api/v1/schedulers").Subrouter()
+
+	subrouter.Path("/next").HandlerFunc(s.NextExecutions()).Methods("GET")
+}
+
+type SchedulerHandler interface {
+	NextExecutions() http.HandlerFunc
+}
+
+// NextExecutions previews the next fire times of the spec query parameter without creating a scheduler
+func (s *SchedulerHandlerObject) NextExecutions() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if spec, err := rest.GetQueryParam[string](request, "spec"); err != nil {
+			rest.HandleWithError(writer, err)
+		} else if count, err := rest.GetQueryParamOrDefault(request, "count", scheduler.DefaultNextExecutions); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(s.serviceScheduler.NextExecutions(spec, "", time.Now(), count)).
+				Perform()
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	"github.com/VlasovArtem/hob/src/scheduler/mocks"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+var serviceScheduler *mocks.ServiceScheduler
+
+func handlerGenerator() SchedulerHandler {
+	serviceScheduler = new(mocks.ServiceScheduler)
+
+	return NewSchedulerHandler(serviceScheduler)
+}
+
+func Test_NextExecutions(t *testing.T) {
+	handler := handlerGenerator()
+
+	next := []time.Time{
+		time.Date(2022, time.February, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, time.March, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	serviceScheduler.On("NextExecutions", "0 0 15 * *", scheduler.TimeZone(""), mock.AnythingOfType("time.Time"), 2).
+		Return(next, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL(fmt.Sprintf("https://test.com/api/v1/schedulers/next?spec=%s&count=2", url.QueryEscape("0 0 15 * *"))).
+		WithMethod("GET").
+		WithHandler(handler.NextExecutions())
+
+	responseByteArray := testRequest.Verify(t, http.StatusOK)
+
+	var actual []time.Time
+
+	json.Unmarshal(responseByteArray, &actual)
+
+	assert.Equal(t, next, actual)
+}
+
+func Test_NextExecutions_WithDefaultCount(t *testing.T) {
+	handler := handlerGenerator()
+
+	serviceScheduler.On("NextExecutions", "@every 720h", scheduler.TimeZone(""), mock.AnythingOfType("time.Time"), scheduler.DefaultNextExecutions).
+		Return([]time.Time{}, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL(fmt.Sprintf("https://test.com/api/v1/schedulers/next?spec=%s", url.QueryEscape("@every 720h"))).
+		WithMethod("GET").
+		WithHandler(handler.NextExecutions())
+
+	testRequest.Verify(t, http.StatusOK)
+}
+
+func Test_NextExecutions_WithInvalidSpec(t *testing.T) {
+	handler := handlerGenerator()
+
+	expected := int_errors.NewErrResponse(int_errors.NewBuilder().WithMessage("scheduler specification 'invalid' is not valid"))
+
+	serviceScheduler.On("NextExecutions", "invalid", scheduler.TimeZone(""), mock.AnythingOfType("time.Time"), scheduler.DefaultNextExecutions).
+		Return(nil, expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/schedulers/next?spec=invalid").
+		WithMethod("GET").
+		WithHandler(handler.NextExecutions())
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, fmt.Sprintf("%s\n", expected.Error()), string(responseByteArray))
+}
+
+func Test_NextExecutions_WithMissingSpec(t *testing.T) {
+	handler := handlerGenerator()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/schedulers/next").
+		WithMethod("GET").
+		WithHandler(handler.NextExecutions())
+
+	responseByteArray := testRequest.Verify(t, http.StatusBadRequest)
+
+	assert.Equal(t, "parameter 'spec' not found\n", string(responseByteArray))
+	serviceScheduler.AssertNotCalled(t, "NextExecutions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+)
+
+// LimitColumns are the fields of the Limits, they are selected for the update to clear the limits that are not set
+var LimitColumns = []string{"StartDate", "EndDate", "MaxOccurrences"}
+
+// Limits restricts the period and the number of executions of a scheduler, zero values mean no restriction
+type Limits struct {
+	StartDate      *time.Time
+	EndDate        *time.Time
+	MaxOccurrences int
+}
+
+func (l Limits) Validate() error {
+	if l.MaxOccurrences < 0 {
+		return errors.New("max occurrences should not be negative")
+	}
+	if l.StartDate != nil && l.EndDate != nil && !l.EndDate.After(*l.StartDate) {
+		return errors.New("end date should be after start date")
+	}
+	return nil
+}
+
+// IsStarted reports whether the execution at the date is not earlier than the start date
+func (l Limits) IsStarted(date time.Time) bool {
+	return l.StartDate == nil || !date.Before(*l.StartDate)
+}
+
+// IsExhausted reports whether no more executions are allowed at the date after the given number of occurrences
+func (l Limits) IsExhausted(date time.Time, occurrences int) bool {
+	if l.EndDate != nil && date.After(*l.EndDate) {
+		return true
+	}
+	return l.MaxOccurrences > 0 && occurrences >= l.MaxOccurrences
+}
+
+// Since returns the moment after which the next executions should be looked for, taking the start date into account
+func (l Limits) Since(date time.Time) time.Time {
+	if l.StartDate != nil && l.StartDate.After(date) {
+		return l.StartDate.Add(-time.Nanosecond)
+	}
+	return date
+}
+
+// Trim drops the dates of the executions that are not allowed after the given number of occurrences
+func (l Limits) Trim(dates []time.Time, occurrences int) []time.Time {
+	for index, date := range dates {
+		if l.IsExhausted(date, occurrences+index) {
+			return dates[:index]
+		}
+	}
+	return dates
+}
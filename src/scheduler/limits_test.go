@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var (
+	startDate = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	endDate   = time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+func Test_Limits_Validate(t *testing.T) {
+	assert.Nil(t, Limits{}.Validate())
+	assert.Nil(t, Limits{StartDate: &startDate, EndDate: &endDate, MaxOccurrences: 12}.Validate())
+}
+
+func Test_Limits_Validate_WithNegativeMaxOccurrences(t *testing.T) {
+	assert.Equal(t, errors.New("max occurrences should not be negative"), Limits{MaxOccurrences: -1}.Validate())
+}
+
+func Test_Limits_Validate_WithEndDateBeforeStartDate(t *testing.T) {
+	assert.Equal(t, errors.New("end date should be after start date"), Limits{StartDate: &endDate, EndDate: &startDate}.Validate())
+}
+
+func Test_Limits_IsStarted(t *testing.T) {
+	limits := Limits{StartDate: &startDate}
+
+	assert.True(t, Limits{}.IsStarted(startDate))
+	assert.True(t, limits.IsStarted(startDate))
+	assert.True(t, limits.IsStarted(endDate))
+	assert.False(t, limits.IsStarted(startDate.Add(-time.Hour)))
+}
+
+func Test_Limits_IsExhausted(t *testing.T) {
+	assert.False(t, Limits{}.IsExhausted(endDate, 100))
+	assert.False(t, Limits{EndDate: &endDate}.IsExhausted(endDate, 100))
+	assert.True(t, Limits{EndDate: &endDate}.IsExhausted(endDate.Add(time.Hour), 0))
+	assert.False(t, Limits{MaxOccurrences: 2}.IsExhausted(startDate, 1))
+	assert.True(t, Limits{MaxOccurrences: 2}.IsExhausted(startDate, 2))
+}
+
+func Test_Limits_Since(t *testing.T) {
+	limits := Limits{StartDate: &startDate}
+
+	assert.Equal(t, endDate, Limits{}.Since(endDate))
+	assert.Equal(t, endDate, limits.Since(endDate))
+	assert.Equal(t, startDate.Add(-time.Nanosecond), limits.Since(startDate.Add(-time.Hour)))
+}
+
+func Test_Limits_Trim(t *testing.T) {
+	dates := []time.Time{startDate, startDate.Add(time.Hour), endDate, endDate.Add(time.Hour)}
+
+	assert.Equal(t, dates, Limits{}.Trim(dates, 10))
+	assert.Equal(t, dates[:3], Limits{EndDate: &endDate}.Trim(dates, 0))
+	assert.Equal(t, dates[:2], Limits{MaxOccurrences: 3}.Trim(dates, 1))
+	assert.Empty(t, Limits{MaxOccurrences: 3}.Trim(dates, 3))
+}
@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/scheduler/lock/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+var FireTime = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func GenerateSchedulerLock(schedulerId uuid.UUID) model.SchedulerLock {
+	return model.SchedulerLock{
+		SchedulerId: schedulerId,
+		FireTime:    FireTime,
+		Owner:       "owner",
+		AcquiredAt:  FireTime,
+	}
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SchedulerLock is the claim of the scheduler occurrence, only the instance that created it executes the occurrence
+type SchedulerLock struct {
+	SchedulerId uuid.UUID `gorm:"primarykey"`
+	FireTime    time.Time `gorm:"primarykey"`
+	// Owner is the instance that executes the occurrence
+	Owner      string
+	AcquiredAt time.Time
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/scheduler/lock/model"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var entity = model.SchedulerLock{}
+
+type SchedulerLockRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewSchedulerLockRepository(database db.DatabaseService) SchedulerLockRepository {
+	return &SchedulerLockRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (s *SchedulerLockRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewSchedulerLockRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (s *SchedulerLockRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type SchedulerLockRepository interface {
+	Create(lock model.SchedulerLock) (bool, error)
+	DeleteAcquiredBefore(acquiredAt time.Time) (int64, error)
+}
+
+// Create saves the lock if the occurrence is not locked yet, false is returned if the lock is already owned by another instance
+func (s *SchedulerLockRepositoryObject) Create(lock model.SchedulerLock) (bool, error) {
+	result := s.database.Modeled().
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&lock)
+
+	return result.RowsAffected == 1, result.Error
+}
+
+// DeleteAcquiredBefore removes the locks acquired before the acquiredAt and returns the number of the removed locks
+func (s *SchedulerLockRepositoryObject) DeleteAcquiredBefore(acquiredAt time.Time) (int64, error) {
+	result := s.database.Modeled().
+		Where("acquired_at < ?", acquiredAt).
+		Delete(&model.SchedulerLock{})
+
+	return result.RowsAffected, result.Error
+}
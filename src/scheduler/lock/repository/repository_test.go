@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/scheduler/lock/mocks"
+	"github.com/VlasovArtem/hob/src/scheduler/lock/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type SchedulerLockRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository SchedulerLockRepository
+}
+
+func (s *SchedulerLockRepositoryTestSuite) SetupSuite() {
+	s.InitDBTestSuite()
+
+	s.CreateRepository(
+		func(service db.DatabaseService) {
+			s.repository = NewSchedulerLockRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, model.SchedulerLock{})
+		}).
+		ExecuteMigration(model.SchedulerLock{})
+}
+
+func TestSchedulerLockRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerLockRepositoryTestSuite))
+}
+
+func (s *SchedulerLockRepositoryTestSuite) Test_Create() {
+	acquired, err := s.repository.Create(mocks.GenerateSchedulerLock(uuid.New()))
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), acquired)
+}
+
+func (s *SchedulerLockRepositoryTestSuite) Test_Create_WithLockedOccurrence() {
+	lock := mocks.GenerateSchedulerLock(uuid.New())
+	s.CreateEntity(&lock)
+
+	other := lock
+	other.Owner = "other"
+
+	acquired, err := s.repository.Create(other)
+
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), acquired)
+}
+
+func (s *SchedulerLockRepositoryTestSuite) Test_Create_WithOtherOccurrence() {
+	lock := mocks.GenerateSchedulerLock(uuid.New())
+	s.CreateEntity(&lock)
+
+	next := lock
+	next.FireTime = lock.FireTime.Add(time.Hour)
+
+	acquired, err := s.repository.Create(next)
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), acquired)
+}
+
+func (s *SchedulerLockRepositoryTestSuite) Test_DeleteAcquiredBefore() {
+	old := mocks.GenerateSchedulerLock(uuid.New())
+	s.CreateEntity(&old)
+	recent := mocks.GenerateSchedulerLock(uuid.New())
+	recent.AcquiredAt = old.AcquiredAt.Add(time.Hour)
+	s.CreateEntity(&recent)
+
+	deleted, err := s.repository.DeleteAcquiredBefore(recent.AcquiredAt)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(1), deleted)
+
+	acquired, _ := s.repository.Create(old)
+	assert.True(s.T(), acquired)
+	acquired, _ = s.repository.Create(recent)
+	assert.False(s.T(), acquired)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"fmt"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/scheduler/lock/model"
+	"github.com/VlasovArtem/hob/src/scheduler/lock/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// Retention of the locks, the occurrence is executed by all instances within the retention after the acquisition
+	// of its lock, so the lock is not required afterwards
+	Retention = 7 * 24 * time.Hour
+	// CleanupInterval is the minimal interval between the removals of the expired locks
+	CleanupInterval = time.Hour
+)
+
+type SchedulerLockServiceObject struct {
+	owner        string
+	repository   repository.SchedulerLockRepository
+	cleanupMutex sync.Mutex
+	cleanedAt    time.Time
+}
+
+func NewSchedulerLockService(repository repository.SchedulerLockRepository) SchedulerLockService {
+	hostname, _ := os.Hostname()
+
+	return &SchedulerLockServiceObject{
+		owner:      fmt.Sprintf("%s-%s", hostname, uuid.New()),
+		repository: repository,
+	}
+}
+
+func (s *SchedulerLockServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewSchedulerLockService(
+		dependency.FindRequiredDependency[repository.SchedulerLockRepositoryObject, repository.SchedulerLockRepository](factory),
+	)
+}
+
+type SchedulerLockService interface {
+	Acquire(schedulerId uuid.UUID, fireTime time.Time) bool
+}
+
+// Acquire claims the occurrence of the scheduler for the current instance, false is returned if the occurrence
+// is already claimed by another instance or the claim is not saved. The fireTime should be the exact fire time of the
+// occurrence, it is the same on all instances
+func (s *SchedulerLockServiceObject) Acquire(schedulerId uuid.UUID, fireTime time.Time) bool {
+	now := time.Now()
+	s.cleanup(now)
+
+	acquired, err := s.repository.Create(model.SchedulerLock{
+		SchedulerId: schedulerId,
+		FireTime:    fireTime.UTC(),
+		Owner:       s.owner,
+		AcquiredAt:  now,
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("occurrence %s of the scheduler %s is not locked", fireTime, schedulerId)
+		return false
+	}
+	if !acquired {
+		log.Debug().Msgf("occurrence %s of the scheduler %s is executed by another instance", fireTime, schedulerId)
+	}
+	return acquired
+}
+
+// cleanup removes the locks acquired before the Retention, at most once per CleanupInterval
+func (s *SchedulerLockServiceObject) cleanup(now time.Time) {
+	s.cleanupMutex.Lock()
+	if now.Sub(s.cleanedAt) < CleanupInterval {
+		s.cleanupMutex.Unlock()
+		return
+	}
+	s.cleanedAt = now
+	s.cleanupMutex.Unlock()
+
+	if deleted, err := s.repository.DeleteAcquiredBefore(now.Add(-Retention)); err != nil {
+		log.Error().Err(err).Msg("expired scheduler locks are not removed")
+	} else if deleted > 0 {
+		log.Debug().Msgf("%d expired scheduler locks removed", deleted)
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/scheduler/lock/mocks"
+	"github.com/VlasovArtem/hob/src/scheduler/lock/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type SchedulerLockServiceTestSuite struct {
+	testhelper.MockTestSuite[SchedulerLockService]
+	repository *mocks.SchedulerLockRepository
+}
+
+func TestSchedulerLockServiceTestSuite(t *testing.T) {
+	ts := &SchedulerLockServiceTestSuite{}
+	ts.TestObjectGenerator = func() SchedulerLockService {
+		ts.repository = new(mocks.SchedulerLockRepository)
+		return NewSchedulerLockService(ts.repository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (s *SchedulerLockServiceTestSuite) Test_Acquire() {
+	schedulerId := uuid.New()
+	location, _ := time.LoadLocation("Europe/Kyiv")
+	fireTime := time.Date(2022, time.January, 1, 2, 0, 0, 150, location)
+
+	s.repository.On("DeleteAcquiredBefore", mock.Anything).Return(int64(0), nil)
+	s.repository.On("Create", mock.Anything).Return(true, nil)
+
+	assert.True(s.T(), s.TestO.Acquire(schedulerId, fireTime))
+
+	actual := s.repository.Calls[1].Arguments.Get(0).(model.SchedulerLock)
+
+	assert.Equal(s.T(), schedulerId, actual.SchedulerId)
+	assert.Equal(s.T(), fireTime.UTC(), actual.FireTime)
+	assert.NotEmpty(s.T(), actual.Owner)
+}
+
+func (s *SchedulerLockServiceTestSuite) Test_Acquire_WithOtherFireTimeWithinMinute() {
+	schedulerId := uuid.New()
+
+	s.repository.On("DeleteAcquiredBefore", mock.Anything).Return(int64(0), nil)
+	s.repository.On("Create", mock.Anything).Return(true, nil)
+
+	s.TestO.Acquire(schedulerId, mocks.FireTime)
+	s.TestO.Acquire(schedulerId, mocks.FireTime.Add(30*time.Second))
+
+	first := s.repository.Calls[1].Arguments.Get(0).(model.SchedulerLock)
+	second := s.repository.Calls[2].Arguments.Get(0).(model.SchedulerLock)
+
+	assert.Equal(s.T(), mocks.FireTime, first.FireTime)
+	assert.Equal(s.T(), mocks.FireTime.Add(30*time.Second), second.FireTime)
+}
+
+func (s *SchedulerLockServiceTestSuite) Test_Acquire_WithExpiredLocks() {
+	s.repository.On("DeleteAcquiredBefore", mock.Anything).Return(int64(2), nil)
+	s.repository.On("Create", mock.Anything).Return(true, nil)
+
+	s.TestO.Acquire(uuid.New(), mocks.FireTime)
+	s.TestO.Acquire(uuid.New(), mocks.FireTime)
+
+	s.repository.AssertNumberOfCalls(s.T(), "DeleteAcquiredBefore", 1)
+
+	acquiredAt := s.repository.Calls[0].Arguments.Get(0).(time.Time)
+
+	assert.WithinDuration(s.T(), time.Now().Add(-Retention), acquiredAt, time.Minute)
+}
+
+func (s *SchedulerLockServiceTestSuite) Test_Acquire_WithErrorDuringCleanup() {
+	s.repository.On("DeleteAcquiredBefore", mock.Anything).Return(int64(0), errors.New("error"))
+	s.repository.On("Create", mock.Anything).Return(true, nil)
+
+	assert.True(s.T(), s.TestO.Acquire(uuid.New(), mocks.FireTime))
+}
+
+func (s *SchedulerLockServiceTestSuite) Test_Acquire_WithLockedOccurrence() {
+	s.repository.On("DeleteAcquiredBefore", mock.Anything).Return(int64(0), nil)
+	s.repository.On("Create", mock.Anything).Return(false, nil)
+
+	assert.False(s.T(), s.TestO.Acquire(uuid.New(), mocks.FireTime))
+}
+
+func (s *SchedulerLockServiceTestSuite) Test_Acquire_WithError() {
+	s.repository.On("DeleteAcquiredBefore", mock.Anything).Return(int64(0), nil)
+	s.repository.On("Create", mock.Anything).Return(false, errors.New("error"))
+
+	assert.False(s.T(), s.TestO.Acquire(uuid.New(), mocks.FireTime))
+}
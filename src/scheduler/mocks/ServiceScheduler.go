@@ -9,6 +9,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	scheduler "github.com/VlasovArtem/hob/src/scheduler"
+
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -38,6 +42,87 @@ func (_m *ServiceScheduler) Add(scheduledItemId uuid.UUID, scheduleSpec string,
 	return r0, r1
 }
 
+// LastExecution provides a mock function with given fields: scheduleSpec, until
+func (_m *ServiceScheduler) LastExecution(scheduleSpec string, until time.Time) (time.Time, error) {
+	ret := _m.Called(scheduleSpec, until)
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(string, time.Time) time.Time); ok {
+		r0 = rf(scheduleSpec, until)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(scheduleSpec, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MissedExecutions provides a mock function with given fields: scheduleSpec, since, until
+func (_m *ServiceScheduler) MissedExecutions(scheduleSpec string, since time.Time, until time.Time) ([]time.Time, error) {
+	ret := _m.Called(scheduleSpec, since, until)
+
+	var r0 []time.Time
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time) []time.Time); ok {
+		r0 = rf(scheduleSpec, since, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time, time.Time) error); ok {
+		r1 = rf(scheduleSpec, since, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NextExecutions provides a mock function with given fields: scheduleSpec, timeZone, since, count
+func (_m *ServiceScheduler) NextExecutions(scheduleSpec string, timeZone scheduler.TimeZone, since time.Time, count int) ([]time.Time, error) {
+	ret := _m.Called(scheduleSpec, timeZone, since, count)
+
+	var r0 []time.Time
+	if rf, ok := ret.Get(0).(func(string, scheduler.TimeZone, time.Time, int) []time.Time); ok {
+		r0 = rf(scheduleSpec, timeZone, since, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, scheduler.TimeZone, time.Time, int) error); ok {
+		r1 = rf(scheduleSpec, timeZone, since, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Pause provides a mock function with given fields: id
+func (_m *ServiceScheduler) Pause(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Remove provides a mock function with given fields: id
 func (_m *ServiceScheduler) Remove(id uuid.UUID) error {
 	ret := _m.Called(id)
@@ -52,6 +137,20 @@ func (_m *ServiceScheduler) Remove(id uuid.UUID) error {
 	return r0
 }
 
+// Resume provides a mock function with given fields: id
+func (_m *ServiceScheduler) Resume(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Stop provides a mock function with given fields:
 func (_m *ServiceScheduler) Stop() context.Context {
 	ret := _m.Called()
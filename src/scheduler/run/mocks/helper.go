@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/scheduler/run/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+var FireTime = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func GenerateSchedulerRun(schedulerId uuid.UUID) model.SchedulerRun {
+	entityId := uuid.New()
+
+	return model.SchedulerRun{
+		Id:          uuid.New(),
+		SchedulerId: schedulerId,
+		FireTime:    FireTime,
+		Status:      model.Succeeded,
+		EntityId:    &entityId,
+	}
+}
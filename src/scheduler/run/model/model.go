@@ -0,0 +1,62 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type RunStatus string
+
+const (
+	Succeeded RunStatus = "SUCCEEDED"
+	Failed    RunStatus = "FAILED"
+)
+
+type SchedulerRun struct {
+	Id          uuid.UUID `gorm:"primarykey"`
+	SchedulerId uuid.UUID `gorm:"index"`
+	FireTime    time.Time
+	Status      RunStatus
+	// EntityId is the id of the payment or income created by the run
+	EntityId *uuid.UUID
+	Error    string
+}
+
+type CreateSchedulerRunRequest struct {
+	SchedulerId uuid.UUID
+	FireTime    time.Time
+	Status      RunStatus
+	EntityId    *uuid.UUID
+	Error       string
+}
+
+type SchedulerRunDto struct {
+	Id          uuid.UUID
+	SchedulerId uuid.UUID
+	FireTime    time.Time
+	Status      RunStatus
+	EntityId    *uuid.UUID
+	Error       string
+}
+
+func (s SchedulerRun) ToDto() SchedulerRunDto {
+	return SchedulerRunDto{
+		Id:          s.Id,
+		SchedulerId: s.SchedulerId,
+		FireTime:    s.FireTime,
+		Status:      s.Status,
+		EntityId:    s.EntityId,
+		Error:       s.Error,
+	}
+}
+
+func (c CreateSchedulerRunRequest) ToEntity() SchedulerRun {
+	return SchedulerRun{
+		Id:          uuid.New(),
+		SchedulerId: c.SchedulerId,
+		FireTime:    c.FireTime,
+		Status:      c.Status,
+		EntityId:    c.EntityId,
+		Error:       c.Error,
+	}
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/scheduler/run/model"
+	"github.com/google/uuid"
+)
+
+var entity = model.SchedulerRun{}
+
+type SchedulerRunRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewSchedulerRunRepository(database db.DatabaseService) SchedulerRunRepository {
+	return &SchedulerRunRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (s *SchedulerRunRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewSchedulerRunRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (s *SchedulerRunRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type SchedulerRunRepository interface {
+	Create(run model.SchedulerRun) (model.SchedulerRun, error)
+	FindBySchedulerId(schedulerId uuid.UUID) ([]model.SchedulerRunDto, error)
+}
+
+func (s *SchedulerRunRepositoryObject) Create(run model.SchedulerRun) (model.SchedulerRun, error) {
+	return run, s.database.Create(&run)
+}
+
+func (s *SchedulerRunRepositoryObject) FindBySchedulerId(schedulerId uuid.UUID) (response []model.SchedulerRunDto, err error) {
+	return response, s.database.Modeled().
+		Where("scheduler_id = ?", schedulerId).
+		Order("fire_time desc").
+		Find(&response).Error
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/scheduler/run/mocks"
+	"github.com/VlasovArtem/hob/src/scheduler/run/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type SchedulerRunRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository SchedulerRunRepository
+}
+
+func (s *SchedulerRunRepositoryTestSuite) SetupSuite() {
+	s.InitDBTestSuite()
+
+	s.CreateRepository(
+		func(service db.DatabaseService) {
+			s.repository = NewSchedulerRunRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, model.SchedulerRun{})
+		}).
+		ExecuteMigration(model.SchedulerRun{})
+}
+
+func TestSchedulerRunRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerRunRepositoryTestSuite))
+}
+
+func (s *SchedulerRunRepositoryTestSuite) Test_Create() {
+	run := mocks.GenerateSchedulerRun(uuid.New())
+
+	actual, err := s.repository.Create(run)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), run, actual)
+}
+
+func (s *SchedulerRunRepositoryTestSuite) Test_FindBySchedulerId() {
+	schedulerId := uuid.New()
+
+	first := mocks.GenerateSchedulerRun(schedulerId)
+	s.CreateEntity(&first)
+
+	second := mocks.GenerateSchedulerRun(schedulerId)
+	second.FireTime = first.FireTime.Add(time.Hour)
+	s.CreateEntity(&second)
+
+	other := mocks.GenerateSchedulerRun(uuid.New())
+	s.CreateEntity(&other)
+
+	actual, err := s.repository.FindBySchedulerId(schedulerId)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []model.SchedulerRunDto{second.ToDto(), first.ToDto()}, actual)
+}
+
+func (s *SchedulerRunRepositoryTestSuite) Test_FindBySchedulerId_WithMissingRuns() {
+	actual, err := s.repository.FindBySchedulerId(uuid.New())
+
+	assert.Nil(s.T(), err)
+	assert.Empty(s.T(), actual)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/scheduler/run/model"
+	"github.com/VlasovArtem/hob/src/scheduler/run/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+type SchedulerRunServiceObject struct {
+	repository repository.SchedulerRunRepository
+}
+
+func NewSchedulerRunService(repository repository.SchedulerRunRepository) SchedulerRunService {
+	return &SchedulerRunServiceObject{repository}
+}
+
+func (s *SchedulerRunServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewSchedulerRunService(
+		dependency.FindRequiredDependency[repository.SchedulerRunRepositoryObject, repository.SchedulerRunRepository](factory),
+	)
+}
+
+type SchedulerRunService interface {
+	Succeeded(schedulerId uuid.UUID, fireTime time.Time, entityId uuid.UUID)
+	Failed(schedulerId uuid.UUID, fireTime time.Time, err error)
+	FindBySchedulerId(schedulerId uuid.UUID) []model.SchedulerRunDto
+}
+
+func (s *SchedulerRunServiceObject) Succeeded(schedulerId uuid.UUID, fireTime time.Time, entityId uuid.UUID) {
+	s.add(model.CreateSchedulerRunRequest{
+		SchedulerId: schedulerId,
+		FireTime:    fireTime,
+		Status:      model.Succeeded,
+		EntityId:    &entityId,
+	})
+}
+
+func (s *SchedulerRunServiceObject) Failed(schedulerId uuid.UUID, fireTime time.Time, err error) {
+	s.add(model.CreateSchedulerRunRequest{
+		SchedulerId: schedulerId,
+		FireTime:    fireTime,
+		Status:      model.Failed,
+		Error:       err.Error(),
+	})
+}
+
+func (s *SchedulerRunServiceObject) FindBySchedulerId(schedulerId uuid.UUID) []model.SchedulerRunDto {
+	response, err := s.repository.FindBySchedulerId(schedulerId)
+	if err != nil {
+		log.Error().Err(err).Msgf("runs of the scheduler %s not found", schedulerId)
+	}
+	return response
+}
+
+func (s *SchedulerRunServiceObject) add(request model.CreateSchedulerRunRequest) {
+	if _, err := s.repository.Create(request.ToEntity()); err != nil {
+		log.Error().Err(err).Msgf("run of the scheduler %s is not saved", request.SchedulerId)
+	}
+}
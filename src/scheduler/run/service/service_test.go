@@ -0,0 +1,85 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/scheduler/run/mocks"
+	"github.com/VlasovArtem/hob/src/scheduler/run/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type SchedulerRunServiceTestSuite struct {
+	testhelper.MockTestSuite[SchedulerRunService]
+	repository *mocks.SchedulerRunRepository
+}
+
+func TestSchedulerRunServiceTestSuite(t *testing.T) {
+	ts := &SchedulerRunServiceTestSuite{}
+	ts.TestObjectGenerator = func() SchedulerRunService {
+		ts.repository = new(mocks.SchedulerRunRepository)
+		return NewSchedulerRunService(ts.repository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (s *SchedulerRunServiceTestSuite) Test_Succeeded() {
+	schedulerId := uuid.New()
+	entityId := uuid.New()
+
+	s.repository.On("Create", mock.Anything).Return(model.SchedulerRun{}, nil)
+
+	s.TestO.Succeeded(schedulerId, mocks.FireTime, entityId)
+
+	actual := s.repository.Calls[0].Arguments.Get(0).(model.SchedulerRun)
+
+	assert.Equal(s.T(), model.SchedulerRun{
+		Id:          actual.Id,
+		SchedulerId: schedulerId,
+		FireTime:    mocks.FireTime,
+		Status:      model.Succeeded,
+		EntityId:    &entityId,
+	}, actual)
+}
+
+func (s *SchedulerRunServiceTestSuite) Test_Failed() {
+	schedulerId := uuid.New()
+
+	s.repository.On("Create", mock.Anything).Return(model.SchedulerRun{}, errors.New("error"))
+
+	s.TestO.Failed(schedulerId, mocks.FireTime, errors.New("house not found"))
+
+	actual := s.repository.Calls[0].Arguments.Get(0).(model.SchedulerRun)
+
+	assert.Equal(s.T(), model.SchedulerRun{
+		Id:          actual.Id,
+		SchedulerId: schedulerId,
+		FireTime:    mocks.FireTime,
+		Status:      model.Failed,
+		Error:       "house not found",
+	}, actual)
+}
+
+func (s *SchedulerRunServiceTestSuite) Test_FindBySchedulerId() {
+	run := mocks.GenerateSchedulerRun(uuid.New())
+
+	s.repository.On("FindBySchedulerId", run.SchedulerId).Return([]model.SchedulerRunDto{run.ToDto()}, nil)
+
+	actual := s.TestO.FindBySchedulerId(run.SchedulerId)
+
+	assert.Equal(s.T(), []model.SchedulerRunDto{run.ToDto()}, actual)
+}
+
+func (s *SchedulerRunServiceTestSuite) Test_FindBySchedulerId_WithError() {
+	schedulerId := uuid.New()
+
+	s.repository.On("FindBySchedulerId", schedulerId).Return(nil, errors.New("error"))
+
+	actual := s.TestO.FindBySchedulerId(schedulerId)
+
+	assert.Empty(s.T(), actual)
+}
@@ -8,6 +8,9 @@ import (
 	intErrors "github.com/VlasovArtem/hob/src/common/int-errors"
 	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
+	"strings"
+	"sync"
+	"time"
 )
 
 type SchedulingSpecification string
@@ -20,15 +23,67 @@ const (
 	ANNUALLY SchedulingSpecification = "@annually"
 )
 
+const (
+	// DefaultNextExecutions is the number of the fire times returned by the next executions endpoints by default
+	DefaultNextExecutions = 5
+	// MaxNextExecutions limits the number of the fire times returned by ServiceScheduler.NextExecutions
+	MaxNextExecutions = 100
+	// MaxExecutionDelay limits the delay of the execution after its fire time, see ServiceScheduler.LastExecution
+	MaxExecutionDelay = time.Hour
+)
+
+// ValidateSpecification checks that the spec is a standard 5-field cron expression or a descriptor like "@every 720h" without a time zone
+func ValidateSpecification(spec SchedulingSpecification) error {
+	if strings.HasPrefix(string(spec), "TZ=") || strings.HasPrefix(string(spec), "CRON_TZ=") {
+		return intErrors.NewErrResponse(
+			intErrors.NewBuilder().
+				WithMessage(fmt.Sprintf("scheduler specification '%s' is not valid", spec)).
+				WithDetail("time zone should be provided separately from the specification"),
+		)
+	}
+	if _, err := cron.ParseStandard(string(spec)); err != nil {
+		return intErrors.NewErrResponse(
+			intErrors.NewBuilder().
+				WithMessage(fmt.Sprintf("scheduler specification '%s' is not valid", spec)).
+				WithDetail(err.Error()),
+		)
+	}
+	return nil
+}
+
+// CatchUpPolicy defines which of the executions missed during the downtime are performed on startup
+type CatchUpPolicy string
+
+const (
+	CatchUpAll    CatchUpPolicy = "all"
+	CatchUpLatest CatchUpPolicy = "latest"
+	CatchUpSkip   CatchUpPolicy = "skip"
+)
+
+type SchedulerConfiguration struct {
+	CatchUpPolicy CatchUpPolicy
+}
+
+func NewDefaultSchedulerConfiguration() SchedulerConfiguration {
+	return SchedulerConfiguration{
+		CatchUpPolicy: CatchUpAll,
+	}
+}
+
 type SchedulerServiceObject struct {
-	cron    *cron.Cron
-	entries map[uuid.UUID]cron.EntryID
+	cron          *cron.Cron
+	mutex         sync.RWMutex
+	entries       map[uuid.UUID]cron.EntryID
+	paused        map[uuid.UUID]bool
+	catchUpPolicy CatchUpPolicy
 }
 
-func NewSchedulerService() ServiceScheduler {
+func NewSchedulerService(config SchedulerConfiguration) ServiceScheduler {
 	schedulerService := &SchedulerServiceObject{
-		cron:    cron.New(),
-		entries: make(map[uuid.UUID]cron.EntryID),
+		cron:          cron.New(),
+		entries:       make(map[uuid.UUID]cron.EntryID),
+		paused:        make(map[uuid.UUID]bool),
+		catchUpPolicy: config.CatchUpPolicy,
 	}
 	schedulerService.cron.Start()
 
@@ -36,7 +91,7 @@ func NewSchedulerService() ServiceScheduler {
 }
 
 func (s *SchedulerServiceObject) Initialize(factory dependency.DependenciesProvider) any {
-	return NewSchedulerService()
+	return NewSchedulerService(factory.FindRequiredByObject(SchedulerConfiguration{}).(SchedulerConfiguration))
 }
 
 type ServiceScheduler interface {
@@ -44,13 +99,21 @@ type ServiceScheduler interface {
 	Remove(id uuid.UUID) error
 	Stop() context.Context
 	Update(id uuid.UUID, scheduleSpec string, scheduleFunc func()) (cron.EntryID, error)
+	Pause(id uuid.UUID) error
+	Resume(id uuid.UUID) error
+	MissedExecutions(scheduleSpec string, since time.Time, until time.Time) ([]time.Time, error)
+	NextExecutions(scheduleSpec string, timeZone TimeZone, since time.Time, count int) ([]time.Time, error)
+	LastExecution(scheduleSpec string, until time.Time) (time.Time, error)
 }
 
 func (s *SchedulerServiceObject) Add(scheduledItemId uuid.UUID, scheduleSpec string, scheduleFunc func()) (entryID cron.EntryID, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	if _, ok := s.entries[scheduledItemId]; ok {
 		return entryID, intErrors.NewErrNotFound("scheduler for the entity id %s exists", scheduledItemId)
 	}
-	if entryID, err = s.cron.AddFunc(scheduleSpec, scheduleFunc); err != nil {
+	if entryID, err = s.cron.AddFunc(scheduleSpec, s.pausable(scheduledItemId, scheduleFunc)); err != nil {
 		return entryID, err
 	} else {
 		s.entries[scheduledItemId] = entryID
@@ -60,10 +123,15 @@ func (s *SchedulerServiceObject) Add(scheduledItemId uuid.UUID, scheduleSpec str
 }
 
 func (s *SchedulerServiceObject) Remove(scheduledItemId uuid.UUID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	if entryId, ok := s.entries[scheduledItemId]; !ok {
 		return errors.New(fmt.Sprintf("Scheduler with is %s not found", scheduledItemId))
 	} else {
 		s.cron.Remove(entryId)
+		delete(s.entries, scheduledItemId)
+		delete(s.paused, scheduledItemId)
 	}
 	return nil
 }
@@ -73,11 +141,14 @@ func (s *SchedulerServiceObject) Stop() context.Context {
 }
 
 func (s *SchedulerServiceObject) Update(id uuid.UUID, scheduleSpec string, scheduleFunc func()) (entryID cron.EntryID, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	if entryID, ok := s.entries[id]; !ok {
 		return entryID, errors.New(fmt.Sprintf("scheduler for the entity id %s not exists", id))
 	} else {
 		s.cron.Remove(entryID)
-		if entryID, err = s.cron.AddFunc(scheduleSpec, scheduleFunc); err != nil {
+		if entryID, err = s.cron.AddFunc(scheduleSpec, s.pausable(id, scheduleFunc)); err != nil {
 			return entryID, err
 		} else {
 			s.entries[id] = entryID
@@ -85,3 +156,125 @@ func (s *SchedulerServiceObject) Update(id uuid.UUID, scheduleSpec string, sched
 		}
 	}
 }
+
+// Pause keeps the scheduler registered but skips its executions until it is resumed, it is called by the running
+// executions as well, so the schedulers are guarded by the mutex
+func (s *SchedulerServiceObject) Pause(id uuid.UUID) error {
+	return s.setPaused(id, true)
+}
+
+func (s *SchedulerServiceObject) Resume(id uuid.UUID) error {
+	return s.setPaused(id, false)
+}
+
+func (s *SchedulerServiceObject) setPaused(id uuid.UUID, paused bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.entries[id]; !ok {
+		return errors.New(fmt.Sprintf("scheduler for the entity id %s not exists", id))
+	}
+	if paused {
+		s.paused[id] = true
+	} else {
+		delete(s.paused, id)
+	}
+	return nil
+}
+
+func (s *SchedulerServiceObject) pausable(id uuid.UUID, scheduleFunc func()) func() {
+	return func() {
+		s.mutex.RLock()
+		paused := s.paused[id]
+		s.mutex.RUnlock()
+
+		if !paused {
+			scheduleFunc()
+		}
+	}
+}
+
+// MissedExecutions returns the fire times of the scheduleSpec in the (since, until] range filtered by the CatchUpPolicy
+func (s *SchedulerServiceObject) MissedExecutions(scheduleSpec string, since time.Time, until time.Time) (response []time.Time, err error) {
+	if s.catchUpPolicy == CatchUpSkip {
+		return response, nil
+	}
+
+	schedule, err := cron.ParseStandard(scheduleSpec)
+	if err != nil {
+		return response, err
+	}
+
+	for next := nextExecution(schedule, since); !next.IsZero() && !next.After(until); next = nextExecution(schedule, next) {
+		response = append(response, next)
+	}
+
+	if s.catchUpPolicy == CatchUpLatest && len(response) > 1 {
+		return response[len(response)-1:], nil
+	}
+
+	return response, nil
+}
+
+// NextExecutions returns the next count fire times of the scheduleSpec in the time zone after since, the spec itself
+// should not contain the time zone
+func (s *SchedulerServiceObject) NextExecutions(scheduleSpec string, timeZone TimeZone, since time.Time, count int) (response []time.Time, err error) {
+	if count <= 0 || count > MaxNextExecutions {
+		return response, errors.New(fmt.Sprintf("count should be between 1 and %d", MaxNextExecutions))
+	}
+
+	if err = ValidateSpecification(SchedulingSpecification(scheduleSpec)); err != nil {
+		return response, err
+	}
+
+	schedule, err := cron.ParseStandard(timeZone.Specification(SchedulingSpecification(scheduleSpec)))
+	if err != nil {
+		return response, err
+	}
+
+	for next := nextExecution(schedule, since); !next.IsZero() && len(response) < count; next = nextExecution(schedule, next) {
+		response = append(response, next)
+	}
+
+	return response, nil
+}
+
+// LastExecution returns the latest fire time of the scheduleSpec that is not after until, it is the fire time of the
+// running execution. The zero time is returned if the scheduleSpec did not fire within the MaxExecutionDelay before until.
+// The fire time of the constant delay schedule is the start of its delay that contains until, see nextExecution
+func (s *SchedulerServiceObject) LastExecution(scheduleSpec string, until time.Time) (response time.Time, err error) {
+	schedule, err := cron.ParseStandard(scheduleSpec)
+	if err != nil {
+		return response, err
+	}
+
+	if constantDelay, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return until.Truncate(constantDelay.Delay), nil
+	}
+
+	for next := schedule.Next(until.Add(-MaxExecutionDelay)); !next.IsZero() && !next.After(until); next = schedule.Next(next) {
+		response = next
+	}
+
+	return response, nil
+}
+
+// nextExecution returns the fire time of the schedule after t. The cron fires the constant delay schedule ("@every")
+// relative to the start of the application, so its fire times are aligned to the whole number of delays since the
+// zero time instead to be the same on every instance of the application
+func nextExecution(schedule cron.Schedule, t time.Time) time.Time {
+	if constantDelay, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return t.Truncate(constantDelay.Delay).Add(constantDelay.Delay)
+	}
+
+	return schedule.Next(t)
+}
+
+func ParseCatchUpPolicy(value string) (CatchUpPolicy, error) {
+	switch policy := CatchUpPolicy(value); policy {
+	case CatchUpAll, CatchUpLatest, CatchUpSkip:
+		return policy, nil
+	default:
+		return "", errors.New(fmt.Sprintf("catch up policy %s is not supported", value))
+	}
+}
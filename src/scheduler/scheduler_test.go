@@ -8,11 +8,13 @@ import (
 	"github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/assert"
 	"log"
+	"sync"
 	"testing"
+	"time"
 )
 
 func Test_Add(t *testing.T) {
-	service := NewSchedulerService()
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
 
 	channel := make(chan bool)
 
@@ -40,7 +42,7 @@ func Test_Add(t *testing.T) {
 }
 
 func Test_Add_WithExistingScheduler(t *testing.T) {
-	service := NewSchedulerService()
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
 
 	itemId := uuid.New()
 	entryId, err := service.Add(itemId, "* * * * *", func() { log.Println("Function") })
@@ -63,7 +65,7 @@ func Test_Add_WithExistingScheduler(t *testing.T) {
 }
 
 func Test_Add_WithInvalidScheduler(t *testing.T) {
-	service := NewSchedulerService()
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
 
 	itemId := uuid.New()
 	entryId, err := service.Add(itemId, "invalid", func() { log.Println("Function") })
@@ -80,7 +82,7 @@ func Test_Add_WithInvalidScheduler(t *testing.T) {
 }
 
 func Test_Remove(t *testing.T) {
-	service := NewSchedulerService()
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
 
 	itemId := uuid.New()
 	entryId, err := service.Add(itemId, "* * * * *", func() { log.Println("Function") })
@@ -91,10 +93,14 @@ func Test_Remove(t *testing.T) {
 	err = service.Remove(itemId)
 
 	assert.Nil(t, err)
+
+	_, ok := service.(*SchedulerServiceObject).entries[itemId]
+
+	assert.False(t, ok)
 }
 
 func Test_Remove_WithNotExisingId(t *testing.T) {
-	service := NewSchedulerService()
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
 
 	itemId := uuid.New()
 
@@ -104,7 +110,7 @@ func Test_Remove_WithNotExisingId(t *testing.T) {
 }
 
 func Test_Update(t *testing.T) {
-	service := NewSchedulerService()
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
 	itemId := uuid.New()
 
 	entryId, err := service.Add(itemId, "* * * * *", func() {})
@@ -144,7 +150,7 @@ func Test_Update(t *testing.T) {
 }
 
 func Test_Update_WithNotExists(t *testing.T) {
-	service := NewSchedulerService()
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
 	itemId := uuid.New()
 
 	entryId, err := service.Update(itemId, "* * * * *", func() {})
@@ -152,3 +158,339 @@ func Test_Update_WithNotExists(t *testing.T) {
 	assert.Equal(t, cron.EntryID(0), entryId)
 	assert.Equal(t, errors.New(fmt.Sprintf("scheduler for the entity id %s not exists", itemId)), err)
 }
+
+func Test_Pause(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+	itemId := uuid.New()
+
+	executed := false
+	entryId, err := service.Add(itemId, "* * * * *", func() {
+		executed = true
+	})
+
+	assert.Nil(t, err)
+
+	err = service.Pause(itemId)
+
+	assert.Nil(t, err)
+
+	object := (service).(*SchedulerServiceObject)
+	object.cron.Entry(entryId).Job.Run()
+
+	assert.False(t, executed)
+}
+
+func Test_Pause_WithConcurrentAdd(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+	object := (service).(*SchedulerServiceObject)
+	itemId := uuid.New()
+
+	entryId, err := service.Add(itemId, "* * * * *", func() {
+		_ = service.Pause(itemId)
+	})
+
+	assert.Nil(t, err)
+
+	var group sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		group.Add(2)
+		go func() {
+			defer group.Done()
+			object.cron.Entry(entryId).Job.Run()
+		}()
+		go func() {
+			defer group.Done()
+			_, addErr := service.Add(uuid.New(), "* * * * *", func() {})
+			assert.Nil(t, addErr)
+		}()
+	}
+	group.Wait()
+
+	object.mutex.RLock()
+	defer object.mutex.RUnlock()
+
+	assert.Len(t, object.entries, 11)
+	assert.True(t, object.paused[itemId])
+}
+
+func Test_Pause_WithNotExists(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+	itemId := uuid.New()
+
+	err := service.Pause(itemId)
+
+	assert.Equal(t, errors.New(fmt.Sprintf("scheduler for the entity id %s not exists", itemId)), err)
+}
+
+func Test_Resume(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+	itemId := uuid.New()
+
+	executed := false
+	entryId, err := service.Add(itemId, "* * * * *", func() {
+		executed = true
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, service.Pause(itemId))
+
+	err = service.Resume(itemId)
+
+	assert.Nil(t, err)
+
+	object := (service).(*SchedulerServiceObject)
+	object.cron.Entry(entryId).Job.Run()
+
+	assert.True(t, executed)
+}
+
+func Test_Resume_WithNotExists(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+	itemId := uuid.New()
+
+	err := service.Resume(itemId)
+
+	assert.Equal(t, errors.New(fmt.Sprintf("scheduler for the entity id %s not exists", itemId)), err)
+}
+
+func Test_MissedExecutions(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	since := time.Date(2022, time.January, 15, 10, 0, 0, 0, time.Local)
+	until := time.Date(2022, time.April, 2, 10, 0, 0, 0, time.Local)
+
+	actual, err := service.MissedExecutions(string(MONTHLY), since, until)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2022, time.February, 1, 0, 0, 0, 0, time.Local),
+		time.Date(2022, time.March, 1, 0, 0, 0, 0, time.Local),
+		time.Date(2022, time.April, 1, 0, 0, 0, 0, time.Local),
+	}, actual)
+}
+
+func Test_MissedExecutions_WithEveryDescriptor(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	since := time.Date(2022, time.January, 20, 10, 0, 0, 0, time.UTC)
+	first := since.Truncate(720 * time.Hour).Add(720 * time.Hour)
+
+	actual, err := service.MissedExecutions("@every 720h", since, first.Add(1000*time.Hour))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{first, first.Add(720 * time.Hour)}, actual)
+}
+
+func Test_MissedExecutions_WithLatestPolicy(t *testing.T) {
+	service := NewSchedulerService(SchedulerConfiguration{CatchUpPolicy: CatchUpLatest})
+
+	since := time.Date(2022, time.January, 15, 10, 0, 0, 0, time.Local)
+	until := time.Date(2022, time.April, 2, 10, 0, 0, 0, time.Local)
+
+	actual, err := service.MissedExecutions(string(MONTHLY), since, until)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{time.Date(2022, time.April, 1, 0, 0, 0, 0, time.Local)}, actual)
+}
+
+func Test_MissedExecutions_WithSkipPolicy(t *testing.T) {
+	service := NewSchedulerService(SchedulerConfiguration{CatchUpPolicy: CatchUpSkip})
+
+	since := time.Date(2022, time.January, 15, 10, 0, 0, 0, time.Local)
+	until := time.Date(2022, time.April, 2, 10, 0, 0, 0, time.Local)
+
+	actual, err := service.MissedExecutions(string(MONTHLY), since, until)
+
+	assert.Nil(t, err)
+	assert.Empty(t, actual)
+}
+
+func Test_MissedExecutions_WithoutMissed(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	since := time.Date(2022, time.January, 15, 10, 0, 0, 0, time.Local)
+	until := time.Date(2022, time.January, 20, 10, 0, 0, 0, time.Local)
+
+	actual, err := service.MissedExecutions(string(MONTHLY), since, until)
+
+	assert.Nil(t, err)
+	assert.Empty(t, actual)
+}
+
+func Test_MissedExecutions_WithInvalidSpec(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	actual, err := service.MissedExecutions("invalid", time.Now(), time.Now())
+
+	assert.NotNil(t, err)
+	assert.Empty(t, actual)
+}
+
+func Test_LastExecution(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	until := time.Date(2022, time.January, 20, 10, 0, 1, 500, time.UTC)
+
+	actual, err := service.LastExecution("CRON_TZ=UTC */15 * * * *", until)
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2022, time.January, 20, 10, 0, 0, 0, time.UTC), actual)
+}
+
+func Test_LastExecution_WithTimeZone(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+	location, _ := time.LoadLocation("Europe/Kyiv")
+
+	until := time.Date(2022, time.January, 20, 0, 0, 2, 0, location)
+
+	actual, err := service.LastExecution("CRON_TZ=Europe/Kyiv @daily", until)
+
+	assert.Nil(t, err)
+	assert.True(t, time.Date(2022, time.January, 20, 0, 0, 0, 0, location).Equal(actual))
+}
+
+func Test_LastExecution_WithDelayedExecution(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	until := time.Date(2022, time.January, 20, 10, 0, 0, 0, time.UTC)
+
+	actual, err := service.LastExecution("CRON_TZ=UTC @daily", until)
+
+	assert.Nil(t, err)
+	assert.True(t, actual.IsZero())
+}
+
+func Test_LastExecution_WithEveryDescriptor(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	until := time.Date(2022, time.January, 20, 10, 0, 0, 0, time.UTC)
+
+	actual, err := service.LastExecution("@every 720h", until)
+
+	assert.Nil(t, err)
+	assert.Equal(t, until.Truncate(720*time.Hour), actual)
+	assert.False(t, actual.After(until))
+}
+
+func Test_LastExecution_WithEveryDescriptorOnDifferentInstances(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+	otherService := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	fireTime := time.Date(2022, time.January, 20, 10, 0, 0, 0, time.UTC).Truncate(720 * time.Hour)
+
+	actual, err := service.LastExecution("@every 720h", fireTime.Add(time.Minute))
+	otherActual, otherErr := otherService.LastExecution("@every 720h", fireTime.Add(500*time.Hour))
+
+	assert.Nil(t, err)
+	assert.Nil(t, otherErr)
+	assert.Equal(t, fireTime, actual)
+	assert.Equal(t, actual, otherActual)
+}
+
+func Test_LastExecution_WithInvalidSpec(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	actual, err := service.LastExecution("invalid", time.Now())
+
+	assert.NotNil(t, err)
+	assert.True(t, actual.IsZero())
+}
+
+func Test_ParseCatchUpPolicy(t *testing.T) {
+	for _, value := range []string{"all", "latest", "skip"} {
+		policy, err := ParseCatchUpPolicy(value)
+
+		assert.Nil(t, err)
+		assert.Equal(t, CatchUpPolicy(value), policy)
+	}
+
+	policy, err := ParseCatchUpPolicy("invalid")
+
+	assert.Equal(t, errors.New("catch up policy invalid is not supported"), err)
+	assert.Equal(t, CatchUpPolicy(""), policy)
+}
+
+func Test_NextExecutions(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	since := time.Date(2022, time.January, 20, 10, 0, 0, 0, time.Local)
+
+	actual, err := service.NextExecutions("0 0 15 * *", "", since, 3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2022, time.February, 15, 0, 0, 0, 0, time.Local),
+		time.Date(2022, time.March, 15, 0, 0, 0, 0, time.Local),
+		time.Date(2022, time.April, 15, 0, 0, 0, 0, time.Local),
+	}, actual)
+}
+
+func Test_NextExecutions_WithEveryDescriptor(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	since := time.Date(2022, time.January, 20, 10, 0, 0, 0, time.Local)
+
+	actual, err := service.NextExecutions("@every 720h", "", since, 2)
+
+	first := since.Truncate(720 * time.Hour).Add(720 * time.Hour)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{first, first.Add(720 * time.Hour)}, actual)
+}
+
+func Test_NextExecutions_WithTimeZone(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+	location, _ := time.LoadLocation("America/New_York")
+
+	since := time.Date(2022, time.January, 20, 10, 0, 0, 0, time.UTC)
+
+	actual, err := service.NextExecutions("0 9 * * *", "America/New_York", since, 2)
+
+	assert.Nil(t, err)
+	assert.True(t, time.Date(2022, time.January, 20, 9, 0, 0, 0, location).Equal(actual[0]))
+	assert.True(t, time.Date(2022, time.January, 21, 9, 0, 0, 0, location).Equal(actual[1]))
+}
+
+func Test_NextExecutions_WithTimeZoneInSpec(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	actual, err := service.NextExecutions("CRON_TZ=Europe/Kyiv @daily", "", time.Now(), 2)
+
+	assert.ErrorIs(t, err, int_errors.ErrResponse{})
+	assert.Empty(t, actual)
+}
+
+func Test_NextExecutions_WithInvalidCount(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	for _, count := range []int{0, -1, MaxNextExecutions + 1} {
+		actual, err := service.NextExecutions(string(MONTHLY), "", time.Now(), count)
+
+		assert.Equal(t, errors.New(fmt.Sprintf("count should be between 1 and %d", MaxNextExecutions)), err)
+		assert.Empty(t, actual)
+	}
+}
+
+func Test_NextExecutions_WithInvalidSpec(t *testing.T) {
+	service := NewSchedulerService(NewDefaultSchedulerConfiguration())
+
+	actual, err := service.NextExecutions("invalid", "", time.Now(), 1)
+
+	assert.ErrorIs(t, err, int_errors.ErrResponse{})
+	assert.Empty(t, actual)
+}
+
+func Test_ValidateSpecification(t *testing.T) {
+	for _, spec := range []SchedulingSpecification{HOURLY, DAILY, WEEKLY, MONTHLY, ANNUALLY, "0 0 15 * *", "@every 720h", "30 8 * * MON-FRI"} {
+		assert.Nil(t, ValidateSpecification(spec), spec)
+	}
+}
+
+func Test_ValidateSpecification_WithInvalidSpec(t *testing.T) {
+	for _, spec := range []SchedulingSpecification{"", "invalid", "0 0 32 * *", "* * * *", "@every hour", "CRON_TZ=Europe/Kyiv @daily", "TZ=UTC @daily"} {
+		err := ValidateSpecification(spec)
+
+		assert.ErrorIs(t, err, int_errors.ErrResponse{}, spec)
+		assert.Contains(t, err.Error(), fmt.Sprintf("scheduler specification '%s' is not valid", spec))
+	}
+}
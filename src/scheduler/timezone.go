@@ -0,0 +1,36 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TimeZone is the IANA name of the location the scheduler runs in, the empty value means the server local time zone
+type TimeZone string
+
+func (t TimeZone) Validate() error {
+	if _, err := time.LoadLocation(string(t)); err != nil || t == "Local" {
+		return errors.New(fmt.Sprintf("time zone %s is not valid", t))
+	}
+	return nil
+}
+
+// Location returns the location of the time zone, the server local location is returned for the empty or unknown time zone
+func (t TimeZone) Location() *time.Location {
+	if t == "" {
+		return time.Local
+	}
+	if location, err := time.LoadLocation(string(t)); err == nil {
+		return location
+	}
+	return time.Local
+}
+
+// Specification returns the spec which fire times are calculated by the cron in the time zone
+func (t TimeZone) Specification(spec SchedulingSpecification) string {
+	if t == "" {
+		return string(spec)
+	}
+	return fmt.Sprintf("CRON_TZ=%s %s", t, spec)
+}
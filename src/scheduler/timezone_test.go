@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	"errors"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_TimeZone_Validate(t *testing.T) {
+	assert.Nil(t, TimeZone("").Validate())
+	assert.Nil(t, TimeZone("UTC").Validate())
+	assert.Nil(t, TimeZone("Europe/Kyiv").Validate())
+}
+
+func Test_TimeZone_Validate_WithInvalidTimeZone(t *testing.T) {
+	for _, timeZone := range []TimeZone{"Europe/Unknown", "Local"} {
+		assert.Equal(t, errors.New("time zone "+string(timeZone)+" is not valid"), timeZone.Validate())
+	}
+}
+
+func Test_TimeZone_Location(t *testing.T) {
+	assert.Equal(t, "Europe/Kyiv", TimeZone("Europe/Kyiv").Location().String())
+	assert.Equal(t, time.Local, TimeZone("").Location())
+	assert.Equal(t, time.Local, TimeZone("Europe/Unknown").Location())
+}
+
+func Test_TimeZone_Specification(t *testing.T) {
+	assert.Equal(t, "@monthly", TimeZone("").Specification(MONTHLY))
+	assert.Equal(t, "CRON_TZ=Europe/Kyiv 0 0 15 * *", TimeZone("Europe/Kyiv").Specification("0 0 15 * *"))
+}
+
+func Test_TimeZone_Specification_FiresInLocation(t *testing.T) {
+	schedule, err := cron.ParseStandard(TimeZone("Europe/Kyiv").Specification(MONTHLY))
+
+	assert.Nil(t, err)
+
+	next := schedule.Next(time.Date(2022, time.January, 31, 23, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, time.Date(2022, time.February, 28, 22, 0, 0, 0, time.UTC), next.UTC())
+}
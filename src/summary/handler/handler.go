@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/VlasovArtem/hob/src/summary/model"
+	"github.com/VlasovArtem/hob/src/summary/service"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type SummaryHandlerObject struct {
+	summaryService service.SummaryService
+}
+
+func NewSummaryHandler(summaryService service.SummaryService) SummaryHandler {
+	return &SummaryHandlerObject{summaryService}
+}
+
+func (s *SummaryHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewSummaryHandler(dependency.FindRequiredDependency[service.SummaryServiceObject, service.SummaryService](factory))
+}
+
+func (s *SummaryHandlerObject) Init(router *mux.Router) {
+	summaryRouter := router.PathPrefix("/api/v1/houses").Subrouter()
+
+	summaryRouter.Path("/{id}/summary").HandlerFunc(s.FindByHouseId()).Methods("GET")
+}
+
+type SummaryHandler interface {
+	FindByHouseId() http.HandlerFunc
+}
+
+// FindByHouseId returns the summary of the house grouped by the groupBy query parameter, the summary is grouped by the
+// month if it is missing
+func (s *SummaryHandlerObject) FindByHouseId() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			from, to := rest.GetRequestFiltering(request)
+			groupBy, _ := rest.GetQueryParamOrDefault(request, "groupBy", string(model.MONTH))
+
+			rest.NewAPIResponse(writer).
+				Ok(s.summaryService.FindByHouseId(id, userId, from, to, model.GroupBy(groupBy))).
+				Perform()
+		}
+	}
+}
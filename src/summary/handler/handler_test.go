@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/VlasovArtem/hob/src/summary/mocks"
+	"github.com/VlasovArtem/hob/src/summary/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type SummaryHandlerTestSuite struct {
+	testhelper.MockTestSuite[SummaryHandler]
+	summaries *mocks.SummaryService
+}
+
+func TestSummaryHandlerTestSuite(t *testing.T) {
+	testingSuite := &SummaryHandlerTestSuite{}
+	testingSuite.TestObjectGenerator = func() SummaryHandler {
+		testingSuite.summaries = new(mocks.SummaryService)
+		return NewSummaryHandler(testingSuite.summaries)
+	}
+
+	suite.Run(t, testingSuite)
+}
+
+func (s *SummaryHandlerTestSuite) Test_FindByHouseId() {
+	id, userId, categoryId := uuid.New(), uuid.New(), uuid.New()
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC)
+	balance := model.BalanceDto{Currency: "UAH", Payments: money.FromMinorUnits(1000), Incomes: money.FromMinorUnits(3000), Balance: money.FromMinorUnits(2000)}
+	expected := model.SummaryDto{
+		GroupBy: model.CATEGORY,
+		Totals:  []model.BalanceDto{balance},
+		Groups:  []model.GroupDto{{CategoryId: &categoryId, Totals: []model.BalanceDto{balance}}},
+	}
+
+	s.summaries.On("FindByHouseId", id, userId, &from, &to, model.CATEGORY).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/summary?from={from}&to={to}&groupBy={groupBy}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(s.TestO.FindByHouseId()).
+		WithVar("id", id.String()).
+		WithParameter("from", from.Format(time.RFC3339)).
+		WithParameter("to", to.Format(time.RFC3339)).
+		WithParameter("groupBy", "category")
+
+	content := testRequest.Verify(s.T(), http.StatusOK)
+
+	actual := model.SummaryDto{}
+
+	assert.Nil(s.T(), json.Unmarshal(content, &actual))
+	assert.Equal(s.T(), expected, actual)
+}
+
+func (s *SummaryHandlerTestSuite) Test_FindByHouseId_WithDefaultGroupBy() {
+	id, userId := uuid.New(), uuid.New()
+
+	s.summaries.On("FindByHouseId", id, userId, (*time.Time)(nil), (*time.Time)(nil), model.MONTH).
+		Return(model.SummaryDto{GroupBy: model.MONTH, Totals: []model.BalanceDto{}, Groups: []model.GroupDto{}}, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/summary").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(s.TestO.FindByHouseId()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(s.T(), http.StatusOK)
+}
+
+func (s *SummaryHandlerTestSuite) Test_FindByHouseId_WithInvalidGroupBy() {
+	id, userId := uuid.New(), uuid.New()
+
+	s.summaries.On("FindByHouseId", id, userId, (*time.Time)(nil), (*time.Time)(nil), model.GroupBy("week")).
+		Return(model.SummaryDto{}, errors.New("group by 'week' is not valid"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/summary?groupBy={groupBy}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(s.TestO.FindByHouseId()).
+		WithVar("id", id.String()).
+		WithParameter("groupBy", "week")
+
+	content := testRequest.Verify(s.T(), http.StatusBadRequest)
+
+	assert.Equal(s.T(), "group by 'week' is not valid\n", string(content))
+}
+
+func (s *SummaryHandlerTestSuite) Test_FindByHouseId_WithNotFoundErrorFromService() {
+	id, userId := uuid.New(), uuid.New()
+
+	s.summaries.On("FindByHouseId", id, userId, (*time.Time)(nil), (*time.Time)(nil), model.MONTH).
+		Return(model.SummaryDto{}, interrors.NewErrNotFound("house with id %s not found", id))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/houses/{id}/summary").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(s.TestO.FindByHouseId()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(s.T(), http.StatusNotFound)
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"github.com/VlasovArtem/hob/src/common/money"
+	"github.com/google/uuid"
+	"time"
+)
+
+// GroupBy is the key the totals of the summary are grouped by
+type GroupBy string
+
+const (
+	MONTH    GroupBy = "month"
+	PROVIDER GroupBy = "provider"
+	CATEGORY GroupBy = "category"
+)
+
+// Total is the sum of the payments or incomes of the group in the currency aggregated in the database, only the field of
+// the group is set
+type Total struct {
+	Month      *time.Time
+	ProviderId *uuid.UUID
+	CategoryId *uuid.UUID
+	Currency   string
+	Sum        money.Money
+}
+
+// BalanceDto is the sum of the payments and incomes in the currency, the balance is the incomes minus the payments
+type BalanceDto struct {
+	Currency string
+	Payments money.Money
+	Incomes  money.Money
+	Balance  money.Money
+}
+
+// GroupDto is the balance of the month, provider or category in every currency, the incomes are not grouped by the
+// provider and are summed in the group without it
+type GroupDto struct {
+	Month      *time.Time
+	ProviderId *uuid.UUID
+	CategoryId *uuid.UUID
+	Totals     []BalanceDto
+}
+
+// SummaryDto is the balance of the house during the period in total and by the groups, the sums in the different currencies
+// are not added up
+type SummaryDto struct {
+	GroupBy GroupBy
+	Totals  []BalanceDto
+	Groups  []GroupDto
+}
+
+func (g GroupBy) IsValid() bool {
+	return g == MONTH || g == PROVIDER || g == CATEGORY
+}
+
+// Key returns the value of the total field the group is identified by, the key of the total without the group is empty
+func (g GroupBy) Key(total Total) string {
+	switch {
+	case g == MONTH && total.Month != nil:
+		return total.Month.Format(time.RFC3339)
+	case g == PROVIDER && total.ProviderId != nil:
+		return total.ProviderId.String()
+	case g == CATEGORY && total.CategoryId != nil:
+		return total.CategoryId.String()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	houses "github.com/VlasovArtem/hob/src/house/service"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	incomes "github.com/VlasovArtem/hob/src/income/repository"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	payments "github.com/VlasovArtem/hob/src/payment/repository"
+	"github.com/VlasovArtem/hob/src/summary/model"
+	"github.com/google/uuid"
+	"sort"
+	"time"
+)
+
+type SummaryServiceObject struct {
+	houseService      houses.HouseService
+	paymentRepository payments.PaymentRepository
+	incomeRepository  incomes.IncomeRepository
+}
+
+func NewSummaryService(
+	houseService houses.HouseService,
+	paymentRepository payments.PaymentRepository,
+	incomeRepository incomes.IncomeRepository) SummaryService {
+	return &SummaryServiceObject{
+		houseService:      houseService,
+		paymentRepository: paymentRepository,
+		incomeRepository:  incomeRepository,
+	}
+}
+
+func (s *SummaryServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewSummaryService(
+		dependency.FindRequiredDependency[houses.HouseServiceObject, houses.HouseService](factory),
+		dependency.FindRequiredDependency[payments.PaymentRepositoryObject, payments.PaymentRepository](factory),
+		dependency.FindRequiredDependency[incomes.IncomeRepositoryObject, incomes.IncomeRepository](factory),
+	)
+}
+
+type SummaryService interface {
+	FindByHouseId(houseId uuid.UUID, userId uuid.UUID, from, to *time.Time, groupBy model.GroupBy) (model.SummaryDto, error)
+}
+
+// FindByHouseId returns the payments, incomes and balance of the house during the period in total and by the groups, the
+// sums are aggregated in the database so all the payments and incomes of the period are counted
+func (s *SummaryServiceObject) FindByHouseId(houseId uuid.UUID, userId uuid.UUID, from, to *time.Time, groupBy model.GroupBy) (model.SummaryDto, error) {
+	if !groupBy.IsValid() {
+		return model.SummaryDto{}, errors.New(fmt.Sprintf(
+			"group by '%s' is not valid, the valid values are '%s', '%s' and '%s'", groupBy, model.MONTH, model.PROVIDER, model.CATEGORY,
+		))
+	}
+	if !s.houseService.HasAccess(houseId, userId) {
+		return model.SummaryDto{}, interrors.NewErrNotFound("house with id %s not found", houseId)
+	}
+
+	// the groups of the summary have the same values as the groups of the repositories
+	paymentTotals, err := s.paymentRepository.SumByHouseId(houseId, from, to, paymentModel.GroupBy(groupBy))
+	if err != nil {
+		return model.SummaryDto{}, err
+	}
+	incomeTotals, err := s.incomeRepository.SumByHouseId(houseId, from, to, incomeModel.GroupBy(groupBy))
+	if err != nil {
+		return model.SummaryDto{}, err
+	}
+
+	summary := newSummary(groupBy)
+	for _, total := range common.MapSlice(paymentTotals, paymentTotal) {
+		summary.add(total, func(balance *model.BalanceDto) { balance.Payments += total.Sum })
+	}
+	for _, total := range common.MapSlice(incomeTotals, incomeTotal) {
+		summary.add(total, func(balance *model.BalanceDto) { balance.Incomes += total.Sum })
+	}
+
+	return summary.toDto(), nil
+}
+
+// summary collects the balances of the groups by the currency
+type summary struct {
+	groupBy  model.GroupBy
+	keys     []string
+	groups   map[string]*model.GroupDto
+	balances map[string]map[string]*model.BalanceDto
+	totals   map[string]*model.BalanceDto
+}
+
+func newSummary(groupBy model.GroupBy) *summary {
+	return &summary{
+		groupBy:  groupBy,
+		groups:   make(map[string]*model.GroupDto),
+		balances: make(map[string]map[string]*model.BalanceDto),
+		totals:   make(map[string]*model.BalanceDto),
+	}
+}
+
+func (s *summary) add(total model.Total, apply func(balance *model.BalanceDto)) {
+	key := s.groupBy.Key(total)
+
+	if _, ok := s.groups[key]; !ok {
+		s.keys = append(s.keys, key)
+		s.groups[key] = &model.GroupDto{Month: total.Month, ProviderId: total.ProviderId, CategoryId: total.CategoryId}
+		s.balances[key] = make(map[string]*model.BalanceDto)
+	}
+
+	apply(balanceOf(s.balances[key], total.Currency))
+	apply(balanceOf(s.totals, total.Currency))
+}
+
+// toDto returns the groups sorted by the key, the months are sorted chronologically and the group without the key is the
+// last one
+func (s *summary) toDto() model.SummaryDto {
+	sort.Slice(s.keys, func(i, j int) bool {
+		if s.keys[i] == "" || s.keys[j] == "" {
+			return s.keys[j] == ""
+		}
+		return s.keys[i] < s.keys[j]
+	})
+
+	groups := make([]model.GroupDto, 0, len(s.keys))
+	for _, key := range s.keys {
+		group := *s.groups[key]
+		group.Totals = balances(s.balances[key])
+		groups = append(groups, group)
+	}
+
+	return model.SummaryDto{
+		GroupBy: s.groupBy,
+		Totals:  balances(s.totals),
+		Groups:  groups,
+	}
+}
+
+func paymentTotal(total paymentModel.Total) model.Total {
+	return model.Total{
+		Month:      total.Month,
+		ProviderId: total.ProviderId,
+		CategoryId: total.CategoryId,
+		Currency:   total.Currency,
+		Sum:        total.Sum,
+	}
+}
+
+func incomeTotal(total incomeModel.Total) model.Total {
+	return model.Total{
+		Month:      total.Month,
+		CategoryId: total.CategoryId,
+		Currency:   total.Currency,
+		Sum:        total.Sum,
+	}
+}
+
+func balanceOf(balances map[string]*model.BalanceDto, currency string) *model.BalanceDto {
+	if balance, ok := balances[currency]; ok {
+		return balance
+	}
+	balance := &model.BalanceDto{Currency: currency}
+	balances[currency] = balance
+	return balance
+}
+
+// balances returns the balances sorted by the currency
+func balances(byCurrency map[string]*model.BalanceDto) []model.BalanceDto {
+	result := make([]model.BalanceDto, 0, len(byCurrency))
+	for _, balance := range byCurrency {
+		balance.Balance = balance.Incomes - balance.Payments
+		result = append(result, *balance)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Currency < result[j].Currency })
+	return result
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/money"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	incomeMocks "github.com/VlasovArtem/hob/src/income/mocks"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	"github.com/VlasovArtem/hob/src/summary/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type SummaryServiceTestSuite struct {
+	testhelper.MockTestSuite[SummaryService]
+	houseService      *houseMocks.HouseService
+	paymentRepository *paymentMocks.PaymentRepository
+	incomeRepository  *incomeMocks.IncomeRepository
+}
+
+func TestSummaryServiceTestSuite(t *testing.T) {
+	ts := &SummaryServiceTestSuite{}
+	ts.TestObjectGenerator = func() SummaryService {
+		ts.houseService = new(houseMocks.HouseService)
+		ts.paymentRepository = new(paymentMocks.PaymentRepository)
+		ts.incomeRepository = new(incomeMocks.IncomeRepository)
+
+		return NewSummaryService(ts.houseService, ts.paymentRepository, ts.incomeRepository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (s *SummaryServiceTestSuite) Test_FindByHouseId() {
+	houseId, userId := uuid.New(), uuid.New()
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	january, february := from, time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	s.houseService.On("HasAccess", houseId, userId).Return(true)
+	s.paymentRepository.On("SumByHouseId", houseId, &from, (*time.Time)(nil), paymentModel.GroupByMonth).Return([]paymentModel.Total{
+		{Month: &january, Currency: "UAH", Sum: money.FromMinorUnits(150000)},
+		{Month: &february, Currency: "EUR", Sum: money.FromMinorUnits(5000)},
+		{Month: &february, Currency: "UAH", Sum: money.FromMinorUnits(120000)},
+	}, nil)
+	s.incomeRepository.On("SumByHouseId", houseId, &from, (*time.Time)(nil), incomeModel.GroupByMonth).Return([]incomeModel.Total{
+		{Month: &february, Currency: "UAH", Sum: money.FromMinorUnits(400000)},
+	}, nil)
+
+	actual, err := s.TestO.FindByHouseId(houseId, userId, &from, nil, model.MONTH)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.SummaryDto{
+		GroupBy: model.MONTH,
+		Totals: []model.BalanceDto{
+			{Currency: "EUR", Payments: money.FromMinorUnits(5000), Balance: money.FromMinorUnits(-5000)},
+			{Currency: "UAH", Payments: money.FromMinorUnits(270000), Incomes: money.FromMinorUnits(400000), Balance: money.FromMinorUnits(130000)},
+		},
+		Groups: []model.GroupDto{
+			{
+				Month:  &january,
+				Totals: []model.BalanceDto{{Currency: "UAH", Payments: money.FromMinorUnits(150000), Balance: money.FromMinorUnits(-150000)}},
+			},
+			{
+				Month: &february,
+				Totals: []model.BalanceDto{
+					{Currency: "EUR", Payments: money.FromMinorUnits(5000), Balance: money.FromMinorUnits(-5000)},
+					{Currency: "UAH", Payments: money.FromMinorUnits(120000), Incomes: money.FromMinorUnits(400000), Balance: money.FromMinorUnits(280000)},
+				},
+			},
+		},
+	}, actual)
+}
+
+func (s *SummaryServiceTestSuite) Test_FindByHouseId_WithProviders() {
+	houseId, userId, providerId := uuid.New(), uuid.New(), uuid.New()
+
+	s.houseService.On("HasAccess", houseId, userId).Return(true)
+	s.paymentRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), paymentModel.GroupByProvider).Return([]paymentModel.Total{
+		{ProviderId: &providerId, Currency: "UAH", Sum: money.FromMinorUnits(100000)},
+		{Currency: "UAH", Sum: money.FromMinorUnits(20000)},
+	}, nil)
+	s.incomeRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), incomeModel.GroupByProvider).Return([]incomeModel.Total{
+		{Currency: "UAH", Sum: money.FromMinorUnits(300000)},
+	}, nil)
+
+	actual, err := s.TestO.FindByHouseId(houseId, userId, nil, nil, model.PROVIDER)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []model.GroupDto{
+		{
+			ProviderId: &providerId,
+			Totals:     []model.BalanceDto{{Currency: "UAH", Payments: money.FromMinorUnits(100000), Balance: money.FromMinorUnits(-100000)}},
+		},
+		{
+			Totals: []model.BalanceDto{{Currency: "UAH", Payments: money.FromMinorUnits(20000), Incomes: money.FromMinorUnits(300000), Balance: money.FromMinorUnits(280000)}},
+		},
+	}, actual.Groups)
+}
+
+func (s *SummaryServiceTestSuite) Test_FindByHouseId_WithoutData() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	s.houseService.On("HasAccess", houseId, userId).Return(true)
+	s.paymentRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), paymentModel.GroupByCategory).Return([]paymentModel.Total{}, nil)
+	s.incomeRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), incomeModel.GroupByCategory).Return([]incomeModel.Total{}, nil)
+
+	actual, err := s.TestO.FindByHouseId(houseId, userId, nil, nil, model.CATEGORY)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.SummaryDto{GroupBy: model.CATEGORY, Totals: []model.BalanceDto{}, Groups: []model.GroupDto{}}, actual)
+}
+
+func (s *SummaryServiceTestSuite) Test_FindByHouseId_WithInvalidGroupBy() {
+	actual, err := s.TestO.FindByHouseId(uuid.New(), uuid.New(), nil, nil, "week")
+
+	assert.Equal(s.T(), errors.New("group by 'week' is not valid, the valid values are 'month', 'provider' and 'category'"), err)
+	assert.Equal(s.T(), model.SummaryDto{}, actual)
+	s.houseService.AssertNotCalled(s.T(), "HasAccess", mock.Anything, mock.Anything)
+}
+
+func (s *SummaryServiceTestSuite) Test_FindByHouseId_WithoutAccess() {
+	houseId, userId := uuid.New(), uuid.New()
+
+	s.houseService.On("HasAccess", houseId, userId).Return(false)
+
+	actual, err := s.TestO.FindByHouseId(houseId, userId, nil, nil, model.MONTH)
+
+	assert.Equal(s.T(), interrors.NewErrNotFound("house with id %s not found", houseId), err)
+	assert.Equal(s.T(), model.SummaryDto{}, actual)
+	s.paymentRepository.AssertNotCalled(s.T(), "SumByHouseId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SummaryServiceTestSuite) Test_FindByHouseId_WithErrorFromIncomeRepository() {
+	houseId, userId := uuid.New(), uuid.New()
+	expectedError := errors.New("error")
+
+	s.houseService.On("HasAccess", houseId, userId).Return(true)
+	s.paymentRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), paymentModel.GroupByMonth).Return([]paymentModel.Total{}, nil)
+	s.incomeRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), incomeModel.GroupByMonth).Return(nil, expectedError)
+
+	actual, err := s.TestO.FindByHouseId(houseId, userId, nil, nil, model.MONTH)
+
+	assert.Equal(s.T(), expectedError, err)
+	assert.Equal(s.T(), model.SummaryDto{}, actual)
+}
+
+func (s *SummaryServiceTestSuite) Test_FindByHouseId_WithErrorFromPaymentRepository() {
+	houseId, userId := uuid.New(), uuid.New()
+	expectedError := errors.New("error")
+
+	s.houseService.On("HasAccess", houseId, userId).Return(true)
+	s.paymentRepository.On("SumByHouseId", houseId, (*time.Time)(nil), (*time.Time)(nil), paymentModel.GroupByMonth).Return(nil, expectedError)
+
+	actual, err := s.TestO.FindByHouseId(houseId, userId, nil, nil, model.MONTH)
+
+	assert.Equal(s.T(), expectedError, err)
+	assert.Equal(s.T(), model.SummaryDto{}, actual)
+	s.incomeRepository.AssertNotCalled(s.T(), "SumByHouseId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/rest"
+	"github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/VlasovArtem/hob/src/tag/service"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type TagHandlerObject struct {
+	tagService service.TagService
+}
+
+func NewTagHandler(tagService service.TagService) TagHandler {
+	return &TagHandlerObject{tagService}
+}
+
+func (t *TagHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewTagHandler(
+		dependency.FindRequiredDependency[service.TagServiceObject, service.TagService](factory),
+	)
+}
+
+func (t *TagHandlerObject) Init(router *mux.Router) {
+	tagRouter := router.PathPrefix("/api/v1/tags").Subrouter()
+
+	tagRouter.Path("").HandlerFunc(t.Add()).Methods("POST")
+	tagRouter.Path("").HandlerFunc(t.FindByUserId()).Methods("GET")
+	tagRouter.Path("/{id}").HandlerFunc(t.FindById()).Methods("GET")
+	tagRouter.Path("/{id}").HandlerFunc(t.Update()).Methods("PUT")
+	tagRouter.Path("/{id}").HandlerFunc(t.Delete()).Methods("DELETE")
+}
+
+type TagHandler interface {
+	Add() http.HandlerFunc
+	FindById() http.HandlerFunc
+	FindByUserId() http.HandlerFunc
+	Update() http.HandlerFunc
+	Delete() http.HandlerFunc
+}
+
+func (t *TagHandlerObject) Add() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		userId, err := rest.GetUserId(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		if body, err := rest.ReadRequestBody[model.CreateTagRequest](request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			body.UserId = userId
+
+			rest.NewAPIResponse(writer).
+				Created(t.tagService.Add(body)).
+				Perform()
+		}
+	}
+}
+
+func (t *TagHandlerObject) FindById() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Ok(t.tagService.FindById(id, userId)).
+				Perform()
+		}
+	}
+}
+
+func (t *TagHandlerObject) FindByUserId() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if userId, err := rest.GetUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			prefix, _ := rest.GetQueryParamOrDefault(request, "prefix", "")
+
+			rest.NewAPIResponse(writer).
+				Body(t.tagService.FindByUserId(userId, prefix)).
+				Perform()
+		}
+	}
+}
+
+func (t *TagHandlerObject) Update() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			if body, err := rest.ReadRequestBody[model.UpdateTagRequest](request); err != nil {
+				rest.HandleWithError(writer, err)
+			} else {
+				rest.NewAPIResponse(writer).
+					Error(t.tagService.Update(id, userId, body)).
+					Perform()
+			}
+		}
+	}
+}
+
+func (t *TagHandlerObject) Delete() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, userId, err := rest.GetIdRequestParameterAndUserId(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				NoContent(t.tagService.DeleteById(id, userId)).
+				Perform()
+		}
+	}
+}
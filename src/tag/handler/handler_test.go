@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	int_errors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/tag/mocks"
+	"github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+)
+
+type TagHandlerTestSuite struct {
+	testhelper.MockTestSuite[TagHandler]
+	tagService *mocks.TagService
+}
+
+func TestTagHandlerTestSuite(t *testing.T) {
+	testingSuite := &TagHandlerTestSuite{}
+	testingSuite.TestObjectGenerator = func() TagHandler {
+		testingSuite.tagService = new(mocks.TagService)
+		return NewTagHandler(testingSuite.tagService)
+	}
+
+	suite.Run(t, testingSuite)
+}
+
+func (c *TagHandlerTestSuite) Test_Add() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateTagRequest(userId)
+	expected := request.ToEntity().ToDto()
+
+	c.tagService.On("Add", request).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(c.TestO.Add()).
+		WithBody(model.CreateTagRequest{Name: request.Name})
+
+	content := testRequest.Verify(c.T(), http.StatusCreated)
+
+	actual := model.TagDto{}
+
+	assert.Nil(c.T(), json.Unmarshal(content, &actual))
+	assert.Equal(c.T(), expected, actual)
+}
+
+func (c *TagHandlerTestSuite) Test_Add_WithInvalidRequest() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(c.TestO.Add())
+
+	testRequest.Verify(c.T(), http.StatusBadRequest)
+}
+
+func (c *TagHandlerTestSuite) Test_Add_WithoutUser() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags").
+		WithMethod("POST").
+		WithHandler(c.TestO.Add()).
+		WithBody(mocks.GenerateCreateTagRequest(uuid.New()))
+
+	content := testRequest.Verify(c.T(), http.StatusUnauthorized)
+
+	assert.Equal(c.T(), "user is not authenticated\n", string(content))
+}
+
+func (c *TagHandlerTestSuite) Test_Add_WithErrorFromService() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateTagRequest(userId)
+
+	c.tagService.On("Add", request).Return(model.TagDto{}, errors.New("error"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags").
+		WithMethod("POST").
+		WithUser(userId).
+		WithHandler(c.TestO.Add()).
+		WithBody(request)
+
+	content := testRequest.Verify(c.T(), http.StatusBadRequest)
+
+	assert.Equal(c.T(), "error\n", string(content))
+}
+
+func (c *TagHandlerTestSuite) Test_FindById() {
+	userId := uuid.New()
+	expected := mocks.GenerateTag(userId, "landlord").ToDto()
+
+	c.tagService.On("FindById", expected.Id, userId).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags/{id}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(c.TestO.FindById()).
+		WithVar("id", expected.Id.String())
+
+	content := testRequest.Verify(c.T(), http.StatusOK)
+
+	actual := model.TagDto{}
+
+	assert.Nil(c.T(), json.Unmarshal(content, &actual))
+	assert.Equal(c.T(), expected, actual)
+}
+
+func (c *TagHandlerTestSuite) Test_FindById_WithNotFoundErrorFromService() {
+	userId, id := uuid.New(), uuid.New()
+
+	c.tagService.On("FindById", id, userId).Return(model.TagDto{}, int_errors.NewErrNotFound("test"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags/{id}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(c.TestO.FindById()).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(c.T(), http.StatusNotFound)
+
+	assert.Equal(c.T(), "test\n", string(content))
+}
+
+func (c *TagHandlerTestSuite) Test_FindById_WithInvalidId() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags/{id}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(c.TestO.FindById()).
+		WithVar("id", "id")
+
+	content := testRequest.Verify(c.T(), http.StatusBadRequest)
+
+	assert.Equal(c.T(), "the id is not valid id\n", string(content))
+}
+
+func (c *TagHandlerTestSuite) Test_FindByUserId() {
+	userId := uuid.New()
+	expected := []model.TagDto{mocks.GenerateTag(userId, "landlord").ToDto()}
+
+	c.tagService.On("FindByUserId", userId, "").Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(c.TestO.FindByUserId())
+
+	content := testRequest.Verify(c.T(), http.StatusOK)
+
+	var actual []model.TagDto
+
+	assert.Nil(c.T(), json.Unmarshal(content, &actual))
+	assert.Equal(c.T(), expected, actual)
+}
+
+func (c *TagHandlerTestSuite) Test_FindByUserId_WithPrefix() {
+	userId := uuid.New()
+	expected := []model.TagDto{mocks.GenerateTag(userId, "landlord").ToDto()}
+
+	c.tagService.On("FindByUserId", userId, "land").Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags?prefix={prefix}").
+		WithMethod("GET").
+		WithUser(userId).
+		WithHandler(c.TestO.FindByUserId()).
+		WithParameter("prefix", "land")
+
+	content := testRequest.Verify(c.T(), http.StatusOK)
+
+	var actual []model.TagDto
+
+	assert.Nil(c.T(), json.Unmarshal(content, &actual))
+	assert.Equal(c.T(), expected, actual)
+}
+
+func (c *TagHandlerTestSuite) Test_Update() {
+	userId, id := uuid.New(), uuid.New()
+	request := model.UpdateTagRequest{Name: "rent"}
+
+	c.tagService.On("Update", id, userId, request).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags/{id}").
+		WithMethod("PUT").
+		WithUser(userId).
+		WithHandler(c.TestO.Update()).
+		WithVar("id", id.String()).
+		WithBody(request)
+
+	testRequest.Verify(c.T(), http.StatusOK)
+}
+
+func (c *TagHandlerTestSuite) Test_Update_WithErrorFromService() {
+	userId, id := uuid.New(), uuid.New()
+	request := model.UpdateTagRequest{Name: "rent"}
+
+	c.tagService.On("Update", id, userId, request).Return(errors.New("error"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags/{id}").
+		WithMethod("PUT").
+		WithUser(userId).
+		WithHandler(c.TestO.Update()).
+		WithVar("id", id.String()).
+		WithBody(request)
+
+	content := testRequest.Verify(c.T(), http.StatusBadRequest)
+
+	assert.Equal(c.T(), "error\n", string(content))
+}
+
+func (c *TagHandlerTestSuite) Test_Update_WithInvalidRequest() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags/{id}").
+		WithMethod("PUT").
+		WithUser(uuid.New()).
+		WithHandler(c.TestO.Update()).
+		WithVar("id", uuid.New().String())
+
+	testRequest.Verify(c.T(), http.StatusBadRequest)
+}
+
+func (c *TagHandlerTestSuite) Test_Delete() {
+	userId, id := uuid.New(), uuid.New()
+
+	c.tagService.On("DeleteById", id, userId).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags/{id}").
+		WithMethod("DELETE").
+		WithUser(userId).
+		WithHandler(c.TestO.Delete()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(c.T(), http.StatusNoContent)
+}
+
+func (c *TagHandlerTestSuite) Test_Delete_WithErrorFromService() {
+	userId, id := uuid.New(), uuid.New()
+
+	c.tagService.On("DeleteById", id, userId).Return(int_errors.NewErrNotFound("tag with id %s not found", id))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/tags/{id}").
+		WithMethod("DELETE").
+		WithUser(userId).
+		WithHandler(c.TestO.Delete()).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(c.T(), http.StatusNotFound)
+
+	assert.Equal(c.T(), fmt.Sprintf("tag with id %s not found\n", id), string(content))
+}
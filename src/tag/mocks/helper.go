@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/google/uuid"
+)
+
+func GenerateCreateTagRequest(userId uuid.UUID) model.CreateTagRequest {
+	return model.CreateTagRequest{
+		Name:   "tax-deductible",
+		UserId: userId,
+	}
+}
+
+func GenerateTag(userId uuid.UUID, name string) model.Tag {
+	return model.Tag{
+		Id:     uuid.New(),
+		Name:   name,
+		UserId: userId,
+	}
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"fmt"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+)
+
+// Tag is the free-form label of the user attached to the payments and incomes
+type Tag struct {
+	Id     uuid.UUID `gorm:"primarykey"`
+	Name   string
+	UserId uuid.UUID      `gorm:"index"`
+	User   userModel.User `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+type CreateTagRequest struct {
+	Name   string
+	UserId uuid.UUID
+}
+
+type UpdateTagRequest struct {
+	Name string
+}
+
+type TagDto struct {
+	Id     uuid.UUID
+	Name   string
+	UserId uuid.UUID
+}
+
+// Filter selects the payments and incomes by the tags, the rows should have all the tags if All is set or any of them
+// otherwise. The filter without the tags selects all the rows
+type Filter struct {
+	Ids []uuid.UUID
+	All bool
+}
+
+func (t Tag) ToDto() TagDto {
+	return TagDto{
+		Id:     t.Id,
+		Name:   t.Name,
+		UserId: t.UserId,
+	}
+}
+
+func (c CreateTagRequest) ToEntity() Tag {
+	return Tag{
+		Id:     uuid.New(),
+		Name:   c.Name,
+		UserId: c.UserId,
+	}
+}
+
+func TagToTagDto(tag Tag) TagDto {
+	return tag.ToDto()
+}
+
+func TagIdToTag(id uuid.UUID) Tag {
+	return Tag{Id: id}
+}
+
+func (f Filter) IsEmpty() bool {
+	return len(f.Ids) == 0
+}
+
+// Condition returns the query of the column with the ids of the tagged rows, the join table links the rows by the join
+// column with the tags by the tag_id column
+func (f Filter) Condition(column string, joinTable string, joinColumn string) (string, []any) {
+	if !f.All {
+		return fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE tag_id IN ?)", column, joinColumn, joinTable), []any{f.Ids}
+	}
+
+	unique := make(map[uuid.UUID]bool, len(f.Ids))
+	for _, id := range f.Ids {
+		unique[id] = true
+	}
+
+	return fmt.Sprintf(
+		"%s IN (SELECT %s FROM %s WHERE tag_id IN ? GROUP BY %s HAVING COUNT(DISTINCT tag_id) = ?)",
+		column, joinColumn, joinTable, joinColumn,
+	), []any{f.Ids, len(unique)}
+}
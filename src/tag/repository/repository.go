@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"strings"
+)
+
+var entity = model.Tag{}
+
+type TagRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewTagRepository(database db.DatabaseService) TagRepository {
+	return &TagRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (t *TagRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewTagRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (t *TagRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type TagRepository interface {
+	Create(entity model.Tag) (model.Tag, error)
+	FindById(id uuid.UUID) (model.Tag, error)
+	FindByUserId(userId uuid.UUID, prefix string) []model.TagDto
+	ExistsByName(userId uuid.UUID, name string, excludeId uuid.UUID) bool
+	CountByIdsAndUserId(ids []uuid.UUID, userId uuid.UUID) int64
+	Update(id uuid.UUID, name string) error
+	DeleteById(id uuid.UUID) error
+}
+
+func (t *TagRepositoryObject) Create(entity model.Tag) (model.Tag, error) {
+	return entity, t.database.Create(&entity)
+}
+
+func (t *TagRepositoryObject) FindById(id uuid.UUID) (response model.Tag, err error) {
+	return response, t.database.Find(&response, id)
+}
+
+// FindByUserId returns the tags of the user sorted by the name, only the tags starting with the prefix are returned if it
+// is not empty
+func (t *TagRepositoryObject) FindByUserId(userId uuid.UUID, prefix string) (response []model.TagDto) {
+	query := t.database.Modeled().Where("user_id = ?", userId)
+
+	if prefix != "" {
+		query = query.Where("LOWER(name) LIKE ?", escapeLike(strings.ToLower(prefix))+"%")
+	}
+
+	if err := query.Order("name").Find(&response).Error; err != nil {
+		return []model.TagDto{}
+	}
+
+	return response
+}
+
+// ExistsByName checks that the user has the tag with the name ignoring the case, the tag with the excluded id is skipped
+func (t *TagRepositoryObject) ExistsByName(userId uuid.UUID, name string, excludeId uuid.UUID) bool {
+	return t.database.ExistsBy("user_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", userId, name, excludeId)
+}
+
+func (t *TagRepositoryObject) CountByIdsAndUserId(ids []uuid.UUID, userId uuid.UUID) (count int64) {
+	t.database.Modeled().Where("id IN ? AND user_id = ?", ids, userId).Count(&count)
+	return count
+}
+
+func (t *TagRepositoryObject) Update(id uuid.UUID, name string) error {
+	return t.database.Modeled().Where("id = ?", id).Update("name", name).Error
+}
+
+// DeleteById removes the tag from the payments and incomes and deletes it
+func (t *TagRepositoryObject) DeleteById(id uuid.UUID) error {
+	return t.database.D().Transaction(func(tx *gorm.DB) error {
+		for _, joinTable := range []string{"payment_tags", "income_tags"} {
+			if err := tx.Exec("DELETE FROM "+joinTable+" WHERE tag_id = ?", id).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Delete(&model.Tag{}, "id = ?", id).Error
+	})
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/db"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	"github.com/VlasovArtem/hob/src/tag/mocks"
+	"github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type TagRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository      TagRepository
+	createdUser     userModel.User
+	createdHouse    houseModel.House
+	createdProvider providerModel.Provider
+}
+
+func (t *TagRepositoryTestSuite) SetupSuite() {
+	t.InitDBTestSuite()
+
+	t.CreateRepository(
+		func(service db.DatabaseService) {
+			t.repository = NewTagRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTableCascade(service, "payment_tags")
+			database.TruncateTable(service, paymentModel.Payment{})
+			database.TruncateTable(service, model.Tag{})
+		}).
+		AddAfterSuite(func(service db.DatabaseService) {
+			database.TruncateTable(service, providerModel.Provider{})
+			database.TruncateTable(service, houseModel.House{})
+			database.TruncateTable(service, userModel.User{})
+		}).
+		ExecuteMigration(
+			userModel.User{},
+			houseModel.House{},
+			providerModel.Provider{},
+			model.Tag{},
+			paymentModel.Payment{},
+			incomeModel.Income{},
+		)
+
+	t.createdUser = userMocks.GenerateUser()
+	t.CreateEntity(&t.createdUser)
+
+	t.createdHouse = houseMocks.GenerateHouse(t.createdUser.Id)
+	t.CreateEntity(&t.createdHouse)
+
+	t.createdProvider = providerMocks.GenerateProvider(t.createdUser.Id)
+	t.CreateEntity(&t.createdProvider)
+}
+
+func TestTagRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TagRepositoryTestSuite))
+}
+
+func (t *TagRepositoryTestSuite) Test_Create() {
+	entity := mocks.GenerateTag(t.createdUser.Id, "landlord")
+
+	actual, err := t.repository.Create(entity)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), entity, actual)
+}
+
+func (t *TagRepositoryTestSuite) Test_FindById() {
+	entity := t.createTag("landlord")
+
+	actual, err := t.repository.FindById(entity.Id)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), entity, actual)
+}
+
+func (t *TagRepositoryTestSuite) Test_FindById_WithNotExists() {
+	actual, err := t.repository.FindById(uuid.New())
+
+	assert.ErrorIs(t.T(), err, gorm.ErrRecordNotFound)
+	assert.Equal(t.T(), model.Tag{}, actual)
+}
+
+func (t *TagRepositoryTestSuite) Test_FindByUserId() {
+	reimbursable := t.createTag("reimbursable")
+	landlord := t.createTag("landlord")
+
+	actual := t.repository.FindByUserId(t.createdUser.Id, "")
+
+	assert.Equal(t.T(), []model.TagDto{landlord.ToDto(), reimbursable.ToDto()}, actual)
+}
+
+func (t *TagRepositoryTestSuite) Test_FindByUserId_WithPrefix() {
+	t.createTag("reimbursable")
+	landlord := t.createTag("Landlord")
+
+	assert.Equal(t.T(), []model.TagDto{landlord.ToDto()}, t.repository.FindByUserId(t.createdUser.Id, "LAND"))
+	assert.Empty(t.T(), t.repository.FindByUserId(t.createdUser.Id, "%"))
+}
+
+func (t *TagRepositoryTestSuite) Test_FindByUserId_WithAnotherUser() {
+	t.createTag("landlord")
+
+	assert.Empty(t.T(), t.repository.FindByUserId(uuid.New(), ""))
+}
+
+func (t *TagRepositoryTestSuite) Test_ExistsByName() {
+	landlord := t.createTag("Landlord")
+
+	assert.True(t.T(), t.repository.ExistsByName(t.createdUser.Id, "landlord", uuid.Nil))
+	assert.False(t.T(), t.repository.ExistsByName(t.createdUser.Id, "landlord", landlord.Id))
+	assert.False(t.T(), t.repository.ExistsByName(uuid.New(), "landlord", uuid.Nil))
+}
+
+func (t *TagRepositoryTestSuite) Test_CountByIdsAndUserId() {
+	landlord := t.createTag("landlord")
+	reimbursable := t.createTag("reimbursable")
+
+	assert.Equal(t.T(), int64(2), t.repository.CountByIdsAndUserId([]uuid.UUID{landlord.Id, reimbursable.Id, uuid.New()}, t.createdUser.Id))
+	assert.Equal(t.T(), int64(0), t.repository.CountByIdsAndUserId([]uuid.UUID{landlord.Id}, uuid.New()))
+}
+
+func (t *TagRepositoryTestSuite) Test_Update() {
+	landlord := t.createTag("landlord")
+
+	assert.Nil(t.T(), t.repository.Update(landlord.Id, "rent"))
+
+	actual, err := t.repository.FindById(landlord.Id)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), "rent", actual.Name)
+}
+
+func (t *TagRepositoryTestSuite) Test_DeleteById() {
+	landlord := t.createTag("landlord")
+	reimbursable := t.createTag("reimbursable")
+	payment := paymentMocks.GeneratePayment(t.createdHouse.Id, t.createdUser.Id, t.createdProvider.Id)
+	payment.Tags = []model.Tag{landlord, reimbursable}
+	t.CreateEntity(&payment)
+
+	err := t.repository.DeleteById(landlord.Id)
+
+	assert.Nil(t.T(), err)
+
+	_, err = t.repository.FindById(landlord.Id)
+
+	assert.ErrorIs(t.T(), err, gorm.ErrRecordNotFound)
+
+	actual := paymentModel.Payment{}
+	assert.Nil(t.T(), t.Database.D().Preload("Tags").First(&actual, payment.Id).Error)
+	assert.Len(t.T(), actual.Tags, 1)
+	assert.Equal(t.T(), reimbursable.Id, actual.Tags[0].Id)
+}
+
+func (t *TagRepositoryTestSuite) createTag(name string) model.Tag {
+	entity := mocks.GenerateTag(t.createdUser.Id, name)
+
+	t.CreateEntity(&entity)
+
+	return entity
+}
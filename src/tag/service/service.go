@@ -0,0 +1,156 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/VlasovArtem/hob/src/tag/repository"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// Separator separates the tags entered as the text, the tag name could not contain it
+const Separator = ","
+
+type TagServiceObject struct {
+	userService userService.UserService
+	repository  repository.TagRepository
+}
+
+func NewTagService(userService userService.UserService, repository repository.TagRepository) TagService {
+	return &TagServiceObject{
+		userService: userService,
+		repository:  repository,
+	}
+}
+
+func (t *TagServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewTagService(
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[repository.TagRepositoryObject, repository.TagRepository](factory),
+	)
+}
+
+type TagService interface {
+	Add(request model.CreateTagRequest) (model.TagDto, error)
+	FindById(id uuid.UUID, userId uuid.UUID) (model.TagDto, error)
+	FindByUserId(userId uuid.UUID, prefix string) []model.TagDto
+	FindOrCreate(userId uuid.UUID, names []string) ([]model.TagDto, error)
+	ExistsByIdsAndUserId(ids []uuid.UUID, userId uuid.UUID) bool
+	Update(id uuid.UUID, userId uuid.UUID, request model.UpdateTagRequest) error
+	DeleteById(id uuid.UUID, userId uuid.UUID) error
+}
+
+// Add creates the tag of the user, the name should be unique among the tags of the user ignoring the case
+func (t *TagServiceObject) Add(request model.CreateTagRequest) (response model.TagDto, err error) {
+	if !t.userService.ExistsById(request.UserId) {
+		return response, interrors.NewErrNotFound("user with id %s not found", request.UserId)
+	}
+
+	request.Name = strings.TrimSpace(request.Name)
+	if err = t.validate(request.UserId, uuid.Nil, request.Name); err != nil {
+		return response, err
+	}
+
+	if entity, err := t.repository.Create(request.ToEntity()); err != nil {
+		return response, err
+	} else {
+		return entity.ToDto(), nil
+	}
+}
+
+func (t *TagServiceObject) FindById(id uuid.UUID, userId uuid.UUID) (model.TagDto, error) {
+	if tag, err := t.repository.FindById(id); err != nil || tag.UserId != userId {
+		return model.TagDto{}, notFoundError(id)
+	} else {
+		return tag.ToDto(), nil
+	}
+}
+
+// FindByUserId returns the tags of the user sorted by the name, the prefix is used for the autocompletion of the tags
+func (t *TagServiceObject) FindByUserId(userId uuid.UUID, prefix string) []model.TagDto {
+	return t.repository.FindByUserId(userId, strings.TrimSpace(prefix))
+}
+
+// FindOrCreate returns the tags of the user with the names, the missing tags are created. The names are trimmed and the
+// empty ones are skipped
+func (t *TagServiceObject) FindOrCreate(userId uuid.UUID, names []string) ([]model.TagDto, error) {
+	existing := make(map[string]model.TagDto)
+	for _, tag := range t.repository.FindByUserId(userId, "") {
+		existing[strings.ToLower(tag.Name)] = tag
+	}
+
+	response := make([]model.TagDto, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if tag, ok := existing[strings.ToLower(name)]; ok {
+			response = append(response, tag)
+			continue
+		}
+
+		tag, err := t.Add(model.CreateTagRequest{Name: name, UserId: userId})
+		if err != nil {
+			return nil, err
+		}
+		existing[strings.ToLower(name)] = tag
+		response = append(response, tag)
+	}
+
+	return response, nil
+}
+
+// ExistsByIdsAndUserId checks that all the tags belong to the user
+func (t *TagServiceObject) ExistsByIdsAndUserId(ids []uuid.UUID, userId uuid.UUID) bool {
+	unique := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		unique[id] = true
+	}
+
+	return t.repository.CountByIdsAndUserId(ids, userId) == int64(len(unique))
+}
+
+func (t *TagServiceObject) Update(id uuid.UUID, userId uuid.UUID, request model.UpdateTagRequest) error {
+	if _, err := t.FindById(id, userId); err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(request.Name)
+	if err := t.validate(userId, id, name); err != nil {
+		return err
+	}
+
+	return t.repository.Update(id, name)
+}
+
+// DeleteById removes the tag, the payments and incomes keep the other tags
+func (t *TagServiceObject) DeleteById(id uuid.UUID, userId uuid.UUID) error {
+	if _, err := t.FindById(id, userId); err != nil {
+		return err
+	}
+
+	return t.repository.DeleteById(id)
+}
+
+// validate checks the name of the tag, the id is uuid.Nil for the new tag
+func (t *TagServiceObject) validate(userId uuid.UUID, id uuid.UUID, name string) error {
+	if name == "" {
+		return errors.New("name should not be empty")
+	}
+	if strings.Contains(name, Separator) {
+		return errors.New(fmt.Sprintf("name should not contain '%s'", Separator))
+	}
+	if t.repository.ExistsByName(userId, name, id) {
+		return errors.New(fmt.Sprintf("tag with name '%s' already exists", name))
+	}
+	return nil
+}
+
+func notFoundError(id uuid.UUID) error {
+	return interrors.NewErrNotFound("tag with id %s not found", id)
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"errors"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/tag/mocks"
+	"github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type TagServiceTestSuite struct {
+	testhelper.MockTestSuite[TagService]
+	userService *userMocks.UserService
+	repository  *mocks.TagRepository
+}
+
+func TestTagServiceTestSuite(t *testing.T) {
+	ts := &TagServiceTestSuite{}
+	ts.TestObjectGenerator = func() TagService {
+		ts.userService = new(userMocks.UserService)
+		ts.repository = new(mocks.TagRepository)
+
+		return NewTagService(ts.userService, ts.repository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (t *TagServiceTestSuite) Test_Add() {
+	userId := uuid.New()
+	request := model.CreateTagRequest{Name: " landlord ", UserId: userId}
+
+	t.userService.On("ExistsById", userId).Return(true)
+	t.repository.On("ExistsByName", userId, "landlord", uuid.Nil).Return(false)
+	t.repository.On("Create", mock.Anything).Return(func(entity model.Tag) model.Tag { return entity }, nil)
+
+	actual, err := t.TestO.Add(request)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), model.TagDto{Id: actual.Id, Name: "landlord", UserId: userId}, actual)
+}
+
+func (t *TagServiceTestSuite) Test_Add_WithUserNotExists() {
+	request := mocks.GenerateCreateTagRequest(uuid.New())
+
+	t.userService.On("ExistsById", request.UserId).Return(false)
+
+	actual, err := t.TestO.Add(request)
+
+	assert.Equal(t.T(), interrors.NewErrNotFound("user with id %s not found", request.UserId), err)
+	assert.Equal(t.T(), model.TagDto{}, actual)
+	t.repository.AssertNotCalled(t.T(), "Create", mock.Anything)
+}
+
+func (t *TagServiceTestSuite) Test_Add_WithEmptyName() {
+	request := model.CreateTagRequest{Name: " ", UserId: uuid.New()}
+
+	t.userService.On("ExistsById", request.UserId).Return(true)
+
+	_, err := t.TestO.Add(request)
+
+	assert.Equal(t.T(), errors.New("name should not be empty"), err)
+	t.repository.AssertNotCalled(t.T(), "Create", mock.Anything)
+}
+
+func (t *TagServiceTestSuite) Test_Add_WithSeparatorInName() {
+	request := model.CreateTagRequest{Name: "tax,landlord", UserId: uuid.New()}
+
+	t.userService.On("ExistsById", request.UserId).Return(true)
+
+	_, err := t.TestO.Add(request)
+
+	assert.Equal(t.T(), errors.New("name should not contain ','"), err)
+	t.repository.AssertNotCalled(t.T(), "Create", mock.Anything)
+}
+
+func (t *TagServiceTestSuite) Test_Add_WithExistingName() {
+	request := mocks.GenerateCreateTagRequest(uuid.New())
+
+	t.userService.On("ExistsById", request.UserId).Return(true)
+	t.repository.On("ExistsByName", request.UserId, request.Name, uuid.Nil).Return(true)
+
+	_, err := t.TestO.Add(request)
+
+	assert.Equal(t.T(), errors.New("tag with name 'tax-deductible' already exists"), err)
+	t.repository.AssertNotCalled(t.T(), "Create", mock.Anything)
+}
+
+func (t *TagServiceTestSuite) Test_FindById() {
+	userId := uuid.New()
+	tag := mocks.GenerateTag(userId, "landlord")
+
+	t.repository.On("FindById", tag.Id).Return(tag, nil)
+
+	actual, err := t.TestO.FindById(tag.Id, userId)
+
+	assert.Nil(t.T(), err)
+	assert.Equal(t.T(), tag.ToDto(), actual)
+}
+
+func (t *TagServiceTestSuite) Test_FindById_WithAnotherUser() {
+	tag := mocks.GenerateTag(uuid.New(), "landlord")
+
+	t.repository.On("FindById", tag.Id).Return(tag, nil)
+
+	actual, err := t.TestO.FindById(tag.Id, uuid.New())
+
+	assert.Equal(t.T(), interrors.NewErrNotFound("tag with id %s not found", tag.Id), err)
+	assert.Equal(t.T(), model.TagDto{}, actual)
+}
+
+func (t *TagServiceTestSuite) Test_FindById_WithNotExists() {
+	id := uuid.New()
+
+	t.repository.On("FindById", id).Return(model.Tag{}, gorm.ErrRecordNotFound)
+
+	_, err := t.TestO.FindById(id, uuid.New())
+
+	assert.Equal(t.T(), interrors.NewErrNotFound("tag with id %s not found", id), err)
+}
+
+func (t *TagServiceTestSuite) Test_FindByUserId() {
+	userId := uuid.New()
+	expected := []model.TagDto{mocks.GenerateTag(userId, "landlord").ToDto()}
+
+	t.repository.On("FindByUserId", userId, "land").Return(expected)
+
+	assert.Equal(t.T(), expected, t.TestO.FindByUserId(userId, " land "))
+}
+
+func (t *TagServiceTestSuite) Test_FindOrCreate() {
+	userId := uuid.New()
+	landlord := mocks.GenerateTag(userId, "landlord").ToDto()
+
+	t.repository.On("FindByUserId", userId, "").Return([]model.TagDto{landlord})
+	t.userService.On("ExistsById", userId).Return(true)
+	t.repository.On("ExistsByName", userId, "reimbursable", uuid.Nil).Return(false)
+	t.repository.On("Create", mock.Anything).Return(func(entity model.Tag) model.Tag { return entity }, nil)
+
+	actual, err := t.TestO.FindOrCreate(userId, []string{" Landlord", "", "reimbursable", "REIMBURSABLE"})
+
+	assert.Nil(t.T(), err)
+	assert.Len(t.T(), actual, 3)
+	assert.Equal(t.T(), landlord, actual[0])
+	assert.Equal(t.T(), "reimbursable", actual[1].Name)
+	assert.Equal(t.T(), actual[1], actual[2])
+	t.repository.AssertNumberOfCalls(t.T(), "Create", 1)
+}
+
+func (t *TagServiceTestSuite) Test_FindOrCreate_WithInvalidName() {
+	userId := uuid.New()
+
+	t.repository.On("FindByUserId", userId, "").Return([]model.TagDto{})
+	t.userService.On("ExistsById", userId).Return(false)
+
+	actual, err := t.TestO.FindOrCreate(userId, []string{"landlord"})
+
+	assert.Equal(t.T(), interrors.NewErrNotFound("user with id %s not found", userId), err)
+	assert.Nil(t.T(), actual)
+}
+
+func (t *TagServiceTestSuite) Test_ExistsByIdsAndUserId() {
+	userId, id := uuid.New(), uuid.New()
+	ids := []uuid.UUID{id, id}
+
+	t.repository.On("CountByIdsAndUserId", ids, userId).Return(int64(1))
+
+	assert.True(t.T(), t.TestO.ExistsByIdsAndUserId(ids, userId))
+}
+
+func (t *TagServiceTestSuite) Test_ExistsByIdsAndUserId_WithMissingTag() {
+	userId := uuid.New()
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	t.repository.On("CountByIdsAndUserId", ids, userId).Return(int64(1))
+
+	assert.False(t.T(), t.TestO.ExistsByIdsAndUserId(ids, userId))
+}
+
+func (t *TagServiceTestSuite) Test_Update() {
+	userId := uuid.New()
+	tag := mocks.GenerateTag(userId, "landlord")
+
+	t.repository.On("FindById", tag.Id).Return(tag, nil)
+	t.repository.On("ExistsByName", userId, "rent", tag.Id).Return(false)
+	t.repository.On("Update", tag.Id, "rent").Return(nil)
+
+	assert.Nil(t.T(), t.TestO.Update(tag.Id, userId, model.UpdateTagRequest{Name: "rent "}))
+	t.repository.AssertCalled(t.T(), "Update", tag.Id, "rent")
+}
+
+func (t *TagServiceTestSuite) Test_Update_WithNotExists() {
+	id := uuid.New()
+
+	t.repository.On("FindById", id).Return(model.Tag{}, gorm.ErrRecordNotFound)
+
+	err := t.TestO.Update(id, uuid.New(), model.UpdateTagRequest{Name: "rent"})
+
+	assert.Equal(t.T(), interrors.NewErrNotFound("tag with id %s not found", id), err)
+	t.repository.AssertNotCalled(t.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (t *TagServiceTestSuite) Test_Update_WithExistingName() {
+	userId := uuid.New()
+	tag := mocks.GenerateTag(userId, "landlord")
+
+	t.repository.On("FindById", tag.Id).Return(tag, nil)
+	t.repository.On("ExistsByName", userId, "rent", tag.Id).Return(true)
+
+	err := t.TestO.Update(tag.Id, userId, model.UpdateTagRequest{Name: "rent"})
+
+	assert.Equal(t.T(), errors.New("tag with name 'rent' already exists"), err)
+	t.repository.AssertNotCalled(t.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (t *TagServiceTestSuite) Test_DeleteById() {
+	userId := uuid.New()
+	tag := mocks.GenerateTag(userId, "landlord")
+
+	t.repository.On("FindById", tag.Id).Return(tag, nil)
+	t.repository.On("DeleteById", tag.Id).Return(nil)
+
+	assert.Nil(t.T(), t.TestO.DeleteById(tag.Id, userId))
+}
+
+func (t *TagServiceTestSuite) Test_DeleteById_WithAnotherUser() {
+	tag := mocks.GenerateTag(uuid.New(), "landlord")
+
+	t.repository.On("FindById", tag.Id).Return(tag, nil)
+
+	err := t.TestO.DeleteById(tag.Id, uuid.New())
+
+	assert.Equal(t.T(), interrors.NewErrNotFound("tag with id %s not found", tag.Id), err)
+	t.repository.AssertNotCalled(t.T(), "DeleteById", mock.Anything)
+}
@@ -18,5 +18,6 @@ var CountryObject = &country.Country{
 		Code: "uk",
 		Name: "Ukrainian",
 	},
-	Flag: "https://restcountries.eu/data/ukr.svg",
+	TimeZone: "Europe/Kyiv",
+	Flag:     "https://restcountries.eu/data/ukr.svg",
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	helperModel "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/common/rest"
 	"github.com/VlasovArtem/hob/src/country/model"
 	countries "github.com/VlasovArtem/hob/src/country/service"
 	"github.com/google/uuid"
@@ -38,6 +39,8 @@ type TestRequest struct {
 	Body       any
 	Vars       map[string]string
 	Parameters map[string]string
+	UserId     *uuid.UUID
+	ApiKey     bool
 	Handler    http.HandlerFunc
 	Request    *http.Request
 	Recorder   *httptest.ResponseRecorder
@@ -59,6 +62,8 @@ type TestRequestBuilder interface {
 	WithHandler(handler http.HandlerFunc) *TestRequest
 	WithVar(key string, value string) *TestRequest
 	WithParameter(key string, value string) *TestRequest
+	WithUser(userId uuid.UUID) *TestRequest
+	WithApiKey() *TestRequest
 	Build() *TestRequest
 }
 
@@ -92,6 +97,17 @@ func (t *TestRequest) WithParameter(key string, value string) *TestRequest {
 	return t
 }
 
+func (t *TestRequest) WithUser(userId uuid.UUID) *TestRequest {
+	t.UserId = &userId
+	return t
+}
+
+// WithApiKey marks the request as authenticated with the api key of the user
+func (t *TestRequest) WithApiKey() *TestRequest {
+	t.ApiKey = true
+	return t
+}
+
 func (t *TestRequest) Build() *TestRequest {
 	body, _ := json.Marshal(t.Body)
 
@@ -105,6 +121,13 @@ func (t *TestRequest) Build() *TestRequest {
 		t.Request = mux.SetURLVars(t.Request, t.Vars)
 	}
 
+	if t.UserId != nil {
+		t.Request = rest.WithUserId(t.Request, *t.UserId)
+	}
+	if t.ApiKey {
+		t.Request = rest.WithApiKey(t.Request)
+	}
+
 	t.build = true
 
 	return t
@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// blocks are the eighths of the cell from the lowest to the full one
+var blocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// BarChart draws the values as the vertical bars with the labels under them, the line values are drawn as the sparkline
+// under the labels
+type BarChart struct {
+	*tview.Box
+	labels    []string
+	values    []float64
+	line      []float64
+	barColor  tcell.Color
+	lineColor tcell.Color
+}
+
+func NewBarChart() *BarChart {
+	return &BarChart{
+		Box:       tview.NewBox(),
+		barColor:  tcell.ColorGreen,
+		lineColor: tcell.ColorYellow,
+	}
+}
+
+// SetData sets the bars and the sparkline, the line is not drawn if it is empty
+func (b *BarChart) SetData(labels []string, values []float64, line []float64) *BarChart {
+	b.labels = labels
+	b.values = values
+	b.line = line
+	return b
+}
+
+func (b *BarChart) Draw(screen tcell.Screen) {
+	b.Box.DrawForSubclass(screen, b)
+
+	x, y, width, height := b.GetInnerRect()
+	chartHeight := height - 1
+	if len(b.line) > 0 {
+		chartHeight--
+	}
+	if len(b.values) == 0 || width <= 0 || chartHeight <= 0 {
+		return
+	}
+
+	columnWidth := width / len(b.values)
+	if columnWidth < 1 {
+		columnWidth = 1
+	}
+	barWidth := columnWidth - 1
+	if barWidth < 1 {
+		barWidth = 1
+	}
+
+	barStyle := tcell.StyleDefault.Foreground(b.barColor)
+	maxValue := maxOf(b.values)
+
+	for index, value := range b.values {
+		left := x + index*columnWidth
+		if left+barWidth > x+width {
+			break
+		}
+
+		eighths := scale(value, maxValue, chartHeight*8)
+		for row := 0; row < chartHeight && eighths > 0; row++ {
+			block := blocks[len(blocks)-1]
+			if eighths < 8 {
+				block = blocks[eighths-1]
+			}
+			for column := 0; column < barWidth; column++ {
+				screen.SetContent(left+column, y+chartHeight-1-row, block, nil, barStyle)
+			}
+			eighths -= 8
+		}
+
+		if index < len(b.labels) {
+			tview.Print(screen, b.labels[index], left, y+chartHeight, barWidth, tview.AlignLeft, tcell.ColorLightGray)
+		}
+	}
+
+	if len(b.line) > 0 {
+		b.drawLine(screen, x, y+chartHeight+1, width, columnWidth, barWidth)
+	}
+}
+
+// drawLine draws the sparkline of the line values under the bars of the same index
+func (b *BarChart) drawLine(screen tcell.Screen, x, y, width, columnWidth, barWidth int) {
+	lineStyle := tcell.StyleDefault.Foreground(b.lineColor)
+	maxValue := maxOf(b.line)
+
+	for index, value := range b.line {
+		left := x + index*columnWidth
+		if left+barWidth > x+width {
+			break
+		}
+		block := blocks[0]
+		if eighths := scale(value, maxValue, len(blocks)); eighths > 0 {
+			block = blocks[eighths-1]
+		}
+		for column := 0; column < barWidth; column++ {
+			screen.SetContent(left+column, y, block, nil, lineStyle)
+		}
+	}
+}
+
+// scale returns the value scaled to the size, the max value takes the whole size
+func scale(value, maxValue float64, size int) int {
+	if maxValue <= 0 || value <= 0 {
+		return 0
+	}
+	return int(value / maxValue * float64(size))
+}
+
+func maxOf(values []float64) (result float64) {
+	for _, value := range values {
+		if value > result {
+			result = value
+		}
+	}
+	return result
+}
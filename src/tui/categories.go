@@ -0,0 +1,91 @@
+package tui
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell/v2"
+	"github.com/google/uuid"
+	"github.com/rivo/tview"
+)
+
+const CategoriesPageName = "categories"
+
+var categoriesTableHeader = []*TableHeader{
+	NewIndexHeader(),
+	NewTableHeader("Id").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Path")}
+
+type Categories struct {
+	*FlexApp
+	*Navigation
+	categories *TableFiller
+}
+
+func (c *Categories) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(CategoriesPageName, func() tview.Primitive { return NewCategories(app) })
+}
+
+func NewCategories(app *TerminalApp) *Categories {
+	c := &Categories{
+		FlexApp:    NewFlexApp(),
+		categories: NewTableFiller(categoriesTableHeader),
+	}
+	c.enrichNavigation(app)
+
+	c.bindKeys()
+	c.InitFlexApp(app)
+
+	c.
+		AddItem(c.fillTable(), 0, 8, true).
+		SetInputCapture(c.KeyboardFunc)
+
+	return c
+}
+
+func (c *Categories) fillTable() *TableFiller {
+	c.categories.SetSelectable(true, false)
+	c.categories.SetTitle("Categories")
+	content := c.App.GetCategoryService().FindByUserId(c.App.AuthorizedUser.Id)
+	c.categories.Fill(content)
+	return c.categories
+}
+
+func (c *Categories) enrichNavigation(app *TerminalApp) {
+	c.Navigation = NewNavigation(app, c.NavigationInfo(app, nil))
+	c.AddCustomPage(&CreateCategory{})
+}
+
+func (c *Categories) bindKeys() {
+	c.Actions = KeyActions{
+		tcell.KeyCtrlN:  NewKeyAction("Create Category", c.createCategory),
+		tcell.KeyCtrlD:  NewKeyAction("Delete Category", c.deleteCategory),
+		tcell.KeyEscape: NewKeyAction("Back", c.KeyBack),
+	}
+}
+
+func (c *Categories) createCategory(key *tcell.EventKey) *tcell.EventKey {
+	c.NavigateTo(CreateCategoryPageName)
+	return key
+}
+
+func (c *Categories) deleteCategory(key *tcell.EventKey) *tcell.EventKey {
+	err := c.categories.PerformWithSelectedId(1, func(row int, id uuid.UUID) {
+		path := c.categories.GetCell(row, 2).Text
+		ShowModal(c.App.Main, fmt.Sprintf("Do you want to delete category %s? The payments, schedulers and incomes of the category become uncategorized.", path), []ModalButton{
+			{
+				Name: "Delete",
+				Action: func() {
+					if err := c.App.GetCategoryService().DeleteById(id, c.App.AuthorizedUser.Id); err != nil {
+						c.ShowErrorTo(err)
+					} else {
+						c.ShowInfoRefresh("Category %s successfully deleted.", path)
+					}
+				},
+			},
+		})
+	})
+
+	if err != nil {
+		c.ShowErrorTo(err)
+	}
+	return key
+}
@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"github.com/VlasovArtem/hob/src/user/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const ChangePasswordPageName = "change-password"
+
+type ChangePassword struct {
+	*FlexApp
+	*Navigation
+	app *TerminalApp
+}
+
+func (c *ChangePassword) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(ChangePasswordPageName, func() tview.Primitive { return NewChangePassword(app) })
+}
+
+func (c *ChangePassword) enrichNavigation(app *TerminalApp) {
+	c.Navigation = NewNavigation(app, c.NavigationInfo(app, nil))
+}
+
+func NewChangePassword(app *TerminalApp) *ChangePassword {
+	f := &ChangePassword{
+		app:     app,
+		FlexApp: NewFlexApp(),
+	}
+	f.bindKeys()
+	f.InitFlexApp(app)
+	f.enrichNavigation(app)
+
+	var request model.ChangePasswordRequest
+
+	form := tview.NewForm().
+		AddPasswordField("Current Password", "", 20, '*', func(text string) { request.CurrentPassword = text }).
+		AddPasswordField("New Password", "", 20, '*', func(text string) { request.NewPassword = text }).
+		AddButton("Change", f.change(&request)).
+		AddButton("Cancel", f.BackFunc())
+
+	form.SetBorder(true).SetTitle("Change Password").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+
+	f.AddItem(form, 0, 8, true)
+
+	f.SetInputCapture(f.KeyboardFunc)
+
+	return f
+}
+
+func (c *ChangePassword) bindKeys() {
+	c.Actions = KeyActions{
+		tcell.KeyEscape: NewKeyAction("Back", c.KeyBack),
+	}
+}
+
+func (c *ChangePassword) change(request *model.ChangePasswordRequest) func() {
+	return func() {
+		if err := c.app.GetUserService().ChangePassword(c.app.AuthorizedUser.Id, *request); err != nil {
+			c.ShowErrorTo(err)
+		} else {
+			c.ShowInfoReturnBack("Password successfully changed.")
+		}
+	}
+}
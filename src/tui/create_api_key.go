@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"github.com/VlasovArtem/hob/src/user/apikey/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const CreateApiKeyPageName = "create-api-key"
+
+type CreateApiKey struct {
+	*FlexApp
+	*Navigation
+	app *TerminalApp
+}
+
+func (c *CreateApiKey) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(CreateApiKeyPageName, func() tview.Primitive { return NewCreateApiKey(app) })
+}
+
+func (c *CreateApiKey) enrichNavigation(app *TerminalApp) {
+	c.Navigation = NewNavigation(app, c.NavigationInfo(app, nil))
+}
+
+func NewCreateApiKey(app *TerminalApp) *CreateApiKey {
+	f := &CreateApiKey{
+		app:     app,
+		FlexApp: NewFlexApp(),
+	}
+	f.bindKeys()
+	f.InitFlexApp(app)
+	f.enrichNavigation(app)
+
+	var request model.CreateApiKeyRequest
+
+	form := tview.NewForm().
+		AddInputField("Name", "", 20, nil, func(text string) { request.Name = text }).
+		AddCheckbox("Read only", false, func(checked bool) { request.ReadOnly = checked }).
+		AddButton("Create", f.create(&request)).
+		AddButton("Cancel", f.BackFunc())
+
+	form.SetBorder(true).SetTitle("Add API Key").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+
+	f.AddItem(form, 0, 8, true)
+
+	f.SetInputCapture(f.KeyboardFunc)
+
+	return f
+}
+
+func (c *CreateApiKey) bindKeys() {
+	c.Actions = KeyActions{
+		tcell.KeyEscape: NewKeyAction("Back", c.KeyBack),
+	}
+}
+
+func (c *CreateApiKey) create(request *model.CreateApiKeyRequest) func() {
+	return func() {
+		if created, err := c.app.GetApiKeyService().Add(c.app.AuthorizedUser.Id, *request); err != nil {
+			c.ShowErrorTo(err)
+		} else {
+			c.ShowInfoReturnBack("API key %s successfully created, copy it now, it is not shown again:\n%s", created.Name, created.Key)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"github.com/VlasovArtem/hob/src/category/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/google/uuid"
+	"github.com/rivo/tview"
+)
+
+const CreateCategoryPageName = "create-category"
+
+type createCategoryReq struct {
+	name     string
+	parentId *uuid.UUID
+}
+
+type CreateCategory struct {
+	*FlexApp
+	*Navigation
+	app *TerminalApp
+}
+
+func (c *CreateCategory) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(CreateCategoryPageName, func() tview.Primitive { return NewCreateCategory(app) })
+}
+
+func (c *CreateCategory) enrichNavigation(app *TerminalApp) {
+	c.Navigation = NewNavigation(app, c.NavigationInfo(app, nil))
+}
+
+func NewCreateCategory(app *TerminalApp) *CreateCategory {
+	f := &CreateCategory{
+		app:     app,
+		FlexApp: NewFlexApp(),
+	}
+	f.bindKeys()
+	f.InitFlexApp(app)
+	f.enrichNavigation(app)
+
+	categories, categoryOptions := GetCategories(app)
+
+	var request createCategoryReq
+
+	form := tview.NewForm().
+		AddInputField("Name", "", 20, nil, func(text string) { request.name = text }).
+		AddDropDown("Parent", categoryOptions, 0, func(option string, optionIndex int) {
+			request.parentId = CategoryIdOf(categories, optionIndex)
+		}).
+		AddButton("Create", f.create(&request)).
+		AddButton("Cancel", f.BackFunc())
+
+	form.SetBorder(true).SetTitle("Add Category").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+
+	f.AddItem(form, 0, 8, true)
+
+	f.SetInputCapture(f.KeyboardFunc)
+
+	return f
+}
+
+func (c *CreateCategory) bindKeys() {
+	c.Actions = KeyActions{
+		tcell.KeyEscape: NewKeyAction("Back", c.KeyBack),
+	}
+}
+
+func (c *CreateCategory) create(request *createCategoryReq) func() {
+	return func() {
+		categoryRequest := model.CreateCategoryRequest{
+			Name:     request.name,
+			ParentId: request.parentId,
+			UserId:   c.app.AuthorizedUser.Id,
+		}
+
+		if created, err := c.app.GetCategoryService().Add(categoryRequest); err != nil {
+			c.ShowErrorTo(err)
+		} else {
+			c.ShowInfoReturnBack("Category %s successfully added.", created.Path)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const CreateExchangeRatePageName = "create-exchange-rate"
+
+type createExchangeRateReq struct {
+	currency, baseCurrency, date, rate string
+}
+
+type CreateExchangeRate struct {
+	*FlexApp
+	*Navigation
+	app *TerminalApp
+}
+
+func (c *CreateExchangeRate) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(CreateExchangeRatePageName, func() tview.Primitive { return NewCreateExchangeRate(app) })
+}
+
+func (c *CreateExchangeRate) enrichNavigation(app *TerminalApp) {
+	c.Navigation = NewNavigation(app, c.NavigationInfo(app, nil))
+}
+
+func NewCreateExchangeRate(app *TerminalApp) *CreateExchangeRate {
+	f := &CreateExchangeRate{
+		app:     app,
+		FlexApp: NewFlexApp(),
+	}
+	f.bindKeys()
+	f.InitFlexApp(app)
+	f.enrichNavigation(app)
+
+	request := createExchangeRateReq{
+		baseCurrency: app.AuthorizedUser.BaseCurrency,
+		date:         time.Now().Format(model.DateFormat),
+	}
+
+	form := tview.NewForm().
+		AddInputField("Currency", "", 20, nil, func(text string) { request.currency = text }).
+		AddInputField("Base Currency", request.baseCurrency, 20, nil, func(text string) { request.baseCurrency = text }).
+		AddInputField("Date (ex. 2006-01-02)", request.date, 20, nil, func(text string) { request.date = text }).
+		AddInputField("Rate", "", 20, nil, func(text string) { request.rate = text }).
+		AddButton("Create", f.create(&request)).
+		AddButton("Cancel", f.BackFunc())
+
+	form.SetBorder(true).SetTitle("Add Exchange Rate").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+
+	f.AddItem(form, 0, 8, true)
+
+	f.SetInputCapture(f.KeyboardFunc)
+
+	return f
+}
+
+func (c *CreateExchangeRate) bindKeys() {
+	c.Actions = KeyActions{
+		tcell.KeyEscape: NewKeyAction("Back", c.KeyBack),
+	}
+}
+
+func (c *CreateExchangeRate) create(request *createExchangeRateReq) func() {
+	return func() {
+		date, err := time.Parse(model.DateFormat, request.date)
+		if err != nil {
+			c.ShowErrorTo(errors.New("date is not valid format. The valid format is 2006-01-02 (02 Jan 2006)"))
+			return
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(request.rate), 64)
+		if err != nil {
+			c.ShowErrorTo(errors.New("rate is not valid number"))
+			return
+		}
+
+		exchangeRateRequest := model.CreateExchangeRateRequest{
+			Currency:     request.currency,
+			BaseCurrency: request.baseCurrency,
+			Date:         date,
+			Rate:         rate,
+		}
+
+		if created, err := c.app.GetExchangeRateService().Add(c.app.AuthorizedUser.Id, exchangeRateRequest); err != nil {
+			c.ShowErrorTo(err)
+		} else {
+			c.ShowInfoReturnBack("Exchange rate %s/%s successfully added.", created.Currency, created.BaseCurrency)
+		}
+	}
+}
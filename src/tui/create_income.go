@@ -2,17 +2,17 @@ package tui
 
 import (
 	"errors"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/income/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
-	"strconv"
 	"time"
 )
 
 const CreateIncomePageName = "create-income"
 
 type createIncome struct {
-	name, description, date, sum string
+	name, description, date, sum, currency, tags string
 }
 
 type CreateIncome struct {
@@ -49,6 +49,8 @@ func NewCreateIncome(app *TerminalApp) *CreateIncome {
 		AddInputField("Description", "", 20, nil, func(text string) { create.description = text }).
 		AddInputField("Date (ex. 2006-01-02)", time.Now().Format("2006-01-02"), 20, nil, func(text string) { create.date = text }).
 		AddInputField("Sum", "", 20, nil, func(text string) { create.sum = text }).
+		AddInputField("Currency", app.HouseCurrency(), 20, nil, func(text string) { create.currency = text }).
+		AddFormItem(NewTagsField(app, nil, func(text string) { create.tags = text })).
 		AddButton("Create", f.create(&create))
 
 	form.SetBorder(true).SetTitle("Add Income").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
@@ -68,10 +70,10 @@ func (c *CreateIncome) bindKeys() {
 
 func (c *CreateIncome) create(create *createIncome) func() {
 	return func() {
-		if newSum, err := strconv.ParseFloat(create.sum, 32); err != nil {
+		if newSum, err := money.Parse(create.sum); err != nil {
 			c.ShowErrorTo(errors.New("sum is not valid"))
 		} else {
-			c.request.Sum = float32(newSum)
+			c.request.Sum = newSum
 		}
 
 		if newDate, err := time.Parse("2006-01-02", create.date); err != nil {
@@ -80,7 +82,16 @@ func (c *CreateIncome) create(create *createIncome) func() {
 			c.request.Date = newDate
 		}
 
-		if _, err := c.App.GetIncomeService().Add(c.request); err != nil {
+		c.request.Currency = create.currency
+
+		if tagIds, err := TagIdsOf(c.App, create.tags); err != nil {
+			c.ShowErrorTo(err)
+			return
+		} else {
+			c.request.TagIds = tagIds
+		}
+
+		if _, err := c.App.GetIncomeService().Add(c.request, c.App.AuthorizedUser.Id); err != nil {
 			c.ShowErrorTo(err)
 		} else {
 			c.ShowInfoReturnBack("Income for the house %s successfully added.", c.App.House.Name)
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"errors"
+	"fmt"
 	"github.com/VlasovArtem/hob/src/meter/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
@@ -82,7 +83,7 @@ func (c *CreateMeter) create(paymentId uuid.UUID, request createMeterReq) func()
 			Details:     details,
 		}
 
-		if _, err := c.app.GetMeterService().Add(paymentRequest); err != nil {
+		if _, err := c.app.GetMeterService().Add(paymentRequest, c.app.AuthorizedUser.Id); err != nil {
 			c.ShowErrorTo(err)
 		} else {
 			c.ShowInfoReturnBack("Payment for the house %s successfully added.", c.app.House.Name)
@@ -113,3 +114,11 @@ func parseDetails(requestDetails string) (details map[string]float64, err error)
 	}
 	return details, err
 }
+
+func formatDetails(details map[string]float64) string {
+	var detailsArray []string
+	for key, value := range details {
+		detailsArray = append(detailsArray, fmt.Sprintf("%s : %.2f", key, value))
+	}
+	return strings.Join(detailsArray, "; ")
+}
@@ -2,19 +2,19 @@ package tui
 
 import (
 	"errors"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/payment/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
-	"strconv"
 	"time"
 )
 
 const CreatePaymentPageName = "create-payment"
 
 type createPaymentReq struct {
-	name, description, date, sum string
-	providerId                   *uuid.UUID
+	name, description, date, sum, currency, tags string
+	providerId, categoryId                       *uuid.UUID
 }
 
 type CreatePayment struct {
@@ -41,6 +41,7 @@ func NewCreatePayment(app *TerminalApp) *CreatePayment {
 	f.enrichNavigation(app)
 
 	providers, providerOptions := GetProviders(app)
+	categories, categoryOptions := GetCategories(app)
 
 	var request createPaymentReq
 
@@ -49,15 +50,20 @@ func NewCreatePayment(app *TerminalApp) *CreatePayment {
 		AddInputField("Description", "", 20, nil, func(text string) { request.description = text }).
 		AddInputField("Date (ex. 2006-01-02)", time.Now().Format("2006-01-02"), 20, nil, func(text string) { request.date = text }).
 		AddInputField("Sum", "", 20, nil, func(text string) { request.sum = text }).
+		AddInputField("Currency", app.HouseCurrency(), 20, nil, func(text string) { request.currency = text }).
 		AddDropDown("Provider", providerOptions, -1, func(option string, optionIndex int) {
 			if len(providers) > 0 {
 				request.providerId = &providers[optionIndex].Id
 			}
 		}).
+		AddDropDown("Category", categoryOptions, 0, func(option string, optionIndex int) {
+			request.categoryId = CategoryIdOf(categories, optionIndex)
+		}).
+		AddFormItem(NewTagsField(app, nil, func(text string) { request.tags = text })).
 		AddButton("Create", f.create(&request)).
 		AddButton("Cancel", f.BackFunc())
 
-	form.SetBorder(true).SetTitle("Add Payment").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+	form.SetBorder(true).SetTitle("Add Payment").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 17)
 
 	f.AddItem(form, 0, 8, true)
 
@@ -84,7 +90,14 @@ func (c *CreatePayment) create(request *createPaymentReq) func() {
 			newDate = parsedDate
 		}
 
-		sum, err := strconv.ParseFloat(request.sum, 32)
+		sum, err := money.Parse(request.sum)
+
+		if err != nil {
+			c.ShowErrorTo(err)
+			return
+		}
+
+		tagIds, err := TagIdsOf(c.app, request.tags)
 
 		if err != nil {
 			c.ShowErrorTo(err)
@@ -95,10 +108,13 @@ func (c *CreatePayment) create(request *createPaymentReq) func() {
 			UserId:      c.app.AuthorizedUser.Id,
 			HouseId:     c.app.House.Id,
 			ProviderId:  request.providerId,
+			CategoryId:  request.categoryId,
+			TagIds:      tagIds,
 			Date:        newDate,
 			Name:        request.name,
 			Description: request.description,
-			Sum:         float32(sum),
+			Sum:         sum,
+			Currency:    request.currency,
 		}
 
 		if _, err := c.app.GetPaymentService().Add(paymentRequest); err != nil {
@@ -10,7 +10,7 @@ import (
 const CreateProviderPageName = "create-provider"
 
 type createProviderReq struct {
-	name, details string
+	name, details, tariffs string
 }
 
 type CreateProvider struct {
@@ -41,7 +41,8 @@ func NewCreateProvider(app *TerminalApp, userId uuid.UUID) *CreateProvider {
 	form := tview.NewForm().
 		AddInputField("Name", "", 20, nil, func(text string) { request.name = text }).
 		AddInputField("Details", "", 20, nil, func(text string) { request.details = text }).
-		AddButton("Create", f.create(userId, request)).
+		AddInputField("Tariffs (ex. day:1.44; night:0.72)", "", 20, nil, func(text string) { request.tariffs = text }).
+		AddButton("Create", f.create(userId, &request)).
 		AddButton("Cancel", f.BackFunc())
 
 	form.SetBorder(true).SetTitle("Add Provider").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
@@ -59,12 +60,19 @@ func (c *CreateProvider) bindKeys() {
 	}
 }
 
-func (c *CreateProvider) create(userId uuid.UUID, request createProviderReq) func() {
+func (c *CreateProvider) create(userId uuid.UUID, request *createProviderReq) func() {
 	return func() {
+		tariffs, err := parseDetails(request.tariffs)
+		if err != nil {
+			c.ShowErrorTo(err)
+			return
+		}
+
 		paymentRequest := model.CreateProviderRequest{
 			UserId:  userId,
 			Name:    request.name,
 			Details: request.details,
+			Tariffs: tariffs,
 		}
 
 		if _, err := c.app.GetProviderService().Add(paymentRequest); err != nil {
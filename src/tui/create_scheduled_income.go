@@ -1,17 +1,18 @@
 package tui
 
 import (
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/income/scheduler/model"
 	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
-	"strconv"
 )
 
 const CreateScheduledIncomePageName = "create-scheduled-payment"
 
 type createScheduledIncomeReq struct {
-	name, description, sum, spec string
+	name, description, sum, spec, currency string
+	options                                schedulerOptionsReq
 }
 
 type CreateScheduledIncome struct {
@@ -43,10 +44,12 @@ func NewCreateScheduledIncome(app *TerminalApp) *CreateScheduledIncome {
 		AddInputField("Name", "", DefaultInputFieldWidth, nil, func(text string) { request.name = text }).
 		AddInputField("Description", "", DefaultInputFieldWidth, nil, func(text string) { request.description = text }).
 		AddInputField("Sum", "", DefaultInputFieldWidth, nil, func(text string) { request.sum = text }).
-		AddDropDown("Spec", specs, 1, func(option string, optionIndex int) {
-			request.spec = option
-		}).
-		AddButton("Create", f.create(request)).
+		AddInputField("Currency", app.HouseCurrency(), DefaultInputFieldWidth, nil, func(text string) { request.currency = text })
+
+	addSpecField(form, string(scheduler.DAILY), func(text string) { request.spec = text })
+
+	addSchedulerOptionsFields(form, &request.options).
+		AddButton("Create", f.create(&request)).
 		AddButton("Cancel", f.BackFunc())
 
 	form.SetBorder(true).SetTitle("Add Scheduled Income").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
@@ -64,9 +67,16 @@ func (c *CreateScheduledIncome) bindKeys() {
 	}
 }
 
-func (c *CreateScheduledIncome) create(request createScheduledIncomeReq) func() {
+func (c *CreateScheduledIncome) create(request *createScheduledIncomeReq) func() {
 	return func() {
-		sum, err := strconv.ParseFloat(request.sum, 32)
+		sum, err := money.Parse(request.sum)
+
+		if err != nil {
+			c.ShowErrorTo(err)
+			return
+		}
+
+		limits, err := request.options.toLimits()
 
 		if err != nil {
 			c.ShowErrorTo(err)
@@ -75,10 +85,15 @@ func (c *CreateScheduledIncome) create(request createScheduledIncomeReq) func()
 
 		paymentRequest := model.CreateIncomeSchedulerRequest{
 			HouseId:     c.app.House.Id,
+			UserId:      c.app.AuthorizedUser.Id,
 			Name:        request.name,
 			Description: request.description,
-			Sum:         float32(sum),
+			Sum:         sum,
+			Currency:    request.currency,
 			Spec:        scheduler.SchedulingSpecification(request.spec),
+			TimeZone:    scheduler.TimeZone(request.options.timeZone),
+			Adjustment:  scheduler.BusinessDayAdjustment(request.options.adjustment),
+			Limits:      limits,
 		}
 
 		if _, err := c.app.GetIncomeSchedulerService().Add(paymentRequest); err != nil {
@@ -2,21 +2,84 @@ package tui
 
 import (
 	"errors"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/model"
 	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
 	"strconv"
+	"strings"
+	"time"
 )
 
 var specs = []string{string(scheduler.HOURLY), string(scheduler.DAILY), string(scheduler.WEEKLY), string(scheduler.MONTHLY), string(scheduler.ANNUALLY)}
 
+// addSpecField adds the input for a cron expression or a descriptor, the predefined specs are suggested while typing
+func addSpecField(form *tview.Form, spec string, changed func(text string)) *tview.Form {
+	field := tview.NewInputField().
+		SetLabel("Spec (cron or descriptor)").
+		SetFieldWidth(20).
+		SetChangedFunc(changed)
+
+	field.SetAutocompleteFunc(func(currentText string) (entries []string) {
+		for _, option := range specs {
+			if strings.HasPrefix(option, currentText) {
+				entries = append(entries, option)
+			}
+		}
+		return entries
+	})
+
+	return form.AddFormItem(field.SetText(spec))
+}
+
 const CreateScheduledPaymentPageName = "create-scheduled-payment"
 
+var adjustments = []string{string(scheduler.NoAdjustment), string(scheduler.NextBusinessDay), string(scheduler.PreviousBusinessDay)}
+
+type schedulerOptionsReq struct {
+	timeZone, adjustment, startDate, endDate, maxOccurrences string
+}
+
+func (o schedulerOptionsReq) toLimits() (limits scheduler.Limits, err error) {
+	location := scheduler.TimeZone(o.timeZone).Location()
+
+	if o.startDate != "" {
+		startDate, err := time.ParseInLocation("2006-01-02", o.startDate, location)
+		if err != nil {
+			return limits, err
+		}
+		limits.StartDate = &startDate
+	}
+	if o.endDate != "" {
+		endDate, err := time.ParseInLocation("2006-01-02", o.endDate, location)
+		if err != nil {
+			return limits, err
+		}
+		limits.EndDate = &endDate
+	}
+	if o.maxOccurrences != "" {
+		if limits.MaxOccurrences, err = strconv.Atoi(o.maxOccurrences); err != nil {
+			return limits, err
+		}
+	}
+	return limits, nil
+}
+
+func addSchedulerOptionsFields(form *tview.Form, options *schedulerOptionsReq) *tview.Form {
+	return form.
+		AddInputField("Time Zone (ex. Europe/Kyiv)", "", 20, nil, func(text string) { options.timeZone = text }).
+		AddDropDown("Business Day Adjustment", adjustments, 0, func(option string, optionIndex int) { options.adjustment = option }).
+		AddInputField("Start Date (ex. 2006-01-02)", "", 20, nil, func(text string) { options.startDate = text }).
+		AddInputField("End Date (ex. 2006-01-02)", "", 20, nil, func(text string) { options.endDate = text }).
+		AddInputField("Max Occurrences", "", 20, nil, func(text string) { options.maxOccurrences = text })
+}
+
 type createScheduledPaymentReq struct {
-	name, description, sum, spec string
-	providerId                   uuid.UUID
+	name, description, sum, spec, meterName, currency string
+	providerId                                        uuid.UUID
+	options                                           schedulerOptionsReq
 }
 
 type CreateScheduledPayment struct {
@@ -50,13 +113,16 @@ func NewCreateScheduledPayment(app *TerminalApp) *CreateScheduledPayment {
 		AddInputField("Name", "", 20, nil, func(text string) { request.name = text }).
 		AddInputField("Description", "", 20, nil, func(text string) { request.description = text }).
 		AddInputField("Sum", "", 20, nil, func(text string) { request.sum = text }).
-		AddDropDown("Spec", specs, 1, func(option string, optionIndex int) {
-			request.spec = option
-		}).
+		AddInputField("Currency", app.HouseCurrency(), 20, nil, func(text string) { request.currency = text })
+
+	addSpecField(form, string(scheduler.DAILY), func(text string) { request.spec = text }).
 		AddDropDown("Provider", providerOptions, -1, func(option string, optionIndex int) {
 			request.providerId = providers[optionIndex].Id
 		}).
-		AddButton("Create", f.create(request)).
+		AddInputField("Meter (sum by provider tariffs)", "", 20, nil, func(text string) { request.meterName = text })
+
+	addSchedulerOptionsFields(form, &request.options).
+		AddButton("Create", f.create(&request)).
 		AddButton("Cancel", f.BackFunc())
 
 	form.SetBorder(true).SetTitle("Add Payment").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
@@ -74,9 +140,9 @@ func (c *CreateScheduledPayment) bindKeys() {
 	}
 }
 
-func (c *CreateScheduledPayment) create(request createScheduledPaymentReq) func() {
+func (c *CreateScheduledPayment) create(request *createScheduledPaymentReq) func() {
 	return func() {
-		sum, err := strconv.ParseFloat(request.sum, 32)
+		sum, err := parseSchedulerSum(request.sum, request.meterName)
 
 		if err != nil {
 			c.ShowErrorTo(err)
@@ -88,14 +154,26 @@ func (c *CreateScheduledPayment) create(request createScheduledPaymentReq) func(
 			return
 		}
 
+		limits, err := request.options.toLimits()
+
+		if err != nil {
+			c.ShowErrorTo(err)
+			return
+		}
+
 		paymentRequest := model.CreatePaymentSchedulerRequest{
 			UserId:      c.app.AuthorizedUser.Id,
 			HouseId:     c.app.House.Id,
 			ProviderId:  request.providerId,
 			Name:        request.name,
 			Description: request.description,
-			Sum:         float32(sum),
+			Sum:         sum,
+			Currency:    request.currency,
+			MeterName:   request.meterName,
 			Spec:        scheduler.SchedulingSpecification(request.spec),
+			TimeZone:    scheduler.TimeZone(request.options.timeZone),
+			Adjustment:  scheduler.BusinessDayAdjustment(request.options.adjustment),
+			Limits:      limits,
 		}
 
 		if _, err := c.app.GetPaymentSchedulerService().Add(paymentRequest); err != nil {
@@ -105,3 +183,15 @@ func (c *CreateScheduledPayment) create(request createScheduledPaymentReq) func(
 		}
 	}
 }
+
+// parseSchedulerSum parses the fixed sum, it could be omitted if the sum is calculated from the meter
+func parseSchedulerSum(sum, meterName string) (money.Money, error) {
+	if sum == "" && meterName != "" {
+		return 0, nil
+	}
+	parsed, err := money.Parse(sum)
+	if err != nil {
+		return 0, errors.New("sum is not valid")
+	}
+	return parsed, nil
+}
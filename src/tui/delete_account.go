@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"github.com/VlasovArtem/hob/src/user/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const DeleteAccountPageName = "delete-account"
+
+type DeleteAccount struct {
+	*FlexApp
+	*Navigation
+	app *TerminalApp
+}
+
+func (d *DeleteAccount) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(DeleteAccountPageName, func() tview.Primitive { return NewDeleteAccount(app) })
+}
+
+func (d *DeleteAccount) enrichNavigation(app *TerminalApp) {
+	d.Navigation = NewNavigation(app, d.NavigationInfo(app, nil))
+}
+
+// NewDeleteAccount asks for the current password before the account is deleted with all its data
+func NewDeleteAccount(app *TerminalApp) *DeleteAccount {
+	d := &DeleteAccount{
+		app:     app,
+		FlexApp: NewFlexApp(),
+	}
+	d.bindKeys()
+	d.InitFlexApp(app)
+	d.enrichNavigation(app)
+
+	var request model.DeleteUserRequest
+
+	form := tview.NewForm().
+		AddPasswordField("Current Password", "", 20, '*', func(text string) { request.Password = text }).
+		AddButton("Delete", d.delete(&request)).
+		AddButton("Cancel", d.BackFunc())
+
+	form.SetBorder(true).
+		SetTitle("Delete Account. All houses, payments, incomes, providers, categories, tags and schedulers of the account are deleted as well").
+		SetTitleAlign(tview.AlignCenter)
+
+	d.AddItem(form, 0, 8, true)
+
+	d.SetInputCapture(d.KeyboardFunc)
+
+	return d
+}
+
+func (d *DeleteAccount) bindKeys() {
+	d.Actions = KeyActions{
+		tcell.KeyEscape: NewKeyAction("Back", d.KeyBack),
+	}
+}
+
+func (d *DeleteAccount) delete(request *model.DeleteUserRequest) func() {
+	return func() {
+		if err := d.app.GetAccountService().Delete(d.app.AuthorizedUser.Id, *request); err != nil {
+			d.ShowErrorTo(err)
+		} else {
+			d.app.AuthorizedUser = nil
+			d.app.House = nil
+			d.ShowInfo("Account successfully deleted.", func(key tcell.Key) {
+				d.app.Main.AddAndSwitchToPage(SignInPageName, NewSignIn(d.app), true)
+			})
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"fmt"
+	"github.com/VlasovArtem/hob/src/exchange/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/google/uuid"
+	"github.com/rivo/tview"
+	"strconv"
+)
+
+const ExchangeRatesPageName = "exchange-rates"
+
+var exchangeRatesTableHeader = []*TableHeader{
+	NewIndexHeader(),
+	NewTableHeader("Id").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Currency").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeaderWithDisplayName("BaseCurrency", "Base Currency").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Date").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Rate").SetContentModifier(AlignCenterExpansion())}
+
+type ExchangeRates struct {
+	*FlexApp
+	*Navigation
+	exchangeRates *TableFiller
+}
+
+func (e *ExchangeRates) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(ExchangeRatesPageName, func() tview.Primitive { return NewExchangeRates(app) })
+}
+
+func NewExchangeRates(app *TerminalApp) *ExchangeRates {
+	e := &ExchangeRates{
+		FlexApp:       NewFlexApp(),
+		exchangeRates: NewTableFiller(exchangeRatesTableHeader),
+	}
+	e.enrichNavigation(app)
+
+	e.bindKeys()
+	e.InitFlexApp(app)
+
+	e.
+		AddItem(e.fillTable(), 0, 8, true).
+		SetInputCapture(e.KeyboardFunc)
+
+	return e
+}
+
+func (e *ExchangeRates) fillTable() *TableFiller {
+	e.exchangeRates.SetSelectable(true, false)
+	e.exchangeRates.SetTitle("Exchange Rates")
+	e.exchangeRates.AddContentProvider("Rate", func(content any) any {
+		return strconv.FormatFloat(content.(model.ExchangeRateDto).Rate, 'f', -1, 64)
+	})
+	content := e.App.GetExchangeRateService().FindByUserId(e.App.AuthorizedUser.Id)
+	e.exchangeRates.Fill(content)
+	return e.exchangeRates
+}
+
+func (e *ExchangeRates) enrichNavigation(app *TerminalApp) {
+	e.Navigation = NewNavigation(app, e.NavigationInfo(app, nil))
+	e.
+		AddCustomPage(&CreateExchangeRate{}).
+		AddCustomPage(&ImportExchangeRates{})
+}
+
+func (e *ExchangeRates) bindKeys() {
+	e.Actions = KeyActions{
+		tcell.KeyCtrlN:  NewKeyAction("Create Exchange Rate", e.createExchangeRate),
+		tcell.KeyCtrlO:  NewKeyAction("Import Exchange Rates", e.importExchangeRates),
+		tcell.KeyCtrlD:  NewKeyAction("Delete Exchange Rate", e.deleteExchangeRate),
+		tcell.KeyEscape: NewKeyAction("Back", e.KeyBack),
+	}
+}
+
+func (e *ExchangeRates) createExchangeRate(key *tcell.EventKey) *tcell.EventKey {
+	e.NavigateTo(CreateExchangeRatePageName)
+	return key
+}
+
+func (e *ExchangeRates) importExchangeRates(key *tcell.EventKey) *tcell.EventKey {
+	e.NavigateTo(ImportExchangeRatesPageName)
+	return key
+}
+
+func (e *ExchangeRates) deleteExchangeRate(key *tcell.EventKey) *tcell.EventKey {
+	err := e.exchangeRates.PerformWithSelectedId(1, func(row int, id uuid.UUID) {
+		pair := fmt.Sprintf("%s/%s", e.exchangeRates.GetCell(row, 2).Text, e.exchangeRates.GetCell(row, 3).Text)
+		date := e.exchangeRates.GetCell(row, 4).Text
+		ShowModal(e.App.Main, fmt.Sprintf("Do you want to delete exchange rate %s on %s?", pair, date), []ModalButton{
+			{
+				Name: "Delete",
+				Action: func() {
+					if err := e.App.GetExchangeRateService().DeleteById(id, e.App.AuthorizedUser.Id); err != nil {
+						e.ShowErrorTo(err)
+					} else {
+						e.ShowInfoRefresh("Exchange rate %s on %s successfully deleted.", pair, date)
+					}
+				},
+			},
+		})
+	})
+
+	if err != nil {
+		e.ShowErrorTo(err)
+	}
+	return key
+}
@@ -2,12 +2,22 @@ package tui
 
 import (
 	"fmt"
+	budgetModel "github.com/VlasovArtem/hob/src/budget/model"
 	"github.com/VlasovArtem/hob/src/common/ctime"
+	"github.com/VlasovArtem/hob/src/common/money"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	reportModel "github.com/VlasovArtem/hob/src/report/model"
+	summaryModel "github.com/VlasovArtem/hob/src/summary/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
 	"github.com/rs/zerolog/log"
+	"sort"
+	"strings"
+	"time"
 )
 
 const HomePageName = "home"
@@ -22,12 +32,28 @@ var homeIncomeFields = []*TableHeader{
 	NewTableHeader("Name"),
 	NewTableHeader("Date").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Sum").SetContentModifier(AlignCenterExpansion())}
+var homeBudgetFields = []*TableHeader{
+	NewIndexHeader(),
+	NewTableHeader("Name"),
+	NewTableHeader("Spent").SetContentModifier(AlignCenterExpansion())}
+var homeTagFields = []*TableHeader{
+	NewIndexHeader(),
+	NewTableHeader("Name"),
+	NewTableHeader("Totals").SetContentModifier(AlignCenterExpansion())}
+var homeBalanceFields = []*TableHeader{
+	NewTableHeader("Month"),
+	NewTableHeader("Payments").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Incomes").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Balance").SetContentModifier(AlignCenterExpansion())}
 
 type Home struct {
 	*FlexApp
 	*Navigation
 	payments *TableFiller
 	incomes  *TableFiller
+	tags     *TableFiller
+	budgets  *TableFiller
+	balance  *TableFiller
 }
 
 func (h *Home) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
@@ -43,7 +69,8 @@ func (h *Home) enrichNavigation(app *TerminalApp) {
 		AddCustomPage(&Providers{}).
 		AddCustomPage(&CreateIncome{}).
 		AddCustomPage(&CreatePayment{}).
-		AddCustomPage(&CreateHouse{})
+		AddCustomPage(&CreateHouse{}).
+		AddCustomPage(&Settings{})
 }
 
 func NewHome(app *TerminalApp) *Home {
@@ -51,6 +78,9 @@ func NewHome(app *TerminalApp) *Home {
 		FlexApp:  NewFlexApp(),
 		payments: NewTableFiller(homePaymentFields),
 		incomes:  NewTableFiller(homeIncomeFields),
+		tags:     NewTableFiller(homeTagFields),
+		budgets:  NewTableFiller(homeBudgetFields),
+		balance:  NewTableFiller(homeBalanceFields),
 	}
 	app.Main.SetFocusFunc(func() {
 		h.Init(app)
@@ -80,17 +110,62 @@ func (h *Home) Init(app *TerminalApp) {
 		SetSelectable(false, false).
 		SetTitle(fmt.Sprintf("Incomes for %s", monthName)).
 		SetBorder(true)
+	h.tags.
+		SetSelectable(false, false).
+		SetTitle(fmt.Sprintf("Tags for %s", monthName)).
+		SetBorder(true)
+	h.budgets.
+		SetSelectable(false, false).
+		SetTitle("Budgets").
+		SetBorder(true)
+	h.balance.
+		SetSelectable(false, false).
+		SetTitle(fmt.Sprintf("Balance for %d", ctime.Now().Year())).
+		SetBorder(true)
+	h.payments.AddContentProvider("Sum", func(payment any) any {
+		paymentDto := payment.(paymentModel.PaymentDto)
+		return h.App.FormatSum(paymentDto.Sum, paymentDto.Currency)
+	})
+	h.incomes.AddContentProvider("Sum", func(income any) any {
+		incomeDto := income.(incomeModel.IncomeDto)
+		return h.App.FormatSum(incomeDto.Sum, incomeDto.Currency)
+	})
+	h.tags.AddContentProvider("Totals", func(tag any) any {
+		return h.formatTagTotals(tag.(reportModel.TagTotalDto).Totals)
+	})
+	h.budgets.AddContentProvider("Spent", func(status any) any {
+		statusDto := status.(budgetModel.BudgetStatusDto)
+		return fmt.Sprintf("%s / %s", h.App.FormatSum(statusDto.Spent, statusDto.Budget.Currency), h.App.FormatSum(statusDto.Planned, statusDto.Budget.Currency))
+	})
+	h.balance.AddContentProvider("Month", func(group any) any {
+		return group.(summaryModel.GroupDto).Month.Format("January")
+	})
+	h.balance.AddContentProvider("Payments", func(group any) any {
+		return h.formatBalances(group.(summaryModel.GroupDto).Totals, paymentsOf)
+	})
+	h.balance.AddContentProvider("Incomes", func(group any) any {
+		return h.formatBalances(group.(summaryModel.GroupDto).Totals, incomesOf)
+	})
+	h.balance.AddContentProvider("Balance", func(group any) any {
+		return h.formatBalances(group.(summaryModel.GroupDto).Totals, balanceOf)
+	})
 
 	info := tview.NewFlex().
 		AddItem(houseList, 0, 1, true).
 		AddItem(h.payments, 0, 2, false).
-		AddItem(h.incomes, 0, 2, false)
+		AddItem(h.incomes, 0, 2, false).
+		AddItem(h.tags, 0, 1, false).
+		AddItem(h.budgets, 0, 1, false)
 
 	h.AddItem(info, 0, 8, true)
+	h.AddItem(h.balance, 0, 4, false)
 
 	h.showHouses(houseList, func(dto houseModel.HouseDto) {
 		h.fillPaymentsTable()
 		h.fillIncomesTable()
+		h.fillTagsTable()
+		h.fillBudgetsTable()
+		h.fillBalanceTable()
 		h.menu.refreshSessionInfo()
 	})
 
@@ -140,7 +215,7 @@ func (h *Home) setHouse(onSelect func(dto houseModel.HouseDto)) func(index int,
 			if err != nil {
 				log.Fatal().Err(err)
 			}
-			if houseDto, err := h.App.GetHouseService().FindById(id); err != nil {
+			if houseDto, err := h.App.GetHouseService().FindById(id, h.App.AuthorizedUser.Id); err != nil {
 				h.ShowErrorTo(err)
 			} else {
 				h.App.House = &houseDto
@@ -156,6 +231,7 @@ func (h *Home) bindKeys() {
 		tcell.KeyCtrlE: NewKeyAction("Show Houses", h.housesPage),
 		tcell.KeyCtrlF: NewKeyAction("Show Incomes", h.incomesPage),
 		tcell.KeyCtrlP: NewKeyAction("Show Providers", h.providersPage),
+		tcell.KeyCtrlK: NewKeyAction("Show Settings", h.settingsPage),
 		tcell.KeyF1:    NewKeyAction("Create Payment", h.createPayment),
 		tcell.KeyF2:    NewKeyAction("Create Income", h.createIncome),
 		tcell.KeyF3:    NewKeyAction("Create House", h.createHouse),
@@ -182,6 +258,11 @@ func (h *Home) providersPage(key *tcell.EventKey) *tcell.EventKey {
 	return key
 }
 
+func (h *Home) settingsPage(key *tcell.EventKey) *tcell.EventKey {
+	h.NavigateTo(SettingsPageName)
+	return key
+}
+
 func (h *Home) createPayment(key *tcell.EventKey) *tcell.EventKey {
 	h.NavigateTo(CreatePaymentPageName)
 	return key
@@ -201,27 +282,170 @@ func (h *Home) fillPaymentsTable() {
 	if h.App.House == nil {
 		return
 	}
-	payments := h.App.GetPaymentService().FindByHouseId(h.App.House.Id, 50, 0, ctime.Now().StartOfMonth(), nil)
+	payments := h.App.GetPaymentService().FindByHouseId(h.App.House.Id, h.App.AuthorizedUser.Id, 50, 0, ctime.Now().StartOfMonth(), nil, nil, tagModel.Filter{})
 
 	h.payments.Fill(payments)
-	var sum float64
-	for _, payment := range payments {
-		sum += float64(payment.Sum)
-	}
-	h.payments.addResultRow(fmt.Sprintf("%v", sum))
+	h.payments.addResultRow(h.formatTotals(h.monthTotals(paymentsOf)) + h.formatBaseTotal(func(total reportModel.TotalDto) money.Money { return total.Payments }))
 }
 
 func (h *Home) fillIncomesTable() {
 	if h.App.House == nil {
 		return
 	}
-	incomes := h.App.GetIncomeService().FindByHouseId(h.App.House.Id, 50, 0, ctime.Now().StartOfMonth(), nil)
+	incomes := h.App.GetIncomeService().FindByHouseId(h.App.House.Id, h.App.AuthorizedUser.Id, 50, 0, ctime.Now().StartOfMonth(), nil, tagModel.Filter{})
 
 	h.incomes.Fill(incomes)
-	var sum float64
-	for _, income := range incomes {
-		sum += float64(income.Sum)
-	}
-	h.incomes.addResultRow(fmt.Sprintf("%v", sum))
+	h.incomes.addResultRow(h.formatTotals(h.monthTotals(incomesOf)) + h.formatBaseTotal(func(total reportModel.TotalDto) money.Money { return total.Incomes }))
 	return
 }
+
+func (h *Home) fillTagsTable() {
+	if h.App.House == nil {
+		return
+	}
+	report, err := h.App.GetReportService().FindByHouseId(h.App.House.Id, h.App.AuthorizedUser.Id, ctime.Now().StartOfMonth(), nil)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get the tag totals")
+		return
+	}
+
+	h.tags.Fill(report.Tags)
+}
+
+// fillBudgetsTable shows the budgets of the current periods with the names of their categories, the budgets exceeded by the
+// payments are coloured red
+func (h *Home) fillBudgetsTable() {
+	if h.App.House == nil {
+		return
+	}
+	statuses, err := h.App.GetBudgetService().FindStatusByHouseId(h.App.House.Id, h.App.AuthorizedUser.Id, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get the budget statuses")
+		return
+	}
+
+	categoryPaths := make(map[uuid.UUID]string)
+	for _, category := range h.App.GetCategoryService().FindByUserId(h.App.AuthorizedUser.Id) {
+		categoryPaths[category.Id] = category.Path
+	}
+	h.budgets.AddContentProvider("Name", func(status any) any {
+		budget := status.(budgetModel.BudgetStatusDto).Budget
+		if budget.CategoryId != nil {
+			if path, ok := categoryPaths[*budget.CategoryId]; ok {
+				return fmt.Sprintf("%s (%s)", budget.Name, path)
+			}
+		}
+		return budget.Name
+	})
+
+	h.budgets.Fill(statuses)
+
+	for index, status := range statuses {
+		if !status.OverBudget {
+			continue
+		}
+		for column := 0; column < h.budgets.GetColumnCount(); column++ {
+			h.budgets.GetCell(index+1, column).SetTextColor(tcell.ColorRed)
+		}
+	}
+}
+
+// fillBalanceTable shows the payments, incomes and balance of the house by the months of the current year
+func (h *Home) fillBalanceTable() {
+	if h.App.House == nil {
+		return
+	}
+	summary, err := h.App.GetSummaryService().FindByHouseId(h.App.House.Id, h.App.AuthorizedUser.Id, ctime.Now().StartOfYear(), nil, summaryModel.MONTH)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get the house summary")
+		return
+	}
+
+	h.balance.Fill(summary.Groups)
+	h.balance.addResultRow(h.formatBalances(summary.Totals, balanceOf))
+}
+
+// monthTotals returns the sums of the current month by the currency, the sums are aggregated in the database so the
+// totals are not limited by the rows shown in the tables
+func (h *Home) monthTotals(sum func(balance summaryModel.BalanceDto) money.Money) map[string]money.Money {
+	summary, err := h.App.GetSummaryService().FindByHouseId(h.App.House.Id, h.App.AuthorizedUser.Id, ctime.Now().StartOfMonth(), nil, summaryModel.MONTH)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get the house summary")
+	}
+
+	sums := make(map[string]money.Money)
+	for _, balance := range summary.Totals {
+		if value := sum(balance); value != 0 {
+			sums[balance.Currency] = value
+		}
+	}
+	return sums
+}
+
+// formatBalances returns the chosen sum of the balances by the currency
+func (h *Home) formatBalances(balances []summaryModel.BalanceDto, sum func(balance summaryModel.BalanceDto) money.Money) string {
+	sums := make(map[string]money.Money)
+	for _, balance := range balances {
+		sums[balance.Currency] = sum(balance)
+	}
+	return h.formatTotals(sums)
+}
+
+func paymentsOf(balance summaryModel.BalanceDto) money.Money { return balance.Payments }
+
+func incomesOf(balance summaryModel.BalanceDto) money.Money { return balance.Incomes }
+
+func balanceOf(balance summaryModel.BalanceDto) money.Money { return balance.Balance }
+
+// formatTagTotals returns the payments and incomes of the tag by the currency
+func (h *Home) formatTagTotals(totals []reportModel.TotalDto) string {
+	formatted := make([]string, 0, len(totals))
+	for _, total := range totals {
+		if total.Payments != 0 {
+			formatted = append(formatted, "-"+h.App.FormatSum(total.Payments, total.Currency))
+		}
+		if total.Incomes != 0 {
+			formatted = append(formatted, "+"+h.App.FormatSum(total.Incomes, total.Currency))
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// formatTotals returns the totals by the currency, the sums in the different currencies are not added up
+func (h *Home) formatTotals(sums map[string]money.Money) string {
+	if len(sums) == 0 {
+		return h.App.FormatSum(0, h.App.HouseCurrency())
+	}
+
+	currencies := make([]string, 0, len(sums))
+	for currency := range sums {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	totals := make([]string, 0, len(currencies))
+	for _, currency := range currencies {
+		totals = append(totals, h.App.FormatSum(sums[currency], currency))
+	}
+
+	return strings.Join(totals, ", ")
+}
+
+// formatBaseTotal returns the total of the month in the base currency of the user, it is empty if the base currency is not
+// chosen or all the sums are already in the base currency
+func (h *Home) formatBaseTotal(total func(total reportModel.TotalDto) money.Money) string {
+	baseCurrency := h.App.AuthorizedUser.BaseCurrency
+	if baseCurrency == "" {
+		return ""
+	}
+
+	report, err := h.App.GetReportService().FindByHouseId(h.App.House.Id, h.App.AuthorizedUser.Id, ctime.Now().StartOfMonth(), nil)
+	if err != nil {
+		return fmt.Sprintf(" (%s)", err.Error())
+	}
+	if len(report.Totals) == 0 || (len(report.Totals) == 1 && report.Totals[0].Currency == baseCurrency) {
+		return ""
+	}
+
+	return fmt.Sprintf(" = %s", h.App.FormatSum(total(*report.BaseCurrency), baseCurrency))
+}
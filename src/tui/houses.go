@@ -112,7 +112,7 @@ func (h *Houses) createDeleteModalButton(houseName string, houseId uuid.UUID) Mo
 	return ModalButton{
 		Name: "Delete",
 		Action: func() {
-			if err := h.App.GetHouseService().DeleteById(houseId); err != nil {
+			if err := h.App.GetHouseService().DeleteById(houseId, h.App.AuthorizedUser.Id); err != nil {
 				h.ShowErrorTo(err)
 			} else {
 				h.ShowInfoRefresh("House %s (%s) successfully deleted.", houseName, houseId)
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"os"
+)
+
+const ImportExchangeRatesPageName = "import-exchange-rates"
+
+type ImportExchangeRates struct {
+	*FlexApp
+	*Navigation
+	app *TerminalApp
+}
+
+func (i *ImportExchangeRates) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(ImportExchangeRatesPageName, func() tview.Primitive { return NewImportExchangeRates(app) })
+}
+
+func (i *ImportExchangeRates) enrichNavigation(app *TerminalApp) {
+	i.Navigation = NewNavigation(app, i.NavigationInfo(app, nil))
+}
+
+func NewImportExchangeRates(app *TerminalApp) *ImportExchangeRates {
+	f := &ImportExchangeRates{
+		app:     app,
+		FlexApp: NewFlexApp(),
+	}
+	f.bindKeys()
+	f.InitFlexApp(app)
+	f.enrichNavigation(app)
+
+	var path string
+
+	form := tview.NewForm().
+		AddInputField("CSV File (date,currency,base_currency,rate)", "", DefaultInputFieldWidth, nil, func(text string) { path = text }).
+		AddButton("Import", func() { f.importFile(path) }).
+		AddButton("Cancel", f.BackFunc())
+
+	form.SetBorder(true).SetTitle("Import Exchange Rates").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+
+	f.AddItem(form, 0, 8, true)
+
+	f.SetInputCapture(f.KeyboardFunc)
+
+	return f
+}
+
+func (i *ImportExchangeRates) bindKeys() {
+	i.Actions = KeyActions{
+		tcell.KeyEscape: NewKeyAction("Back", i.KeyBack),
+	}
+}
+
+func (i *ImportExchangeRates) importFile(path string) {
+	file, err := os.Open(path)
+	if err != nil {
+		i.ShowErrorTo(err)
+		return
+	}
+	defer file.Close()
+
+	if imported, err := i.app.GetExchangeRateService().Import(i.app.AuthorizedUser.Id, file); err != nil {
+		i.ShowErrorTo(err)
+	} else {
+		i.ShowInfoReturnBack("%d exchange rates successfully imported.", len(imported))
+	}
+}
@@ -3,6 +3,8 @@ package tui
 import (
 	"fmt"
 	"github.com/VlasovArtem/hob/src/common/ctime"
+	"github.com/VlasovArtem/hob/src/income/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
@@ -17,7 +19,8 @@ var incomesTableHeader = []*TableHeader{
 	NewTableHeader("Name"),
 	NewTableHeader("Description"),
 	NewTableHeader("Date").SetContentModifier(AlignCenterExpansion()),
-	NewTableHeader("Sum").SetContentModifier(AlignCenterExpansion())}
+	NewTableHeader("Sum").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Tags")}
 
 type Incomes struct {
 	*FlexApp
@@ -57,10 +60,15 @@ func NewIncomes(app *TerminalApp) *Incomes {
 func (i *Incomes) initTable() {
 	i.incomes.SetSelectable(true, false)
 	i.incomes.SetTitle(fmt.Sprintf("Incomes for %d", time.Now().Year()))
+	i.incomes.AddContentProvider("Sum", func(income any) any {
+		incomeDto := income.(model.IncomeDto)
+		return i.App.FormatSum(incomeDto.Sum, incomeDto.Currency)
+	})
+	i.incomes.AddContentProvider("Tags", func(income any) any { return TagNames(income.(model.IncomeDto).Tags) })
 
 	i.incomes.SetFocusFunc(func() {
 		from, to := ctime.Now().StartOfYearAndCurrent()
-		content := i.App.GetIncomeService().FindByHouseId(i.App.House.Id, 50, 0, from, to)
+		content := i.App.GetIncomeService().FindByHouseId(i.App.House.Id, i.App.AuthorizedUser.Id, 50, 0, from, to, tagModel.Filter{})
 
 		i.incomes.Fill(content)
 	})
@@ -119,7 +127,7 @@ func (i *Incomes) createDeleteModalButton(name string, id uuid.UUID) ModalButton
 	return ModalButton{
 		Name: "Delete",
 		Action: func() {
-			if err := i.App.GetIncomeService().DeleteById(id); err != nil {
+			if err := i.App.GetIncomeService().DeleteById(id, i.App.AuthorizedUser.Id); err != nil {
 				i.ShowErrorTo(err)
 			} else {
 				i.ShowInfoRefresh("Income %s (%s) successfully deleted.", name, id)
@@ -2,12 +2,16 @@ package tui
 
 import (
 	"fmt"
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
 	"github.com/VlasovArtem/hob/src/common/ctime"
 	"github.com/VlasovArtem/hob/src/payment/model"
 	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+	tagService "github.com/VlasovArtem/hob/src/tag/service"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
+	"strings"
 	"time"
 )
 
@@ -22,6 +26,8 @@ var paymentsTableHeader = []*TableHeader{
 	NewTableHeader("Sum").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Provider").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Meter Id").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Category"),
+	NewTableHeader("Tags"),
 }
 
 type Payments struct {
@@ -56,19 +62,31 @@ func NewPayments(app *TerminalApp) *Payments {
 func (p *Payments) initTable() {
 	p.payments.SetSelectable(true, false)
 	p.payments.SetTitle(fmt.Sprintf("Payments for %d", time.Now().Year()))
+	p.payments.AddContentProvider("Sum", p.paymentSum)
 	p.payments.AddContentProvider("Provider", p.findProviderName)
 	p.payments.AddContentProvider("Meter Id", p.findMeterId)
+	p.payments.AddContentProvider("Category", p.findCategoryPath)
+	p.payments.AddContentProvider("Tags", func(payment any) any { return TagNames(payment.(model.PaymentDto).Tags) })
 
 	p.payments.SetFocusFunc(func() {
 		from, to := ctime.Now().StartOfYearAndCurrent()
 
-		content := p.App.GetPaymentService().FindByHouseId(p.App.House.Id, 50, 0, from, to)
+		content := p.App.GetPaymentService().FindByHouseId(p.App.House.Id, p.App.AuthorizedUser.Id, 50, 0, from, to, nil, tagModel.Filter{})
 		p.payments.Fill(content)
 	})
 
 	return
 }
 
+func (p *Payments) paymentSum(payment any) any {
+	paymentDto := payment.(model.PaymentDto)
+
+	if paymentDto.Pending {
+		return "pending"
+	}
+	return p.App.FormatSum(paymentDto.Sum, paymentDto.Currency)
+}
+
 func (p *Payments) findProviderName(payment any) any {
 	providerId := payment.(model.PaymentDto).ProviderId
 
@@ -76,7 +94,7 @@ func (p *Payments) findProviderName(payment any) any {
 		return ""
 	}
 
-	providerDto, err := p.App.GetProviderService().FindById(*providerId)
+	providerDto, err := p.App.GetProviderService().FindById(*providerId, p.App.AuthorizedUser.Id)
 
 	if err != nil {
 		p.ShowErrorTo(err)
@@ -90,7 +108,7 @@ func (p *Payments) findProviderName(payment any) any {
 func (p *Payments) findMeterId(payment any) any {
 	paymentId := payment.(model.PaymentDto).Id
 
-	meterDto, err := p.App.GetMeterService().FindByPaymentId(paymentId)
+	meterDto, err := p.App.GetMeterService().FindByPaymentId(paymentId, p.App.AuthorizedUser.Id)
 
 	if err != nil {
 		return nil
@@ -98,6 +116,21 @@ func (p *Payments) findMeterId(payment any) any {
 	return meterDto.Id
 }
 
+func (p *Payments) findCategoryPath(payment any) any {
+	categoryId := payment.(model.PaymentDto).CategoryId
+
+	if categoryId == nil {
+		return ""
+	}
+
+	categoryDto, err := p.App.GetCategoryService().FindById(*categoryId, p.App.AuthorizedUser.Id)
+
+	if err != nil {
+		return ""
+	}
+	return categoryDto.Path
+}
+
 func (p *Payments) enrichNavigation(app *TerminalApp) {
 	p.Navigation = NewNavigation(app, p.NavigationInfo(app, nil))
 	p.AddCustomPage(&CreatePayment{})
@@ -158,7 +191,7 @@ func (p *Payments) showMeter(key *tcell.EventKey) *tcell.EventKey {
 	if err != nil {
 		p.ShowErrorTo(err)
 	} else {
-		meterDto, err := p.App.GetMeterService().FindById(meterId)
+		meterDto, err := p.App.GetMeterService().FindById(meterId, p.App.AuthorizedUser.Id)
 
 		if err != nil {
 			p.ShowErrorTo(err)
@@ -203,7 +236,7 @@ func (p *Payments) createDeleteModalButton(paymentName string, paymentId uuid.UU
 	return ModalButton{
 		Name: "Delete",
 		Action: func() {
-			if err := p.App.GetPaymentService().DeleteById(paymentId); err != nil {
+			if err := p.App.GetPaymentService().DeleteById(paymentId, p.App.AuthorizedUser.Id); err != nil {
 				p.ShowErrorTo(err)
 			} else {
 				p.ShowInfoRefresh("Payment %s (%s) successfully deleted.", paymentName, paymentId)
@@ -234,3 +267,85 @@ func GetProviders(t *TerminalApp) ([]providerModel.ProviderDto, []string) {
 
 	return providerDtos, providerOptions
 }
+
+// GetCategories returns the categories of the user with the paths as the options, the first empty option is used for
+// the payments without a category
+func GetCategories(t *TerminalApp) ([]categoryModel.CategoryDto, []string) {
+	categoryDtos := t.GetCategoryService().FindByUserId(t.AuthorizedUser.Id)
+	categoryOptions := []string{""}
+	for _, category := range categoryDtos {
+		categoryOptions = append(categoryOptions, category.Path)
+	}
+
+	return categoryDtos, categoryOptions
+}
+
+// CategoryIdOf returns the id of the category selected by the option index of GetCategories
+func CategoryIdOf(categories []categoryModel.CategoryDto, optionIndex int) *uuid.UUID {
+	if optionIndex <= 0 || optionIndex > len(categories) {
+		return nil
+	}
+	return &categories[optionIndex-1].Id
+}
+
+// CategoryOptionIndex returns the option index of GetCategories for the category id
+func CategoryOptionIndex(categories []categoryModel.CategoryDto, categoryId *uuid.UUID) int {
+	if categoryId != nil {
+		for index, category := range categories {
+			if category.Id == *categoryId {
+				return index + 1
+			}
+		}
+	}
+	return 0
+}
+
+// NewTagsField creates the input field of the comma separated tag names, the last entered name is completed from the
+// tags of the user
+func NewTagsField(t *TerminalApp, tags []tagModel.TagDto, changed func(text string)) *tview.InputField {
+	field := tview.NewInputField().
+		SetLabel("Tags (ex. rent, landlord)").
+		SetText(TagNames(tags)).
+		SetFieldWidth(20).
+		SetChangedFunc(changed)
+
+	field.SetAutocompleteFunc(func(currentText string) (entries []string) {
+		separatorIndex := strings.LastIndex(currentText, tagService.Separator)
+		entered, prefix := currentText[:separatorIndex+1], strings.TrimSpace(currentText[separatorIndex+1:])
+		if prefix == "" {
+			return nil
+		}
+		if entered != "" {
+			entered += " "
+		}
+		for _, tag := range t.GetTagService().FindByUserId(t.AuthorizedUser.Id, prefix) {
+			entries = append(entries, entered+tag.Name)
+		}
+		return entries
+	})
+
+	return field
+}
+
+// TagNames returns the comma separated names of the tags
+func TagNames(tags []tagModel.TagDto) string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return strings.Join(names, tagService.Separator+" ")
+}
+
+// TagIdsOf returns the ids of the tags entered to the field of NewTagsField, the missing tags are created
+func TagIdsOf(t *TerminalApp, text string) ([]uuid.UUID, error) {
+	tags, err := t.GetTagService().FindOrCreate(t.AuthorizedUser.Id, strings.Split(text, tagService.Separator))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(tags))
+	for _, tag := range tags {
+		ids = append(ids, tag.Id)
+	}
+	return ids, nil
+}
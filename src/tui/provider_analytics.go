@@ -0,0 +1,207 @@
+package tui
+
+import (
+	"fmt"
+	analyticsModel "github.com/VlasovArtem/hob/src/analytics/model"
+	analyticsService "github.com/VlasovArtem/hob/src/analytics/service"
+	"github.com/gdamore/tcell/v2"
+	"github.com/google/uuid"
+	"github.com/rivo/tview"
+	"strconv"
+	"time"
+)
+
+const ProviderAnalyticsPageName = "provider-analytics"
+
+// chartMonths is the number of the last months shown in the chart
+const chartMonths = 24
+
+var analyticsYearFields = []*TableHeader{
+	NewTableHeader("Year"),
+	NewTableHeader("Sum").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Change").SetContentModifier(AlignCenterExpansion())}
+
+type ProviderAnalytics struct {
+	*FlexApp
+	*Navigation
+	providerId uuid.UUID
+	years      int
+	months     *tview.Flex
+	yearTotals *TableFiller
+	stats      *tview.TextView
+	chart      *BarChart
+}
+
+func (p *ProviderAnalytics) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(ProviderAnalyticsPageName, func() tview.Primitive {
+		return NewProviderAnalytics(app, variables["id"].(uuid.UUID))
+	})
+}
+
+func (p *ProviderAnalytics) enrichNavigation(app *TerminalApp) {
+	p.Navigation = NewNavigation(app, p.NavigationInfo(app, map[string]any{"id": p.providerId}))
+}
+
+func NewProviderAnalytics(app *TerminalApp, providerId uuid.UUID) *ProviderAnalytics {
+	p := &ProviderAnalytics{
+		FlexApp:    NewFlexApp(),
+		providerId: providerId,
+		years:      2,
+		months:     tview.NewFlex(),
+		yearTotals: NewTableFiller(analyticsYearFields),
+		stats:      tview.NewTextView().SetDynamicColors(true),
+		chart:      NewBarChart(),
+	}
+	p.enrichNavigation(app)
+
+	p.bindKeys()
+	p.InitFlexApp(app)
+
+	p.yearTotals.SetSelectable(false, false).SetTitle("Years")
+	p.yearTotals.AddContentProvider("Change", func(year any) any {
+		return formatChange(year.(analyticsModel.YearDto).Change)
+	})
+	p.stats.SetBorder(true).SetTitle("Statistics").SetBorderPadding(1, 1, 1, 1)
+	p.chart.SetBorder(true)
+
+	summary := tview.NewFlex().
+		AddItem(p.yearTotals, 0, 1, false).
+		AddItem(p.stats, 0, 1, false)
+	content := tview.NewFlex().
+		AddItem(p.months, 0, 2, true).
+		AddItem(summary, 0, 1, false)
+
+	p.
+		AddItem(content, 0, 5, true).
+		AddItem(p.chart, 0, 3, false).
+		SetInputCapture(p.KeyboardFunc)
+
+	p.fill()
+
+	return p
+}
+
+func (p *ProviderAnalytics) bindKeys() {
+	p.Actions = KeyActions{
+		tcell.KeyCtrlY:  NewKeyAction("Add Year", p.addYear),
+		tcell.KeyCtrlR:  NewKeyAction("Remove Year", p.removeYear),
+		tcell.KeyEscape: NewKeyAction("Back", p.KeyBack),
+	}
+}
+
+func (p *ProviderAnalytics) addYear(key *tcell.EventKey) *tcell.EventKey {
+	if p.years < analyticsService.MaxYears {
+		p.years++
+		p.fill()
+	}
+	return key
+}
+
+func (p *ProviderAnalytics) removeYear(key *tcell.EventKey) *tcell.EventKey {
+	if p.years > 1 {
+		p.years--
+		p.fill()
+	}
+	return key
+}
+
+// fill shows the monthly totals of the years side by side, the totals of the years, the min and max months and the chart
+// of the last months with the rolling average
+func (p *ProviderAnalytics) fill() {
+	if p.App.House == nil {
+		p.fillEmpty()
+		return
+	}
+	provider, err := p.App.GetProviderService().FindById(p.providerId, p.App.AuthorizedUser.Id)
+	if err != nil {
+		p.ShowErrorTo(err)
+		return
+	}
+	analytics, err := p.App.GetAnalyticsService().FindByProviderId(p.App.House.Id, p.providerId, p.App.AuthorizedUser.Id, "", p.years, time.Now())
+	if err != nil {
+		p.ShowErrorTo(err)
+		return
+	}
+
+	p.fillMonths(provider.Name, analytics)
+	p.yearTotals.AddContentProvider("Sum", func(year any) any {
+		return p.App.FormatSum(year.(analyticsModel.YearDto).Sum, analytics.Currency)
+	})
+	p.yearTotals.Fill(analytics.Years)
+	p.fillStats(analytics)
+	p.fillChart(analytics)
+}
+
+// fillEmpty clears the analytics, they are shown only for the house chosen on the home page
+func (p *ProviderAnalytics) fillEmpty() {
+	p.months.Clear()
+	p.yearTotals.Fill([]analyticsModel.YearDto{})
+	p.stats.SetText("Choose the house on the home page to see the analytics of the provider.")
+	p.chart.SetData(nil, nil, nil)
+	p.chart.SetTitle("")
+}
+
+// fillMonths replaces the table of the months as its columns depend on the number of the years
+func (p *ProviderAnalytics) fillMonths(providerName string, analytics analyticsModel.ProviderAnalyticsDto) {
+	headers := []*TableHeader{NewTableHeader("Month")}
+	for index, year := range analytics.Years {
+		yearIndex := index
+		headers = append(headers, NewTableHeader(strconv.Itoa(year.Year)).
+			SetContentModifier(AlignCenterExpansion()).
+			SetContentProvider(func(month any) any {
+				return p.App.FormatSum(month.(analyticsModel.MonthDto).Sums[yearIndex], analytics.Currency)
+			}))
+	}
+	headers = append(headers, NewTableHeader("Change").
+		SetContentModifier(AlignCenterExpansion()).
+		SetContentProvider(func(month any) any { return formatChange(month.(analyticsModel.MonthDto).Change) }))
+
+	table := NewTableFiller(headers)
+	table.AddContentProvider("Month", func(month any) any { return month.(analyticsModel.MonthDto).Month.String() })
+	table.SetSelectable(false, false).SetTitle(fmt.Sprintf("%s in %s", providerName, analytics.Currency))
+	table.Fill(analytics.Months)
+
+	p.months.Clear().AddItem(table, 0, 1, true)
+}
+
+func (p *ProviderAnalytics) fillStats(analytics analyticsModel.ProviderAnalyticsDto) {
+	p.stats.Clear()
+
+	if analytics.Min == nil {
+		p.stats.SetText("There are no payments in the period.")
+		return
+	}
+
+	last := analytics.Trend[len(analytics.Trend)-1]
+	_, _ = fmt.Fprintf(p.stats, "[lightgray]Max:[-] %s - %s\n", analytics.Max.Month.Format("January 2006"), p.App.FormatSum(analytics.Max.Sum, analytics.Currency))
+	_, _ = fmt.Fprintf(p.stats, "[lightgray]Min:[-] %s - %s\n", analytics.Min.Month.Format("January 2006"), p.App.FormatSum(analytics.Min.Sum, analytics.Currency))
+	_, _ = fmt.Fprintf(p.stats, "[lightgray]12 months average:[-] %s\n", p.App.FormatSum(last.Average, analytics.Currency))
+}
+
+// fillChart draws the sums of the last months as the bars and their rolling average as the sparkline
+func (p *ProviderAnalytics) fillChart(analytics analyticsModel.ProviderAnalyticsDto) {
+	trend := analytics.Trend
+	if len(trend) > chartMonths {
+		trend = trend[len(trend)-chartMonths:]
+	}
+
+	labels := make([]string, 0, len(trend))
+	values := make([]float64, 0, len(trend))
+	averages := make([]float64, 0, len(trend))
+	for _, total := range trend {
+		labels = append(labels, total.Month.Format("Jan"))
+		values = append(values, float64(total.Sum))
+		averages = append(averages, float64(total.Average))
+	}
+
+	p.chart.SetData(labels, values, averages)
+	p.chart.SetTitle(fmt.Sprintf("Last %d months, the line is the 12 months average", len(trend)))
+}
+
+// formatChange returns the percentage change with the sign, a dash is returned if the change is unknown
+func formatChange(change *float64) string {
+	if change == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%+.2f%%", *change)
+}
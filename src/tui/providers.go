@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"errors"
 	"fmt"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
@@ -62,6 +63,7 @@ func (p *Providers) bindKeys() {
 		tcell.KeyCtrlO:  NewKeyAction("Create Provider as default", p.createProviderWithDefaultUser),
 		tcell.KeyCtrlD:  NewKeyAction("Delete Provider", p.deleteProvider),
 		tcell.KeyCtrlU:  NewKeyAction("Update Payment", p.updatePayment),
+		tcell.KeyCtrlA:  NewKeyAction("Show Analytics", p.showAnalytics),
 		tcell.KeyEscape: NewKeyAction("Back Home", p.KeyHome),
 	}
 }
@@ -111,7 +113,7 @@ func (p *Providers) createDeleteModalButton(paymentName string, paymentId uuid.U
 	return ModalButton{
 		Name: "Delete",
 		Action: func() {
-			if err := p.App.GetProviderService().Delete(paymentId); err != nil {
+			if err := p.App.GetProviderService().Delete(paymentId, p.App.AuthorizedUser.Id); err != nil {
 				p.ShowErrorTo(err)
 			} else {
 				p.ShowInfoRefresh("Provider %s (%s) successfully deleted.", paymentName, paymentId)
@@ -132,3 +134,21 @@ func (p *Providers) updatePayment(key *tcell.EventKey) *tcell.EventKey {
 	}
 	return key
 }
+
+func (p *Providers) showAnalytics(key *tcell.EventKey) *tcell.EventKey {
+	if p.App.House == nil {
+		p.ShowErrorTo(errors.New("choose the house on the home page to see the analytics of the provider"))
+		return key
+	}
+
+	err := p.providers.PerformWithSelectedId(1, func(row int, id uuid.UUID) {
+		p.Navigate(NewNavigationInfo(ProviderAnalyticsPageName, func() tview.Primitive {
+			return NewProviderAnalytics(p.App, id)
+		}))
+	})
+
+	if err != nil {
+		p.ShowErrorTo(err)
+	}
+	return key
+}
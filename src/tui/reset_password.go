@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"github.com/VlasovArtem/hob/src/user/reset/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const ResetPasswordPageName = "reset-password"
+
+// ResetPassword requests the reset token for the email and sets the new password with the received token
+type ResetPassword struct {
+	*tview.Form
+	*Navigation
+	*Keyboard
+	passwordResetRequest model.PasswordResetRequest
+	resetPasswordRequest model.ResetPasswordRequest
+}
+
+func (r *ResetPassword) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(ResetPasswordPageName, func() tview.Primitive { return NewResetPassword(app) })
+}
+
+func (r *ResetPassword) enrichNavigation(app *TerminalApp) {
+	r.Navigation = NewNavigation(app, r.NavigationInfo(app, nil))
+}
+
+func NewResetPassword(app *TerminalApp) *ResetPassword {
+	f := &ResetPassword{
+		Form: tview.NewForm(),
+	}
+	f.bindKeys()
+	f.enrichNavigation(app)
+
+	f.
+		AddInputField("Email", "", 20, nil, func(text string) { f.passwordResetRequest.Email = text }).
+		AddButton("Send Token", func() {
+			if err := app.GetPasswordResetService().Request(f.passwordResetRequest); err != nil {
+				f.ShowErrorTo(err)
+			} else {
+				f.ShowInfo("The reset token is sent if the email is registered.", func(key tcell.Key) {
+					f.ShowOnMe(f)
+				})
+			}
+		}).
+		AddInputField("Token", "", 20, nil, func(text string) { f.resetPasswordRequest.Token = text }).
+		AddPasswordField("New Password", "", 20, '*', func(text string) { f.resetPasswordRequest.Password = text }).
+		AddButton("Reset", func() {
+			if err := app.GetPasswordResetService().Reset(f.resetPasswordRequest); err != nil {
+				f.ShowErrorTo(err)
+			} else {
+				f.ShowInfo("Password successfully reset.", f.DoneFuncBack)
+			}
+		}).
+		AddButton("Cancel", func() {
+			f.Back()
+		})
+
+	f.SetBorder(true).SetTitle("Reset Password").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+
+	f.SetInputCapture(f.KeyboardFunc)
+
+	return f
+}
+
+func (r *ResetPassword) bindKeys() {
+	r.Actions = KeyActions{
+		tcell.KeyEscape: NewKeyAction("Back", r.KeyBack),
+	}
+}
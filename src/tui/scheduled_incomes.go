@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"github.com/VlasovArtem/hob/src/income/scheduler/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
@@ -14,9 +15,9 @@ var scheduledIncomesTableHeader = []*TableHeader{
 	NewTableHeader("Id").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Name"),
 	NewTableHeader("Description"),
-	NewTableHeader("Date").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Sum").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Spec").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Paused").SetContentModifier(AlignCenterExpansion()),
 }
 
 type ScheduledIncomes struct {
@@ -49,7 +50,11 @@ func NewScheduledIncomes(app *TerminalApp) *ScheduledIncomes {
 func (p *ScheduledIncomes) fillTable() *TableFiller {
 	p.scheduledIncomes.SetSelectable(true, false)
 	p.scheduledIncomes.SetTitle("Scheduled Incomes")
-	content := p.App.GetPaymentService().FindByHouseId(p.App.House.Id, 100, 0, nil, nil)
+	p.scheduledIncomes.AddContentProvider("Sum", func(income any) any {
+		incomeDto := income.(model.IncomeSchedulerDto)
+		return p.App.FormatSum(incomeDto.Sum, incomeDto.Currency)
+	})
+	content := p.App.GetIncomeSchedulerService().FindByHouseId(p.App.House.Id, p.App.AuthorizedUser.Id)
 	p.scheduledIncomes.Fill(content)
 	return p.scheduledIncomes
 }
@@ -64,6 +69,9 @@ func (p *ScheduledIncomes) bindKeys() {
 		tcell.KeyCtrlP:  NewKeyAction("Create", p.createScheduledIncome),
 		tcell.KeyCtrlD:  NewKeyAction("Delete", p.deleteScheduledIncome),
 		tcell.KeyCtrlU:  NewKeyAction("Update", p.updateScheduledIncome),
+		tcell.KeyCtrlA:  NewKeyAction("Pause", p.pauseScheduledIncome),
+		tcell.KeyCtrlR:  NewKeyAction("Resume", p.resumeScheduledIncome),
+		tcell.KeyCtrlT:  NewKeyAction("Run Now", p.triggerScheduledIncome),
 		tcell.KeyEscape: NewKeyAction("Back", p.KeyBack),
 	}
 }
@@ -95,7 +103,7 @@ func (p *ScheduledIncomes) createDeleteModalButton(name string, id uuid.UUID) Mo
 	return ModalButton{
 		Name: "Delete",
 		Action: func() {
-			if err := p.App.GetIncomeSchedulerService().DeleteById(id); err != nil {
+			if err := p.App.GetIncomeSchedulerService().DeleteById(id, p.App.AuthorizedUser.Id); err != nil {
 				p.ShowErrorTo(err)
 			} else {
 				p.ShowInfoRefresh("Income scheduler %s (%s) successfully deleted.", name, id)
@@ -116,3 +124,30 @@ func (p *ScheduledIncomes) updateScheduledIncome(key *tcell.EventKey) *tcell.Eve
 	}
 	return key
 }
+
+func (p *ScheduledIncomes) pauseScheduledIncome(key *tcell.EventKey) *tcell.EventKey {
+	return p.performWithSelected(key, p.App.GetIncomeSchedulerService().Pause, "Income scheduler %s successfully paused.")
+}
+
+func (p *ScheduledIncomes) resumeScheduledIncome(key *tcell.EventKey) *tcell.EventKey {
+	return p.performWithSelected(key, p.App.GetIncomeSchedulerService().Resume, "Income scheduler %s successfully resumed.")
+}
+
+func (p *ScheduledIncomes) triggerScheduledIncome(key *tcell.EventKey) *tcell.EventKey {
+	return p.performWithSelected(key, p.App.GetIncomeSchedulerService().Trigger, "Income scheduler %s successfully executed.")
+}
+
+func (p *ScheduledIncomes) performWithSelected(key *tcell.EventKey, action func(id uuid.UUID, userId uuid.UUID) error, successMessage string) *tcell.EventKey {
+	err := p.scheduledIncomes.PerformWithSelectedId(1, func(row int, id uuid.UUID) {
+		if err := action(id, p.App.AuthorizedUser.Id); err != nil {
+			p.ShowErrorTo(err)
+		} else {
+			p.ShowInfoRefresh(successMessage, id)
+		}
+	})
+
+	if err != nil {
+		p.ShowErrorTo(err)
+	}
+	return key
+}
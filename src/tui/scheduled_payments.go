@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/model"
+	runModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
@@ -15,16 +16,25 @@ var scheduledPaymentsTableHeader = []*TableHeader{
 	NewTableHeader("Id").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Name"),
 	NewTableHeader("Description"),
-	NewTableHeader("Date").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Sum").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Spec").SetContentModifier(AlignCenterExpansion()),
 	NewTableHeader("Provider").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Paused").SetContentModifier(AlignCenterExpansion()),
+}
+
+var scheduledPaymentRunsTableHeader = []*TableHeader{
+	NewIndexHeader(),
+	NewTableHeaderWithDisplayName("FireTime", "Fire Time").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Status").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeaderWithDisplayName("EntityId", "Payment").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Error"),
 }
 
 type ScheduledPayments struct {
 	*FlexApp
 	*Navigation
 	payments *TableFiller
+	runs     *TableFiller
 }
 
 func (p *ScheduledPayments) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
@@ -35,6 +45,7 @@ func NewScheduledPayments(app *TerminalApp) *ScheduledPayments {
 	p := &ScheduledPayments{
 		FlexApp:  NewFlexApp(),
 		payments: NewTableFiller(scheduledPaymentsTableHeader),
+		runs:     NewTableFiller(scheduledPaymentRunsTableHeader),
 	}
 	p.enrichNavigation(app)
 
@@ -42,7 +53,8 @@ func NewScheduledPayments(app *TerminalApp) *ScheduledPayments {
 	p.InitFlexApp(app)
 
 	p.
-		AddItem(p.fillTable(), 0, 8, true).
+		AddItem(p.fillTable(), 0, 5, true).
+		AddItem(p.initRunsTable(), 0, 3, false).
 		SetInputCapture(p.KeyboardFunc)
 
 	return p
@@ -51,16 +63,56 @@ func NewScheduledPayments(app *TerminalApp) *ScheduledPayments {
 func (p *ScheduledPayments) fillTable() *TableFiller {
 	p.payments.SetSelectable(true, false)
 	p.payments.SetTitle("Scheduled Payments")
+	p.payments.AddContentProvider("Sum", func(payment any) any {
+		paymentDto := payment.(model.PaymentSchedulerDto)
+		return p.App.FormatSum(paymentDto.Sum, paymentDto.Currency)
+	})
 	p.payments.AddContentProvider("Provider", p.findProviderName)
-	content := p.App.GetPaymentService().FindByHouseId(p.App.House.Id, 100, 0, nil, nil)
+	p.payments.SetSelectionChangedFunc(func(row, column int) {
+		p.fillRunsTable()
+	})
+	content := p.App.GetPaymentSchedulerService().FindByHouseId(p.App.House.Id, p.App.AuthorizedUser.Id)
 	p.payments.Fill(content)
 	return p.payments
 }
 
+func (p *ScheduledPayments) initRunsTable() *TableFiller {
+	p.runs.SetSelectable(false, false)
+	p.runs.SetTitle("Execution History")
+	p.runs.AddContentProvider("EntityId", func(run any) any {
+		if entityId := run.(runModel.SchedulerRunDto).EntityId; entityId != nil {
+			return entityId.String()
+		}
+		return ""
+	})
+	p.fillRunsTable()
+	return p.runs
+}
+
+func (p *ScheduledPayments) fillRunsTable() {
+	content := []runModel.SchedulerRunDto{}
+
+	if !p.payments.empty {
+		err := p.payments.PerformWithSelectedId(1, func(row int, id uuid.UUID) {
+			if runs, err := p.App.GetPaymentSchedulerService().FindRunsById(id, p.App.AuthorizedUser.Id); err != nil {
+				p.ShowErrorTo(err)
+			} else {
+				content = append(content, runs...)
+			}
+		})
+
+		if err != nil {
+			p.ShowErrorTo(err)
+		}
+	}
+
+	p.runs.Fill(content)
+}
+
 func (p *ScheduledPayments) findProviderName(payment any) any {
 	providerId := payment.(model.PaymentSchedulerDto).ProviderId
 
-	providerDto, err := p.App.GetProviderService().FindById(providerId)
+	providerDto, err := p.App.GetProviderService().FindById(providerId, p.App.AuthorizedUser.Id)
 
 	if err != nil {
 		p.ShowErrorTo(err)
@@ -81,6 +133,9 @@ func (p *ScheduledPayments) bindKeys() {
 		tcell.KeyCtrlP:  NewKeyAction("Create", p.createScheduledPayment),
 		tcell.KeyCtrlD:  NewKeyAction("Delete", p.deleteScheduledPayment),
 		tcell.KeyCtrlU:  NewKeyAction("Update", p.updateScheduledPayment),
+		tcell.KeyCtrlA:  NewKeyAction("Pause", p.pauseScheduledPayment),
+		tcell.KeyCtrlR:  NewKeyAction("Resume", p.resumeScheduledPayment),
+		tcell.KeyCtrlT:  NewKeyAction("Run Now", p.triggerScheduledPayment),
 		tcell.KeyEscape: NewKeyAction("Back", p.KeyBack),
 	}
 }
@@ -113,7 +168,7 @@ func (p *ScheduledPayments) createDeleteModalButton(paymentName string, paymentI
 	return ModalButton{
 		Name: "Delete",
 		Action: func() {
-			if err := p.App.GetPaymentSchedulerService().Remove(paymentId); err != nil {
+			if err := p.App.GetPaymentSchedulerService().Remove(paymentId, p.App.AuthorizedUser.Id); err != nil {
 				p.ShowErrorTo(err)
 			} else {
 				p.ShowInfoRefresh("Payment scheduler %s (%s) successfully deleted.", paymentName, paymentId)
@@ -134,3 +189,30 @@ func (p *ScheduledPayments) updateScheduledPayment(key *tcell.EventKey) *tcell.E
 	}
 	return key
 }
+
+func (p *ScheduledPayments) pauseScheduledPayment(key *tcell.EventKey) *tcell.EventKey {
+	return p.performWithSelected(key, p.App.GetPaymentSchedulerService().Pause, "Payment scheduler %s successfully paused.")
+}
+
+func (p *ScheduledPayments) resumeScheduledPayment(key *tcell.EventKey) *tcell.EventKey {
+	return p.performWithSelected(key, p.App.GetPaymentSchedulerService().Resume, "Payment scheduler %s successfully resumed.")
+}
+
+func (p *ScheduledPayments) triggerScheduledPayment(key *tcell.EventKey) *tcell.EventKey {
+	return p.performWithSelected(key, p.App.GetPaymentSchedulerService().Trigger, "Payment scheduler %s successfully executed.")
+}
+
+func (p *ScheduledPayments) performWithSelected(key *tcell.EventKey, action func(id uuid.UUID, userId uuid.UUID) error, successMessage string) *tcell.EventKey {
+	err := p.payments.PerformWithSelectedId(1, func(row int, id uuid.UUID) {
+		if err := action(id, p.App.AuthorizedUser.Id); err != nil {
+			p.ShowErrorTo(err)
+		} else {
+			p.ShowInfoRefresh(successMessage, id)
+		}
+	})
+
+	if err != nil {
+		p.ShowErrorTo(err)
+	}
+	return key
+}
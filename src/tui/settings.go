@@ -0,0 +1,154 @@
+package tui
+
+import (
+	"fmt"
+	"github.com/VlasovArtem/hob/src/user/apikey/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/google/uuid"
+	"github.com/rivo/tview"
+)
+
+const SettingsPageName = "settings"
+
+var apiKeysTableHeader = []*TableHeader{
+	NewIndexHeader(),
+	NewTableHeader("Id").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Name"),
+	NewTableHeader("Prefix").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("ReadOnly").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("CreatedAt").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("LastUsedAt").SetContentModifier(AlignCenterExpansion())}
+
+type Settings struct {
+	*FlexApp
+	*Navigation
+	apiKeys *TableFiller
+}
+
+func (s *Settings) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(SettingsPageName, func() tview.Primitive { return NewSettings(app) })
+}
+
+func NewSettings(app *TerminalApp) *Settings {
+	s := &Settings{
+		FlexApp: NewFlexApp(),
+		apiKeys: NewTableFiller(apiKeysTableHeader),
+	}
+	s.enrichNavigation(app)
+
+	s.bindKeys()
+	s.InitFlexApp(app)
+
+	s.
+		AddItem(s.fillTable(), 0, 8, true).
+		SetInputCapture(s.KeyboardFunc)
+
+	return s
+}
+
+func (s *Settings) fillTable() *TableFiller {
+	s.apiKeys.SetSelectable(true, false)
+	s.apiKeys.SetTitle("API Keys")
+	s.apiKeys.AddContentProvider("LastUsedAt", lastUsedAt)
+	content := s.App.GetApiKeyService().FindByUserId(s.App.AuthorizedUser.Id)
+	s.apiKeys.Fill(content)
+	return s.apiKeys
+}
+
+func (s *Settings) enrichNavigation(app *TerminalApp) {
+	s.Navigation = NewNavigation(app, s.NavigationInfo(app, nil))
+	s.
+		AddCustomPage(&UpdateUser{}).
+		AddCustomPage(&ExchangeRates{}).
+		AddCustomPage(&Categories{}).
+		AddCustomPage(&Tags{})
+}
+
+func (s *Settings) bindKeys() {
+	s.Actions = KeyActions{
+		tcell.KeyCtrlN:  NewKeyAction("Create API Key", s.createApiKey),
+		tcell.KeyCtrlD:  NewKeyAction("Revoke API Key", s.revokeApiKey),
+		tcell.KeyCtrlP:  NewKeyAction("Change Password", s.changePassword),
+		tcell.KeyCtrlU:  NewKeyAction("Update Profile", s.updateUser),
+		tcell.KeyCtrlR:  NewKeyAction("Show Exchange Rates", s.exchangeRates),
+		tcell.KeyCtrlG:  NewKeyAction("Show Categories", s.categories),
+		tcell.KeyCtrlT:  NewKeyAction("Show Tags", s.tags),
+		tcell.KeyCtrlX:  NewKeyAction("Delete Account", s.deleteAccount),
+		tcell.KeyEscape: NewKeyAction("Back Home", s.KeyHome),
+	}
+}
+
+func (s *Settings) createApiKey(key *tcell.EventKey) *tcell.EventKey {
+	s.Navigate(NewNavigationInfo(CreateApiKeyPageName, func() tview.Primitive {
+		return NewCreateApiKey(s.App)
+	}))
+	return key
+}
+
+func (s *Settings) revokeApiKey(key *tcell.EventKey) *tcell.EventKey {
+	err := s.apiKeys.PerformWithSelectedId(1, func(row int, id uuid.UUID) {
+		name := s.apiKeys.GetCell(row, 2).Text
+		ShowModal(s.App.Main, fmt.Sprintf("Do you want to revoke api key %s (%s)?", id, name), []ModalButton{
+			s.createRevokeModalButton(name, id),
+		})
+	})
+
+	if err != nil {
+		s.ShowErrorTo(err)
+	}
+	return key
+}
+
+func (s *Settings) createRevokeModalButton(name string, id uuid.UUID) ModalButton {
+	return ModalButton{
+		Name: "Revoke",
+		Action: func() {
+			if err := s.App.GetApiKeyService().Revoke(id, s.App.AuthorizedUser.Id); err != nil {
+				s.ShowErrorTo(err)
+			} else {
+				s.ShowInfoRefresh("API key %s (%s) successfully revoked.", name, id)
+			}
+		},
+	}
+}
+
+func (s *Settings) changePassword(key *tcell.EventKey) *tcell.EventKey {
+	s.Navigate(NewNavigationInfo(ChangePasswordPageName, func() tview.Primitive {
+		return NewChangePassword(s.App)
+	}))
+	return key
+}
+
+func (s *Settings) updateUser(key *tcell.EventKey) *tcell.EventKey {
+	s.NavigateTo(UpdateUserPageName)
+	return key
+}
+
+func (s *Settings) exchangeRates(key *tcell.EventKey) *tcell.EventKey {
+	s.NavigateTo(ExchangeRatesPageName)
+	return key
+}
+
+func (s *Settings) categories(key *tcell.EventKey) *tcell.EventKey {
+	s.NavigateTo(CategoriesPageName)
+	return key
+}
+
+func (s *Settings) tags(key *tcell.EventKey) *tcell.EventKey {
+	s.NavigateTo(TagsPageName)
+	return key
+}
+
+func (s *Settings) deleteAccount(key *tcell.EventKey) *tcell.EventKey {
+	s.Navigate(NewNavigationInfo(DeleteAccountPageName, func() tview.Primitive {
+		return NewDeleteAccount(s.App)
+	}))
+	return key
+}
+
+func lastUsedAt(content any) any {
+	if apiKey := content.(model.ApiKeyDto); apiKey.LastUsedAt != nil {
+		return apiKey.LastUsedAt.Format("2006-01-02 15:04")
+	}
+	return "never"
+}
@@ -21,6 +21,7 @@ func (s *SignInForm) NavigationInfo(app *TerminalApp, variables map[string]any)
 func (s *SignInForm) enrichNavigation(app *TerminalApp) {
 	s.Navigation = NewNavigation(app, s.NavigationInfo(app, nil))
 	s.AddCustomPage(&SignUp{})
+	s.AddCustomPage(&ResetPassword{})
 }
 
 func NewSignIn(app *TerminalApp) *SignInForm {
@@ -35,7 +36,7 @@ func NewSignIn(app *TerminalApp) *SignInForm {
 		AddInputField("Email", "", 20, nil, func(text string) { f.email = text }).
 		AddPasswordField("Password", "", 20, '*', func(text string) { f.password = text }).
 		AddButton("Enter", func() {
-			user, err := app.GetUserService().VerifyUser(f.email, f.password)
+			user, err := app.GetLoginAttemptService().VerifyUser(f.email, f.password, "")
 			if err != nil {
 				f.ShowErrorTo(err)
 			} else {
@@ -48,6 +49,9 @@ func NewSignIn(app *TerminalApp) *SignInForm {
 		}).
 		AddButton("Sign Up", func() {
 			f.NavigateTo(SingUpPageName)
+		}).
+		AddButton("Reset Password", func() {
+			f.NavigateTo(ResetPasswordPageName)
 		})
 
 	f.SetBorder(true).SetTitle("Sign In").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
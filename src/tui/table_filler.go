@@ -166,6 +166,8 @@ func (t *TableFiller) fillRowContent(currentRow int, data any) {
 		header := contentHeader.header
 		if contentHeader.IsIndex() {
 			value = fmt.Sprintf("%-2s", strconv.Itoa(currentRow))
+		} else if contentHeader.customProvider != nil {
+			value = fmt.Sprintf("%v", contentHeader.customProvider(data))
 		} else {
 			byName := reflect.ValueOf(data).FieldByName(header)
 
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"fmt"
+	"github.com/gdamore/tcell/v2"
+	"github.com/google/uuid"
+	"github.com/rivo/tview"
+)
+
+const TagsPageName = "tags"
+
+var tagsTableHeader = []*TableHeader{
+	NewIndexHeader(),
+	NewTableHeader("Id").SetContentModifier(AlignCenterExpansion()),
+	NewTableHeader("Name")}
+
+type Tags struct {
+	*FlexApp
+	*Navigation
+	tags *TableFiller
+}
+
+func (t *Tags) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(TagsPageName, func() tview.Primitive { return NewTags(app) })
+}
+
+func NewTags(app *TerminalApp) *Tags {
+	t := &Tags{
+		FlexApp: NewFlexApp(),
+		tags:    NewTableFiller(tagsTableHeader),
+	}
+	t.enrichNavigation(app)
+
+	t.bindKeys()
+	t.InitFlexApp(app)
+
+	t.
+		AddItem(t.fillTable(), 0, 8, true).
+		SetInputCapture(t.KeyboardFunc)
+
+	return t
+}
+
+func (t *Tags) fillTable() *TableFiller {
+	t.tags.SetSelectable(true, false)
+	t.tags.SetTitle("Tags")
+	content := t.App.GetTagService().FindByUserId(t.App.AuthorizedUser.Id, "")
+	t.tags.Fill(content)
+	return t.tags
+}
+
+func (t *Tags) enrichNavigation(app *TerminalApp) {
+	t.Navigation = NewNavigation(app, t.NavigationInfo(app, nil))
+}
+
+func (t *Tags) bindKeys() {
+	t.Actions = KeyActions{
+		tcell.KeyCtrlD:  NewKeyAction("Delete Tag", t.deleteTag),
+		tcell.KeyEscape: NewKeyAction("Back", t.KeyBack),
+	}
+}
+
+func (t *Tags) deleteTag(key *tcell.EventKey) *tcell.EventKey {
+	err := t.tags.PerformWithSelectedId(1, func(row int, id uuid.UUID) {
+		name := t.tags.GetCell(row, 2).Text
+		ShowModal(t.App.Main, fmt.Sprintf("Do you want to delete tag %s? The tag is removed from the payments and incomes.", name), []ModalButton{
+			{
+				Name: "Delete",
+				Action: func() {
+					if err := t.App.GetTagService().DeleteById(id, t.App.AuthorizedUser.Id); err != nil {
+						t.ShowErrorTo(err)
+					} else {
+						t.ShowInfoRefresh("Tag %s successfully deleted.", name)
+					}
+				},
+			},
+		})
+	})
+
+	if err != nil {
+		t.ShowErrorTo(err)
+	}
+	return key
+}
@@ -1,9 +1,16 @@
 package tui
 
 import (
+	"fmt"
+	analytics "github.com/VlasovArtem/hob/src/analytics/service"
 	"github.com/VlasovArtem/hob/src/app"
+	attempts "github.com/VlasovArtem/hob/src/auth/attempt/service"
+	budgets "github.com/VlasovArtem/hob/src/budget/service"
+	categories "github.com/VlasovArtem/hob/src/category/service"
 	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/money"
 	countries "github.com/VlasovArtem/hob/src/country/service"
+	exchangeRates "github.com/VlasovArtem/hob/src/exchange/service"
 	houseModel "github.com/VlasovArtem/hob/src/house/model"
 	houses "github.com/VlasovArtem/hob/src/house/service"
 	incomeSchedulers "github.com/VlasovArtem/hob/src/income/scheduler/service"
@@ -12,13 +19,20 @@ import (
 	paymentSchedulers "github.com/VlasovArtem/hob/src/payment/scheduler/service"
 	payments "github.com/VlasovArtem/hob/src/payment/service"
 	providers "github.com/VlasovArtem/hob/src/provider/service"
+	reports "github.com/VlasovArtem/hob/src/report/service"
+	summaries "github.com/VlasovArtem/hob/src/summary/service"
+	tags "github.com/VlasovArtem/hob/src/tag/service"
+	accounts "github.com/VlasovArtem/hob/src/user/account/service"
+	apiKeys "github.com/VlasovArtem/hob/src/user/apikey/service"
 	userModel "github.com/VlasovArtem/hob/src/user/model"
+	passwordResets "github.com/VlasovArtem/hob/src/user/reset/service"
 	users "github.com/VlasovArtem/hob/src/user/service"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
 	"github.com/rs/zerolog/log"
 	"os"
+	"strings"
 )
 
 var DefaultInputFieldWidth = 60
@@ -33,7 +47,11 @@ type TerminalApp struct {
 	Countries      map[string]string
 	CountriesCodes []string
 	CountriesNames []string
-	actions        KeyActions
+	// CountryCurrencies is the currency code by the country code
+	CountryCurrencies map[string]string
+	// CurrencySymbols is the currency symbol by the currency code
+	CurrencySymbols map[string]string
+	actions         KeyActions
 }
 
 func NewTApp(rootApplication *app.RootApplication) *TerminalApp {
@@ -49,8 +67,12 @@ func NewTApp(rootApplication *app.RootApplication) *TerminalApp {
 
 	allCountries := tapp.getCountryService().FindAllCountries()
 	tapp.Countries = make(map[string]string, len(allCountries))
+	tapp.CountryCurrencies = make(map[string]string, len(allCountries))
+	tapp.CurrencySymbols = make(map[string]string)
 	for _, country := range allCountries {
 		tapp.Countries[country.Code] = country.Name
+		tapp.CountryCurrencies[country.Code] = country.Currency.Code
+		tapp.CurrencySymbols[country.Currency.Code] = country.Currency.Symbol
 		tapp.CountriesNames = append(tapp.CountriesNames, country.Name)
 		tapp.CountriesCodes = append(tapp.CountriesCodes, country.Code)
 	}
@@ -64,7 +86,7 @@ func (t *TerminalApp) Init() {
 	config := t.root.Config
 	if config != nil && config.User.Email != "" {
 		user := config.User
-		userResponse, err := t.GetUserService().VerifyUser(user.Email, user.Password)
+		userResponse, err := t.GetLoginAttemptService().VerifyUser(user.Email, user.Password, "")
 
 		if err != nil {
 			log.Error().Err(err).Msg("XDG user configuration is not valid")
@@ -82,6 +104,22 @@ func (t *TerminalApp) Init() {
 	t.SetRoot(t.Main, true).EnableMouse(true)
 }
 
+// HouseCurrency returns the currency code of the selected house country
+func (t *TerminalApp) HouseCurrency() string {
+	if t.House == nil {
+		return ""
+	}
+	return t.CountryCurrencies[t.House.CountryCode]
+}
+
+// FormatSum returns the sum with the symbol of the currency, the code is used if the currency does not have the symbol
+func (t *TerminalApp) FormatSum(sum money.Money, currency string) string {
+	if symbol := t.CurrencySymbols[currency]; symbol != "" {
+		return fmt.Sprintf("%s %s", sum, symbol)
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s", sum, currency))
+}
+
 func (t *TerminalApp) GetHouseService() houses.HouseService {
 	return dependency.FindRequiredDependency[houses.HouseServiceObject, houses.HouseService](t.root.DependenciesFactory)
 }
@@ -90,6 +128,22 @@ func (t *TerminalApp) GetUserService() users.UserService {
 	return dependency.FindRequiredDependency[users.UserServiceObject, users.UserService](t.root.DependenciesFactory)
 }
 
+func (t *TerminalApp) GetLoginAttemptService() attempts.LoginAttemptService {
+	return dependency.FindRequiredDependency[attempts.LoginAttemptServiceObject, attempts.LoginAttemptService](t.root.DependenciesFactory)
+}
+
+func (t *TerminalApp) GetApiKeyService() apiKeys.ApiKeyService {
+	return dependency.FindRequiredDependency[apiKeys.ApiKeyServiceObject, apiKeys.ApiKeyService](t.root.DependenciesFactory)
+}
+
+func (t *TerminalApp) GetPasswordResetService() passwordResets.PasswordResetService {
+	return dependency.FindRequiredDependency[passwordResets.PasswordResetServiceObject, passwordResets.PasswordResetService](t.root.DependenciesFactory)
+}
+
+func (t *TerminalApp) GetAccountService() accounts.AccountService {
+	return dependency.FindRequiredDependency[accounts.AccountServiceObject, accounts.AccountService](t.root.DependenciesFactory)
+}
+
 func (t *TerminalApp) getCountryService() countries.CountryService {
 	return dependency.FindRequiredDependency[countries.CountryServiceObject, countries.CountryService](t.root.DependenciesFactory)
 }
@@ -106,6 +160,14 @@ func (t *TerminalApp) GetProviderService() providers.ProviderService {
 	return dependency.FindRequiredDependency[providers.ProviderServiceObject, providers.ProviderService](t.root.DependenciesFactory)
 }
 
+func (t *TerminalApp) GetCategoryService() categories.CategoryService {
+	return dependency.FindRequiredDependency[categories.CategoryServiceObject, categories.CategoryService](t.root.DependenciesFactory)
+}
+
+func (t *TerminalApp) GetTagService() tags.TagService {
+	return dependency.FindRequiredDependency[tags.TagServiceObject, tags.TagService](t.root.DependenciesFactory)
+}
+
 func (t *TerminalApp) GetMeterService() meters.MeterService {
 	return dependency.FindRequiredDependency[meters.MeterServiceObject, meters.MeterService](t.root.DependenciesFactory)
 }
@@ -118,6 +180,26 @@ func (t *TerminalApp) GetIncomeSchedulerService() incomeSchedulers.IncomeSchedul
 	return dependency.FindRequiredDependency[incomeSchedulers.IncomeSchedulerServiceObject, incomeSchedulers.IncomeSchedulerService](t.root.DependenciesFactory)
 }
 
+func (t *TerminalApp) GetExchangeRateService() exchangeRates.ExchangeRateService {
+	return dependency.FindRequiredDependency[exchangeRates.ExchangeRateServiceObject, exchangeRates.ExchangeRateService](t.root.DependenciesFactory)
+}
+
+func (t *TerminalApp) GetReportService() reports.ReportService {
+	return dependency.FindRequiredDependency[reports.ReportServiceObject, reports.ReportService](t.root.DependenciesFactory)
+}
+
+func (t *TerminalApp) GetBudgetService() budgets.BudgetService {
+	return dependency.FindRequiredDependency[budgets.BudgetServiceObject, budgets.BudgetService](t.root.DependenciesFactory)
+}
+
+func (t *TerminalApp) GetSummaryService() summaries.SummaryService {
+	return dependency.FindRequiredDependency[summaries.SummaryServiceObject, summaries.SummaryService](t.root.DependenciesFactory)
+}
+
+func (t *TerminalApp) GetAnalyticsService() analytics.AnalyticsService {
+	return dependency.FindRequiredDependency[analytics.AnalyticsServiceObject, analytics.AnalyticsService](t.root.DependenciesFactory)
+}
+
 func AsKey(evt *tcell.EventKey) tcell.Key {
 	if evt.Key() != tcell.KeyRune {
 		return evt.Key()
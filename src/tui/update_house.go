@@ -37,7 +37,7 @@ func NewUpdateHouse(app *TerminalApp, houseId uuid.UUID) *UpdateHouse {
 	f.InitFlexApp(app)
 	f.bindKeys()
 
-	houseDto, err := app.GetHouseService().FindById(houseId)
+	houseDto, err := app.GetHouseService().FindById(houseId, app.AuthorizedUser.Id)
 	if err != nil {
 		f.ShowInfoReturnBack(err.Error())
 	}
@@ -63,7 +63,7 @@ func NewUpdateHouse(app *TerminalApp, houseId uuid.UUID) *UpdateHouse {
 		AddInputField("Street Line 1", houseDto.StreetLine1, 20, nil, func(text string) { request.StreetLine1 = text }).
 		AddInputField("Street Line 2", houseDto.StreetLine2, 20, nil, func(text string) { request.StreetLine2 = text }).
 		AddButton("Update", func() {
-			if err := f.app.GetHouseService().Update(houseId, request); err != nil {
+			if err := f.app.GetHouseService().Update(houseId, f.app.AuthorizedUser.Id, request); err != nil {
 				f.ShowErrorTo(err)
 			} else {
 				f.ShowInfoReturnBack("House %s successfully updated.", request.Name)
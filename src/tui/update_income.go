@@ -3,19 +3,22 @@ package tui
 import (
 	"context"
 	"errors"
-	"fmt"
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/money"
+	groupModel "github.com/VlasovArtem/hob/src/group/model"
 	"github.com/VlasovArtem/hob/src/income/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
-	"strconv"
 	"time"
 )
 
 const UpdateIncomePageName = "income-update-page"
 
 type updateIncomeReq struct {
-	name, description, date, sum string
+	name, description, date, sum, currency, tags string
+	categoryId                                   *uuid.UUID
+	groupIds                                     []uuid.UUID
 }
 
 type UpdateIncome struct {
@@ -42,19 +45,30 @@ func NewUpdateIncome(app *TerminalApp, incomeId uuid.UUID) *UpdateIncome {
 	f.InitFlexApp(app)
 	f.bindKeys()
 
-	incomeDto, err := app.GetIncomeService().FindById(incomeId)
+	incomeDto, err := app.GetIncomeService().FindById(incomeId, app.AuthorizedUser.Id)
 	if err != nil {
 		f.ShowInfoReturnBack(err.Error())
 	}
 
-	var request updateIncomeReq
+	request := updateIncomeReq{
+		name:        incomeDto.Name,
+		description: incomeDto.Description,
+		date:        incomeDto.Date.Format("2006-01-02"),
+		sum:         incomeDto.Sum.String(),
+		currency:    incomeDto.Currency,
+		tags:        TagNames(incomeDto.Tags),
+		categoryId:  incomeDto.CategoryId,
+		groupIds:    common.MapSlice(incomeDto.Groups, func(group groupModel.GroupDto) uuid.UUID { return group.Id }),
+	}
 
 	form := tview.NewForm().
 		AddInputField("Name", incomeDto.Name, 20, nil, func(text string) { request.name = text }).
 		AddInputField("Description", incomeDto.Description, 20, nil, func(text string) { request.description = text }).
 		AddInputField("Date (ex. 2006-01-02)", incomeDto.Date.Format("2006-01-02"), 20, nil, func(text string) { request.date = text }).
-		AddInputField("Sum", fmt.Sprintf("%.2f", incomeDto.Sum), 20, nil, func(text string) { request.sum = text }).
-		AddButton("Update", f.update(request, incomeId)).
+		AddInputField("Sum", incomeDto.Sum.String(), 20, nil, func(text string) { request.sum = text }).
+		AddInputField("Currency", incomeDto.Currency, 20, nil, func(text string) { request.currency = text }).
+		AddFormItem(NewTagsField(app, incomeDto.Tags, func(text string) { request.tags = text })).
+		AddButton("Update", f.update(&request, incomeId)).
 		AddButton("Cancel", f.BackFunc())
 
 	form.SetBorder(true).SetTitle("Update a House").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
@@ -72,18 +86,21 @@ func (u *UpdateIncome) bindKeys() {
 	}
 }
 
-func (u *UpdateIncome) update(update updateIncomeReq, id uuid.UUID) func() {
+func (u *UpdateIncome) update(update *updateIncomeReq, id uuid.UUID) func() {
 	return func() {
 		request := model.UpdateIncomeRequest{
 			Name:        update.name,
 			Description: update.description,
+			Currency:    update.currency,
+			CategoryId:  update.categoryId,
+			GroupIds:    update.groupIds,
 		}
 
-		if newSum, err := strconv.ParseFloat(update.sum, 32); err != nil {
+		if newSum, err := money.Parse(update.sum); err != nil {
 			u.ShowErrorTo(errors.New("sum is not valid"))
 			return
 		} else {
-			request.Sum = float32(newSum)
+			request.Sum = newSum
 		}
 
 		if newDate, err := time.Parse("2006-01-02", update.date); err != nil {
@@ -93,7 +110,14 @@ func (u *UpdateIncome) update(update updateIncomeReq, id uuid.UUID) func() {
 			request.Date = newDate
 		}
 
-		if err := u.app.GetIncomeService().Update(id, request); err != nil {
+		if tagIds, err := TagIdsOf(u.app, update.tags); err != nil {
+			u.ShowErrorTo(err)
+			return
+		} else {
+			request.TagIds = tagIds
+		}
+
+		if err := u.app.GetIncomeService().Update(id, u.app.AuthorizedUser.Id, request); err != nil {
 			u.ShowErrorTo(err)
 		} else {
 			u.ShowInfoReturnBack("Income %s successfully updated.", request.Name)
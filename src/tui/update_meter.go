@@ -1,12 +1,10 @@
 package tui
 
 import (
-	"fmt"
 	"github.com/VlasovArtem/hob/src/meter/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
-	"strings"
 )
 
 const UpdateMeterPageName = "meter-update-page"
@@ -38,24 +36,16 @@ func NewUpdateMeter(app *TerminalApp, meterId uuid.UUID) *UpdateMeter {
 	f.InitFlexApp(app)
 	f.bindKeys()
 
-	meterDto, err := app.GetMeterService().FindById(meterId)
+	meterDto, err := app.GetMeterService().FindById(meterId, app.AuthorizedUser.Id)
 	if err != nil {
 		f.ShowInfoReturnBack(err.Error())
 	}
-	var detailsArray []string
-
-	if meterDto.Details != nil {
-		for key, value := range meterDto.Details {
-			detailsArray = append(detailsArray, fmt.Sprintf("%s : %.2f", key, value))
-		}
-	}
-
 	var request updateMeterReq
 
 	form := tview.NewForm().
 		AddInputField("Name", meterDto.Name, 20, nil, func(text string) { request.name = text }).
 		AddInputField("Description", meterDto.Description, 20, nil, func(text string) { request.description = text }).
-		AddInputField("Details", strings.Join(detailsArray, "; "), 20, nil, func(text string) { request.details = text }).
+		AddInputField("Details", formatDetails(meterDto.Details), 20, nil, func(text string) { request.details = text }).
 		AddButton("Update", f.update(request, meterId)).
 		AddButton("Cancel", f.BackFunc())
 
@@ -88,7 +78,7 @@ func (u *UpdateMeter) update(update updateMeterReq, id uuid.UUID) func() {
 		}
 		request.Details = details
 
-		if err := u.app.GetMeterService().Update(id, request); err != nil {
+		if err := u.app.GetMeterService().Update(id, u.app.AuthorizedUser.Id, request); err != nil {
 			u.ShowErrorTo(err)
 		} else {
 			u.ShowInfoReturnBack("Payment %s successfully updated.", request.Name)
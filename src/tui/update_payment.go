@@ -2,20 +2,19 @@ package tui
 
 import (
 	"errors"
-	"fmt"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/payment/model"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
-	"strconv"
 	"time"
 )
 
 const UpdatePaymentPageName = "payment-update-page"
 
 type updatePaymentReq struct {
-	name, description, date, sum string
-	providerId                   uuid.UUID
+	name, description, date, sum, currency, tags string
+	providerId, categoryId                       *uuid.UUID
 }
 
 type UpdatePayment struct {
@@ -41,27 +40,42 @@ func NewUpdatePayment(app *TerminalApp, paymentId uuid.UUID) *UpdatePayment {
 	f.InitFlexApp(app)
 	f.bindKeys()
 
-	paymentDto, err := app.GetPaymentService().FindById(paymentId)
+	paymentDto, err := app.GetPaymentService().FindById(paymentId, app.AuthorizedUser.Id)
 	if err != nil {
 		f.ShowInfoReturnBack(err.Error())
 	}
 
 	providers, providerOptions := GetProviders(app)
-
-	var request updatePaymentReq
+	categories, categoryOptions := GetCategories(app)
+
+	request := updatePaymentReq{
+		name:        paymentDto.Name,
+		description: paymentDto.Description,
+		date:        paymentDto.Date.Format("2006-01-02"),
+		sum:         paymentDto.Sum.String(),
+		currency:    paymentDto.Currency,
+		providerId:  paymentDto.ProviderId,
+		categoryId:  paymentDto.CategoryId,
+		tags:        TagNames(paymentDto.Tags),
+	}
 
 	form := tview.NewForm().
 		AddInputField("Name", paymentDto.Name, 20, nil, func(text string) { request.name = text }).
 		AddInputField("Description", paymentDto.Description, 20, nil, func(text string) { request.description = text }).
 		AddInputField("Date (ex. 2006-01-02)", paymentDto.Date.Format("2006-01-02"), 20, nil, func(text string) { request.date = text }).
-		AddInputField("Sum", fmt.Sprintf("%.2f", paymentDto.Sum), 20, nil, func(text string) { request.sum = text }).
+		AddInputField("Sum", paymentDto.Sum.String(), 20, nil, func(text string) { request.sum = text }).
+		AddInputField("Currency", paymentDto.Currency, 20, nil, func(text string) { request.currency = text }).
 		AddDropDown("Provider", providerOptions, -1, func(option string, optionIndex int) {
-			request.providerId = providers[optionIndex].Id
+			request.providerId = &providers[optionIndex].Id
+		}).
+		AddDropDown("Category", categoryOptions, CategoryOptionIndex(categories, paymentDto.CategoryId), func(option string, optionIndex int) {
+			request.categoryId = CategoryIdOf(categories, optionIndex)
 		}).
-		AddButton("Update", f.update(request, paymentId)).
+		AddFormItem(NewTagsField(app, paymentDto.Tags, func(text string) { request.tags = text })).
+		AddButton("Update", f.update(&request, paymentId)).
 		AddButton("Cancel", f.BackFunc())
 
-	form.SetBorder(true).SetTitle("Update Payment").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+	form.SetBorder(true).SetTitle("Update Payment").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 17)
 
 	f.AddItem(form, 0, 8, true)
 
@@ -76,18 +90,21 @@ func (u *UpdatePayment) bindKeys() {
 	}
 }
 
-func (u *UpdatePayment) update(update updatePaymentReq, id uuid.UUID) func() {
+func (u *UpdatePayment) update(update *updatePaymentReq, id uuid.UUID) func() {
 	return func() {
 		request := model.UpdatePaymentRequest{
 			Name:        update.name,
 			Description: update.description,
+			Currency:    update.currency,
+			ProviderId:  update.providerId,
+			CategoryId:  update.categoryId,
 		}
 
-		if newSum, err := strconv.ParseFloat(update.sum, 32); err != nil {
+		if newSum, err := money.Parse(update.sum); err != nil {
 			u.ShowErrorTo(errors.New("sum is not valid"))
 			return
 		} else {
-			request.Sum = float32(newSum)
+			request.Sum = newSum
 		}
 
 		if newDate, err := time.Parse("2006-01-02", update.date); err != nil {
@@ -97,14 +114,14 @@ func (u *UpdatePayment) update(update updatePaymentReq, id uuid.UUID) func() {
 			request.Date = newDate
 		}
 
-		if request.ProviderId == nil {
-			u.ShowErrorTo(errors.New("provider id is not valid"))
-
+		if tagIds, err := TagIdsOf(u.app, update.tags); err != nil {
+			u.ShowErrorTo(err)
 			return
+		} else {
+			request.TagIds = tagIds
 		}
-		request.ProviderId = &update.providerId
 
-		if err := u.app.GetPaymentService().Update(id, request); err != nil {
+		if err := u.app.GetPaymentService().Update(id, u.app.AuthorizedUser.Id, request); err != nil {
 			u.ShowErrorTo(err)
 		} else {
 			u.ShowInfoReturnBack("Payment %s successfully updated.", request.Name)
@@ -10,7 +10,7 @@ import (
 const UpdateProviderPageName = "provider-update-page"
 
 type updateProviderReq struct {
-	name, details string
+	name, details, tariffs string
 }
 
 type UpdateProvider struct {
@@ -36,17 +36,22 @@ func NewUpdateProvider(app *TerminalApp, providerId uuid.UUID) *UpdateProvider {
 	f.InitFlexApp(app)
 	f.bindKeys()
 
-	providerDto, err := app.GetProviderService().FindById(providerId)
+	providerDto, err := app.GetProviderService().FindById(providerId, app.AuthorizedUser.Id)
 	if err != nil {
 		f.ShowInfoReturnBack(err.Error())
 	}
 
-	var request updateProviderReq
+	request := updateProviderReq{
+		name:    providerDto.Name,
+		details: providerDto.Details,
+		tariffs: formatDetails(providerDto.Tariffs),
+	}
 
 	form := tview.NewForm().
-		AddInputField("Name", providerDto.Name, 20, nil, func(text string) { request.name = text }).
-		AddInputField("Details", providerDto.Details, 20, nil, func(text string) { request.details = text }).
-		AddButton("Update", f.update(providerId, request)).
+		AddInputField("Name", request.name, 20, nil, func(text string) { request.name = text }).
+		AddInputField("Details", request.details, 20, nil, func(text string) { request.details = text }).
+		AddInputField("Tariffs (ex. day:1.44; night:0.72)", request.tariffs, 20, nil, func(text string) { request.tariffs = text }).
+		AddButton("Update", f.update(providerId, &request)).
 		AddButton("Cancel", f.BackFunc())
 
 	form.SetBorder(true).SetTitle("Update Provider").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
@@ -64,14 +69,21 @@ func (u *UpdateProvider) bindKeys() {
 	}
 }
 
-func (u *UpdateProvider) update(id uuid.UUID, update updateProviderReq) func() {
+func (u *UpdateProvider) update(id uuid.UUID, update *updateProviderReq) func() {
 	return func() {
+		tariffs, err := parseDetails(update.tariffs)
+		if err != nil {
+			u.ShowErrorTo(err)
+			return
+		}
+
 		request := model.UpdateProviderRequest{
 			Name:    update.name,
 			Details: update.details,
+			Tariffs: tariffs,
 		}
 
-		if err := u.app.GetProviderService().Update(id, request); err != nil {
+		if err := u.app.GetProviderService().Update(id, u.app.AuthorizedUser.Id, request); err != nil {
 			u.ShowErrorTo(err)
 		} else {
 			u.ShowInfoReturnBack("Provider %s (%s) successfully updated.", request.Name, id)
@@ -2,19 +2,18 @@ package tui
 
 import (
 	"errors"
-	"fmt"
+	"github.com/VlasovArtem/hob/src/common/money"
 	"github.com/VlasovArtem/hob/src/income/scheduler/model"
 	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
-	"strconv"
 )
 
 const UpdateScheduledIncomePageName = "scheduled-income-update-page"
 
 type updateScheduledIncomeReq struct {
-	name, description, sum, spec string
+	name, description, sum, spec, currency string
 }
 
 type UpdateScheduledIncome struct {
@@ -40,7 +39,7 @@ func NewUpdateScheduledIncome(app *TerminalApp, scheduledPaymentId uuid.UUID) *U
 	f.InitFlexApp(app)
 	f.bindKeys()
 
-	paymentDto, err := app.GetPaymentSchedulerService().FindById(scheduledPaymentId)
+	paymentDto, err := app.GetPaymentSchedulerService().FindById(scheduledPaymentId, app.AuthorizedUser.Id)
 	if err != nil {
 		f.ShowInfoReturnBack(err.Error())
 	}
@@ -50,10 +49,10 @@ func NewUpdateScheduledIncome(app *TerminalApp, scheduledPaymentId uuid.UUID) *U
 	form := tview.NewForm().
 		AddInputField("Name", paymentDto.Name, 20, nil, func(text string) { request.name = text }).
 		AddInputField("Description", paymentDto.Description, 20, nil, func(text string) { request.description = text }).
-		AddInputField("Sum", fmt.Sprintf("%.2f", paymentDto.Sum), 20, nil, func(text string) { request.sum = text }).
-		AddDropDown("Spec", specs, 1, func(option string, optionIndex int) {
-			request.spec = option
-		}).
+		AddInputField("Sum", paymentDto.Sum.String(), 20, nil, func(text string) { request.sum = text }).
+		AddInputField("Currency", paymentDto.Currency, 20, nil, func(text string) { request.currency = text })
+
+	addSpecField(form, string(paymentDto.Spec), func(text string) { request.spec = text }).
 		AddButton("Update", f.update(request, scheduledPaymentId)).
 		AddButton("Cancel", f.BackFunc())
 
@@ -77,17 +76,18 @@ func (u *UpdateScheduledIncome) update(update updateScheduledIncomeReq, id uuid.
 		request := model.UpdateIncomeSchedulerRequest{
 			Name:        update.name,
 			Description: update.description,
+			Currency:    update.currency,
 			Spec:        scheduler.SchedulingSpecification(update.spec),
 		}
 
-		if newSum, err := strconv.ParseFloat(update.sum, 32); err != nil {
+		if newSum, err := money.Parse(update.sum); err != nil {
 			u.ShowErrorTo(errors.New("sum is not valid"))
 			return
 		} else {
-			request.Sum = float32(newSum)
+			request.Sum = newSum
 		}
 
-		if err := u.app.GetIncomeSchedulerService().Update(id, request); err != nil {
+		if err := u.app.GetIncomeSchedulerService().Update(id, u.app.AuthorizedUser.Id, request); err != nil {
 			u.ShowErrorTo(err)
 		} else {
 			u.ShowInfoReturnBack("Scheduled Income %s successfully updated.", request.Name)
@@ -2,20 +2,18 @@ package tui
 
 import (
 	"errors"
-	"fmt"
 	"github.com/VlasovArtem/hob/src/payment/scheduler/model"
 	"github.com/VlasovArtem/hob/src/scheduler"
 	"github.com/gdamore/tcell/v2"
 	"github.com/google/uuid"
 	"github.com/rivo/tview"
-	"strconv"
 )
 
 const UpdateScheduledPaymentPageName = "scheduled-payment-update-page"
 
 type updateScheduledPaymentReq struct {
-	name, description, sum, spec string
-	providerId                   uuid.UUID
+	name, description, sum, spec, meterName, currency string
+	providerId                                        uuid.UUID
 }
 
 type UpdateScheduledPayment struct {
@@ -41,26 +39,35 @@ func NewUpdateScheduledPayment(app *TerminalApp, scheduledPaymentId uuid.UUID) *
 	f.InitFlexApp(app)
 	f.bindKeys()
 
-	paymentDto, err := app.GetPaymentSchedulerService().FindById(scheduledPaymentId)
+	paymentDto, err := app.GetPaymentSchedulerService().FindById(scheduledPaymentId, app.AuthorizedUser.Id)
 	if err != nil {
 		f.ShowInfoReturnBack(err.Error())
 	}
 
 	providers, providerOptions := GetProviders(app)
 
-	var request updateScheduledPaymentReq
+	request := updateScheduledPaymentReq{
+		name:        paymentDto.Name,
+		description: paymentDto.Description,
+		sum:         paymentDto.Sum.String(),
+		currency:    paymentDto.Currency,
+		spec:        string(paymentDto.Spec),
+		meterName:   paymentDto.MeterName,
+		providerId:  paymentDto.ProviderId,
+	}
 
 	form := tview.NewForm().
 		AddInputField("Name", paymentDto.Name, 20, nil, func(text string) { request.name = text }).
 		AddInputField("Description", paymentDto.Description, 20, nil, func(text string) { request.description = text }).
-		AddInputField("Sum", fmt.Sprintf("%.2f", paymentDto.Sum), 20, nil, func(text string) { request.sum = text }).
-		AddDropDown("Spec", specs, 1, func(option string, optionIndex int) {
-			request.spec = option
-		}).
+		AddInputField("Sum", paymentDto.Sum.String(), 20, nil, func(text string) { request.sum = text }).
+		AddInputField("Currency", paymentDto.Currency, 20, nil, func(text string) { request.currency = text })
+
+	addSpecField(form, string(paymentDto.Spec), func(text string) { request.spec = text }).
 		AddDropDown("Provider", providerOptions, -1, func(option string, optionIndex int) {
 			request.providerId = providers[optionIndex].Id
 		}).
-		AddButton("Update", f.update(request, scheduledPaymentId)).
+		AddInputField("Meter (sum by provider tariffs)", paymentDto.MeterName, 20, nil, func(text string) { request.meterName = text }).
+		AddButton("Update", f.update(&request, scheduledPaymentId)).
 		AddButton("Cancel", f.BackFunc())
 
 	form.SetBorder(true).SetTitle("Update Scheduled Payment").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
@@ -78,29 +85,31 @@ func (u *UpdateScheduledPayment) bindKeys() {
 	}
 }
 
-func (u *UpdateScheduledPayment) update(update updateScheduledPaymentReq, id uuid.UUID) func() {
+func (u *UpdateScheduledPayment) update(update *updateScheduledPaymentReq, id uuid.UUID) func() {
 	return func() {
 		request := model.UpdatePaymentSchedulerRequest{
 			Name:        update.name,
 			Description: update.description,
+			Currency:    update.currency,
+			MeterName:   update.meterName,
 			Spec:        scheduler.SchedulingSpecification(update.spec),
 		}
 
-		if newSum, err := strconv.ParseFloat(update.sum, 32); err != nil {
-			u.ShowErrorTo(errors.New("sum is not valid"))
+		if newSum, err := parseSchedulerSum(update.sum, update.meterName); err != nil {
+			u.ShowErrorTo(err)
 			return
 		} else {
-			request.Sum = float32(newSum)
+			request.Sum = newSum
 		}
 
-		if request.ProviderId == DefaultUUID {
+		if update.providerId == DefaultUUID {
 			u.ShowErrorTo(errors.New("provider id is not valid"))
 
 			return
 		}
 		request.ProviderId = update.providerId
 
-		if err := u.app.GetPaymentSchedulerService().Update(id, request); err != nil {
+		if err := u.app.GetPaymentSchedulerService().Update(id, u.app.AuthorizedUser.Id, request); err != nil {
 			u.ShowErrorTo(err)
 		} else {
 			u.ShowInfoReturnBack("Scheduled Payment %s successfully updated.", request.Name)
@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"github.com/VlasovArtem/hob/src/user/model"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const UpdateUserPageName = "update-user"
+
+type UpdateUser struct {
+	*FlexApp
+	*Navigation
+	app *TerminalApp
+}
+
+func (u *UpdateUser) NavigationInfo(app *TerminalApp, variables map[string]any) *NavigationInfo {
+	return NewNavigationInfo(UpdateUserPageName, func() tview.Primitive { return NewUpdateUser(app) })
+}
+
+func (u *UpdateUser) enrichNavigation(app *TerminalApp) {
+	u.Navigation = NewNavigation(app, u.NavigationInfo(app, nil))
+}
+
+func NewUpdateUser(app *TerminalApp) *UpdateUser {
+	f := &UpdateUser{
+		app:     app,
+		FlexApp: NewFlexApp(),
+	}
+	f.bindKeys()
+	f.InitFlexApp(app)
+	f.enrichNavigation(app)
+
+	user := app.AuthorizedUser
+
+	request := model.UpdateUserRequest{
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		BaseCurrency: user.BaseCurrency,
+	}
+
+	form := tview.NewForm().
+		AddInputField("First Name", user.FirstName, 20, nil, func(text string) { request.FirstName = text }).
+		AddInputField("Last Name", user.LastName, 20, nil, func(text string) { request.LastName = text }).
+		AddInputField("Base Currency", user.BaseCurrency, 20, nil, func(text string) { request.BaseCurrency = text }).
+		AddButton("Update", f.update(&request)).
+		AddButton("Cancel", f.BackFunc())
+
+	form.SetBorder(true).SetTitle("Update Profile").SetTitleAlign(tview.AlignCenter).SetRect(150, 30, 60, 15)
+
+	f.AddItem(form, 0, 8, true)
+
+	f.SetInputCapture(f.KeyboardFunc)
+
+	return f
+}
+
+func (u *UpdateUser) bindKeys() {
+	u.Actions = KeyActions{
+		tcell.KeyEscape: NewKeyAction("Back", u.KeyBack),
+	}
+}
+
+func (u *UpdateUser) update(request *model.UpdateUserRequest) func() {
+	return func() {
+		if err := u.app.GetUserService().Update(u.app.AuthorizedUser.Id, *request); err != nil {
+			u.ShowErrorTo(err)
+		} else if user, err := u.app.GetUserService().FindById(u.app.AuthorizedUser.Id); err != nil {
+			u.ShowErrorTo(err)
+		} else {
+			u.app.AuthorizedUser = &user
+			u.ShowInfoReturnBack("Profile successfully updated.")
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	budgetModel "github.com/VlasovArtem/hob/src/budget/model"
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
+	groupModel "github.com/VlasovArtem/hob/src/group/model"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	incomeSchedulerModel "github.com/VlasovArtem/hob/src/income/scheduler/model"
+	meterModel "github.com/VlasovArtem/hob/src/meter/model"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	paymentSchedulerModel "github.com/VlasovArtem/hob/src/payment/scheduler/model"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	schedulerLockModel "github.com/VlasovArtem/hob/src/scheduler/lock/model"
+	schedulerRunModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AccountRepositoryObject struct {
+	database db.DatabaseService
+}
+
+func NewAccountRepository(database db.DatabaseService) AccountRepository {
+	return &AccountRepositoryObject{database}
+}
+
+func (a *AccountRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewAccountRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+type AccountRepository interface {
+	FindPaymentSchedulerIds(userId uuid.UUID) []uuid.UUID
+	FindIncomeSchedulerIds(userId uuid.UUID) []uuid.UUID
+	Delete(userId uuid.UUID) error
+}
+
+// FindPaymentSchedulerIds returns the payment schedulers that are removed with the user data
+func (a *AccountRepositoryObject) FindPaymentSchedulerIds(userId uuid.UUID) (response []uuid.UUID) {
+	a.paymentSchedulers(a.database.D(), userId).Pluck("id", &response)
+	return response
+}
+
+// FindIncomeSchedulerIds returns the income schedulers that are removed with the user data
+func (a *AccountRepositoryObject) FindIncomeSchedulerIds(userId uuid.UUID) (response []uuid.UUID) {
+	a.incomeSchedulers(a.database.D(), userId).Pluck("id", &response)
+	return response
+}
+
+// Delete removes the user with the owned houses, providers, groups, categories, tags and all the data attached to them. The
+// payments of the other users keep their data, but lose the reference to the removed providers and categories. The payments
+// the user added to the houses of the other users are kept and reassigned to the owners of the houses
+func (a *AccountRepositoryObject) Delete(userId uuid.UUID) error {
+	return a.database.D().Transaction(func(tx *gorm.DB) error {
+		var houseIds, providerIds, groupIds, categoryIds, tagIds, paymentIds, incomeIds, paymentSchedulerIds, incomeSchedulerIds []uuid.UUID
+
+		if err := tx.Model(&houseModel.House{}).Where("user_id = ?", userId).Pluck("id", &houseIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&providerModel.Provider{}).Where("user_id = ?", userId).Pluck("id", &providerIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&groupModel.Group{}).Where("owner_id = ?", userId).Pluck("id", &groupIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&categoryModel.Category{}).Where("user_id = ?", userId).Pluck("id", &categoryIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&tagModel.Tag{}).Where("user_id = ?", userId).Pluck("id", &tagIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&paymentModel.Payment{}).Where("house_id IN (?)", ownedHouses(tx, userId)).Pluck("id", &paymentIds).Error; err != nil {
+			return err
+		}
+		if err := a.incomes(tx, houseIds, groupIds).Pluck("id", &incomeIds).Error; err != nil {
+			return err
+		}
+		if err := a.paymentSchedulers(tx, userId).Pluck("id", &paymentSchedulerIds).Error; err != nil {
+			return err
+		}
+		if err := a.incomeSchedulers(tx, userId).Pluck("id", &incomeSchedulerIds).Error; err != nil {
+			return err
+		}
+
+		schedulerIds := append(paymentSchedulerIds, incomeSchedulerIds...)
+
+		if err := tx.Model(&paymentModel.Payment{}).Where("provider_id IN ?", providerIds).Update("provider_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("UPDATE payments SET user_id = houses.user_id FROM houses WHERE houses.id = payments.house_id AND payments.user_id = ? "+
+			"AND houses.user_id <> ?", userId, userId).Error; err != nil {
+			return err
+		}
+		for _, categorized := range []any{&paymentModel.Payment{}, &paymentSchedulerModel.PaymentScheduler{}, &incomeModel.Income{}} {
+			if err := tx.Model(categorized).Where("category_id IN ?", categoryIds).Update("category_id", nil).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Exec("DELETE FROM income_groups WHERE income_id IN ? OR group_id IN ?", incomeIds, groupIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM payment_tags WHERE payment_id IN ? OR tag_id IN ?", paymentIds, tagIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM income_tags WHERE income_id IN ? OR tag_id IN ?", incomeIds, tagIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM house_groups WHERE house_id IN ? OR group_id IN ?", houseIds, groupIds).Error; err != nil {
+			return err
+		}
+
+		for _, deletion := range []deletion{
+			{&meterModel.Meter{}, "payment_id IN ?", []any{paymentIds}},
+			{&paymentModel.Payment{}, "id IN ?", []any{paymentIds}},
+			{&schedulerRunModel.SchedulerRun{}, "scheduler_id IN ?", []any{schedulerIds}},
+			{&schedulerLockModel.SchedulerLock{}, "scheduler_id IN ?", []any{schedulerIds}},
+			{&paymentSchedulerModel.PaymentScheduler{}, "id IN ?", []any{paymentSchedulerIds}},
+			{&incomeSchedulerModel.IncomeScheduler{}, "id IN ?", []any{incomeSchedulerIds}},
+			{&incomeModel.Income{}, "id IN ?", []any{incomeIds}},
+			{&budgetModel.Budget{}, "house_id IN ? OR user_id = ?", []any{houseIds, userId}},
+			{&houseModel.House{}, "id IN ?", []any{houseIds}},
+			{&memberModel.Member{}, "group_id IN ? OR user_id = ?", []any{groupIds, userId}},
+			{&groupModel.Group{}, "id IN ?", []any{groupIds}},
+			{&providerModel.Provider{}, "id IN ?", []any{providerIds}},
+			{&categoryModel.Category{}, "id IN ?", []any{categoryIds}},
+			{&tagModel.Tag{}, "id IN ?", []any{tagIds}},
+			{&userModel.User{}, "id = ?", []any{userId}},
+		} {
+			if err := tx.Where(deletion.query, deletion.args...).Delete(deletion.model).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// paymentSchedulers are created by the user, belong to the user houses or use the user providers
+func (a *AccountRepositoryObject) paymentSchedulers(tx *gorm.DB, userId uuid.UUID) *gorm.DB {
+	return tx.Model(&paymentSchedulerModel.PaymentScheduler{}).
+		Where("user_id = ? OR house_id IN (?) OR provider_id IN (?)", userId, ownedHouses(tx, userId), ownedProviders(tx, userId))
+}
+
+// incomeSchedulers are created by the user or belong to the user houses
+func (a *AccountRepositoryObject) incomeSchedulers(tx *gorm.DB, userId uuid.UUID) *gorm.DB {
+	return tx.Model(&incomeSchedulerModel.IncomeScheduler{}).
+		Where("user_id = ? OR house_id IN (?)", userId, ownedHouses(tx, userId))
+}
+
+// incomes belong to the houses or only to the groups
+func (a *AccountRepositoryObject) incomes(tx *gorm.DB, houseIds []uuid.UUID, groupIds []uuid.UUID) *gorm.DB {
+	return tx.Model(&incomeModel.Income{}).
+		Where("house_id IN ?", houseIds).
+		Or("house_id IS NULL AND id IN (SELECT income_id FROM income_groups WHERE group_id IN ?) "+
+			"AND id NOT IN (SELECT income_id FROM income_groups WHERE group_id NOT IN ?)", groupIds, groupIds)
+}
+
+func ownedHouses(tx *gorm.DB, userId uuid.UUID) *gorm.DB {
+	return tx.Session(&gorm.Session{NewDB: true}).Model(&houseModel.House{}).Select("id").Where("user_id = ?", userId)
+}
+
+func ownedProviders(tx *gorm.DB, userId uuid.UUID) *gorm.DB {
+	return tx.Session(&gorm.Session{NewDB: true}).Model(&providerModel.Provider{}).Select("id").Where("user_id = ?", userId)
+}
+
+// deletion removes the rows of the model matched by the query
+type deletion struct {
+	model any
+	query string
+	args  []any
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	budgetMocks "github.com/VlasovArtem/hob/src/budget/mocks"
+	budgetModel "github.com/VlasovArtem/hob/src/budget/model"
+	categoryMocks "github.com/VlasovArtem/hob/src/category/mocks"
+	categoryModel "github.com/VlasovArtem/hob/src/category/model"
+	"github.com/VlasovArtem/hob/src/db"
+	memberModel "github.com/VlasovArtem/hob/src/group/member/model"
+	groupMocks "github.com/VlasovArtem/hob/src/group/mocks"
+	groupModel "github.com/VlasovArtem/hob/src/group/model"
+	houseMocks "github.com/VlasovArtem/hob/src/house/mocks"
+	houseModel "github.com/VlasovArtem/hob/src/house/model"
+	incomeMocks "github.com/VlasovArtem/hob/src/income/mocks"
+	incomeModel "github.com/VlasovArtem/hob/src/income/model"
+	incomeSchedulerModel "github.com/VlasovArtem/hob/src/income/scheduler/model"
+	meterMocks "github.com/VlasovArtem/hob/src/meter/mocks"
+	meterModel "github.com/VlasovArtem/hob/src/meter/model"
+	paymentMocks "github.com/VlasovArtem/hob/src/payment/mocks"
+	paymentModel "github.com/VlasovArtem/hob/src/payment/model"
+	paymentSchedulerMocks "github.com/VlasovArtem/hob/src/payment/scheduler/mocks"
+	paymentSchedulerModel "github.com/VlasovArtem/hob/src/payment/scheduler/model"
+	providerMocks "github.com/VlasovArtem/hob/src/provider/mocks"
+	providerModel "github.com/VlasovArtem/hob/src/provider/model"
+	schedulerLockModel "github.com/VlasovArtem/hob/src/scheduler/lock/model"
+	schedulerRunModel "github.com/VlasovArtem/hob/src/scheduler/run/model"
+	tagMocks "github.com/VlasovArtem/hob/src/tag/mocks"
+	tagModel "github.com/VlasovArtem/hob/src/tag/model"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type AccountRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository AccountRepository
+}
+
+func (a *AccountRepositoryTestSuite) SetupSuite() {
+	a.InitDBTestSuite()
+
+	a.CreateRepository(
+		func(service db.DatabaseService) {
+			a.repository = NewAccountRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, meterModel.Meter{})
+			database.TruncateTableCascade(service, "payment_tags")
+			database.TruncateTable(service, paymentModel.Payment{})
+			database.TruncateTable(service, paymentSchedulerModel.PaymentScheduler{})
+			database.TruncateTableCascade(service, "income_groups")
+			database.TruncateTableCascade(service, "income_tags")
+			database.TruncateTable(service, incomeModel.Income{})
+			database.TruncateTable(service, budgetModel.Budget{})
+			database.TruncateTableCascade(service, "house_groups")
+			database.TruncateTable(service, houseModel.House{})
+			database.TruncateTable(service, groupModel.Group{})
+			database.TruncateTable(service, providerModel.Provider{})
+			database.TruncateTable(service, categoryModel.Category{})
+			database.TruncateTable(service, tagModel.Tag{})
+			database.TruncateTable(service, userModel.User{})
+		}).
+		ExecuteMigration(
+			userModel.User{},
+			groupModel.Group{},
+			houseModel.House{},
+			providerModel.Provider{},
+			categoryModel.Category{},
+			tagModel.Tag{},
+			paymentModel.Payment{},
+			meterModel.Meter{},
+			incomeModel.Income{},
+			paymentSchedulerModel.PaymentScheduler{},
+			incomeSchedulerModel.IncomeScheduler{},
+			memberModel.Member{},
+			schedulerRunModel.SchedulerRun{},
+			schedulerLockModel.SchedulerLock{},
+			budgetModel.Budget{},
+		)
+}
+
+func TestAccountRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountRepositoryTestSuite))
+}
+
+func (a *AccountRepositoryTestSuite) Test_Delete() {
+	user, other := a.createUsers()
+
+	group := groupMocks.GenerateGroup(user.Id)
+	a.CreateEntity(&group)
+
+	house := houseMocks.GenerateHouse(user.Id)
+	house.Groups = []groupModel.Group{group}
+	a.CreateEntity(&house)
+
+	otherHouse := houseMocks.GenerateHouse(other.Id)
+	a.CreateEntity(&otherHouse)
+
+	provider := providerMocks.GenerateProvider(user.Id)
+	a.CreateEntity(&provider)
+
+	category := categoryMocks.GenerateCategory(user.Id, nil, "Utilities")
+	a.CreateEntity(&category)
+
+	tag := tagMocks.GenerateTag(user.Id, "landlord")
+	a.CreateEntity(&tag)
+
+	payment := paymentMocks.GeneratePayment(house.Id, user.Id, provider.Id)
+	payment.CategoryId = &category.Id
+	payment.Tags = []tagModel.Tag{tag}
+	a.CreateEntity(&payment)
+
+	meter := meterMocks.GenerateMeter(payment.Id)
+	a.CreateEntity(&meter)
+
+	otherPayment := paymentMocks.GeneratePayment(otherHouse.Id, other.Id, provider.Id)
+	a.CreateEntity(&otherPayment)
+
+	paymentInOtherHouse := paymentMocks.GeneratePayment(otherHouse.Id, user.Id, provider.Id)
+	a.CreateEntity(&paymentInOtherHouse)
+
+	meterInOtherHouse := meterMocks.GenerateMeter(paymentInOtherHouse.Id)
+	a.CreateEntity(&meterInOtherHouse)
+
+	income := incomeMocks.GenerateIncome(&house.Id)
+	income.Tags = []tagModel.Tag{tag}
+	a.CreateEntity(&income)
+
+	paymentScheduler := paymentSchedulerMocks.GeneratePaymentScheduler(house.Id, user.Id, provider.Id)
+	a.CreateEntity(&paymentScheduler)
+
+	budget := budgetMocks.GenerateBudget(house.Id, user.Id)
+	budget.CategoryId = &category.Id
+	a.CreateEntity(&budget)
+
+	err := a.repository.Delete(user.Id)
+
+	assert.Nil(a.T(), err)
+
+	assert.False(a.T(), a.exists(&userModel.User{}, "id = ?", user.Id))
+	assert.False(a.T(), a.exists(&houseModel.House{}, "id = ?", house.Id))
+	assert.False(a.T(), a.exists(&groupModel.Group{}, "id = ?", group.Id))
+	assert.False(a.T(), a.exists(&providerModel.Provider{}, "id = ?", provider.Id))
+	assert.False(a.T(), a.exists(&categoryModel.Category{}, "id = ?", category.Id))
+	assert.False(a.T(), a.exists(&tagModel.Tag{}, "id = ?", tag.Id))
+	assert.False(a.T(), a.exists(&paymentModel.Payment{}, "id = ?", payment.Id))
+	assert.False(a.T(), a.exists(&meterModel.Meter{}, "id = ?", meter.Id))
+	assert.False(a.T(), a.exists(&incomeModel.Income{}, "id = ?", income.Id))
+	assert.False(a.T(), a.exists(&paymentSchedulerModel.PaymentScheduler{}, "id = ?", paymentScheduler.Id))
+	assert.False(a.T(), a.exists(&budgetModel.Budget{}, "id = ?", budget.Id))
+
+	var actual paymentModel.Payment
+	a.Database.D().First(&actual, otherPayment.Id)
+
+	assert.Equal(a.T(), otherPayment.Id, actual.Id)
+	assert.Nil(a.T(), actual.ProviderId)
+	assert.True(a.T(), a.exists(&userModel.User{}, "id = ?", other.Id))
+	assert.True(a.T(), a.exists(&houseModel.House{}, "id = ?", otherHouse.Id))
+	assert.True(a.T(), a.exists(&paymentModel.Payment{}, "id = ? AND user_id = ?", paymentInOtherHouse.Id, other.Id))
+	assert.True(a.T(), a.exists(&meterModel.Meter{}, "id = ?", meterInOtherHouse.Id))
+}
+
+func (a *AccountRepositoryTestSuite) Test_FindPaymentSchedulerIds() {
+	user, other := a.createUsers()
+
+	house := houseMocks.GenerateHouse(user.Id)
+	a.CreateEntity(&house)
+
+	otherHouse := houseMocks.GenerateHouse(other.Id)
+	a.CreateEntity(&otherHouse)
+
+	provider := providerMocks.GenerateProvider(user.Id)
+	a.CreateEntity(&provider)
+
+	otherProvider := providerMocks.GenerateProvider(other.Id)
+	a.CreateEntity(&otherProvider)
+
+	inHouse := paymentSchedulerMocks.GeneratePaymentScheduler(house.Id, other.Id, otherProvider.Id)
+	a.CreateEntity(&inHouse)
+
+	withProvider := paymentSchedulerMocks.GeneratePaymentScheduler(otherHouse.Id, other.Id, provider.Id)
+	a.CreateEntity(&withProvider)
+
+	notOwned := paymentSchedulerMocks.GeneratePaymentScheduler(otherHouse.Id, other.Id, otherProvider.Id)
+	a.CreateEntity(&notOwned)
+
+	actual := a.repository.FindPaymentSchedulerIds(user.Id)
+
+	assert.ElementsMatch(a.T(), []uuid.UUID{inHouse.Id, withProvider.Id}, actual)
+}
+
+func (a *AccountRepositoryTestSuite) createUsers() (userModel.User, userModel.User) {
+	user := userMocks.GenerateUser()
+	a.CreateEntity(&user)
+
+	other := userMocks.GenerateUser()
+	other.Email = "other@mail.com"
+	a.CreateEntity(&other)
+
+	return user, other
+}
+
+func (a *AccountRepositoryTestSuite) exists(model any, query string, args ...any) bool {
+	var count int64
+	a.Database.D().Model(model).Where(query, args...).Count(&count)
+	return count > 0
+}
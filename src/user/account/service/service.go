@@ -0,0 +1,63 @@
+package service
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/scheduler"
+	"github.com/VlasovArtem/hob/src/user/account/repository"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type AccountServiceObject struct {
+	userService      userService.UserService
+	serviceScheduler scheduler.ServiceScheduler
+	repository       repository.AccountRepository
+}
+
+func NewAccountService(
+	userService userService.UserService,
+	serviceScheduler scheduler.ServiceScheduler,
+	repository repository.AccountRepository,
+) AccountService {
+	return &AccountServiceObject{
+		userService:      userService,
+		serviceScheduler: serviceScheduler,
+		repository:       repository,
+	}
+}
+
+func (a *AccountServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewAccountService(
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[scheduler.SchedulerServiceObject, scheduler.ServiceScheduler](factory),
+		dependency.FindRequiredDependency[repository.AccountRepositoryObject, repository.AccountRepository](factory),
+	)
+}
+
+type AccountService interface {
+	Delete(userId uuid.UUID, request userModel.DeleteUserRequest) error
+}
+
+// Delete removes the user with all the owned data if the password matches, the schedulers of the removed data are not
+// executed anymore
+func (a *AccountServiceObject) Delete(userId uuid.UUID, request userModel.DeleteUserRequest) error {
+	if err := a.userService.VerifyPassword(userId, request.Password); err != nil {
+		return err
+	}
+
+	schedulerIds := append(a.repository.FindPaymentSchedulerIds(userId), a.repository.FindIncomeSchedulerIds(userId)...)
+
+	if err := a.repository.Delete(userId); err != nil {
+		return err
+	}
+
+	for _, schedulerId := range schedulerIds {
+		if err := a.serviceScheduler.Remove(schedulerId); err != nil {
+			log.Debug().Err(err).Msgf("scheduler %s of the deleted user %s is not registered", schedulerId, userId)
+		}
+	}
+
+	return nil
+}
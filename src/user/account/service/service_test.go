@@ -0,0 +1,95 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	schedulerMocks "github.com/VlasovArtem/hob/src/scheduler/mocks"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/VlasovArtem/hob/src/user/account/mocks"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type AccountServiceTestSuite struct {
+	testhelper.MockTestSuite[AccountService]
+	userService       *userMocks.UserService
+	serviceScheduler  *schedulerMocks.ServiceScheduler
+	accountRepository *mocks.AccountRepository
+}
+
+func TestAccountServiceTestSuite(t *testing.T) {
+	ts := &AccountServiceTestSuite{}
+	ts.TestObjectGenerator = func() AccountService {
+		ts.userService = new(userMocks.UserService)
+		ts.serviceScheduler = new(schedulerMocks.ServiceScheduler)
+		ts.accountRepository = new(mocks.AccountRepository)
+
+		return NewAccountService(ts.userService, ts.serviceScheduler, ts.accountRepository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (a *AccountServiceTestSuite) Test_Delete() {
+	userId, paymentSchedulerId, incomeSchedulerId := uuid.New(), uuid.New(), uuid.New()
+	request := userModel.DeleteUserRequest{Password: "password"}
+
+	a.userService.On("VerifyPassword", userId, request.Password).Return(nil)
+	a.accountRepository.On("FindPaymentSchedulerIds", userId).Return([]uuid.UUID{paymentSchedulerId})
+	a.accountRepository.On("FindIncomeSchedulerIds", userId).Return([]uuid.UUID{incomeSchedulerId})
+	a.accountRepository.On("Delete", userId).Return(nil)
+	a.serviceScheduler.On("Remove", paymentSchedulerId).Return(nil)
+	a.serviceScheduler.On("Remove", incomeSchedulerId).Return(errors.New("scheduler is not registered"))
+
+	assert.Nil(a.T(), a.TestO.Delete(userId, request))
+
+	a.serviceScheduler.AssertCalled(a.T(), "Remove", paymentSchedulerId)
+	a.serviceScheduler.AssertCalled(a.T(), "Remove", incomeSchedulerId)
+}
+
+func (a *AccountServiceTestSuite) Test_Delete_WithMissingUser() {
+	userId := uuid.New()
+	request := userModel.DeleteUserRequest{Password: "password"}
+	expectedError := int_errors.NewErrNotFound("user with id %s not found", userId)
+
+	a.userService.On("VerifyPassword", userId, request.Password).Return(expectedError)
+
+	err := a.TestO.Delete(userId, request)
+
+	assert.Equal(a.T(), expectedError, err)
+	a.accountRepository.AssertNotCalled(a.T(), "Delete", mock.Anything)
+}
+
+func (a *AccountServiceTestSuite) Test_Delete_WithInvalidPassword() {
+	userId := uuid.New()
+	request := userModel.DeleteUserRequest{Password: "invalid"}
+
+	a.userService.On("VerifyPassword", userId, request.Password).Return(errors.New("current password is not valid"))
+
+	err := a.TestO.Delete(userId, request)
+
+	assert.Equal(a.T(), errors.New("current password is not valid"), err)
+	a.accountRepository.AssertNotCalled(a.T(), "FindPaymentSchedulerIds", mock.Anything)
+	a.accountRepository.AssertNotCalled(a.T(), "Delete", mock.Anything)
+}
+
+func (a *AccountServiceTestSuite) Test_Delete_WithErrorFromRepository() {
+	userId, schedulerId := uuid.New(), uuid.New()
+	request := userModel.DeleteUserRequest{Password: "password"}
+	expectedError := errors.New("error")
+
+	a.userService.On("VerifyPassword", userId, request.Password).Return(nil)
+	a.accountRepository.On("FindPaymentSchedulerIds", userId).Return([]uuid.UUID{schedulerId})
+	a.accountRepository.On("FindIncomeSchedulerIds", userId).Return([]uuid.UUID{})
+	a.accountRepository.On("Delete", userId).Return(expectedError)
+
+	err := a.TestO.Delete(userId, request)
+
+	assert.Equal(a.T(), expectedError, err)
+	a.serviceScheduler.AssertNotCalled(a.T(), "Remove", mock.Anything)
+}
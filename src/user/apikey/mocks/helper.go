@@ -0,0 +1,28 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/user/apikey/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+const Key = "hob_0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func GenerateApiKey(userId uuid.UUID) model.ApiKey {
+	return model.ApiKey{
+		Id:        uuid.New(),
+		UserId:    userId,
+		Name:      "Integration",
+		Prefix:    Key[:12],
+		Hash:      model.Hash(Key),
+		ReadOnly:  false,
+		CreatedAt: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func GenerateCreateApiKeyRequest() model.CreateApiKeyRequest {
+	return model.CreateApiKeyRequest{
+		Name:     "Integration",
+		ReadOnly: true,
+	}
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+// KeyPrefix starts every generated key, it makes the keys easy to recognize in the scripts and secret scanners
+const KeyPrefix = "hob_"
+
+// ApiKey is the personal credential of the user for the scripts and integrations, only the hash of the key is stored
+type ApiKey struct {
+	Id     uuid.UUID `gorm:"primarykey"`
+	UserId uuid.UUID
+	Name   string
+	// Prefix is the beginning of the key that helps the user to identify it
+	Prefix string
+	Hash   string `gorm:"uniqueIndex"`
+	// ReadOnly keys are allowed to perform only the safe requests
+	ReadOnly   bool
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	User       userModel.User `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+type ApiKeyDto struct {
+	Id         uuid.UUID
+	UserId     uuid.UUID
+	Name       string
+	Prefix     string
+	ReadOnly   bool
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+}
+
+// CreatedApiKeyDto contains the plain key, it is returned only once when the key is created
+type CreatedApiKeyDto struct {
+	ApiKeyDto
+	Key string
+}
+
+type CreateApiKeyRequest struct {
+	Name     string
+	ReadOnly bool
+}
+
+func (a ApiKey) ToDto() ApiKeyDto {
+	return ApiKeyDto{
+		Id:         a.Id,
+		UserId:     a.UserId,
+		Name:       a.Name,
+		Prefix:     a.Prefix,
+		ReadOnly:   a.ReadOnly,
+		CreatedAt:  a.CreatedAt,
+		LastUsedAt: a.LastUsedAt,
+	}
+}
+
+func (c CreateApiKeyRequest) ToEntity(userId uuid.UUID, key string) ApiKey {
+	return ApiKey{
+		Id:        uuid.New(),
+		UserId:    userId,
+		Name:      c.Name,
+		Prefix:    key[:len(KeyPrefix)+8],
+		Hash:      Hash(key),
+		ReadOnly:  c.ReadOnly,
+		CreatedAt: time.Now(),
+	}
+}
+
+// Hash returns the representation of the key that is stored in the database
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func ApiKeyToApiKeyDto(apiKey ApiKey) ApiKeyDto {
+	return apiKey.ToDto()
+}
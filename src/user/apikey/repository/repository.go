@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/common"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/user/apikey/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+var entity = model.ApiKey{}
+
+type ApiKeyRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewApiKeyRepository(database db.DatabaseService) ApiKeyRepository {
+	return &ApiKeyRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (a *ApiKeyRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewApiKeyRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (a *ApiKeyRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type ApiKeyRepository interface {
+	Create(entity model.ApiKey) (model.ApiKey, error)
+	FindByUserId(userId uuid.UUID) []model.ApiKeyDto
+	FindByHash(hash string) (model.ApiKey, error)
+	ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool
+	UpdateLastUsedAt(id uuid.UUID, lastUsedAt time.Time) error
+	DeleteById(id uuid.UUID) error
+}
+
+func (a *ApiKeyRepositoryObject) Create(entity model.ApiKey) (model.ApiKey, error) {
+	return entity, a.database.Create(&entity)
+}
+
+func (a *ApiKeyRepositoryObject) FindByUserId(userId uuid.UUID) []model.ApiKeyDto {
+	var apiKeys []model.ApiKey
+
+	if err := a.database.Modeled().Where("user_id = ?", userId).Order("created_at").Find(&apiKeys).Error; err != nil {
+		return []model.ApiKeyDto{}
+	}
+
+	return common.MapSlice(apiKeys, model.ApiKeyToApiKeyDto)
+}
+
+func (a *ApiKeyRepositoryObject) FindByHash(hash string) (response model.ApiKey, err error) {
+	return response, a.database.FirstBy(&response, "hash = ?", hash)
+}
+
+func (a *ApiKeyRepositoryObject) ExistsByIdAndUserId(id uuid.UUID, userId uuid.UUID) bool {
+	return a.database.ExistsBy("id = ? AND user_id = ?", id, userId)
+}
+
+func (a *ApiKeyRepositoryObject) UpdateLastUsedAt(id uuid.UUID, lastUsedAt time.Time) error {
+	return a.database.Modeled().Where("id = ?", id).Update("last_used_at", lastUsedAt).Error
+}
+
+func (a *ApiKeyRepositoryObject) DeleteById(id uuid.UUID) error {
+	return a.database.Delete(id)
+}
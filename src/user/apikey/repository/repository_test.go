@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	"github.com/VlasovArtem/hob/src/user/apikey/mocks"
+	"github.com/VlasovArtem/hob/src/user/apikey/model"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+type ApiKeyRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository  ApiKeyRepository
+	createdUser userModel.User
+}
+
+func (a *ApiKeyRepositoryTestSuite) SetupSuite() {
+	a.InitDBTestSuite()
+
+	a.CreateRepository(
+		func(service db.DatabaseService) {
+			a.repository = NewApiKeyRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, model.ApiKey{})
+		}).
+		AddAfterSuite(func(service db.DatabaseService) {
+			database.TruncateTable(service, userModel.User{})
+		}).
+		ExecuteMigration(userModel.User{}, model.ApiKey{})
+
+	a.createdUser = userMocks.GenerateUser()
+	a.CreateEntity(&a.createdUser)
+}
+
+func TestApiKeyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ApiKeyRepositoryTestSuite))
+}
+
+func (a *ApiKeyRepositoryTestSuite) Test_Create() {
+	apiKey := mocks.GenerateApiKey(a.createdUser.Id)
+
+	actual, err := a.repository.Create(apiKey)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), apiKey, actual)
+}
+
+func (a *ApiKeyRepositoryTestSuite) Test_FindByUserId() {
+	apiKey := a.createApiKey()
+
+	actual := a.repository.FindByUserId(a.createdUser.Id)
+
+	assert.Equal(a.T(), []model.ApiKeyDto{apiKey.ToDto()}, actual)
+}
+
+func (a *ApiKeyRepositoryTestSuite) Test_FindByUserId_WithMissingUser() {
+	a.createApiKey()
+
+	assert.Equal(a.T(), []model.ApiKeyDto{}, a.repository.FindByUserId(uuid.New()))
+}
+
+func (a *ApiKeyRepositoryTestSuite) Test_FindByHash() {
+	apiKey := a.createApiKey()
+
+	actual, err := a.repository.FindByHash(apiKey.Hash)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), apiKey.Id, actual.Id)
+}
+
+func (a *ApiKeyRepositoryTestSuite) Test_FindByHash_WithMissingKey() {
+	_, err := a.repository.FindByHash(model.Hash("missing"))
+
+	assert.ErrorIs(a.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (a *ApiKeyRepositoryTestSuite) Test_ExistsByIdAndUserId() {
+	apiKey := a.createApiKey()
+
+	assert.True(a.T(), a.repository.ExistsByIdAndUserId(apiKey.Id, a.createdUser.Id))
+	assert.False(a.T(), a.repository.ExistsByIdAndUserId(apiKey.Id, uuid.New()))
+}
+
+func (a *ApiKeyRepositoryTestSuite) Test_UpdateLastUsedAt() {
+	apiKey := a.createApiKey()
+	lastUsedAt := time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	err := a.repository.UpdateLastUsedAt(apiKey.Id, lastUsedAt)
+
+	assert.Nil(a.T(), err)
+
+	actual, _ := a.repository.FindByHash(apiKey.Hash)
+
+	assert.True(a.T(), lastUsedAt.Equal(*actual.LastUsedAt))
+}
+
+func (a *ApiKeyRepositoryTestSuite) Test_DeleteById() {
+	apiKey := a.createApiKey()
+
+	err := a.repository.DeleteById(apiKey.Id)
+
+	assert.Nil(a.T(), err)
+	assert.False(a.T(), a.repository.ExistsByIdAndUserId(apiKey.Id, a.createdUser.Id))
+}
+
+func (a *ApiKeyRepositoryTestSuite) createApiKey() model.ApiKey {
+	apiKey := mocks.GenerateApiKey(a.createdUser.Id)
+
+	a.CreateEntity(&apiKey)
+
+	return apiKey
+}
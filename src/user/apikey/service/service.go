@@ -0,0 +1,106 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/user/apikey/model"
+	"github.com/VlasovArtem/hob/src/user/apikey/repository"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"strings"
+	"time"
+)
+
+// keyLength is the number of the random bytes in the key
+const keyLength = 24
+
+type ApiKeyServiceObject struct {
+	userService userService.UserService
+	repository  repository.ApiKeyRepository
+}
+
+func NewApiKeyService(userService userService.UserService, repository repository.ApiKeyRepository) ApiKeyService {
+	return &ApiKeyServiceObject{
+		userService: userService,
+		repository:  repository,
+	}
+}
+
+func (a *ApiKeyServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewApiKeyService(
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[repository.ApiKeyRepositoryObject, repository.ApiKeyRepository](factory),
+	)
+}
+
+type ApiKeyService interface {
+	Add(userId uuid.UUID, request model.CreateApiKeyRequest) (model.CreatedApiKeyDto, error)
+	FindByUserId(userId uuid.UUID) []model.ApiKeyDto
+	Revoke(id uuid.UUID, userId uuid.UUID) error
+	Authenticate(key string) (model.ApiKeyDto, error)
+}
+
+// Add generates the new key for the user, the plain key is available only in the response
+func (a *ApiKeyServiceObject) Add(userId uuid.UUID, request model.CreateApiKeyRequest) (response model.CreatedApiKeyDto, err error) {
+	if strings.TrimSpace(request.Name) == "" {
+		return response, errors.New("name is missing")
+	}
+	if !a.userService.ExistsById(userId) {
+		return response, interrors.NewErrNotFound("user with id %s not found", userId)
+	}
+
+	key, err := generateKey()
+	if err != nil {
+		return response, err
+	}
+
+	if entity, err := a.repository.Create(request.ToEntity(userId, key)); err != nil {
+		return response, err
+	} else {
+		return model.CreatedApiKeyDto{ApiKeyDto: entity.ToDto(), Key: key}, nil
+	}
+}
+
+func (a *ApiKeyServiceObject) FindByUserId(userId uuid.UUID) []model.ApiKeyDto {
+	return a.repository.FindByUserId(userId)
+}
+
+func (a *ApiKeyServiceObject) Revoke(id uuid.UUID, userId uuid.UUID) error {
+	if !a.repository.ExistsByIdAndUserId(id, userId) {
+		return interrors.NewErrNotFound("api key with id %s not found", id)
+	}
+	return a.repository.DeleteById(id)
+}
+
+// Authenticate finds the key by its hash and records the usage of the key
+func (a *ApiKeyServiceObject) Authenticate(key string) (response model.ApiKeyDto, err error) {
+	if !strings.HasPrefix(key, model.KeyPrefix) {
+		return response, interrors.NewErrUnauthorized("api key is not valid")
+	}
+
+	entity, err := a.repository.FindByHash(model.Hash(key))
+	if err != nil {
+		return response, interrors.NewErrUnauthorized("api key is not valid")
+	}
+
+	now := time.Now()
+	if err := a.repository.UpdateLastUsedAt(entity.Id, now); err != nil {
+		log.Error().Err(err).Msgf("last usage of the api key %s is not updated", entity.Id)
+	} else {
+		entity.LastUsedAt = &now
+	}
+
+	return entity.ToDto(), nil
+}
+
+func generateKey() (string, error) {
+	bytes := make([]byte, keyLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return model.KeyPrefix + hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	"github.com/VlasovArtem/hob/src/user/apikey/mocks"
+	"github.com/VlasovArtem/hob/src/user/apikey/model"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"strings"
+	"testing"
+)
+
+type ApiKeyServiceTestSuite struct {
+	testhelper.MockTestSuite[ApiKeyService]
+	userService      *userMocks.UserService
+	apiKeyRepository *mocks.ApiKeyRepository
+}
+
+func TestApiKeyServiceTestSuite(t *testing.T) {
+	ts := &ApiKeyServiceTestSuite{}
+	ts.TestObjectGenerator = func() ApiKeyService {
+		ts.userService = new(userMocks.UserService)
+		ts.apiKeyRepository = new(mocks.ApiKeyRepository)
+
+		return NewApiKeyService(ts.userService, ts.apiKeyRepository)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Add() {
+	userId := uuid.New()
+	request := mocks.GenerateCreateApiKeyRequest()
+
+	var expected model.ApiKey
+
+	a.userService.On("ExistsById", userId).Return(true)
+	a.apiKeyRepository.On("Create", mock.Anything).Return(
+		func(apiKey model.ApiKey) model.ApiKey {
+			expected = apiKey
+			return apiKey
+		}, nil)
+
+	actual, err := a.TestO.Add(userId, request)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), expected.ToDto(), actual.ApiKeyDto)
+	assert.True(a.T(), strings.HasPrefix(actual.Key, model.KeyPrefix))
+	assert.True(a.T(), strings.HasPrefix(actual.Key, actual.Prefix))
+	assert.Equal(a.T(), model.Hash(actual.Key), expected.Hash)
+	assert.NotContains(a.T(), expected.Hash, actual.Key)
+	assert.True(a.T(), actual.ReadOnly)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Add_WithMissingName() {
+	request := mocks.GenerateCreateApiKeyRequest()
+	request.Name = " "
+
+	actual, err := a.TestO.Add(uuid.New(), request)
+
+	assert.Equal(a.T(), errors.New("name is missing"), err)
+	assert.Equal(a.T(), model.CreatedApiKeyDto{}, actual)
+	a.apiKeyRepository.AssertNotCalled(a.T(), "Create", mock.Anything)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Add_WithMissingUser() {
+	userId := uuid.New()
+
+	a.userService.On("ExistsById", userId).Return(false)
+
+	actual, err := a.TestO.Add(userId, mocks.GenerateCreateApiKeyRequest())
+
+	assert.Equal(a.T(), int_errors.NewErrNotFound("user with id %s not found", userId), err)
+	assert.Equal(a.T(), model.CreatedApiKeyDto{}, actual)
+	a.apiKeyRepository.AssertNotCalled(a.T(), "Create", mock.Anything)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Add_WithErrorFromRepository() {
+	userId := uuid.New()
+	expectedError := errors.New("error")
+
+	a.userService.On("ExistsById", userId).Return(true)
+	a.apiKeyRepository.On("Create", mock.Anything).Return(model.ApiKey{}, expectedError)
+
+	actual, err := a.TestO.Add(userId, mocks.GenerateCreateApiKeyRequest())
+
+	assert.Equal(a.T(), expectedError, err)
+	assert.Equal(a.T(), model.CreatedApiKeyDto{}, actual)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_FindByUserId() {
+	userId := uuid.New()
+	expected := []model.ApiKeyDto{mocks.GenerateApiKey(userId).ToDto()}
+
+	a.apiKeyRepository.On("FindByUserId", userId).Return(expected)
+
+	assert.Equal(a.T(), expected, a.TestO.FindByUserId(userId))
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Revoke() {
+	id, userId := uuid.New(), uuid.New()
+
+	a.apiKeyRepository.On("ExistsByIdAndUserId", id, userId).Return(true)
+	a.apiKeyRepository.On("DeleteById", id).Return(nil)
+
+	assert.Nil(a.T(), a.TestO.Revoke(id, userId))
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Revoke_WithMissingKey() {
+	id, userId := uuid.New(), uuid.New()
+
+	a.apiKeyRepository.On("ExistsByIdAndUserId", id, userId).Return(false)
+
+	err := a.TestO.Revoke(id, userId)
+
+	assert.Equal(a.T(), int_errors.NewErrNotFound("api key with id %s not found", id), err)
+	a.apiKeyRepository.AssertNotCalled(a.T(), "DeleteById", mock.Anything)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Authenticate() {
+	apiKey := mocks.GenerateApiKey(uuid.New())
+
+	a.apiKeyRepository.On("FindByHash", model.Hash(mocks.Key)).Return(apiKey, nil)
+	a.apiKeyRepository.On("UpdateLastUsedAt", apiKey.Id, mock.Anything).Return(nil)
+
+	actual, err := a.TestO.Authenticate(mocks.Key)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), apiKey.Id, actual.Id)
+	assert.Equal(a.T(), apiKey.UserId, actual.UserId)
+	assert.NotNil(a.T(), actual.LastUsedAt)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Authenticate_WithErrorOnLastUsedUpdate() {
+	apiKey := mocks.GenerateApiKey(uuid.New())
+
+	a.apiKeyRepository.On("FindByHash", model.Hash(mocks.Key)).Return(apiKey, nil)
+	a.apiKeyRepository.On("UpdateLastUsedAt", apiKey.Id, mock.Anything).Return(errors.New("error"))
+
+	actual, err := a.TestO.Authenticate(mocks.Key)
+
+	assert.Nil(a.T(), err)
+	assert.Equal(a.T(), apiKey.ToDto(), actual)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Authenticate_WithUnknownKey() {
+	a.apiKeyRepository.On("FindByHash", model.Hash(mocks.Key)).Return(model.ApiKey{}, gorm.ErrRecordNotFound)
+
+	actual, err := a.TestO.Authenticate(mocks.Key)
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("api key is not valid"), err)
+	assert.Equal(a.T(), model.ApiKeyDto{}, actual)
+}
+
+func (a *ApiKeyServiceTestSuite) Test_Authenticate_WithInvalidFormat() {
+	actual, err := a.TestO.Authenticate("invalid")
+
+	assert.Equal(a.T(), int_errors.NewErrUnauthorized("api key is not valid"), err)
+	assert.Equal(a.T(), model.ApiKeyDto{}, actual)
+	a.apiKeyRepository.AssertNotCalled(a.T(), "FindByHash", mock.Anything)
+}
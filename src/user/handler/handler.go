@@ -2,7 +2,11 @@ package handler
 
 import (
 	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
 	"github.com/VlasovArtem/hob/src/common/rest"
+	accountService "github.com/VlasovArtem/hob/src/user/account/service"
+	apiKeyModel "github.com/VlasovArtem/hob/src/user/apikey/model"
+	apiKeyService "github.com/VlasovArtem/hob/src/user/apikey/service"
 	"github.com/VlasovArtem/hob/src/user/model"
 	"github.com/VlasovArtem/hob/src/user/service"
 	"github.com/VlasovArtem/hob/src/user/validator"
@@ -11,18 +15,27 @@ import (
 )
 
 type UserHandlerObject struct {
-	userService   service.UserService
-	userValidator validator.UserRequestValidator
+	userService    service.UserService
+	userValidator  validator.UserRequestValidator
+	apiKeyService  apiKeyService.ApiKeyService
+	accountService accountService.AccountService
 }
 
-func NewUserHandler(userService service.UserService, userValidator validator.UserRequestValidator) UserHandler {
-	return &UserHandlerObject{userService, userValidator}
+func NewUserHandler(
+	userService service.UserService,
+	userValidator validator.UserRequestValidator,
+	apiKeyService apiKeyService.ApiKeyService,
+	accountService accountService.AccountService,
+) UserHandler {
+	return &UserHandlerObject{userService, userValidator, apiKeyService, accountService}
 }
 
 func (u *UserHandlerObject) Initialize(factory dependency.DependenciesProvider) any {
 	return NewUserHandler(
 		dependency.FindRequiredDependency[service.UserServiceObject, service.UserService](factory),
 		dependency.FindRequiredDependency[validator.UserRequestValidatorObject, validator.UserRequestValidator](factory),
+		dependency.FindRequiredDependency[apiKeyService.ApiKeyServiceObject, apiKeyService.ApiKeyService](factory),
+		dependency.FindRequiredDependency[accountService.AccountServiceObject, accountService.AccountService](factory),
 	)
 }
 
@@ -31,6 +44,10 @@ type UserHandler interface {
 	FindById() http.HandlerFunc
 	Delete() http.HandlerFunc
 	Update() http.HandlerFunc
+	ChangePassword() http.HandlerFunc
+	AddApiKey() http.HandlerFunc
+	FindApiKeys() http.HandlerFunc
+	RevokeApiKey() http.HandlerFunc
 }
 
 func (u *UserHandlerObject) Init(router *mux.Router) {
@@ -40,6 +57,10 @@ func (u *UserHandlerObject) Init(router *mux.Router) {
 	userRouter.Path("/{id}").HandlerFunc(u.FindById()).Methods("GET")
 	userRouter.Path("/{id}").HandlerFunc(u.Delete()).Methods("DELETE")
 	userRouter.Path("/{id}").HandlerFunc(u.Update()).Methods("PUT")
+	userRouter.Path("/{id}/password").HandlerFunc(u.ChangePassword()).Methods("PUT")
+	userRouter.Path("/{id}/api-keys").HandlerFunc(u.AddApiKey()).Methods("POST")
+	userRouter.Path("/{id}/api-keys").HandlerFunc(u.FindApiKeys()).Methods("GET")
+	userRouter.Path("/{id}/api-keys/{keyId}").HandlerFunc(u.RevokeApiKey()).Methods("DELETE")
 }
 
 func (u *UserHandlerObject) Add() http.HandlerFunc {
@@ -61,7 +82,7 @@ func (u *UserHandlerObject) Add() http.HandlerFunc {
 
 func (u *UserHandlerObject) FindById() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, err := rest.GetUserIdRequestParameter(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
@@ -71,13 +92,19 @@ func (u *UserHandlerObject) FindById() http.HandlerFunc {
 	}
 }
 
+// Delete removes the account after the confirmation with the current password, the request authenticated with the api key
+// is not allowed to remove the account
 func (u *UserHandlerObject) Delete() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if rest.IsApiKey(request) {
+			rest.HandleWithError(writer, int_errors.NewErrForbidden("account can not be deleted with the api key"))
+		} else if id, err := rest.GetUserIdRequestParameter(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else if body, err := rest.ReadRequestBody[model.DeleteUserRequest](request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			rest.NewAPIResponse(writer).
-				NoContent(u.userService.Delete(id)).
+				NoContent(u.accountService.Delete(id, body)).
 				Perform()
 		}
 	}
@@ -85,21 +112,97 @@ func (u *UserHandlerObject) Delete() http.HandlerFunc {
 
 func (u *UserHandlerObject) Update() http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		if id, err := rest.GetIdRequestParameter(request); err != nil {
+		if id, err := rest.GetUserIdRequestParameter(request); err != nil {
 			rest.HandleWithError(writer, err)
 		} else {
 			if body, err := rest.ReadRequestBody[model.UpdateUserRequest](request); err != nil {
 				rest.HandleWithError(writer, err)
 			} else {
-				if err := u.userValidator.ValidateUpdateRequest(body); err != nil {
+				rest.NewAPIResponse(writer).
+					Error(u.userService.Update(id, body)).
+					Perform()
+			}
+		}
+	}
+}
+
+// ChangePassword replaces the password of the user, the request authenticated with the api key is not allowed to change it
+func (u *UserHandlerObject) ChangePassword() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if rest.IsApiKey(request) {
+			rest.HandleWithError(writer, int_errors.NewErrForbidden("password can not be changed with the api key"))
+		} else if id, err := rest.GetUserIdRequestParameter(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			if body, err := rest.ReadRequestBody[model.ChangePasswordRequest](request); err != nil {
+				rest.HandleWithError(writer, err)
+			} else {
+				if err := u.userValidator.ValidateChangePasswordRequest(body); err != nil {
 					rest.HandleWithError(writer, err)
 					return
 				} else {
 					rest.NewAPIResponse(writer).
-						Error(u.userService.Update(id, body)).
+						StatusCode(http.StatusNoContent).
+						Error(u.userService.ChangePassword(id, body)).
 						Perform()
 				}
 			}
 		}
 	}
 }
+
+// AddApiKey issues the new api key of the user, the request authenticated with the api key is not allowed to issue it
+func (u *UserHandlerObject) AddApiKey() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if rest.IsApiKey(request) {
+			rest.HandleWithError(writer, int_errors.NewErrForbidden("api key can not be added with the api key"))
+		} else if id, err := rest.GetUserIdRequestParameter(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			if body, err := rest.ReadRequestBody[apiKeyModel.CreateApiKeyRequest](request); err != nil {
+				rest.HandleWithError(writer, err)
+			} else {
+				rest.NewAPIResponse(writer).
+					Created(u.apiKeyService.Add(id, body)).
+					Perform()
+			}
+		}
+	}
+}
+
+func (u *UserHandlerObject) FindApiKeys() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if id, err := rest.GetUserIdRequestParameter(request); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				Body(u.apiKeyService.FindByUserId(id)).
+				Perform()
+		}
+	}
+}
+
+// RevokeApiKey revokes the api key of the user, the request authenticated with the api key is not allowed to revoke it
+func (u *UserHandlerObject) RevokeApiKey() http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if rest.IsApiKey(request) {
+			rest.HandleWithError(writer, int_errors.NewErrForbidden("api key can not be revoked with the api key"))
+			return
+		}
+
+		id, err := rest.GetUserIdRequestParameter(request)
+		if err != nil {
+			rest.HandleWithError(writer, err)
+			return
+		}
+
+		if keyId, err := rest.GetUUIDRequestParameter(request, "keyId"); err != nil {
+			rest.HandleWithError(writer, err)
+		} else {
+			rest.NewAPIResponse(writer).
+				StatusCode(http.StatusNoContent).
+				Error(u.apiKeyService.Revoke(keyId, id)).
+				Perform()
+		}
+	}
+}
@@ -3,8 +3,12 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	helperModel "github.com/VlasovArtem/hob/src/common/int-errors"
 	"github.com/VlasovArtem/hob/src/test/testhelper"
+	accountMocks "github.com/VlasovArtem/hob/src/user/account/mocks"
+	apiKeyMocks "github.com/VlasovArtem/hob/src/user/apikey/mocks"
+	apiKeyModel "github.com/VlasovArtem/hob/src/user/apikey/model"
 	"github.com/VlasovArtem/hob/src/user/mocks"
 	"github.com/VlasovArtem/hob/src/user/model"
 	"github.com/google/uuid"
@@ -17,8 +21,10 @@ import (
 
 type UserHandlerTestSuite struct {
 	testhelper.MockTestSuite[UserHandler]
-	userService   *mocks.UserService
-	userValidator *mocks.UserRequestValidator
+	userService    *mocks.UserService
+	userValidator  *mocks.UserRequestValidator
+	apiKeyService  *apiKeyMocks.ApiKeyService
+	accountService *accountMocks.AccountService
 }
 
 func TestUserHandlerTestSuite(t *testing.T) {
@@ -26,8 +32,10 @@ func TestUserHandlerTestSuite(t *testing.T) {
 	ts.TestObjectGenerator = func() UserHandler {
 		ts.userService = new(mocks.UserService)
 		ts.userValidator = new(mocks.UserRequestValidator)
+		ts.apiKeyService = new(apiKeyMocks.ApiKeyService)
+		ts.accountService = new(accountMocks.AccountService)
 
-		return NewUserHandler(ts.userService, ts.userValidator)
+		return NewUserHandler(ts.userService, ts.userValidator, ts.apiKeyService, ts.accountService)
 	}
 
 	suite.Run(t, ts)
@@ -109,13 +117,14 @@ func (u *UserHandlerTestSuite) Test_Add_WithErrorFromService() {
 
 func (u *UserHandlerTestSuite) Test_FindById() {
 	request := mocks.GenerateCreateUserRequest()
-	expected := request.ToEntity().ToDto()
+	expected := request.ToEntity(nil).ToDto()
 
 	u.userService.On("FindById", expected.Id).Return(expected, nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("GET").
+		WithUser(expected.Id).
 		WithHandler(u.TestO.FindById()).
 		WithVar("id", expected.Id.String())
 
@@ -131,13 +140,16 @@ func (u *UserHandlerTestSuite) Test_FindById() {
 }
 
 func (u *UserHandlerTestSuite) Test_FindById_WithErrorFromService() {
+	id := uuid.New()
+
 	u.userService.On("FindById", mock.Anything).Return(model.UserDto{}, errors.New("test"))
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("GET").
+		WithUser(id).
 		WithHandler(u.TestO.FindById()).
-		WithVar("id", uuid.New().String())
+		WithVar("id", id.String())
 
 	content := testRequest.Verify(u.T(), http.StatusBadRequest)
 
@@ -148,6 +160,7 @@ func (u *UserHandlerTestSuite) Test_FindByIdWithMissingParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(u.TestO.FindById())
 
 	content := testRequest.Verify(u.T(), http.StatusBadRequest)
@@ -159,6 +172,7 @@ func (u *UserHandlerTestSuite) Test_FindById_WithInvalidUUID() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("GET").
+		WithUser(uuid.New()).
 		WithHandler(u.TestO.FindById()).
 		WithVar("id", "id")
 
@@ -167,15 +181,41 @@ func (u *UserHandlerTestSuite) Test_FindById_WithInvalidUUID() {
 	assert.Equal(u.T(), "the id is not valid id\n", string(content))
 }
 
+func (u *UserHandlerTestSuite) Test_FindById_WithAnotherUser() {
+	id := uuid.New()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/user/{id}").
+		WithMethod("GET").
+		WithUser(uuid.New()).
+		WithHandler(u.TestO.FindById()).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(u.T(), http.StatusNotFound)
+
+	assert.Equal(u.T(), fmt.Sprintf("user with id %s not found\n", id), string(content))
+	u.userService.AssertNotCalled(u.T(), "FindById", mock.Anything)
+}
+
+func (u *UserHandlerTestSuite) Test_FindById_WithoutUser() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/user/{id}").
+		WithMethod("GET").
+		WithHandler(u.TestO.FindById()).
+		WithVar("id", uuid.New().String())
+
+	testRequest.Verify(u.T(), http.StatusUnauthorized)
+}
+
 func (u *UserHandlerTestSuite) Test_Update() {
 	id, request := mocks.GenerateUpdateUserRequest()
 
-	u.userValidator.On("ValidateUpdateRequest", request).Return(nil)
 	u.userService.On("Update", id, request).Return(nil)
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("PUT").
+		WithUser(id).
 		WithHandler(u.TestO.Update()).
 		WithBody(request).
 		WithVar("id", id.String())
@@ -189,6 +229,7 @@ func (u *UserHandlerTestSuite) Test_Update_WithInvalidRequest() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("PUT").
+		WithUser(id).
 		WithHandler(u.TestO.Update()).
 		WithVar("id", id.String())
 
@@ -201,6 +242,7 @@ func (u *UserHandlerTestSuite) Test_Update_WithInvalidUUID() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(u.TestO.Update()).
 		WithVar("id", "id")
 
@@ -213,6 +255,7 @@ func (u *UserHandlerTestSuite) Test_Update_WithMissingIdParameter() {
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("PUT").
+		WithUser(uuid.New()).
 		WithHandler(u.TestO.Update())
 
 	testRequest.Verify(u.T(), http.StatusBadRequest)
@@ -220,36 +263,177 @@ func (u *UserHandlerTestSuite) Test_Update_WithMissingIdParameter() {
 	u.userService.AssertNotCalled(u.T(), "Update", mock.Anything, mock.Anything)
 }
 
-func (u *UserHandlerTestSuite) Test_Update_WithInvalidDate() {
-	id, request := mocks.GenerateUpdateUserRequest()
+func (u *UserHandlerTestSuite) Test_ChangePassword() {
+	id := uuid.New()
+	request := mocks.GenerateChangePasswordRequest()
+
+	u.userValidator.On("ValidateChangePasswordRequest", request).Return(nil)
+	u.userService.On("ChangePassword", id, request).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/password").
+		WithMethod("PUT").
+		WithUser(id).
+		WithHandler(u.TestO.ChangePassword()).
+		WithBody(request).
+		WithVar("id", id.String())
+
+	testRequest.Verify(u.T(), http.StatusNoContent)
+}
+
+func (u *UserHandlerTestSuite) Test_ChangePassword_WithApiKey() {
+	id := uuid.New()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/password").
+		WithMethod("PUT").
+		WithUser(id).
+		WithApiKey().
+		WithHandler(u.TestO.ChangePassword()).
+		WithBody(mocks.GenerateChangePasswordRequest()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(u.T(), http.StatusForbidden)
+
+	u.userService.AssertNotCalled(u.T(), "ChangePassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserHandlerTestSuite) Test_ChangePassword_WithInvalidData() {
+	id := uuid.New()
+	request := mocks.GenerateChangePasswordRequest()
 
 	errorResponse := helperModel.NewWithDetails("error", "details")
-	u.userValidator.On("ValidateUpdateRequest", request).Return(&helperModel.ErrResponse{
+	u.userValidator.On("ValidateChangePasswordRequest", request).Return(&helperModel.ErrResponse{
 		Response: errorResponse,
 	})
 
 	testRequest := testhelper.NewTestRequest().
-		WithURL("https://test.com/api/v1/user/{id}").
+		WithURL("https://test.com/api/v1/users/{id}/password").
 		WithMethod("PUT").
-		WithHandler(u.TestO.Update()).
+		WithUser(id).
+		WithHandler(u.TestO.ChangePassword()).
 		WithBody(request).
 		WithVar("id", id.String())
 
 	response := testRequest.Verify(u.T(), http.StatusBadRequest)
 
 	assert.Equal(u.T(), *errorResponse.(*helperModel.ErrorResponseObject), testhelper.ReadErrorResponse(response))
-	u.userService.AssertNotCalled(u.T(), "Update", mock.Anything, mock.Anything)
+	u.userService.AssertNotCalled(u.T(), "ChangePassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserHandlerTestSuite) Test_ChangePassword_WithInvalidCurrentPassword() {
+	id := uuid.New()
+	request := mocks.GenerateChangePasswordRequest()
+
+	u.userValidator.On("ValidateChangePasswordRequest", request).Return(nil)
+	u.userService.On("ChangePassword", id, request).Return(errors.New("current password is not valid"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/password").
+		WithMethod("PUT").
+		WithUser(id).
+		WithHandler(u.TestO.ChangePassword()).
+		WithBody(request).
+		WithVar("id", id.String())
+
+	response := testRequest.Verify(u.T(), http.StatusBadRequest)
+
+	assert.Equal(u.T(), "current password is not valid\n", string(response))
+}
+
+func (u *UserHandlerTestSuite) Test_ChangePassword_WithAnotherUser() {
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/password").
+		WithMethod("PUT").
+		WithUser(uuid.New()).
+		WithHandler(u.TestO.ChangePassword()).
+		WithBody(mocks.GenerateChangePasswordRequest()).
+		WithVar("id", uuid.New().String())
+
+	testRequest.Verify(u.T(), http.StatusNotFound)
+
+	u.userService.AssertNotCalled(u.T(), "ChangePassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserHandlerTestSuite) Test_Delete() {
+	id := uuid.New()
+	request := model.DeleteUserRequest{Password: "password"}
+
+	u.accountService.On("Delete", id, request).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}").
+		WithMethod("DELETE").
+		WithUser(id).
+		WithHandler(u.TestO.Delete()).
+		WithBody(request).
+		WithVar("id", id.String())
+
+	testRequest.Verify(u.T(), http.StatusNoContent)
+}
+
+func (u *UserHandlerTestSuite) Test_Delete_WithErrorFromService() {
+	id := uuid.New()
+	request := model.DeleteUserRequest{Password: "password"}
+
+	u.accountService.On("Delete", id, request).Return(helperModel.NewErrNotFound("user with id %s not found", id))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}").
+		WithMethod("DELETE").
+		WithUser(id).
+		WithHandler(u.TestO.Delete()).
+		WithBody(request).
+		WithVar("id", id.String())
+
+	testRequest.Verify(u.T(), http.StatusNotFound)
+}
+
+func (u *UserHandlerTestSuite) Test_Delete_WithInvalidPassword() {
+	id := uuid.New()
+	request := model.DeleteUserRequest{Password: "invalid"}
+
+	u.accountService.On("Delete", id, request).Return(errors.New("current password is not valid"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}").
+		WithMethod("DELETE").
+		WithUser(id).
+		WithHandler(u.TestO.Delete()).
+		WithBody(request).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(u.T(), http.StatusBadRequest)
+
+	assert.Equal(u.T(), "current password is not valid\n", string(content))
+}
+
+func (u *UserHandlerTestSuite) Test_Delete_WithApiKey() {
+	id := uuid.New()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}").
+		WithMethod("DELETE").
+		WithUser(id).
+		WithApiKey().
+		WithHandler(u.TestO.Delete()).
+		WithBody(model.DeleteUserRequest{Password: "password"}).
+		WithVar("id", id.String())
+
+	testRequest.Verify(u.T(), http.StatusForbidden)
+
+	u.accountService.AssertNotCalled(u.T(), "Delete", mock.Anything, mock.Anything)
 }
 
 func (u *UserHandlerTestSuite) Test_Update_WithErrorFromService() {
 	id, request := mocks.GenerateUpdateUserRequest()
 
-	u.userValidator.On("ValidateUpdateRequest", request).Return(nil)
 	u.userService.On("Update", id, request).Return(errors.New("error"))
 
 	testRequest := testhelper.NewTestRequest().
 		WithURL("https://test.com/api/v1/user/{id}").
 		WithMethod("PUT").
+		WithUser(id).
 		WithHandler(u.TestO.Update()).
 		WithBody(request).
 		WithVar("id", id.String())
@@ -258,3 +442,171 @@ func (u *UserHandlerTestSuite) Test_Update_WithErrorFromService() {
 
 	assert.Equal(u.T(), []byte("error\n"), response)
 }
+
+func (u *UserHandlerTestSuite) Test_AddApiKey() {
+	id := uuid.New()
+	request := apiKeyMocks.GenerateCreateApiKeyRequest()
+	expected := apiKeyModel.CreatedApiKeyDto{
+		ApiKeyDto: apiKeyMocks.GenerateApiKey(id).ToDto(),
+		Key:       apiKeyMocks.Key,
+	}
+
+	u.apiKeyService.On("Add", id, request).Return(expected, nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys").
+		WithMethod("POST").
+		WithUser(id).
+		WithHandler(u.TestO.AddApiKey()).
+		WithBody(request).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(u.T(), http.StatusCreated)
+
+	actual := apiKeyModel.CreatedApiKeyDto{}
+
+	json.Unmarshal(content, &actual)
+
+	assert.Equal(u.T(), expected, actual)
+}
+
+func (u *UserHandlerTestSuite) Test_AddApiKey_WithApiKey() {
+	id := uuid.New()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys").
+		WithMethod("POST").
+		WithUser(id).
+		WithApiKey().
+		WithHandler(u.TestO.AddApiKey()).
+		WithBody(apiKeyMocks.GenerateCreateApiKeyRequest()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(u.T(), http.StatusForbidden)
+
+	u.apiKeyService.AssertNotCalled(u.T(), "Add", mock.Anything, mock.Anything)
+}
+
+func (u *UserHandlerTestSuite) Test_AddApiKey_WithAnotherUser() {
+	id := uuid.New()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys").
+		WithMethod("POST").
+		WithUser(uuid.New()).
+		WithHandler(u.TestO.AddApiKey()).
+		WithBody(apiKeyMocks.GenerateCreateApiKeyRequest()).
+		WithVar("id", id.String())
+
+	testRequest.Verify(u.T(), http.StatusNotFound)
+
+	u.apiKeyService.AssertNotCalled(u.T(), "Add", mock.Anything, mock.Anything)
+}
+
+func (u *UserHandlerTestSuite) Test_AddApiKey_WithErrorFromService() {
+	id := uuid.New()
+	request := apiKeyMocks.GenerateCreateApiKeyRequest()
+
+	u.apiKeyService.On("Add", id, request).Return(apiKeyModel.CreatedApiKeyDto{}, errors.New("name is missing"))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys").
+		WithMethod("POST").
+		WithUser(id).
+		WithHandler(u.TestO.AddApiKey()).
+		WithBody(request).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(u.T(), http.StatusBadRequest)
+
+	assert.Equal(u.T(), "name is missing\n", string(content))
+}
+
+func (u *UserHandlerTestSuite) Test_FindApiKeys() {
+	id := uuid.New()
+	expected := []apiKeyModel.ApiKeyDto{apiKeyMocks.GenerateApiKey(id).ToDto()}
+
+	u.apiKeyService.On("FindByUserId", id).Return(expected)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys").
+		WithMethod("GET").
+		WithUser(id).
+		WithHandler(u.TestO.FindApiKeys()).
+		WithVar("id", id.String())
+
+	content := testRequest.Verify(u.T(), http.StatusOK)
+
+	var actual []apiKeyModel.ApiKeyDto
+
+	json.Unmarshal(content, &actual)
+
+	assert.Equal(u.T(), expected, actual)
+}
+
+func (u *UserHandlerTestSuite) Test_RevokeApiKey() {
+	id, keyId := uuid.New(), uuid.New()
+
+	u.apiKeyService.On("Revoke", keyId, id).Return(nil)
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys/{keyId}").
+		WithMethod("DELETE").
+		WithUser(id).
+		WithHandler(u.TestO.RevokeApiKey()).
+		WithVar("id", id.String()).
+		WithVar("keyId", keyId.String())
+
+	testRequest.Verify(u.T(), http.StatusNoContent)
+}
+
+func (u *UserHandlerTestSuite) Test_RevokeApiKey_WithApiKey() {
+	id, keyId := uuid.New(), uuid.New()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys/{keyId}").
+		WithMethod("DELETE").
+		WithUser(id).
+		WithApiKey().
+		WithHandler(u.TestO.RevokeApiKey()).
+		WithVar("id", id.String()).
+		WithVar("keyId", keyId.String())
+
+	testRequest.Verify(u.T(), http.StatusForbidden)
+
+	u.apiKeyService.AssertNotCalled(u.T(), "Revoke", mock.Anything, mock.Anything)
+}
+
+func (u *UserHandlerTestSuite) Test_RevokeApiKey_WithMissingKey() {
+	id, keyId := uuid.New(), uuid.New()
+
+	u.apiKeyService.On("Revoke", keyId, id).Return(helperModel.NewErrNotFound("api key with id %s not found", keyId))
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys/{keyId}").
+		WithMethod("DELETE").
+		WithUser(id).
+		WithHandler(u.TestO.RevokeApiKey()).
+		WithVar("id", id.String()).
+		WithVar("keyId", keyId.String())
+
+	content := testRequest.Verify(u.T(), http.StatusNotFound)
+
+	assert.Equal(u.T(), fmt.Sprintf("api key with id %s not found\n", keyId), string(content))
+}
+
+func (u *UserHandlerTestSuite) Test_RevokeApiKey_WithInvalidKeyId() {
+	id := uuid.New()
+
+	testRequest := testhelper.NewTestRequest().
+		WithURL("https://test.com/api/v1/users/{id}/api-keys/{keyId}").
+		WithMethod("DELETE").
+		WithUser(id).
+		WithHandler(u.TestO.RevokeApiKey()).
+		WithVar("id", id.String()).
+		WithVar("keyId", "id")
+
+	testRequest.Verify(u.T(), http.StatusBadRequest)
+
+	u.apiKeyService.AssertNotCalled(u.T(), "Revoke", mock.Anything, mock.Anything)
+}
@@ -119,12 +119,26 @@ func (_m *UserRepository) FindById(id uuid.UUID) (model.User, error) {
 	return r0, r1
 }
 
+// ResetPassword provides a mock function with given fields: id, password
+func (_m *UserRepository) ResetPassword(id uuid.UUID, password []byte) error {
+	ret := _m.Called(id, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, []byte) error); ok {
+		r0 = rf(id, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: id, user
-func (_m *UserRepository) Update(id uuid.UUID, user model.UpdateUserRequest) error {
+func (_m *UserRepository) Update(id uuid.UUID, user model.User) error {
 	ret := _m.Called(id, user)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, model.UpdateUserRequest) error); ok {
+	if rf, ok := ret.Get(0).(func(uuid.UUID, model.User) error); ok {
 		r0 = rf(id, user)
 	} else {
 		r0 = ret.Error(0)
@@ -133,15 +147,15 @@ func (_m *UserRepository) Update(id uuid.UUID, user model.UpdateUserRequest) err
 	return r0
 }
 
-// Verify provides a mock function with given fields: email, password
-func (_m *UserRepository) Verify(email string, password []byte) bool {
-	ret := _m.Called(email, password)
+// UpdatePassword provides a mock function with given fields: id, password
+func (_m *UserRepository) UpdatePassword(id uuid.UUID, password []byte) error {
+	ret := _m.Called(id, password)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string, []byte) bool); ok {
-		r0 = rf(email, password)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, []byte) error); ok {
+		r0 = rf(id, password)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Error(0)
 	}
 
 	return r0
@@ -12,12 +12,12 @@ type UserRequestValidator struct {
 	mock.Mock
 }
 
-// ValidateCreateRequest provides a mock function with given fields: request
-func (_m *UserRequestValidator) ValidateCreateRequest(request model.CreateUserRequest) error {
+// ValidateChangePasswordRequest provides a mock function with given fields: request
+func (_m *UserRequestValidator) ValidateChangePasswordRequest(request model.ChangePasswordRequest) error {
 	ret := _m.Called(request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(model.CreateUserRequest) error); ok {
+	if rf, ok := ret.Get(0).(func(model.ChangePasswordRequest) error); ok {
 		r0 = rf(request)
 	} else {
 		r0 = ret.Error(0)
@@ -26,12 +26,12 @@ func (_m *UserRequestValidator) ValidateCreateRequest(request model.CreateUserRe
 	return r0
 }
 
-// ValidateUpdateRequest provides a mock function with given fields: request
-func (_m *UserRequestValidator) ValidateUpdateRequest(request model.UpdateUserRequest) error {
+// ValidateCreateRequest provides a mock function with given fields: request
+func (_m *UserRequestValidator) ValidateCreateRequest(request model.CreateUserRequest) error {
 	ret := _m.Called(request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(model.UpdateUserRequest) error); ok {
+	if rf, ok := ret.Get(0).(func(model.CreateUserRequest) error); ok {
 		r0 = rf(request)
 	} else {
 		r0 = ret.Error(0)
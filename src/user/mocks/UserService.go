@@ -35,13 +35,13 @@ func (_m *UserService) Add(request model.CreateUserRequest) (model.UserDto, erro
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *UserService) Delete(id uuid.UUID) error {
-	ret := _m.Called(id)
+// ChangePassword provides a mock function with given fields: id, request
+func (_m *UserService) ChangePassword(id uuid.UUID, request model.ChangePasswordRequest) error {
+	ret := _m.Called(id, request)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID, model.ChangePasswordRequest) error); ok {
+		r0 = rf(id, request)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -63,6 +63,27 @@ func (_m *UserService) ExistsById(id uuid.UUID) bool {
 	return r0
 }
 
+// FindByEmail provides a mock function with given fields: email
+func (_m *UserService) FindByEmail(email string) (model.UserDto, error) {
+	ret := _m.Called(email)
+
+	var r0 model.UserDto
+	if rf, ok := ret.Get(0).(func(string) model.UserDto); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Get(0).(model.UserDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindById provides a mock function with given fields: id
 func (_m *UserService) FindById(id uuid.UUID) (model.UserDto, error) {
 	ret := _m.Called(id)
@@ -84,6 +105,41 @@ func (_m *UserService) FindById(id uuid.UUID) (model.UserDto, error) {
 	return r0, r1
 }
 
+// FindTokenVersion provides a mock function with given fields: id
+func (_m *UserService) FindTokenVersion(id uuid.UUID) (int, error) {
+	ret := _m.Called(id)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(uuid.UUID) int); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetPassword provides a mock function with given fields: id, password
+func (_m *UserService) ResetPassword(id uuid.UUID, password string) error {
+	ret := _m.Called(id, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) error); ok {
+		r0 = rf(id, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: id, request
 func (_m *UserService) Update(id uuid.UUID, request model.UpdateUserRequest) error {
 	ret := _m.Called(id, request)
@@ -98,6 +154,20 @@ func (_m *UserService) Update(id uuid.UUID, request model.UpdateUserRequest) err
 	return r0
 }
 
+// VerifyPassword provides a mock function with given fields: id, password
+func (_m *UserService) VerifyPassword(id uuid.UUID, password string) error {
+	ret := _m.Called(id, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, string) error); ok {
+		r0 = rf(id, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // VerifyUser provides a mock function with given fields: email, password
 func (_m *UserService) VerifyUser(email string, password string) (model.UserDto, error) {
 	ret := _m.Called(email, password)
@@ -17,9 +17,16 @@ func GenerateCreateUserRequest() model.CreateUserRequest {
 
 func GenerateUpdateUserRequest() (uuid.UUID, model.UpdateUserRequest) {
 	return uuid.New(), model.UpdateUserRequest{
-		FirstName: "First Name New",
-		LastName:  "Last Name New",
-		Password:  "password-new",
+		FirstName:    "First Name New",
+		LastName:     "Last Name New",
+		BaseCurrency: "EUR",
+	}
+}
+
+func GenerateChangePasswordRequest() model.ChangePasswordRequest {
+	return model.ChangePasswordRequest{
+		CurrentPassword: "password",
+		NewPassword:     "password-new",
 	}
 }
 
@@ -8,15 +8,21 @@ type User struct {
 	Id        uuid.UUID `gorm:"primarykey"`
 	FirstName string
 	LastName  string
-	Password  []byte
-	Email     string `gorm:"unique"`
+	// Password is the bcrypt hash of the user password, legacy users could have it in plain text until the next sign in
+	Password []byte `json:"-"`
+	Email    string `gorm:"unique"`
+	// TokenVersion is incremented on every password change or reset, the tokens issued for the previous versions are revoked
+	TokenVersion int `gorm:"not null;default:0" json:"-"`
+	// BaseCurrency is the ISO 4217 code of the currency the totals are reported in, the totals are not converted if it is empty
+	BaseCurrency string
 }
 
 type UserDto struct {
-	Id        uuid.UUID
-	FirstName string
-	LastName  string
-	Email     string
+	Id           uuid.UUID
+	FirstName    string
+	LastName     string
+	Email        string
+	BaseCurrency string
 }
 
 type CreateUserRequest struct {
@@ -27,26 +33,46 @@ type CreateUserRequest struct {
 }
 
 type UpdateUserRequest struct {
-	FirstName string
-	LastName  string
-	Password  string
+	FirstName    string
+	LastName     string
+	BaseCurrency string
+}
+
+// ChangePasswordRequest replaces the password of the user, the current password is required
+type ChangePasswordRequest struct {
+	CurrentPassword string
+	NewPassword     string
 }
 
-func (u CreateUserRequest) ToEntity() User {
+// DeleteUserRequest confirms the deletion of the account with the current password
+type DeleteUserRequest struct {
+	Password string
+}
+
+func (u CreateUserRequest) ToEntity(password []byte) User {
 	return User{
 		Id:        uuid.New(),
 		FirstName: u.FirstName,
 		LastName:  u.LastName,
-		Password:  []byte(u.Password),
+		Password:  password,
 		Email:     u.Email,
 	}
 }
 
+func (u UpdateUserRequest) ToEntity() User {
+	return User{
+		FirstName:    u.FirstName,
+		LastName:     u.LastName,
+		BaseCurrency: u.BaseCurrency,
+	}
+}
+
 func (u User) ToDto() UserDto {
 	return UserDto{
-		Id:        u.Id,
-		FirstName: u.FirstName,
-		LastName:  u.LastName,
-		Email:     u.Email,
+		Id:           u.Id,
+		FirstName:    u.FirstName,
+		LastName:     u.LastName,
+		Email:        u.Email,
+		BaseCurrency: u.BaseCurrency,
 	}
 }
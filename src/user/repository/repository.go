@@ -5,6 +5,7 @@ import (
 	"github.com/VlasovArtem/hob/src/db"
 	"github.com/VlasovArtem/hob/src/user/model"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 var entity = model.User{}
@@ -36,8 +37,9 @@ type UserRepository interface {
 	FindByEmail(email string) (model.User, error)
 	ExistsById(id uuid.UUID) bool
 	ExistsByEmail(email string) bool
-	Verify(email string, password []byte) bool
-	Update(id uuid.UUID, user model.UpdateUserRequest) error
+	Update(id uuid.UUID, user model.User) error
+	UpdatePassword(id uuid.UUID, password []byte) error
+	ResetPassword(id uuid.UUID, password []byte) error
 	Delete(id uuid.UUID) error
 }
 
@@ -61,22 +63,30 @@ func (u *UserRepositoryObject) ExistsByEmail(email string) bool {
 	return u.database.ExistsBy("email = ?", email)
 }
 
-func (u *UserRepositoryObject) Verify(email string, password []byte) bool {
-	return u.database.ExistsByQuery(u.database.Model, "email = ? AND password = ?", email, password)
-}
-
 func (u *UserRepositoryObject) Delete(id uuid.UUID) error {
 	return u.database.Delete(id)
 }
 
-func (u *UserRepositoryObject) Update(id uuid.UUID, user model.UpdateUserRequest) error {
+func (u *UserRepositoryObject) Update(id uuid.UUID, user model.User) error {
 	return u.database.Update(id, struct {
-		FirstName string
-		LastName  string
-		Password  []byte
+		FirstName    string
+		LastName     string
+		BaseCurrency string
 	}{
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Password:  []byte(user.Password),
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		BaseCurrency: user.BaseCurrency,
 	})
 }
+
+func (u *UserRepositoryObject) UpdatePassword(id uuid.UUID, password []byte) error {
+	return u.database.Modeled().Where("id = ?", id).Update("password", password).Error
+}
+
+// ResetPassword replaces the password and increments the token version to revoke the tokens issued before
+func (u *UserRepositoryObject) ResetPassword(id uuid.UUID, password []byte) error {
+	return u.database.Modeled().Where("id = ?", id).Updates(map[string]any{
+		"password":      password,
+		"token_version": gorm.Expr("token_version + 1"),
+	}).Error
+}
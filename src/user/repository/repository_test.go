@@ -126,10 +126,11 @@ func (p *UserRepositoryTestSuite) Test_Delete() {
 func (p *UserRepositoryTestSuite) Test_Update() {
 	user := p.createUser()
 
-	updated := model.UpdateUserRequest{
-		FirstName: "New First Name",
-		LastName:  "New Last Name",
-		Password:  "new",
+	updated := model.User{
+		FirstName:    "New First Name",
+		LastName:     "New Last Name",
+		Password:     []byte("new"),
+		BaseCurrency: "EUR",
 	}
 	err := p.repository.Update(user.Id, updated)
 
@@ -137,30 +138,48 @@ func (p *UserRepositoryTestSuite) Test_Update() {
 	user1, err := p.repository.FindById(user.Id)
 	assert.Nil(p.T(), err)
 	assert.Equal(p.T(), model.User{
-		Id:        user.Id,
-		FirstName: "New First Name",
-		LastName:  "New Last Name",
-		Password:  []byte("new"),
-		Email:     user.Email,
+		Id:           user.Id,
+		FirstName:    "New First Name",
+		LastName:     "New Last Name",
+		Password:     user.Password,
+		Email:        user.Email,
+		BaseCurrency: "EUR",
 	}, user1)
 }
 
-func (p *UserRepositoryTestSuite) Test_Verify() {
+func (p *UserRepositoryTestSuite) Test_Update_WithoutPassword() {
 	user := p.createUser()
 
-	assert.True(p.T(), p.repository.Verify(user.Email, user.Password))
+	err := p.repository.Update(user.Id, model.User{FirstName: "New First Name"})
+
+	assert.Nil(p.T(), err)
+	actual, err := p.repository.FindById(user.Id)
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), "New First Name", actual.FirstName)
+	assert.Equal(p.T(), user.Password, actual.Password)
 }
 
-func (p *UserRepositoryTestSuite) Test_Verify_WithNotFoundEmail() {
+func (p *UserRepositoryTestSuite) Test_UpdatePassword() {
 	user := p.createUser()
 
-	assert.False(p.T(), p.repository.Verify("mail@mail.com", user.Password))
+	err := p.repository.UpdatePassword(user.Id, []byte("hash"))
+
+	assert.Nil(p.T(), err)
+	actual, err := p.repository.FindById(user.Id)
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), []byte("hash"), actual.Password)
 }
 
-func (p *UserRepositoryTestSuite) Test_Verify_WithNotFoundPassword() {
+func (p *UserRepositoryTestSuite) Test_ResetPassword() {
 	user := p.createUser()
 
-	assert.False(p.T(), p.repository.Verify(user.Email, []byte("invalid")))
+	err := p.repository.ResetPassword(user.Id, []byte("hash"))
+
+	assert.Nil(p.T(), err)
+	actual, err := p.repository.FindById(user.Id)
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), []byte("hash"), actual.Password)
+	assert.Equal(p.T(), user.TokenVersion+1, actual.TokenVersion)
 }
 
 func (p *UserRepositoryTestSuite) createUser() model.User {
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"github.com/VlasovArtem/hob/src/user/reset/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+const Token = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func GeneratePasswordReset(userId uuid.UUID) model.PasswordReset {
+	return model.NewPasswordReset(userId, Token, time.Now())
+}
+
+func GenerateResetPasswordRequest() model.ResetPasswordRequest {
+	return model.ResetPasswordRequest{
+		Token:    Token,
+		Password: "password-new",
+	}
+}
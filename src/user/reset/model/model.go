@@ -0,0 +1,51 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/google/uuid"
+	"time"
+)
+
+// TokenTTL is the lifetime of the reset token
+const TokenTTL = time.Hour
+
+// PasswordReset is the request of the user to reset the forgotten password, only the hash of the token is stored
+type PasswordReset struct {
+	Id        uuid.UUID `gorm:"primarykey"`
+	UserId    uuid.UUID
+	Hash      string `gorm:"uniqueIndex"`
+	ExpiresAt time.Time
+	User      userModel.User `gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+}
+
+// PasswordResetRequest starts the reset, the token is delivered to the user by the notifier
+type PasswordResetRequest struct {
+	Email string
+}
+
+// ResetPasswordRequest sets the new password with the token received by the user
+type ResetPasswordRequest struct {
+	Token    string
+	Password string
+}
+
+func NewPasswordReset(userId uuid.UUID, token string, now time.Time) PasswordReset {
+	return PasswordReset{
+		Id:        uuid.New(),
+		UserId:    userId,
+		Hash:      Hash(token),
+		ExpiresAt: now.Add(TokenTTL),
+	}
+}
+
+func (p PasswordReset) IsExpired(now time.Time) bool {
+	return !now.Before(p.ExpiresAt)
+}
+
+// Hash returns the representation of the token that is stored in the database
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/user/reset/model"
+	"github.com/google/uuid"
+)
+
+var entity = model.PasswordReset{}
+
+type PasswordResetRepositoryObject struct {
+	database db.ModeledDatabase
+}
+
+func NewPasswordResetRepository(database db.DatabaseService) PasswordResetRepository {
+	return &PasswordResetRepositoryObject{
+		db.ModeledDatabase{
+			DatabaseService: database,
+			Model:           entity,
+		},
+	}
+}
+
+func (p *PasswordResetRepositoryObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewPasswordResetRepository(dependency.FindRequiredDependency[db.DatabaseObject, db.DatabaseService](factory))
+}
+
+func (p *PasswordResetRepositoryObject) GetEntity() any {
+	return entity
+}
+
+type PasswordResetRepository interface {
+	Create(entity model.PasswordReset) (model.PasswordReset, error)
+	FindByHash(hash string) (model.PasswordReset, error)
+	DeleteByUserId(userId uuid.UUID) error
+}
+
+func (p *PasswordResetRepositoryObject) Create(entity model.PasswordReset) (model.PasswordReset, error) {
+	return entity, p.database.Create(&entity)
+}
+
+func (p *PasswordResetRepositoryObject) FindByHash(hash string) (response model.PasswordReset, err error) {
+	return response, p.database.FirstBy(&response, "hash = ?", hash)
+}
+
+func (p *PasswordResetRepositoryObject) DeleteByUserId(userId uuid.UUID) error {
+	return p.database.D().
+		Where("user_id = ?", userId).
+		Delete(&model.PasswordReset{}).
+		Error
+}
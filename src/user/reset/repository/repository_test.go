@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"github.com/VlasovArtem/hob/src/db"
+	"github.com/VlasovArtem/hob/src/test/testhelper/database"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/VlasovArtem/hob/src/user/reset/mocks"
+	"github.com/VlasovArtem/hob/src/user/reset/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"testing"
+)
+
+type PasswordResetRepositoryTestSuite struct {
+	database.DBTestSuite
+	repository  PasswordResetRepository
+	createdUser userModel.User
+}
+
+func (p *PasswordResetRepositoryTestSuite) SetupSuite() {
+	p.InitDBTestSuite()
+
+	p.CreateRepository(
+		func(service db.DatabaseService) {
+			p.repository = NewPasswordResetRepository(service)
+		},
+	).
+		AddAfterTest(func(service db.DatabaseService) {
+			database.TruncateTable(service, model.PasswordReset{})
+		}).
+		AddAfterSuite(func(service db.DatabaseService) {
+			database.TruncateTable(service, userModel.User{})
+		}).
+		ExecuteMigration(userModel.User{}, model.PasswordReset{})
+
+	p.createdUser = userMocks.GenerateUser()
+	p.CreateEntity(&p.createdUser)
+}
+
+func TestPasswordResetRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordResetRepositoryTestSuite))
+}
+
+func (p *PasswordResetRepositoryTestSuite) Test_Create() {
+	passwordReset := mocks.GeneratePasswordReset(p.createdUser.Id)
+
+	actual, err := p.repository.Create(passwordReset)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), passwordReset, actual)
+}
+
+func (p *PasswordResetRepositoryTestSuite) Test_FindByHash() {
+	passwordReset := p.createPasswordReset()
+
+	actual, err := p.repository.FindByHash(passwordReset.Hash)
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), passwordReset.Id, actual.Id)
+	assert.Equal(p.T(), p.createdUser.Id, actual.UserId)
+}
+
+func (p *PasswordResetRepositoryTestSuite) Test_FindByHash_WithMissingToken() {
+	_, err := p.repository.FindByHash(model.Hash("missing"))
+
+	assert.ErrorIs(p.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (p *PasswordResetRepositoryTestSuite) Test_DeleteByUserId() {
+	passwordReset := p.createPasswordReset()
+
+	err := p.repository.DeleteByUserId(p.createdUser.Id)
+
+	assert.Nil(p.T(), err)
+
+	_, err = p.repository.FindByHash(passwordReset.Hash)
+
+	assert.ErrorIs(p.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (p *PasswordResetRepositoryTestSuite) createPasswordReset() model.PasswordReset {
+	passwordReset := mocks.GeneratePasswordReset(p.createdUser.Id)
+
+	p.CreateEntity(&passwordReset)
+
+	return passwordReset
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/VlasovArtem/hob/src/common/dependency"
+	interrors "github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/notification"
+	"github.com/VlasovArtem/hob/src/user/reset/model"
+	"github.com/VlasovArtem/hob/src/user/reset/repository"
+	userService "github.com/VlasovArtem/hob/src/user/service"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+// tokenLength is the number of the random bytes in the reset token
+const tokenLength = 32
+
+type PasswordResetServiceObject struct {
+	userService userService.UserService
+	repository  repository.PasswordResetRepository
+	notifier    notification.Notifier
+}
+
+func NewPasswordResetService(
+	userService userService.UserService,
+	repository repository.PasswordResetRepository,
+	notifier notification.Notifier,
+) PasswordResetService {
+	return &PasswordResetServiceObject{
+		userService: userService,
+		repository:  repository,
+		notifier:    notifier,
+	}
+}
+
+func (p *PasswordResetServiceObject) Initialize(factory dependency.DependenciesProvider) any {
+	return NewPasswordResetService(
+		dependency.FindRequiredDependency[userService.UserServiceObject, userService.UserService](factory),
+		dependency.FindRequiredDependency[repository.PasswordResetRepositoryObject, repository.PasswordResetRepository](factory),
+		dependency.FindRequiredDependency[notification.NotifierObject, notification.Notifier](factory),
+	)
+}
+
+type PasswordResetService interface {
+	Request(request model.PasswordResetRequest) error
+	Reset(request model.ResetPasswordRequest) error
+}
+
+// Request sends the reset token to the user, the missing user is not reported to not disclose the registered emails
+func (p *PasswordResetServiceObject) Request(request model.PasswordResetRequest) error {
+	user, err := p.userService.FindByEmail(request.Email)
+	if err != nil {
+		if errors.Is(err, interrors.ErrNotFound{}) {
+			log.Debug().Msgf("password reset for the missing user %s is requested", request.Email)
+			return nil
+		}
+		return err
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return err
+	}
+
+	if err = p.repository.DeleteByUserId(user.Id); err != nil {
+		return err
+	}
+
+	entity, err := p.repository.Create(model.NewPasswordReset(user.Id, token, time.Now()))
+	if err != nil {
+		return err
+	}
+
+	return p.notifier.Notify(notification.Notification{
+		Recipient: user.Email,
+		Subject:   "Password reset",
+		Body:      fmt.Sprintf("Use the token %s to reset the password, the token expires at %s", token, entity.ExpiresAt.Format(time.RFC3339)),
+	})
+}
+
+// Reset sets the new password of the user, the token is not valid after the reset
+func (p *PasswordResetServiceObject) Reset(request model.ResetPasswordRequest) error {
+	entity, err := p.repository.FindByHash(model.Hash(request.Token))
+	if err != nil || entity.IsExpired(time.Now()) {
+		return interrors.NewErrUnauthorized("reset token is not valid")
+	}
+
+	if err = p.userService.ResetPassword(entity.UserId, request.Password); err != nil {
+		return err
+	}
+
+	if err = p.repository.DeleteByUserId(entity.UserId); err != nil {
+		log.Error().Err(err).Msgf("reset tokens of the user %s are not deleted", entity.UserId)
+	}
+
+	return nil
+}
+
+func generateToken() (string, error) {
+	bytes := make([]byte, tokenLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"errors"
+	"github.com/VlasovArtem/hob/src/common/int-errors"
+	"github.com/VlasovArtem/hob/src/notification"
+	notificationMocks "github.com/VlasovArtem/hob/src/notification/mocks"
+	"github.com/VlasovArtem/hob/src/test/testhelper"
+	userMocks "github.com/VlasovArtem/hob/src/user/mocks"
+	userModel "github.com/VlasovArtem/hob/src/user/model"
+	"github.com/VlasovArtem/hob/src/user/reset/mocks"
+	"github.com/VlasovArtem/hob/src/user/reset/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+	"strings"
+	"testing"
+	"time"
+)
+
+type PasswordResetServiceTestSuite struct {
+	testhelper.MockTestSuite[PasswordResetService]
+	userService             *userMocks.UserService
+	passwordResetRepository *mocks.PasswordResetRepository
+	notifier                *notificationMocks.Notifier
+}
+
+func TestPasswordResetServiceTestSuite(t *testing.T) {
+	ts := &PasswordResetServiceTestSuite{}
+	ts.TestObjectGenerator = func() PasswordResetService {
+		ts.userService = new(userMocks.UserService)
+		ts.passwordResetRepository = new(mocks.PasswordResetRepository)
+		ts.notifier = new(notificationMocks.Notifier)
+
+		return NewPasswordResetService(ts.userService, ts.passwordResetRepository, ts.notifier)
+	}
+
+	suite.Run(t, ts)
+}
+
+func (p *PasswordResetServiceTestSuite) Test_Request() {
+	user := userMocks.GenerateUserResponse()
+
+	var created model.PasswordReset
+	var sent notification.Notification
+
+	p.userService.On("FindByEmail", user.Email).Return(user, nil)
+	p.passwordResetRepository.On("DeleteByUserId", user.Id).Return(nil)
+	p.passwordResetRepository.On("Create", mock.Anything).Return(
+		func(entity model.PasswordReset) model.PasswordReset {
+			created = entity
+			return entity
+		}, nil)
+	p.notifier.On("Notify", mock.Anything).Return(
+		func(notification notification.Notification) error {
+			sent = notification
+			return nil
+		})
+
+	err := p.TestO.Request(model.PasswordResetRequest{Email: user.Email})
+
+	assert.Nil(p.T(), err)
+	assert.Equal(p.T(), user.Id, created.UserId)
+	assert.False(p.T(), created.IsExpired(time.Now()))
+	assert.Equal(p.T(), user.Email, sent.Recipient)
+
+	token := strings.Fields(sent.Body)[3]
+
+	assert.Equal(p.T(), model.Hash(token), created.Hash)
+}
+
+func (p *PasswordResetServiceTestSuite) Test_Request_WithMissingUser() {
+	p.userService.On("FindByEmail", "missing@mail.com").Return(userModel.UserDto{}, int_errors.NewErrNotFound("user with email %s not found", "missing@mail.com"))
+
+	err := p.TestO.Request(model.PasswordResetRequest{Email: "missing@mail.com"})
+
+	assert.Nil(p.T(), err)
+	p.passwordResetRepository.AssertNotCalled(p.T(), "Create", mock.Anything)
+	p.notifier.AssertNotCalled(p.T(), "Notify", mock.Anything)
+}
+
+func (p *PasswordResetServiceTestSuite) Test_Request_WithErrorFromUserService() {
+	expectedError := errors.New("error")
+
+	p.userService.On("FindByEmail", "mail@mail.com").Return(userModel.UserDto{}, expectedError)
+
+	err := p.TestO.Request(model.PasswordResetRequest{Email: "mail@mail.com"})
+
+	assert.Equal(p.T(), expectedError, err)
+	p.notifier.AssertNotCalled(p.T(), "Notify", mock.Anything)
+}
+
+func (p *PasswordResetServiceTestSuite) Test_Request_WithErrorFromRepository() {
+	user := userMocks.GenerateUserResponse()
+	expectedError := errors.New("error")
+
+	p.userService.On("FindByEmail", user.Email).Return(user, nil)
+	p.passwordResetRepository.On("DeleteByUserId", user.Id).Return(nil)
+	p.passwordResetRepository.On("Create", mock.Anything).Return(model.PasswordReset{}, expectedError)
+
+	err := p.TestO.Request(model.PasswordResetRequest{Email: user.Email})
+
+	assert.Equal(p.T(), expectedError, err)
+	p.notifier.AssertNotCalled(p.T(), "Notify", mock.Anything)
+}
+
+func (p *PasswordResetServiceTestSuite) Test_Reset() {
+	passwordReset := mocks.GeneratePasswordReset(uuid.New())
+	request := mocks.GenerateResetPasswordRequest()
+
+	p.passwordResetRepository.On("FindByHash", model.Hash(request.Token)).Return(passwordReset, nil)
+	p.userService.On("ResetPassword", passwordReset.UserId, request.Password).Return(nil)
+	p.passwordResetRepository.On("DeleteByUserId", passwordReset.UserId).Return(nil)
+
+	assert.Nil(p.T(), p.TestO.Reset(request))
+	p.passwordResetRepository.AssertCalled(p.T(), "DeleteByUserId", passwordReset.UserId)
+}
+
+func (p *PasswordResetServiceTestSuite) Test_Reset_WithUnknownToken() {
+	request := mocks.GenerateResetPasswordRequest()
+
+	p.passwordResetRepository.On("FindByHash", model.Hash(request.Token)).Return(model.PasswordReset{}, gorm.ErrRecordNotFound)
+
+	err := p.TestO.Reset(request)
+
+	assert.Equal(p.T(), int_errors.NewErrUnauthorized("reset token is not valid"), err)
+	p.userService.AssertNotCalled(p.T(), "ResetPassword", mock.Anything, mock.Anything)
+}
+
+func (p *PasswordResetServiceTestSuite) Test_Reset_WithExpiredToken() {
+	passwordReset := model.NewPasswordReset(uuid.New(), mocks.Token, time.Now().Add(-model.TokenTTL))
+	request := mocks.GenerateResetPasswordRequest()
+
+	p.passwordResetRepository.On("FindByHash", model.Hash(request.Token)).Return(passwordReset, nil)
+
+	err := p.TestO.Reset(request)
+
+	assert.Equal(p.T(), int_errors.NewErrUnauthorized("reset token is not valid"), err)
+	p.userService.AssertNotCalled(p.T(), "ResetPassword", mock.Anything, mock.Anything)
+}
+
+func (p *PasswordResetServiceTestSuite) Test_Reset_WithErrorFromUserService() {
+	passwordReset := mocks.GeneratePasswordReset(uuid.New())
+	request := mocks.GenerateResetPasswordRequest()
+	expectedError := errors.New("password is missing")
+
+	p.passwordResetRepository.On("FindByHash", model.Hash(request.Token)).Return(passwordReset, nil)
+	p.userService.On("ResetPassword", passwordReset.UserId, request.Password).Return(expectedError)
+
+	err := p.TestO.Reset(request)
+
+	assert.Equal(p.T(), expectedError, err)
+	p.passwordResetRepository.AssertNotCalled(p.T(), "DeleteByUserId", mock.Anything)
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"crypto/subtle"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// hashPassword returns the salted bcrypt hash of the password
+func hashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+}
+
+// comparePassword checks the password against the stored one, legacy is true if the stored password is in plain text
+func comparePassword(stored []byte, password string) (matched bool, legacy bool) {
+	if _, err := bcrypt.Cost(stored); err == nil {
+		return bcrypt.CompareHashAndPassword(stored, []byte(password)) == nil, false
+	}
+	return subtle.ConstantTimeCompare(stored, []byte(password)) == 1, true
+}
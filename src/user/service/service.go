@@ -6,29 +6,41 @@ import (
 	"github.com/VlasovArtem/hob/src/common/database"
 	"github.com/VlasovArtem/hob/src/common/dependency"
 	"github.com/VlasovArtem/hob/src/common/int-errors"
+	countries "github.com/VlasovArtem/hob/src/country/service"
 	"github.com/VlasovArtem/hob/src/user/model"
 	"github.com/VlasovArtem/hob/src/user/repository"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"strings"
 )
 
 type UserServiceObject struct {
-	repository repository.UserRepository
+	countriesService countries.CountryService
+	repository       repository.UserRepository
 }
 
 func (u *UserServiceObject) Initialize(factory dependency.DependenciesProvider) any {
-	return NewUserService(dependency.FindRequiredDependency[repository.UserRepositoryObject, repository.UserRepository](factory))
+	return NewUserService(
+		dependency.FindRequiredDependency[countries.CountryServiceObject, countries.CountryService](factory),
+		dependency.FindRequiredDependency[repository.UserRepositoryObject, repository.UserRepository](factory),
+	)
 }
 
-func NewUserService(repository repository.UserRepository) UserService {
-	return &UserServiceObject{repository}
+func NewUserService(countriesService countries.CountryService, repository repository.UserRepository) UserService {
+	return &UserServiceObject{countriesService, repository}
 }
 
 type UserService interface {
 	Add(request model.CreateUserRequest) (model.UserDto, error)
 	Update(id uuid.UUID, request model.UpdateUserRequest) error
-	Delete(id uuid.UUID) error
+	ChangePassword(id uuid.UUID, request model.ChangePasswordRequest) error
+	VerifyPassword(id uuid.UUID, password string) error
+	ResetPassword(id uuid.UUID, password string) error
 	FindById(id uuid.UUID) (model.UserDto, error)
+	FindByEmail(email string) (model.UserDto, error)
 	ExistsById(id uuid.UUID) bool
+	FindTokenVersion(id uuid.UUID) (int, error)
 	VerifyUser(email string, password string) (model.UserDto, error)
 }
 
@@ -43,7 +55,12 @@ func (u *UserServiceObject) Add(request model.CreateUserRequest) (response model
 		return response, errors.New(fmt.Sprintf("password is missing"))
 	}
 
-	if user, err := u.repository.Create(request.ToEntity()); err != nil {
+	password, err := hashPassword(request.Password)
+	if err != nil {
+		return response, err
+	}
+
+	if user, err := u.repository.Create(request.ToEntity(password)); err != nil {
 		return response, err
 	} else {
 		return user.ToDto(), err
@@ -54,11 +71,52 @@ func (u *UserServiceObject) Update(id uuid.UUID, request model.UpdateUserRequest
 	if !u.ExistsById(id) {
 		return int_errors.NewErrNotFound("user with id %s not found", id)
 	}
-	return u.repository.Update(id, request)
+	if request.BaseCurrency != "" {
+		request.BaseCurrency = strings.ToUpper(strings.TrimSpace(request.BaseCurrency))
+
+		if _, err := u.countriesService.FindCurrencyByCode(request.BaseCurrency); err != nil {
+			return err
+		}
+	}
+
+	return u.repository.Update(id, request.ToEntity())
+}
+
+// ChangePassword replaces the password of the user if the current password matches
+func (u *UserServiceObject) ChangePassword(id uuid.UUID, request model.ChangePasswordRequest) error {
+	if err := u.VerifyPassword(id, request.CurrentPassword); err != nil {
+		return err
+	}
+
+	return u.ResetPassword(id, request.NewPassword)
+}
+
+// VerifyPassword checks that the password matches the current password of the user
+func (u *UserServiceObject) VerifyPassword(id uuid.UUID, password string) error {
+	user, err := u.repository.FindById(id)
+	if err != nil {
+		return database.HandlerFindError(err, "user with id %s not found", id)
+	}
+
+	if matched, _ := comparePassword(user.Password, password); !matched {
+		return errors.New("current password is not valid")
+	}
+
+	return nil
 }
 
-func (u *UserServiceObject) Delete(id uuid.UUID) error {
-	return u.repository.Delete(id)
+// ResetPassword replaces the password of the user without the verification of the current password
+func (u *UserServiceObject) ResetPassword(id uuid.UUID, password string) error {
+	if password == "" {
+		return errors.New("password is missing")
+	}
+
+	hashed, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return u.repository.ResetPassword(id, hashed)
 }
 
 func (u *UserServiceObject) FindById(id uuid.UUID) (response model.UserDto, err error) {
@@ -69,18 +127,49 @@ func (u *UserServiceObject) FindById(id uuid.UUID) (response model.UserDto, err
 	}
 }
 
+func (u *UserServiceObject) FindByEmail(email string) (response model.UserDto, err error) {
+	if user, err := u.repository.FindByEmail(email); err != nil {
+		return response, database.HandlerFindError(err, "user with email %s not found", email)
+	} else {
+		return user.ToDto(), err
+	}
+}
+
 func (u *UserServiceObject) ExistsById(id uuid.UUID) bool {
 	return u.repository.ExistsById(id)
 }
 
-func (u *UserServiceObject) VerifyUser(email string, password string) (response model.UserDto, err error) {
-	if !u.repository.Verify(email, []byte(password)) {
-		return response, errors.New("credentials are not valid")
+// FindTokenVersion returns the version of the tokens of the user, it changes on every password change or reset
+func (u *UserServiceObject) FindTokenVersion(id uuid.UUID) (int, error) {
+	if user, err := u.repository.FindById(id); err != nil {
+		return 0, database.HandlerFindError(err, "user with id %s not found", id)
+	} else {
+		return user.TokenVersion, nil
 	}
+}
 
-	if user, err := u.repository.FindByEmail(email); err != nil {
+// VerifyUser checks the user credentials, the legacy plain text password is replaced with the hash on the successful verification
+func (u *UserServiceObject) VerifyUser(email string, password string) (response model.UserDto, err error) {
+	user, err := u.repository.FindByEmail(email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response, int_errors.NewErrUnauthorized("credentials are not valid")
+		}
 		return response, err
-	} else {
-		return user.ToDto(), err
 	}
+
+	matched, legacy := comparePassword(user.Password, password)
+	if !matched {
+		return response, int_errors.NewErrUnauthorized("credentials are not valid")
+	}
+
+	if legacy {
+		if hashed, err := hashPassword(password); err != nil {
+			log.Error().Err(err).Msgf("password of the user %s is not hashed", user.Id)
+		} else if err = u.repository.UpdatePassword(user.Id, hashed); err != nil {
+			log.Error().Err(err).Msgf("password of the user %s is not upgraded", user.Id)
+		}
+	}
+
+	return user.ToDto(), nil
 }
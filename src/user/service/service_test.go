@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 	"testing"
 )
@@ -25,7 +26,7 @@ func TestUserServiceTestSuite(t *testing.T) {
 	ts.TestObjectGenerator = func() UserService {
 		ts.userRepository = new(mocks.UserRepository)
 
-		return NewUserService(ts.userRepository)
+		return NewUserService(testhelper.InitCountryService(), ts.userRepository)
 	}
 
 	suite.Run(t, ts)
@@ -47,6 +48,8 @@ func (u *UserServiceTestSuite) Test_Add() {
 
 	assert.Nil(u.T(), err)
 	assert.Equal(u.T(), expected.ToDto(), response)
+	assert.NotEqual(u.T(), []byte(createUserRequest.Password), expected.Password)
+	assert.Nil(u.T(), bcrypt.CompareHashAndPassword(expected.Password, []byte(createUserRequest.Password)))
 }
 
 func (u *UserServiceTestSuite) Test_Add_WithExistingEmail() {
@@ -70,6 +73,61 @@ func (u *UserServiceTestSuite) Test_Update() {
 	err := u.TestO.Update(id, request)
 
 	assert.Nil(u.T(), err)
+
+	updated := u.userRepository.Calls[1].Arguments.Get(1).(model.User)
+
+	assert.Equal(u.T(), request.FirstName, updated.FirstName)
+	assert.Equal(u.T(), request.LastName, updated.LastName)
+	assert.Equal(u.T(), request.BaseCurrency, updated.BaseCurrency)
+	assert.Nil(u.T(), updated.Password)
+}
+
+func (u *UserServiceTestSuite) Test_Update_WithoutPassword() {
+	id := uuid.New()
+	request := model.UpdateUserRequest{FirstName: "New First Name"}
+
+	u.userRepository.On("ExistsById", id).Return(true)
+	u.userRepository.On("Update", id, mock.Anything).Return(nil)
+
+	err := u.TestO.Update(id, request)
+
+	assert.Nil(u.T(), err)
+
+	updated := u.userRepository.Calls[1].Arguments.Get(1).(model.User)
+
+	assert.Equal(u.T(), "New First Name", updated.FirstName)
+	assert.Nil(u.T(), updated.Password)
+	u.userRepository.AssertNotCalled(u.T(), "UpdatePassword", id, mock.Anything)
+	u.userRepository.AssertNotCalled(u.T(), "ResetPassword", id, mock.Anything)
+}
+
+func (u *UserServiceTestSuite) Test_Update_WithLowerCaseBaseCurrency() {
+	id, request := mocks.GenerateUpdateUserRequest()
+	request.BaseCurrency = "usd"
+
+	u.userRepository.On("ExistsById", id).Return(true)
+	u.userRepository.On("Update", id, mock.Anything).Return(nil)
+
+	err := u.TestO.Update(id, request)
+
+	assert.Nil(u.T(), err)
+
+	updated := u.userRepository.Calls[1].Arguments.Get(1).(model.User)
+
+	assert.Equal(u.T(), "USD", updated.BaseCurrency)
+}
+
+func (u *UserServiceTestSuite) Test_Update_WithNotSupportedBaseCurrency() {
+	id, request := mocks.GenerateUpdateUserRequest()
+	request.BaseCurrency = "XXX"
+
+	u.userRepository.On("ExistsById", id).Return(true)
+
+	err := u.TestO.Update(id, request)
+
+	assert.Equal(u.T(), int_errors.NewErrNotFound("currency with code XXX is not found"), err)
+
+	u.userRepository.AssertNotCalled(u.T(), "Update", id, mock.Anything)
 }
 
 func (u *UserServiceTestSuite) Test_Update_WithNotExists() {
@@ -84,6 +142,139 @@ func (u *UserServiceTestSuite) Test_Update_WithNotExists() {
 	u.userRepository.AssertNotCalled(u.T(), "Update", id, mock.Anything)
 }
 
+func (u *UserServiceTestSuite) Test_ChangePassword() {
+	user := mocks.GenerateUser()
+	request := mocks.GenerateChangePasswordRequest()
+
+	u.userRepository.On("FindById", user.Id).Return(user, nil)
+	u.userRepository.On("ResetPassword", user.Id, mock.Anything).Return(nil)
+
+	err := u.TestO.ChangePassword(user.Id, request)
+
+	assert.Nil(u.T(), err)
+
+	updated := u.userRepository.Calls[1].Arguments.Get(1).([]byte)
+
+	assert.Nil(u.T(), bcrypt.CompareHashAndPassword(updated, []byte(request.NewPassword)))
+}
+
+func (u *UserServiceTestSuite) Test_ChangePassword_WithHashedPassword() {
+	user := mocks.GenerateUser()
+	user.Password, _ = bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	request := mocks.GenerateChangePasswordRequest()
+
+	u.userRepository.On("FindById", user.Id).Return(user, nil)
+	u.userRepository.On("ResetPassword", user.Id, mock.Anything).Return(nil)
+
+	assert.Nil(u.T(), u.TestO.ChangePassword(user.Id, request))
+}
+
+func (u *UserServiceTestSuite) Test_ChangePassword_WithInvalidCurrentPassword() {
+	user := mocks.GenerateUser()
+	request := mocks.GenerateChangePasswordRequest()
+	request.CurrentPassword = "invalid"
+
+	u.userRepository.On("FindById", user.Id).Return(user, nil)
+
+	err := u.TestO.ChangePassword(user.Id, request)
+
+	assert.Equal(u.T(), errors.New("current password is not valid"), err)
+	u.userRepository.AssertNotCalled(u.T(), "ResetPassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserServiceTestSuite) Test_ChangePassword_WithMissingNewPassword() {
+	user := mocks.GenerateUser()
+	request := mocks.GenerateChangePasswordRequest()
+	request.NewPassword = ""
+
+	u.userRepository.On("FindById", user.Id).Return(user, nil)
+
+	err := u.TestO.ChangePassword(user.Id, request)
+
+	assert.Equal(u.T(), errors.New("password is missing"), err)
+	u.userRepository.AssertNotCalled(u.T(), "ResetPassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserServiceTestSuite) Test_VerifyPassword() {
+	user := mocks.GenerateUser()
+	user.Password, _ = bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+
+	u.userRepository.On("FindById", user.Id).Return(user, nil)
+
+	assert.Nil(u.T(), u.TestO.VerifyPassword(user.Id, "password"))
+}
+
+func (u *UserServiceTestSuite) Test_VerifyPassword_WithInvalidPassword() {
+	user := mocks.GenerateUser()
+	user.Password, _ = bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+
+	u.userRepository.On("FindById", user.Id).Return(user, nil)
+
+	assert.Equal(u.T(), errors.New("current password is not valid"), u.TestO.VerifyPassword(user.Id, ""))
+}
+
+func (u *UserServiceTestSuite) Test_VerifyPassword_WithNotExists() {
+	id := uuid.New()
+
+	u.userRepository.On("FindById", id).Return(model.User{}, gorm.ErrRecordNotFound)
+
+	assert.Equal(u.T(), int_errors.NewErrNotFound("user with id %s not found", id), u.TestO.VerifyPassword(id, "password"))
+}
+
+func (u *UserServiceTestSuite) Test_ChangePassword_WithNotExists() {
+	id := uuid.New()
+
+	u.userRepository.On("FindById", id).Return(model.User{}, gorm.ErrRecordNotFound)
+
+	err := u.TestO.ChangePassword(id, mocks.GenerateChangePasswordRequest())
+
+	assert.Equal(u.T(), int_errors.NewErrNotFound("user with id %s not found", id), err)
+}
+
+func (u *UserServiceTestSuite) Test_ResetPassword() {
+	id := uuid.New()
+
+	u.userRepository.On("ResetPassword", id, mock.Anything).Return(nil)
+
+	err := u.TestO.ResetPassword(id, "password-new")
+
+	assert.Nil(u.T(), err)
+
+	updated := u.userRepository.Calls[0].Arguments.Get(1).([]byte)
+
+	assert.Nil(u.T(), bcrypt.CompareHashAndPassword(updated, []byte("password-new")))
+}
+
+func (u *UserServiceTestSuite) Test_ResetPassword_WithMissingPassword() {
+	err := u.TestO.ResetPassword(uuid.New(), "")
+
+	assert.Equal(u.T(), errors.New("password is missing"), err)
+	u.userRepository.AssertNotCalled(u.T(), "ResetPassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserServiceTestSuite) Test_FindTokenVersion() {
+	user := mocks.GenerateUser()
+	user.TokenVersion = 2
+
+	u.userRepository.On("FindById", user.Id).Return(user, nil)
+
+	version, err := u.TestO.FindTokenVersion(user.Id)
+
+	assert.Nil(u.T(), err)
+	assert.Equal(u.T(), 2, version)
+}
+
+func (u *UserServiceTestSuite) Test_FindTokenVersion_WithNotExists() {
+	id := uuid.New()
+
+	u.userRepository.On("FindById", id).Return(model.User{}, gorm.ErrRecordNotFound)
+
+	version, err := u.TestO.FindTokenVersion(id)
+
+	assert.Equal(u.T(), int_errors.NewErrNotFound("user with id %s not found", id), err)
+	assert.Equal(u.T(), 0, version)
+}
+
 func (u *UserServiceTestSuite) Test_FindById() {
 	user := mocks.GenerateUser()
 
@@ -118,6 +309,26 @@ func (u *UserServiceTestSuite) Test_FindById_WithError() {
 	assert.Equal(u.T(), model.UserDto{}, response)
 }
 
+func (u *UserServiceTestSuite) Test_FindByEmail() {
+	user := mocks.GenerateUser()
+
+	u.userRepository.On("FindByEmail", user.Email).Return(user, nil)
+
+	response, err := u.TestO.FindByEmail(user.Email)
+
+	assert.Nil(u.T(), err)
+	assert.Equal(u.T(), user.ToDto(), response)
+}
+
+func (u *UserServiceTestSuite) Test_FindByEmail_WithNotExistsUser() {
+	u.userRepository.On("FindByEmail", "missing@mail.com").Return(model.User{}, gorm.ErrRecordNotFound)
+
+	response, err := u.TestO.FindByEmail("missing@mail.com")
+
+	assert.Equal(u.T(), int_errors.NewErrNotFound("user with email %s not found", "missing@mail.com"), err)
+	assert.Equal(u.T(), model.UserDto{}, response)
+}
+
 func (u *UserServiceTestSuite) Test_ExistsById() {
 	id := uuid.New()
 
@@ -133,3 +344,89 @@ func (u *UserServiceTestSuite) Test_ExistsById_WithoutUser() {
 
 	assert.False(u.T(), u.TestO.ExistsById(id))
 }
+
+func (u *UserServiceTestSuite) Test_VerifyUser() {
+	user := mocks.GenerateUser()
+	user.Password, _ = bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+
+	u.userRepository.On("FindByEmail", user.Email).Return(user, nil)
+
+	actual, err := u.TestO.VerifyUser(user.Email, "password")
+
+	assert.Nil(u.T(), err)
+	assert.Equal(u.T(), user.ToDto(), actual)
+	u.userRepository.AssertNotCalled(u.T(), "UpdatePassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserServiceTestSuite) Test_VerifyUser_WithInvalidPassword() {
+	user := mocks.GenerateUser()
+	user.Password, _ = bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+
+	u.userRepository.On("FindByEmail", user.Email).Return(user, nil)
+
+	actual, err := u.TestO.VerifyUser(user.Email, "invalid")
+
+	assert.Equal(u.T(), int_errors.NewErrUnauthorized("credentials are not valid"), err)
+	assert.Equal(u.T(), model.UserDto{}, actual)
+}
+
+func (u *UserServiceTestSuite) Test_VerifyUser_WithNotExistingEmail() {
+	u.userRepository.On("FindByEmail", "mail@mail.com").Return(model.User{}, gorm.ErrRecordNotFound)
+
+	actual, err := u.TestO.VerifyUser("mail@mail.com", "password")
+
+	assert.Equal(u.T(), int_errors.NewErrUnauthorized("credentials are not valid"), err)
+	assert.Equal(u.T(), model.UserDto{}, actual)
+}
+
+func (u *UserServiceTestSuite) Test_VerifyUser_WithError() {
+	expectedError := errors.New("error")
+
+	u.userRepository.On("FindByEmail", "mail@mail.com").Return(model.User{}, expectedError)
+
+	actual, err := u.TestO.VerifyUser("mail@mail.com", "password")
+
+	assert.Equal(u.T(), expectedError, err)
+	assert.Equal(u.T(), model.UserDto{}, actual)
+}
+
+func (u *UserServiceTestSuite) Test_VerifyUser_WithLegacyPassword() {
+	user := mocks.GenerateUser()
+
+	u.userRepository.On("FindByEmail", user.Email).Return(user, nil)
+	u.userRepository.On("UpdatePassword", user.Id, mock.Anything).Return(nil)
+
+	actual, err := u.TestO.VerifyUser(user.Email, "password")
+
+	assert.Nil(u.T(), err)
+	assert.Equal(u.T(), user.ToDto(), actual)
+
+	upgraded := u.userRepository.Calls[1].Arguments.Get(1).([]byte)
+
+	assert.Nil(u.T(), bcrypt.CompareHashAndPassword(upgraded, []byte("password")))
+	u.userRepository.AssertNotCalled(u.T(), "ResetPassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserServiceTestSuite) Test_VerifyUser_WithInvalidLegacyPassword() {
+	user := mocks.GenerateUser()
+
+	u.userRepository.On("FindByEmail", user.Email).Return(user, nil)
+
+	actual, err := u.TestO.VerifyUser(user.Email, "invalid")
+
+	assert.Equal(u.T(), int_errors.NewErrUnauthorized("credentials are not valid"), err)
+	assert.Equal(u.T(), model.UserDto{}, actual)
+	u.userRepository.AssertNotCalled(u.T(), "UpdatePassword", mock.Anything, mock.Anything)
+}
+
+func (u *UserServiceTestSuite) Test_VerifyUser_WithErrorDuringUpgrade() {
+	user := mocks.GenerateUser()
+
+	u.userRepository.On("FindByEmail", user.Email).Return(user, nil)
+	u.userRepository.On("UpdatePassword", user.Id, mock.Anything).Return(errors.New("error"))
+
+	actual, err := u.TestO.VerifyUser(user.Email, "password")
+
+	assert.Nil(u.T(), err)
+	assert.Equal(u.T(), user.ToDto(), actual)
+}
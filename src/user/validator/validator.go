@@ -20,7 +20,7 @@ func NewUserRequestValidator() UserRequestValidator {
 
 type UserRequestValidator interface {
 	ValidateCreateRequest(request userModel.CreateUserRequest) error
-	ValidateUpdateRequest(request userModel.UpdateUserRequest) error
+	ValidateChangePasswordRequest(request userModel.ChangePasswordRequest) error
 }
 
 func (u *UserRequestValidatorObject) ValidateCreateRequest(request userModel.CreateUserRequest) error {
@@ -29,7 +29,8 @@ func (u *UserRequestValidatorObject) ValidateCreateRequest(request userModel.Cre
 		Result("Create User Request Validation Error")
 }
 
-func (u *UserRequestValidatorObject) ValidateUpdateRequest(request userModel.UpdateUserRequest) error {
-	return u.ValidateStringFieldNotEmpty(request.Password, "password should not be empty").
-		Result("Create User Request Validation Error")
+func (u *UserRequestValidatorObject) ValidateChangePasswordRequest(request userModel.ChangePasswordRequest) error {
+	return u.ValidateStringFieldNotEmpty(request.CurrentPassword, "current password should not be empty").
+		ValidateStringFieldNotEmpty(request.NewPassword, "new password should not be empty").
+		Result("Change Password Request Validation Error")
 }
@@ -3,6 +3,7 @@ package validator
 import (
 	"github.com/VlasovArtem/hob/src/common/int-errors"
 	"github.com/VlasovArtem/hob/src/user/mocks"
+	"github.com/VlasovArtem/hob/src/user/model"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -59,3 +60,21 @@ func Test_WithCreateUserRequest_WithAllErrors(t *testing.T) {
 	assert.Equal(t, "Create User Request Validation Error", response.Message)
 	assert.ElementsMatch(t, []string{"email should not be empty", "password should not be empty"}, response.Details)
 }
+
+func Test_WithChangePasswordRequest(t *testing.T) {
+	validator := NewUserRequestValidator()
+
+	assert.Nil(t, validator.ValidateChangePasswordRequest(mocks.GenerateChangePasswordRequest()))
+}
+
+func Test_WithChangePasswordRequest_WithEmptyPasswords(t *testing.T) {
+	validator := NewUserRequestValidator()
+
+	result := validator.ValidateChangePasswordRequest(model.ChangePasswordRequest{}).(*int_errors.ErrResponse)
+
+	assert.NotNil(t, result)
+	response := result.Response.(*int_errors.ErrorResponseObject)
+
+	assert.Equal(t, "Change Password Request Validation Error", response.Message)
+	assert.Equal(t, []string{"current password should not be empty", "new password should not be empty"}, response.Details)
+}